@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -8,11 +9,16 @@ import (
 	"net/http"
 	_ "net/http/pprof" //nolint:gosec // registration on DefaultServeMux is expected
 	"net/url"
+	"os"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/app/parkserver"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/blob"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/geocoding"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/push"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 	"github.com/sourcegraph/conc"
@@ -60,14 +66,94 @@ func (c *DBConfig) String() string {
 	return c.URL.String()
 }
 
+type PushConfig struct {
+	FCMCredentials string `name:"fcm-credentials" placeholder:"FILE" env:"FCM_CREDENTIALS" help:"Google service account key (JSON) used to push to Android devices through FCM."`
+	APNsKey        string `name:"apns-key" placeholder:"FILE" env:"APNS_KEY" help:"APNs signing key (.p8) used to push to iOS devices."`
+	APNsKeyID      string `name:"apns-key-id" placeholder:"ID" env:"APNS_KEY_ID" help:"ID of the APNs signing key."`
+	APNsTeamID     string `name:"apns-team-id" placeholder:"ID" env:"APNS_TEAM_ID" help:"ID of the Apple developer team owning the APNs signing key."`
+	APNsTopic      string `name:"apns-topic" placeholder:"BUNDLE-ID" env:"APNS_TOPIC" help:"Bundle ID of the iOS app."`
+	APNsSandbox    bool   `name:"apns-sandbox" env:"APNS_SANDBOX" help:"Use the APNs development environment."`
+}
+
+// Returns the push providers by device platform, only including the configured ones
+func (c *PushConfig) Pushers(client *http.Client) (map[string]push.Pusher, error) {
+	result := make(map[string]push.Pusher)
+	if c.FCMCredentials != "" {
+		credentials, err := os.ReadFile(c.FCMCredentials)
+		if err != nil {
+			return nil, fmt.Errorf("could not read FCM credentials: %w", err)
+		}
+		fcm, err := push.NewFCM(client, credentials)
+		if err != nil {
+			return nil, fmt.Errorf("invalid FCM credentials: %w", err)
+		}
+		result[models.DevicePlatformAndroid] = fcm
+	}
+	if c.APNsKey != "" {
+		key, err := os.ReadFile(c.APNsKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not read APNs key: %w", err)
+		}
+		apns, err := push.NewAPNs(client, &push.APNsConfig{
+			Key:     key,
+			KeyID:   c.APNsKeyID,
+			TeamID:  c.APNsTeamID,
+			Topic:   c.APNsTopic,
+			Sandbox: c.APNsSandbox,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("invalid APNs key: %w", err)
+		}
+		result[models.DevicePlatformIOS] = apns
+	}
+	return result, nil
+}
+
+type MediaConfig struct {
+	S3Endpoint  *url.URL `name:"s3-endpoint" placeholder:"URL" env:"S3_ENDPOINT" help:"S3 API endpoint where uploaded images are stored (example: https://s3.us-east-1.amazonaws.com). Images are stored in the media directory if not specified."`
+	Dir         string   `placeholder:"DIR" env:"DIR" default:"media" help:"Directory where uploaded images are stored when S3 is not configured (default: ${default})."`
+	S3Region    string   `name:"s3-region" placeholder:"REGION" env:"S3_REGION" help:"Region of the S3 bucket (default: us-east-1)."`
+	S3Bucket    string   `name:"s3-bucket" placeholder:"BUCKET" env:"S3_BUCKET" help:"S3 bucket where uploaded images are stored."`
+	S3AccessKey string   `name:"s3-access-key" placeholder:"KEY" env:"S3_ACCESS_KEY" help:"Access key ID used to access the S3 bucket."`
+	S3SecretKey string   `name:"s3-secret-key" placeholder:"KEY" env:"S3_SECRET_KEY" help:"Secret access key used to access the S3 bucket."`
+}
+
+// Returns the storage of uploaded images, an S3 bucket if configured or a local directory otherwise
+func (c *MediaConfig) BlobStore(client *http.Client) (blob.BlobStore, error) {
+	if c.S3Endpoint == nil {
+		store, err := blob.NewLocal(c.Dir)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	}
+	store, err := blob.NewS3(client, &blob.S3Config{
+		Endpoint:  c.S3Endpoint,
+		Region:    c.S3Region,
+		Bucket:    c.S3Bucket,
+		AccessKey: c.S3AccessKey,
+		SecretKey: c.S3SecretKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 configuration: %w", err)
+	}
+	return store, nil
+}
+
 type ServeCmd struct {
-	APIPrefix      *url.URL `env:"API_PREFIX" placeholder:"PREFIX" help:"Specify the base prefix of the API server (example: http://localhost:8080/). If not specified, will be set to localhost at serve port."`
-	CorsOrigin     string   `placeholder:"ORIGIN" env:"CORS_ORIGIN" help:"Allow pages from ORIGIN to access the API server."`
-	GeocodioAPIKey string   `placeholder:"API-KEY" env:"GEOCODIO_API_KEY" help:"API key for geocod.io service."`
-	DB             DBConfig `embed:"" group:"db" prefix:"db-" envprefix:"DB_"`
-	Port           uint16   `short:"p" placeholder:"PORT" env:"PORT" default:"8080" help:"Port to serve the server on (default: ${default})."`
-	ProfilerPort   uint16   `placeholder:"PORT" env:"PROFILER_PORT" help:"Port to serve pprof endpoints on (disabled by default)."`
-	Insecure       bool     `env:"INSECURE" help:"Run in insecure mode for development (ie. CORS allow-all, HTTP cookies)."`
+	APIPrefix        *url.URL    `env:"API_PREFIX" placeholder:"PREFIX" help:"Specify the base prefix of the API server (example: http://localhost:8080/). If not specified, will be set to localhost at serve port."`
+	CorsOrigin       string      `placeholder:"ORIGIN" env:"CORS_ORIGIN" help:"Allow pages from ORIGIN to access the API server."`
+	GeocodioAPIKey   string      `placeholder:"API-KEY" env:"GEOCODIO_API_KEY" help:"API key for geocod.io service."`
+	NominatimURL     *url.URL    `placeholder:"URL" env:"NOMINATIM_URL" help:"Base URL of a Nominatim server used when geocod.io is unavailable (example: https://nominatim.openstreetmap.org). Disabled if not specified."`
+	GeocodingData    string      `placeholder:"FILE" env:"GEOCODING_DATA" help:"CSV dataset used for offline geocoding. Defaults to a development dataset covering the load test addresses."`
+	DB               DBConfig    `embed:"" group:"db" prefix:"db-" envprefix:"DB_"`
+	Media            MediaConfig `embed:"" group:"media" prefix:"media-" envprefix:"MEDIA_"`
+	Push             PushConfig  `embed:"" group:"push" prefix:"push-" envprefix:"PUSH_"`
+	Port             uint16      `short:"p" placeholder:"PORT" env:"PORT" default:"8080" help:"Port to serve the server on (default: ${default})."`
+	ProfilerPort     uint16      `placeholder:"PORT" env:"PROFILER_PORT" help:"Port to serve pprof endpoints on (disabled by default)."`
+	Insecure         bool        `env:"INSECURE" help:"Run in insecure mode for development (ie. CORS allow-all, HTTP cookies)."`
+	OfflineGeocoding bool        `env:"OFFLINE_GEOCODING" help:"Resolve addresses from a local dataset instead of geocod.io, for development and tests."`
+	AllowLoopback    bool        `env:"ALLOW_LOOPBACK" help:"Allow webhooks and imported calendars on this machine, for development and tests."`
 }
 
 func (s *ServeCmd) getAPIPrefix() string {
@@ -82,6 +168,24 @@ func (s *ServeCmd) getAPIPrefix() string {
 	return s.APIPrefix.String()
 }
 
+// Returns the offline geocoder if offline geocoding is enabled, nil otherwise
+func (s *ServeCmd) offlineGeocoder() (*geocoding.Offline, error) {
+	if !s.OfflineGeocoding {
+		return nil, nil //nolint:nilnil // offline geocoding is optional
+	}
+	var geocoder *geocoding.Offline
+	var err error
+	if s.GeocodingData == "" {
+		geocoder, err = geocoding.LoadOffline(bytes.NewReader(geocoding.DevDataset))
+	} else {
+		geocoder, err = geocoding.LoadOfflineFile(s.GeocodingData)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load geocoding dataset: %w", err)
+	}
+	return geocoder, nil
+}
+
 func (s *ServeCmd) Run(ctx context.Context, l *zerolog.Logger, globals *Globals) error {
 	log := globals.ConfigureZerolog(l).
 		With().
@@ -89,7 +193,13 @@ func (s *ServeCmd) Run(ctx context.Context, l *zerolog.Logger, globals *Globals)
 		Logger()
 
 	ctx = log.WithContext(ctx)
-	if s.GeocodioAPIKey == "" {
+	offlineGeocoder, err := s.offlineGeocoder()
+	if err != nil {
+		return err
+	}
+	if offlineGeocoder != nil {
+		log.Warn().Msg("using offline geocoding, addresses outside of the dataset cannot be listed")
+	} else if s.GeocodioAPIKey == "" {
 		log.Warn().Msg("no geocodio api key provided, some features might not work")
 	}
 
@@ -116,6 +226,19 @@ func (s *ServeCmd) Run(ctx context.Context, l *zerolog.Logger, globals *Globals)
 		})
 	}
 
+	pushers, err := s.Push.Pushers(http.DefaultClient)
+	if err != nil {
+		return err
+	}
+	if len(pushers) == 0 {
+		log.Warn().Msg("no push provider configured, notifications will not be pushed to devices")
+	}
+
+	blobStore, err := s.Media.BlobStore(http.DefaultClient)
+	if err != nil {
+		return err
+	}
+
 	pool, err := pgxpool.New(ctx, s.DB.String())
 	if err != nil {
 		return fmt.Errorf("could not connect to database: %w", err)
@@ -126,9 +249,20 @@ func (s *ServeCmd) Run(ctx context.Context, l *zerolog.Logger, globals *Globals)
 		DBPool:         pool,
 		APIPrefix:      s.getAPIPrefix(),
 		GeocodioAPIKey: s.GeocodioAPIKey,
+		NominatimURL:   s.NominatimURL,
 		Addr:           net.JoinHostPort("", strconv.Itoa(int(s.Port))),
 		Insecure:       s.Insecure,
+		AllowLoopback:  s.AllowLoopback,
 		CorsOrigin:     s.CorsOrigin,
+		Pushers:        pushers,
+		BlobStore:      blobStore,
+	}
+
+	if offlineGeocoder != nil {
+		config.Geocoder = offlineGeocoder
+	}
+	if s.AllowLoopback {
+		log.Warn().Msg("allowing webhooks and calendar imports to reach this machine")
 	}
 
 	log.Info().Msg("running migrations")
@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/blob"
 	"github.com/alecthomas/kong"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -138,3 +141,89 @@ func TestAPIPrefix(t *testing.T) {
 		assert.Equal(t, "http://localhost", cli.getAPIPrefix())
 	})
 }
+
+func TestPushConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no providers by default", func(t *testing.T) {
+		t.Parallel()
+
+		var cli ServeCmd
+		k, err := kong.New(&cli)
+		require.NoError(t, err)
+		_, err = k.Parse([]string{})
+		require.NoError(t, err)
+
+		pushers, err := cli.Push.Pushers(http.DefaultClient)
+		require.NoError(t, err)
+		assert.Empty(t, pushers)
+	})
+
+	t.Run("invalid credentials are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		credentials := filepath.Join(t.TempDir(), "credentials.json")
+		require.NoError(t, os.WriteFile(credentials, []byte("{}"), 0o600))
+
+		var cli ServeCmd
+		k, err := kong.New(&cli)
+		require.NoError(t, err)
+		_, err = k.Parse([]string{"--push-fcm-credentials", credentials})
+		require.NoError(t, err)
+
+		_, err = cli.Push.Pushers(http.DefaultClient)
+		require.Error(t, err)
+	})
+}
+
+func TestMediaConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("local directory by default", func(t *testing.T) {
+		t.Parallel()
+
+		dir := filepath.Join(t.TempDir(), "media")
+		var cli ServeCmd
+		k, err := kong.New(&cli)
+		require.NoError(t, err)
+		_, err = k.Parse([]string{"--media-dir", dir})
+		require.NoError(t, err)
+
+		store, err := cli.Media.BlobStore(http.DefaultClient)
+		require.NoError(t, err)
+		assert.IsType(t, &blob.Local{}, store)
+		assert.DirExists(t, dir)
+	})
+
+	t.Run("s3 when an endpoint is given", func(t *testing.T) {
+		t.Parallel()
+
+		var cli ServeCmd
+		k, err := kong.New(&cli)
+		require.NoError(t, err)
+		_, err = k.Parse([]string{
+			"--media-s3-endpoint", "http://localhost:9000",
+			"--media-s3-bucket", "parkeasy",
+			"--media-s3-access-key", "minio",
+			"--media-s3-secret-key", "minio123",
+		})
+		require.NoError(t, err)
+
+		store, err := cli.Media.BlobStore(http.DefaultClient)
+		require.NoError(t, err)
+		assert.IsType(t, &blob.S3{}, store)
+	})
+
+	t.Run("incomplete s3 configuration is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		var cli ServeCmd
+		k, err := kong.New(&cli)
+		require.NoError(t, err)
+		_, err = k.Parse([]string{"--media-s3-endpoint", "http://localhost:9000"})
+		require.NoError(t, err)
+
+		_, err = cli.Media.BlobStore(http.DefaultClient)
+		require.Error(t, err)
+	})
+}
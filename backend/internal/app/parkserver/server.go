@@ -5,11 +5,18 @@ import (
 	"errors"
 	"net"
 	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/admin"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/blob"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/geocoding"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/preferencespot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/push"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/quote"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/resettoken"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/review"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/routes"
 	"github.com/sourcegraph/conc"
 	"github.com/stephenafamo/bob"
@@ -27,9 +34,48 @@ import (
 	parkingSpotRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/parkingspot"
 
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/spotphoto"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/photo"
+
 	bookingRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	holdRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/hold"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/booking"
 
+	promoCodeRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/promocode"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/promocode"
+
+	messageRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/message"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/message"
+
+	availabilityRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/availability"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/availability"
+
+	notificationRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/notification"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/notification"
+
+	deviceRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/device"
+	pushService "github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/push"
+
+	alertRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/alert"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/alert"
+
+	savedSearchRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/savedsearch"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/savedsearch"
+
+	webhookRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/webhook"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/webhook"
+
+	outboxRepo "github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/outbox"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/outbox"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/calendarfeed"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/calendarimport"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/calendar"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/ratelimit"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/safehttp"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/geocode"
+
 	"github.com/alexedwards/scs/pgxstore"
 	"github.com/alexedwards/scs/v2"
 	"github.com/danielgtaylor/huma/v2"
@@ -39,6 +85,23 @@ import (
 	"github.com/rs/cors"
 )
 
+const (
+	// Number of attempts at geocoding an address with a provider
+	geocodingAttempts = 3
+	// Delay before the second geocoding attempt, doubled for each following attempt
+	geocodingRetryDelay = 200 * time.Millisecond
+	// Longest time given to each geocoding attempt
+	geocodingTimeout = 5 * time.Second
+	// Number of consecutive failed geocoding requests after which a provider is skipped
+	geocodingFailureThreshold = 5
+	// How long a failing geocoding provider is skipped
+	geocodingCooldown = 30 * time.Second
+	// Number of reverse geocoding and address suggestion requests each user can send per second
+	geocodeRequestRate = 1
+	// Number of reverse geocoding and address suggestion requests each user can send at once
+	geocodeRequestBurst = 20
+)
+
 type Config struct {
 	// Database pool for Postgres connection
 	DBPool *pgxpool.Pool
@@ -46,12 +109,24 @@ type Config struct {
 	APIPrefix string
 	// Geocodio API key
 	GeocodioAPIKey string
+	// Base URL of the Nominatim server used when Geocodio is unavailable, nil or empty if none
+	NominatimURL *url.URL
+	// Geocoder used instead of the providers above and their cache, nil to use the providers
+	Geocoder geocoding.Geocoder
 	// The address to run the server on
 	Addr string
 	// The origin to allow cross-origin request from.
 	CorsOrigin string
+	// Push providers by device platform, devices on other platforms do not receive push notifications
+	Pushers map[string]push.Pusher
+	// Storage of uploaded images
+	BlobStore blob.BlobStore
+	// Background tasks registered along with the routes, run while the server is up
+	workers []func(ctx context.Context)
 	// Whether to run server in insecure mode. This allows cookies to be transferred over plain HTTP.
 	Insecure bool
+	// Whether requests to URLs provided by users may reach loopback addresses, for development and tests
+	AllowLoopback bool
 }
 
 // Register all routes
@@ -67,34 +142,148 @@ func (c *Config) RegisterRoutes(api huma.API, sessionManager *scs.SessionManager
 	userService := user.NewService(authService, userRepository)
 	userRoute := routes.NewUserRoute(userService, sessionManager)
 
-	geocodioRepository := geocoding.NewGeocodio(http.DefaultClient, c.GeocodioAPIKey)
+	geocoder := c.Geocoder
+	if geocoder == nil {
+		geocodingCache := geocoding.NewPostgresCache(db)
+		c.workers = append(c.workers, geocodingCache.RunCleanup)
+		geocoder = geocoding.NewCache(c.geocodingProviders(), geocodingCache, geocoding.DefaultCacheTTL)
+	}
 
 	preferenceSpotRepository := preferencespot.NewPostgres(db)
 
+	pricingRepository := pricing.NewPostgres(db)
+
+	photoRepository := spotphoto.NewPostgres(db)
+
+	deviceRepository := deviceRepo.NewPostgres(db)
+	devicePushService := pushService.New(deviceRepository, c.Pushers)
+	deviceRoute := routes.NewDeviceRoute(devicePushService, sessionManager)
+
+	notificationRepository := notificationRepo.NewPostgres(db)
+	notificationService := notification.New(notificationRepository, devicePushService)
+	notificationRoute := routes.NewNotificationRoute(notificationService, sessionManager)
+
 	parkingSpotRepository := parkingSpotRepo.NewPostgres(db)
-	parkingSpotService := parkingspot.New(parkingSpotRepository, geocodioRepository, preferenceSpotRepository)
+	carRepository := carRepo.NewPostgres(db)
+	parkingSpotService := parkingspot.New(parkingSpotRepository, geocoder, preferenceSpotRepository, pricingRepository, photoRepository, carRepository, notificationService)
 	parkingSpotRoute := routes.NewParkingSpotRoute(parkingSpotService, sessionManager)
 
-	carRepository := carRepo.NewPostgres(db)
+	photoService := photo.New(photoRepository, parkingSpotRepository, c.BlobStore)
+	photoRoute := routes.NewPhotoRoute(photoService, sessionManager)
+
 	carService := car.New(carRepository)
 	carRoute := routes.NewCarRoute(carService, sessionManager)
 
 	healthService := health.New(c.DBPool)
 	healthRoute := routes.NewHealthRoute(healthService)
 
+	adminRepository := admin.NewPostgres(db)
+
+	promoCodeRepository := promoCodeRepo.NewPostgres(db)
+	promoCodeService := promocode.New(promoCodeRepository, adminRepository, parkingSpotRepository)
+	promoCodeRoute := routes.NewPromoCodeRoute(promoCodeService, sessionManager)
+
+	reviewRepository := review.NewPostgres(db)
+
 	bookingRepository := bookingRepo.NewPostgres(db)
-	bookingService := booking.New(bookingRepository, parkingSpotRepository, carRepository)
+	quoteRepository := quote.NewPostgres(db)
+	c.workers = append(c.workers, quoteRepository.RunCleanup)
+	holdRepository := holdRepo.NewPostgres(db)
+	bookingService := booking.New(bookingRepository, parkingSpotRepository, carRepository, pricingRepository, quoteRepository, promoCodeRepository, reviewRepository, holdRepository, notificationService)
 	bookingRoute := routes.NewBookingRoute(bookingService, sessionManager)
+	reviewRoute := routes.NewReviewRoute(bookingService, sessionManager)
+	holdRoute := routes.NewHoldRoute(bookingService, sessionManager)
+	c.workers = append(c.workers, bookingService.RunHoldExpiry, bookingService.RunReminders)
+
+	messageRepository := messageRepo.NewPostgres(db)
+	messageService := message.New(messageRepository, bookingRepository, parkingSpotRepository, message.ContactRedactor{})
+	messageRoute := routes.NewMessageRoute(messageService, sessionManager)
+
+	availabilityListener := availabilityRepo.NewPostgresListener(c.DBPool)
+	availabilityService := availability.New(availabilityListener, parkingSpotRepository)
+	availabilityRoute := routes.NewAvailabilityRoute(availabilityService)
+	c.workers = append(c.workers, availabilityService.Run)
+
+	alertRepository := alertRepo.NewPostgres(db)
+	alertService := alert.New(alertRepository, parkingSpotRepository, notificationService)
+	alertRoute := routes.NewAlertRoute(alertService, sessionManager)
+
+	savedSearchRepository := savedSearchRepo.NewPostgres(db)
+	savedSearchService := savedsearch.New(savedSearchRepository, parkingSpotRepository, notificationService)
+	savedSearchRoute := routes.NewSavedSearchRoute(savedSearchService, sessionManager)
+
+	// Webhooks and imported calendars are requested from URLs given by users
+	urlGuard := safehttp.New(c.AllowLoopback)
+
+	webhookRepository := webhookRepo.NewPostgres(db)
+	webhookService := webhook.New(webhookRepository, parkingSpotRepository, urlGuard)
+	webhookRoute := routes.NewWebhookRoute(webhookService, sessionManager)
+	c.workers = append(c.workers, webhookService.RunDeliveries)
+
+	outboxRepository := outboxRepo.NewPostgres(db)
+	outboxService := outbox.New(outboxRepository)
+	outboxService.Subscribe("notifications", bookingService.HandleEvent)
+	outboxService.Subscribe("webhooks", webhookService.HandleEvent)
+	outboxService.Subscribe("alerts", outbox.AvailabilityHandler(alertService.HandleAvailability))
+	outboxService.Subscribe("saved searches", outbox.AvailabilityHandler(savedSearchService.HandleAvailability))
+	outboxService.Subscribe("spot owners", outbox.AvailabilityHandler(parkingSpotService.HandleAvailability))
+	c.workers = append(c.workers, outboxService.Run)
+
+	calendarFeedRepository := calendarfeed.NewPostgres(db)
+	calendarImportRepository := calendarimport.NewPostgres(db)
+	calendarService := calendar.New(
+		calendarFeedRepository,
+		calendarImportRepository,
+		bookingRepository,
+		parkingSpotRepository,
+		parkingSpotService,
+		urlGuard,
+	)
+	c.workers = append(c.workers, calendarService.RunImports)
+	calendarRoute := routes.NewCalendarRoute(calendarService, sessionManager)
+
+	geocodeService := geocode.New(geocoder)
+	geocodeRoute := routes.NewGeocodeRoute(geocodeService, sessionManager, ratelimit.New[int64](geocodeRequestRate, geocodeRequestBurst))
 
 	routes.UseHumaMiddlewares(api, sessionManager, userService)
 	huma.AutoRegister(api, authRoute)
 	huma.AutoRegister(api, userRoute)
 	huma.AutoRegister(api, parkingSpotRoute)
+	huma.AutoRegister(api, photoRoute)
 	huma.AutoRegister(api, carRoute)
 	huma.AutoRegister(api, bookingRoute)
+	huma.AutoRegister(api, promoCodeRoute)
+	huma.AutoRegister(api, reviewRoute)
+	huma.AutoRegister(api, holdRoute)
+	huma.AutoRegister(api, messageRoute)
+	huma.AutoRegister(api, availabilityRoute)
+	huma.AutoRegister(api, notificationRoute)
+	huma.AutoRegister(api, deviceRoute)
+	huma.AutoRegister(api, alertRoute)
+	huma.AutoRegister(api, savedSearchRoute)
+	huma.AutoRegister(api, webhookRoute)
+	huma.AutoRegister(api, calendarRoute)
+	huma.AutoRegister(api, geocodeRoute)
 	huma.AutoRegister(api, healthRoute)
 }
 
+// Returns a Geocoder using the configured geocoding providers in order of preference.
+//
+// Temporary failures of each provider are retried, and providers that keep failing are skipped for a while.
+func (c *Config) geocodingProviders() geocoding.Geocoder {
+	providers := []geocoding.Geocoder{geocoding.NewGeocodio(http.DefaultClient, c.GeocodioAPIKey)}
+	if c.NominatimURL != nil && c.NominatimURL.Host != "" {
+		providers = append(providers, geocoding.NewNominatim(http.DefaultClient, c.NominatimURL, "ParkEasy"))
+	}
+
+	chain := make([]geocoding.Geocoder, 0, len(providers))
+	for _, provider := range providers {
+		retry := geocoding.NewRetry(provider, geocodingAttempts, geocodingRetryDelay, geocodingTimeout)
+		chain = append(chain, geocoding.NewCircuitBreaker(retry, geocodingFailureThreshold, geocodingCooldown))
+	}
+	return geocoding.NewFailover(chain...)
+}
+
 // Creates a new Huma API instance with routes configured
 func (c *Config) NewHumaAPI() huma.API {
 	router := http.NewServeMux()
@@ -134,6 +323,9 @@ func (c *Config) ListenAndServe(ctx context.Context) error {
 	defer wg.Wait()
 
 	api := c.NewHumaAPI()
+	for _, worker := range c.workers {
+		wg.Go(func() { worker(ctx) })
+	}
 
 	srv := http.Server{
 		Addr:              c.Addr,
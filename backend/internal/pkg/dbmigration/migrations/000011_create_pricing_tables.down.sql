@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS PricingRule;
+DROP TABLE IF EXISTS SpotPricing;
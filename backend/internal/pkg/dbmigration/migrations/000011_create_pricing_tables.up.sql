@@ -0,0 +1,19 @@
+-- Spot-wide pricing settings
+CREATE TABLE IF NOT EXISTS SpotPricing (
+  ParkingSpotId BIGINT PRIMARY KEY REFERENCES ParkingSpot(ParkingSpotId),
+  MinimumCharge DECIMAL NOT NULL DEFAULT 0,
+  DailyMaximum DECIMAL NOT NULL DEFAULT 0
+);
+
+-- Pricing rules, the first matching rule for a time slot sets its price
+CREATE TABLE IF NOT EXISTS PricingRule (
+  PricingRuleId BIGSERIAL PRIMARY KEY,
+  ParkingSpotId BIGINT NOT NULL REFERENCES ParkingSpot(ParkingSpotId),
+  Position INTEGER NOT NULL,
+  RuleDate DATE DEFAULT NULL,
+  Weekdays SMALLINT NOT NULL DEFAULT 127,
+  StartMinute INTEGER NOT NULL DEFAULT 0,
+  EndMinute INTEGER NOT NULL DEFAULT 1440,
+  PricePerHour DECIMAL NOT NULL,
+  UNIQUE (ParkingSpotId, Position)
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS BookingPromoCodeIdx;
+ALTER TABLE Booking
+  DROP COLUMN IF EXISTS PayoutAmount,
+  DROP COLUMN IF EXISTS DiscountAmount,
+  DROP COLUMN IF EXISTS PromoCodeId;
+DROP TABLE IF EXISTS PromoCode;
+DROP TABLE IF EXISTS Administrator;
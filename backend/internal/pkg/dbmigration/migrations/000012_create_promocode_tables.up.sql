@@ -0,0 +1,35 @@
+-- Users allowed to manage platform-wide settings such as promo codes
+CREATE TABLE IF NOT EXISTS Administrator (
+  UserId BIGINT PRIMARY KEY REFERENCES Users(UserId),
+  AddedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Promo codes discounting bookings
+CREATE TABLE IF NOT EXISTS PromoCode (
+  PromoCodeId BIGSERIAL PRIMARY KEY,
+  PromoCodeUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  Code TEXT UNIQUE NOT NULL,
+  DiscountType TEXT NOT NULL CHECK (DiscountType IN ('percentage', 'fixed')),
+  DiscountValue DECIMAL NOT NULL,
+  MinimumSpend DECIMAL NOT NULL DEFAULT 0,
+  ExpiresAt TIMESTAMPTZ DEFAULT NULL,
+  MaxUses INTEGER NOT NULL DEFAULT 0,
+  MaxUsesPerUser INTEGER NOT NULL DEFAULT 0,
+  ParkingSpotId BIGINT DEFAULT NULL REFERENCES ParkingSpot(ParkingSpotId),
+  OwnerId BIGINT DEFAULT NULL REFERENCES Users(UserId),
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS PromoCodeUUIDIdx ON PromoCode(PromoCodeUUID);
+
+-- Discounts applied to bookings and the resulting seller payout
+ALTER TABLE Booking
+  ADD COLUMN PromoCodeId BIGINT DEFAULT NULL REFERENCES PromoCode(PromoCodeId),
+  ADD COLUMN DiscountAmount DECIMAL NOT NULL DEFAULT 0,
+  ADD COLUMN PayoutAmount DECIMAL;
+
+UPDATE Booking SET PayoutAmount = PaidAmount;
+
+ALTER TABLE Booking ALTER COLUMN PayoutAmount SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS BookingPromoCodeIdx ON Booking(PromoCodeId);
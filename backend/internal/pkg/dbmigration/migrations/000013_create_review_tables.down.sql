@@ -0,0 +1,4 @@
+ALTER TABLE ParkingSpot
+  DROP COLUMN IF EXISTS RatingTotal,
+  DROP COLUMN IF EXISTS RatingCount;
+DROP TABLE IF EXISTS Review;
@@ -0,0 +1,21 @@
+-- Reviews left by the participants of a booking
+--
+-- Drivers review the spot (Subject = 'spot') and hosts review the driver (Subject = 'driver')
+CREATE TABLE IF NOT EXISTS Review (
+  ReviewId BIGSERIAL PRIMARY KEY,
+  ReviewUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  BookingId BIGINT NOT NULL REFERENCES Booking(BookingId),
+  ReviewerId BIGINT NOT NULL REFERENCES Users(UserId),
+  Subject TEXT NOT NULL CHECK (Subject IN ('spot', 'driver')),
+  Rating SMALLINT NOT NULL CHECK (Rating BETWEEN 1 AND 5),
+  Comment TEXT NOT NULL DEFAULT '',
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (BookingId, Subject)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ReviewUUIDIdx ON Review(ReviewUUID);
+
+-- Aggregated spot ratings, maintained alongside spot reviews
+ALTER TABLE ParkingSpot
+  ADD COLUMN RatingCount INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN RatingTotal INTEGER NOT NULL DEFAULT 0;
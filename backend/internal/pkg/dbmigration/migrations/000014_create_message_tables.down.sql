@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS MessageUnreadIdx;
+DROP INDEX IF EXISTS MessageBookingIdx;
+DROP TABLE IF EXISTS Message;
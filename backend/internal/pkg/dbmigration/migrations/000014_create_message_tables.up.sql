@@ -0,0 +1,18 @@
+-- Messages exchanged between the booker and the spot owner of a booking
+CREATE TABLE IF NOT EXISTS Message (
+  MessageId BIGSERIAL PRIMARY KEY,
+  MessageUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  BookingId BIGINT NOT NULL REFERENCES Booking(BookingId),
+  SenderId BIGINT NOT NULL REFERENCES Users(UserId),
+  Body TEXT NOT NULL,
+  Redacted BOOLEAN NOT NULL DEFAULT FALSE,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  ReadAt TIMESTAMPTZ DEFAULT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS MessageUUIDIdx ON Message(MessageUUID);
+
+CREATE INDEX IF NOT EXISTS MessageBookingIdx ON Message(BookingId, MessageId);
+
+-- Speeds up unread counts
+CREATE INDEX IF NOT EXISTS MessageUnreadIdx ON Message(BookingId) WHERE ReadAt IS NULL;
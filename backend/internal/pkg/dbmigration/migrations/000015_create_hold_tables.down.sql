@@ -0,0 +1,5 @@
+ALTER TABLE TimeUnit
+DROP COLUMN IF EXISTS HoldId;
+
+DROP INDEX IF EXISTS HoldExpiresAtIdx;
+DROP TABLE IF EXISTS Hold;
@@ -0,0 +1,17 @@
+-- Short-lived locks on time units while a driver checks out
+CREATE TABLE IF NOT EXISTS Hold (
+  HoldId BIGSERIAL PRIMARY KEY,
+  HoldUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  UserId BIGINT NOT NULL REFERENCES Users(UserId),
+  ParkingSpotId BIGINT NOT NULL REFERENCES ParkingSpot(ParkingSpotId),
+  ExpiresAt TIMESTAMPTZ NOT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS HoldUUIDIdx ON Hold(HoldUUID);
+
+CREATE INDEX IF NOT EXISTS HoldExpiresAtIdx ON Hold(ExpiresAt);
+
+-- Deleting a hold releases its time units
+ALTER TABLE TimeUnit
+ADD HoldId BIGINT DEFAULT NULL REFERENCES Hold(HoldId) ON DELETE SET NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS AvailabilityAlertTargetIdx;
+DROP INDEX IF EXISTS AvailabilityAlertSpotIdx;
+DROP TABLE IF EXISTS AvailabilityAlert;
+
+DROP INDEX IF EXISTS NotificationUserIdx;
+DROP TABLE IF EXISTS Notification;
@@ -0,0 +1,42 @@
+-- In-app notifications of a user
+CREATE TABLE IF NOT EXISTS Notification (
+  NotificationId BIGSERIAL PRIMARY KEY,
+  NotificationUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  UserId BIGINT NOT NULL REFERENCES Users(UserId),
+  Type TEXT NOT NULL,
+  Title TEXT NOT NULL,
+  Body TEXT NOT NULL,
+  -- The resource this notification is about
+  SubjectUUID UUID DEFAULT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  ReadAt TIMESTAMPTZ DEFAULT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS NotificationUUIDIdx ON Notification(NotificationUUID);
+
+CREATE INDEX IF NOT EXISTS NotificationUserIdx ON Notification(UserId, NotificationId);
+
+-- Subscriptions to availability of a parking spot or of an area
+CREATE TABLE IF NOT EXISTS AvailabilityAlert (
+  AlertId BIGSERIAL PRIMARY KEY,
+  AlertUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  UserId BIGINT NOT NULL REFERENCES Users(UserId),
+  ParkingSpotId BIGINT DEFAULT NULL REFERENCES ParkingSpot(ParkingSpotId) ON DELETE CASCADE,
+  Longitude DECIMAL(8,5) DEFAULT NULL,
+  Latitude DECIMAL(8,5) DEFAULT NULL,
+  -- Radius of the area in meters
+  Distance INTEGER DEFAULT NULL,
+  LastNotifiedAt TIMESTAMPTZ DEFAULT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  -- Alerts are either for a spot or for an area
+  CONSTRAINT alert_target_check CHECK (
+    (ParkingSpotId IS NOT NULL AND Longitude IS NULL AND Latitude IS NULL AND Distance IS NULL)
+    OR (ParkingSpotId IS NULL AND Longitude IS NOT NULL AND Latitude IS NOT NULL AND Distance IS NOT NULL)
+  )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS AvailabilityAlertUUIDIdx ON AvailabilityAlert(AlertUUID);
+
+CREATE UNIQUE INDEX IF NOT EXISTS AvailabilityAlertSpotIdx ON AvailabilityAlert(UserId, ParkingSpotId) WHERE ParkingSpotId IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS AvailabilityAlertTargetIdx ON AvailabilityAlert(ParkingSpotId);
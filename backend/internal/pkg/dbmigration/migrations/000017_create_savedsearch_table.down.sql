@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS SavedSearchUserIdx;
+DROP TABLE IF EXISTS SavedSearch;
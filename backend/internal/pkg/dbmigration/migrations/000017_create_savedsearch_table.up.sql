@@ -0,0 +1,28 @@
+-- Parking spot searches saved by drivers to be notified of new matches
+CREATE TABLE IF NOT EXISTS SavedSearch (
+  SavedSearchId BIGSERIAL PRIMARY KEY,
+  SavedSearchUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  UserId BIGINT NOT NULL REFERENCES Users(UserId),
+  Name TEXT NOT NULL,
+  Longitude DECIMAL(8,5) NOT NULL,
+  Latitude DECIMAL(8,5) NOT NULL,
+  -- Radius of the searched area in meters
+  Distance INTEGER NOT NULL,
+  -- Bitmask of the matching days, indexed by day of the week starting on Sunday
+  Weekdays SMALLINT NOT NULL DEFAULT 127,
+  -- Time band of matching slots, in minutes since midnight of the spot local time
+  StartMinute INTEGER NOT NULL DEFAULT 0,
+  EndMinute INTEGER NOT NULL DEFAULT 1440,
+  -- 0 if there is no limit
+  MaxPricePerHour DECIMAL(12, 2) NOT NULL DEFAULT 0,
+  HasShelter BOOLEAN NOT NULL DEFAULT FALSE,
+  HasPlugIn BOOLEAN NOT NULL DEFAULT FALSE,
+  HasChargingStation BOOLEAN NOT NULL DEFAULT FALSE,
+  LastNotifiedAt TIMESTAMPTZ DEFAULT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT savedsearch_time_check CHECK (0 <= StartMinute AND StartMinute < EndMinute AND EndMinute <= 1440)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS SavedSearchUUIDIdx ON SavedSearch(SavedSearchUUID);
+
+CREATE INDEX IF NOT EXISTS SavedSearchUserIdx ON SavedSearch(UserId);
@@ -0,0 +1,4 @@
+ALTER TABLE Booking DROP COLUMN IF EXISTS RemindedAt;
+DROP INDEX IF EXISTS DeviceUserIdx;
+DROP INDEX IF EXISTS DeviceUUIDIdx;
+DROP TABLE IF EXISTS Device;
@@ -0,0 +1,19 @@
+-- Devices registered to receive push notifications
+CREATE TABLE IF NOT EXISTS Device (
+  DeviceId BIGSERIAL PRIMARY KEY,
+  DeviceUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  UserId BIGINT NOT NULL REFERENCES Users(UserId),
+  -- Either 'android' or 'ios', selects the push provider
+  Platform TEXT NOT NULL,
+  -- Provider token of the device, a device belongs to at most one user
+  Token TEXT UNIQUE NOT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS DeviceUUIDIdx ON Device(DeviceUUID);
+
+CREATE INDEX IF NOT EXISTS DeviceUserIdx ON Device(UserId);
+
+-- Set once the booker has been reminded of an upcoming booking
+ALTER TABLE Booking
+ADD RemindedAt TIMESTAMPTZ DEFAULT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS WebhookDeliveryPendingIdx;
+DROP TABLE IF EXISTS WebhookDelivery;
+DROP INDEX IF EXISTS WebhookUserIdx;
+DROP TABLE IF EXISTS Webhook;
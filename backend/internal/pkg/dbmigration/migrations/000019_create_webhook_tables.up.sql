@@ -0,0 +1,42 @@
+-- Endpoints of integrators receiving events of a user
+CREATE TABLE IF NOT EXISTS Webhook (
+  WebhookId BIGSERIAL PRIMARY KEY,
+  WebhookUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  UserId BIGINT NOT NULL REFERENCES Users(UserId),
+  Url TEXT NOT NULL,
+  -- Key used to sign payloads, shared with the integrator
+  Secret TEXT NOT NULL,
+  -- Bitmask of the subscribed event types
+  Events SMALLINT NOT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS WebhookUUIDIdx ON Webhook(WebhookUUID);
+
+CREATE INDEX IF NOT EXISTS WebhookUserIdx ON Webhook(UserId);
+
+-- Events queued for delivery to a webhook, along with the outcome of the last attempt
+CREATE TABLE IF NOT EXISTS WebhookDelivery (
+  DeliveryId BIGSERIAL PRIMARY KEY,
+  DeliveryUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  WebhookId BIGINT NOT NULL REFERENCES Webhook(WebhookId) ON DELETE CASCADE,
+  -- Events are delivered at most once per webhook, even when published by multiple servers
+  EventId UUID NOT NULL,
+  EventType TEXT NOT NULL,
+  Payload TEXT NOT NULL,
+  -- One of 'pending', 'succeeded' or 'failed'
+  Status TEXT NOT NULL DEFAULT 'pending',
+  Attempts INTEGER NOT NULL DEFAULT 0,
+  -- Time of the next attempt of pending deliveries
+  NextAttemptAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  LastAttemptAt TIMESTAMPTZ DEFAULT NULL,
+  -- HTTP status returned by the endpoint on the last attempt, NULL if there were no response
+  LastStatusCode INTEGER DEFAULT NULL,
+  LastError TEXT DEFAULT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (WebhookId, EventId)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS WebhookDeliveryUUIDIdx ON WebhookDelivery(DeliveryUUID);
+
+CREATE INDEX IF NOT EXISTS WebhookDeliveryPendingIdx ON WebhookDelivery(NextAttemptAt) WHERE Status = 'pending';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS OutboxPublishedIdx;
+DROP INDEX IF EXISTS OutboxPendingIdx;
+DROP TABLE IF EXISTS Outbox;
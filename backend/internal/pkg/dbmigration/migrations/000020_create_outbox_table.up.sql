@@ -0,0 +1,19 @@
+-- Domain events recorded in the transaction of the state change they describe, relayed to subscribers afterwards
+CREATE TABLE IF NOT EXISTS Outbox (
+  EventId BIGSERIAL PRIMARY KEY,
+  EventUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  EventType TEXT NOT NULL,
+  Payload TEXT NOT NULL,
+  Attempts INTEGER NOT NULL DEFAULT 0,
+  -- Time of the next relay attempt of unpublished events
+  NextAttemptAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  -- Time all subscribers handled the event, NULL until then
+  PublishedAt TIMESTAMPTZ DEFAULT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS OutboxUUIDIdx ON Outbox(EventUUID);
+
+CREATE INDEX IF NOT EXISTS OutboxPendingIdx ON Outbox(NextAttemptAt) WHERE PublishedAt IS NULL;
+
+CREATE INDEX IF NOT EXISTS OutboxPublishedIdx ON Outbox(PublishedAt) WHERE PublishedAt IS NOT NULL;
@@ -0,0 +1,7 @@
+-- Secret calendar feed URLs, a user has at most one feed
+CREATE TABLE IF NOT EXISTS CalendarFeed (
+  UserId BIGINT PRIMARY KEY REFERENCES Users(UserId),
+  -- Secret part of the feed URL, replaced when the feed is reset
+  Token TEXT UNIQUE NOT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
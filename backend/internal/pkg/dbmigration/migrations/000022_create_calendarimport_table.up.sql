@@ -0,0 +1,16 @@
+-- Calendars polled to block the availability of parking spots, a spot has at most one
+CREATE TABLE IF NOT EXISTS CalendarImport (
+  ParkingSpotId BIGINT PRIMARY KEY REFERENCES ParkingSpot(ParkingSpotId) ON DELETE CASCADE,
+  URL TEXT NOT NULL,
+  -- Time of the next poll, pushed forward while a poll is in progress
+  NextSyncAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  -- Time of the last poll, NULL until the calendar is first polled
+  LastSyncedAt TIMESTAMPTZ DEFAULT NULL,
+  -- Why the last poll failed, NULL if it succeeded
+  LastError TEXT DEFAULT NULL,
+  -- JSON encoded outcome of the last successful poll
+  LastResult TEXT DEFAULT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS CalendarImportNextSyncIdx ON CalendarImport(NextSyncAt);
@@ -0,0 +1,10 @@
+-- Geocoding results by normalized address, so addresses are not resolved again by the provider
+CREATE TABLE IF NOT EXISTS GeocodeCache (
+  AddressKey TEXT PRIMARY KEY,
+  -- JSON encoded results
+  Results TEXT NOT NULL,
+  ExpiresAt TIMESTAMPTZ NOT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS GeocodeCacheExpiresIdx ON GeocodeCache(ExpiresAt);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS ParkingSpotLocationIdx;
+
+-- Fails if a location has more than one space
+ALTER TABLE ParkingSpot
+DROP COLUMN IF EXISTS Label,
+DROP COLUMN IF EXISTS ParkingLocationId,
+ADD CONSTRAINT latlon_overlap_exclude EXCLUDE USING gist (earth_box(ll_to_earth(latitude, longitude), 3) with &&);
+
+DROP INDEX IF EXISTS ParkingLocationUserIdx;
+DROP TABLE IF EXISTS ParkingLocation;
@@ -0,0 +1,30 @@
+-- A geocoded address owning one or more bookable parking spaces
+CREATE TABLE IF NOT EXISTS ParkingLocation (
+  ParkingLocationId BIGSERIAL PRIMARY KEY,
+  ParkingLocationUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  UserId BIGINT NOT NULL REFERENCES Users(UserId),
+  Longitude DECIMAL(8,5) NOT NULL,
+  Latitude DECIMAL(8,5) NOT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT location_latlon_overlap_exclude EXCLUDE USING gist (earth_box(ll_to_earth(latitude, longitude), 3) with &&)
+);
+
+CREATE INDEX IF NOT EXISTS ParkingLocationUserIdx ON ParkingLocation(UserId);
+
+-- Spots are the spaces of a location, and keep a copy of its address
+ALTER TABLE ParkingSpot
+ADD ParkingLocationId BIGINT REFERENCES ParkingLocation(ParkingLocationId),
+ADD Label TEXT NOT NULL DEFAULT '';
+
+-- Existing spots never overlap, each becomes the only space of its own location
+INSERT INTO ParkingLocation (ParkingLocationId, UserId, Longitude, Latitude)
+SELECT ParkingSpotId, UserId, Longitude, Latitude FROM ParkingSpot;
+SELECT setval(pg_get_serial_sequence('parkinglocation', 'parkinglocationid'), COALESCE(MAX(ParkingLocationId), 0) + 1, false)
+FROM ParkingLocation;
+UPDATE ParkingSpot SET ParkingLocationId = ParkingSpotId;
+
+ALTER TABLE ParkingSpot
+ALTER COLUMN ParkingLocationId SET NOT NULL,
+DROP CONSTRAINT IF EXISTS latlon_overlap_exclude;
+
+CREATE INDEX IF NOT EXISTS ParkingSpotLocationIdx ON ParkingSpot(ParkingLocationId);
@@ -0,0 +1,17 @@
+-- Photos of parking spots, the images themselves are kept in blob storage
+CREATE TABLE IF NOT EXISTS ParkingSpotPhoto (
+  ParkingSpotPhotoId BIGSERIAL PRIMARY KEY,
+  ParkingSpotPhotoUUID UUID UNIQUE NOT NULL DEFAULT gen_random_uuid(),
+  ParkingSpotId BIGINT NOT NULL REFERENCES ParkingSpot(ParkingSpotId) ON DELETE CASCADE,
+  -- Photos are shown by ascending position
+  Position INTEGER NOT NULL,
+  ContentType TEXT NOT NULL,
+  -- Blob store keys of the full size image and of its thumbnail
+  ImageKey TEXT NOT NULL,
+  ThumbnailKey TEXT NOT NULL,
+  Width INTEGER NOT NULL,
+  Height INTEGER NOT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS ParkingSpotPhotoSpotIdx ON ParkingSpotPhoto(ParkingSpotId, Position);
@@ -0,0 +1,9 @@
+ALTER TABLE ParkingSpot
+DROP COLUMN IF EXISTS IsWellLit,
+DROP COLUMN IF EXISTS IsStepFree,
+DROP COLUMN IF EXISTS IsAccessible,
+DROP COLUMN IF EXISTS NoTrucks,
+DROP COLUMN IF EXISTS MaxLength,
+DROP COLUMN IF EXISTS MaxHeight,
+DROP COLUMN IF EXISTS AccessInstructions,
+DROP COLUMN IF EXISTS Description;
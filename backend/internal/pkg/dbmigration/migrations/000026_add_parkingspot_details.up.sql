@@ -0,0 +1,13 @@
+-- What hosts tell drivers about their spots
+ALTER TABLE ParkingSpot
+ADD Description TEXT NOT NULL DEFAULT '',
+-- Only shown to the owner and to drivers who booked the spot
+ADD AccessInstructions TEXT NOT NULL DEFAULT '',
+-- Vehicle restrictions, sizes are in centimetres and 0 means no limit
+ADD MaxHeight INTEGER NOT NULL DEFAULT 0 CHECK (MaxHeight >= 0),
+ADD MaxLength INTEGER NOT NULL DEFAULT 0 CHECK (MaxLength >= 0),
+ADD NoTrucks BOOLEAN NOT NULL DEFAULT false,
+-- Accessibility
+ADD IsAccessible BOOLEAN NOT NULL DEFAULT false,
+ADD IsStepFree BOOLEAN NOT NULL DEFAULT false,
+ADD IsWellLit BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,8 @@
+ALTER TABLE ParkingSpot
+DROP COLUMN IF EXISTS MaxWidth;
+
+ALTER TABLE Car
+DROP COLUMN IF EXISTS Width,
+DROP COLUMN IF EXISTS Length,
+DROP COLUMN IF EXISTS Height,
+DROP COLUMN IF EXISTS VehicleClass;
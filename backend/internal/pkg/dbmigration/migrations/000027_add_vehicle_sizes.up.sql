@@ -0,0 +1,11 @@
+-- Vehicle size, sizes are in centimetres and 0 means unknown
+ALTER TABLE Car
+ADD VehicleClass TEXT NOT NULL DEFAULT ''
+  CHECK (VehicleClass IN ('', 'compact', 'midsize', 'fullsize', 'suv', 'van', 'truck')),
+ADD Height INTEGER NOT NULL DEFAULT 0 CHECK (Height >= 0),
+ADD Length INTEGER NOT NULL DEFAULT 0 CHECK (Length >= 0),
+ADD Width INTEGER NOT NULL DEFAULT 0 CHECK (Width >= 0);
+
+-- 0 means no limit
+ALTER TABLE ParkingSpot
+ADD MaxWidth INTEGER NOT NULL DEFAULT 0 CHECK (MaxWidth >= 0);
@@ -0,0 +1,6 @@
+-- Normalized plates are kept
+DROP INDEX IF EXISTS CarUserPlateIdx;
+
+ALTER TABLE Car
+DROP COLUMN IF EXISTS State,
+DROP COLUMN IF EXISTS CountryCode;
@@ -0,0 +1,31 @@
+-- Jurisdiction that issued the license plate, empty if unknown
+ALTER TABLE Car
+ADD CountryCode TEXT NOT NULL DEFAULT '',
+ADD State TEXT NOT NULL DEFAULT '';
+
+-- License plates are stored in upper case without spaces or dashes
+UPDATE Car SET LicensePlate = upper(regexp_replace(LicensePlate, '[[:space:]-]', '', 'g'));
+
+-- Cars registered more than once by a user cannot be merged without losing their details or changing
+-- the car of past bookings, so they have to be resolved by hand before migrating
+DO $$
+DECLARE
+  conflicts TEXT;
+BEGIN
+  SELECT string_agg(format('user %s has plate %s on cars %s', UserId, LicensePlate, CarIds), '; ')
+  INTO conflicts
+  FROM (
+    SELECT UserId, LicensePlate, string_agg(CarId::TEXT, ', ' ORDER BY CarId) AS CarIds
+    FROM Car
+    GROUP BY UserId, LicensePlate
+    HAVING count(*) > 1
+  ) AS Duplicates;
+
+  IF conflicts IS NOT NULL THEN
+    RAISE EXCEPTION 'license plates registered more than once: %', conflicts
+      USING HINT = 'Delete or correct the duplicate cars, then run the migration again.';
+  END IF;
+END
+$$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS CarUserPlateIdx ON Car(UserId, CountryCode, State, LicensePlate);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS NotificationEventIdx;
+ALTER TABLE Notification DROP COLUMN IF EXISTS EventUUID;
+ALTER TABLE Outbox DROP COLUMN IF EXISTS DeliveredTo;
@@ -0,0 +1,7 @@
+-- Subscribers that handled an event, so failed relays are only retried for the others
+ALTER TABLE Outbox ADD COLUMN IF NOT EXISTS DeliveredTo TEXT[] NOT NULL DEFAULT '{}';
+
+-- The domain event a notification was sent for, so events relayed again do not notify twice
+ALTER TABLE Notification ADD COLUMN IF NOT EXISTS EventUUID UUID DEFAULT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS NotificationEventIdx ON Notification(EventUUID, UserId, Type) WHERE EventUUID IS NOT NULL;
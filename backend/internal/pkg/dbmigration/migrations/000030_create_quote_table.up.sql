@@ -0,0 +1,21 @@
+-- Prices quoted to drivers, paid when booking before they expire.
+--
+-- Quotes are short-lived and only read by the booking consuming them, so they do not reference the
+-- quoted user, spot or promo code.
+CREATE TABLE IF NOT EXISTS Quote (
+  QuoteUUID UUID PRIMARY KEY,
+  UserId BIGINT NOT NULL,
+  ParkingSpotId BIGINT NOT NULL,
+  PromoCodeId BIGINT DEFAULT NULL,
+  -- The normalized promo code applied, empty if none
+  PromoCode TEXT NOT NULL DEFAULT '',
+  -- JSON encoded time slots
+  BookedTimes TEXT NOT NULL,
+  Total DECIMAL NOT NULL,
+  Discount DECIMAL NOT NULL,
+  Payout DECIMAL NOT NULL,
+  ExpiresAt TIMESTAMPTZ NOT NULL,
+  CreatedAt TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS QuoteExpiresIdx ON Quote(ExpiresAt);
@@ -14,51 +14,144 @@ import (
 )
 
 var TableNames = struct {
-	Auths           string
-	Bookings        string
-	Cars            string
-	Parkingspots    string
-	Preferencespots string
-	Resettokens     string
-	Sessions        string
-	Timeunits       string
-	Users           string
+	Administrators     string
+	Auths              string
+	Availabilityalerts string
+	Bookings           string
+	Calendarfeeds      string
+	Calendarimports    string
+	Cars               string
+	Devices            string
+	Geocodecaches      string
+	Holds              string
+	Messages           string
+	Notifications      string
+	Outboxes           string
+	Parkinglocations   string
+	Parkingspots       string
+	Parkingspotphotos  string
+	Preferencespots    string
+	Pricingrules       string
+	Promocodes         string
+	Quotes             string
+	Resettokens        string
+	Reviews            string
+	Savedsearches      string
+	Sessions           string
+	Spotpricings       string
+	Timeunits          string
+	Users              string
+	Webhooks           string
+	Webhookdeliveries  string
 }{
-	Auths:           "auth",
-	Bookings:        "booking",
-	Cars:            "car",
-	Parkingspots:    "parkingspot",
-	Preferencespots: "preferencespot",
-	Resettokens:     "resettoken",
-	Sessions:        "sessions",
-	Timeunits:       "timeunit",
-	Users:           "users",
+	Administrators:     "administrator",
+	Auths:              "auth",
+	Availabilityalerts: "availabilityalert",
+	Bookings:           "booking",
+	Calendarfeeds:      "calendarfeed",
+	Calendarimports:    "calendarimport",
+	Cars:               "car",
+	Devices:            "device",
+	Geocodecaches:      "geocodecache",
+	Holds:              "hold",
+	Messages:           "message",
+	Notifications:      "notification",
+	Outboxes:           "outbox",
+	Parkinglocations:   "parkinglocation",
+	Parkingspots:       "parkingspot",
+	Parkingspotphotos:  "parkingspotphoto",
+	Preferencespots:    "preferencespot",
+	Pricingrules:       "pricingrule",
+	Promocodes:         "promocode",
+	Quotes:             "quote",
+	Resettokens:        "resettoken",
+	Reviews:            "review",
+	Savedsearches:      "savedsearch",
+	Sessions:           "sessions",
+	Spotpricings:       "spotpricing",
+	Timeunits:          "timeunit",
+	Users:              "users",
+	Webhooks:           "webhook",
+	Webhookdeliveries:  "webhookdelivery",
 }
 
 var ColumnNames = struct {
-	Auths           authColumnNames
-	Bookings        bookingColumnNames
-	Cars            carColumnNames
-	Parkingspots    parkingspotColumnNames
-	Preferencespots preferencespotColumnNames
-	Resettokens     resettokenColumnNames
-	Sessions        sessionColumnNames
-	Timeunits       timeunitColumnNames
-	Users           userColumnNames
+	Administrators     administratorColumnNames
+	Auths              authColumnNames
+	Availabilityalerts availabilityalertColumnNames
+	Bookings           bookingColumnNames
+	Calendarfeeds      calendarfeedColumnNames
+	Calendarimports    calendarimportColumnNames
+	Cars               carColumnNames
+	Devices            deviceColumnNames
+	Geocodecaches      geocodecacheColumnNames
+	Holds              holdColumnNames
+	Messages           messageColumnNames
+	Notifications      notificationColumnNames
+	Outboxes           outboxColumnNames
+	Parkinglocations   parkinglocationColumnNames
+	Parkingspots       parkingspotColumnNames
+	Parkingspotphotos  parkingspotphotoColumnNames
+	Preferencespots    preferencespotColumnNames
+	Pricingrules       pricingruleColumnNames
+	Promocodes         promocodeColumnNames
+	Quotes             quoteColumnNames
+	Resettokens        resettokenColumnNames
+	Reviews            reviewColumnNames
+	Savedsearches      savedsearchColumnNames
+	Sessions           sessionColumnNames
+	Spotpricings       spotpricingColumnNames
+	Timeunits          timeunitColumnNames
+	Users              userColumnNames
+	Webhooks           webhookColumnNames
+	Webhookdeliveries  webhookdeliveryColumnNames
 }{
+	Administrators: administratorColumnNames{
+		Userid:  "userid",
+		Addedat: "addedat",
+	},
 	Auths: authColumnNames{
 		Authid:       "authid",
 		Authuuid:     "authuuid",
 		Email:        "email",
 		Passwordhash: "passwordhash",
 	},
+	Availabilityalerts: availabilityalertColumnNames{
+		Alertid:        "alertid",
+		Alertuuid:      "alertuuid",
+		Userid:         "userid",
+		Parkingspotid:  "parkingspotid",
+		Longitude:      "longitude",
+		Latitude:       "latitude",
+		Distance:       "distance",
+		Lastnotifiedat: "lastnotifiedat",
+		Createdat:      "createdat",
+	},
 	Bookings: bookingColumnNames{
-		Bookingid:     "bookingid",
-		Bookinguuid:   "bookinguuid",
-		Userid:        "userid",
+		Bookingid:      "bookingid",
+		Bookinguuid:    "bookinguuid",
+		Userid:         "userid",
+		Parkingspotid:  "parkingspotid",
+		Carid:          "carid",
+		Paidamount:     "paidamount",
+		Createdat:      "createdat",
+		Promocodeid:    "promocodeid",
+		Discountamount: "discountamount",
+		Payoutamount:   "payoutamount",
+		Remindedat:     "remindedat",
+	},
+	Calendarfeeds: calendarfeedColumnNames{
+		Userid:    "userid",
+		Token:     "token",
+		Createdat: "createdat",
+	},
+	Calendarimports: calendarimportColumnNames{
 		Parkingspotid: "parkingspotid",
-		Carid:         "carid",
-		Paidamount:    "paidamount",
+		URL:           "url",
+		Nextsyncat:    "nextsyncat",
+		Lastsyncedat:  "lastsyncedat",
+		Lasterror:     "lasterror",
+		Lastresult:    "lastresult",
 		Createdat:     "createdat",
 	},
 	Cars: carColumnNames{
@@ -70,6 +163,67 @@ var ColumnNames = struct {
 		Model:        "model",
 		Color:        "color",
 	},
+	Devices: deviceColumnNames{
+		Deviceid:   "deviceid",
+		Deviceuuid: "deviceuuid",
+		Userid:     "userid",
+		Platform:   "platform",
+		Token:      "token",
+		Createdat:  "createdat",
+	},
+	Geocodecaches: geocodecacheColumnNames{
+		Addresskey: "addresskey",
+		Results:    "results",
+		Expiresat:  "expiresat",
+		Createdat:  "createdat",
+	},
+	Holds: holdColumnNames{
+		Holdid:        "holdid",
+		Holduuid:      "holduuid",
+		Userid:        "userid",
+		Parkingspotid: "parkingspotid",
+		Expiresat:     "expiresat",
+		Createdat:     "createdat",
+	},
+	Messages: messageColumnNames{
+		Messageid:   "messageid",
+		Messageuuid: "messageuuid",
+		Bookingid:   "bookingid",
+		Senderid:    "senderid",
+		Body:        "body",
+		Redacted:    "redacted",
+		Createdat:   "createdat",
+		Readat:      "readat",
+	},
+	Notifications: notificationColumnNames{
+		Notificationid:   "notificationid",
+		Notificationuuid: "notificationuuid",
+		Userid:           "userid",
+		Type:             "type",
+		Title:            "title",
+		Body:             "body",
+		Subjectuuid:      "subjectuuid",
+		Createdat:        "createdat",
+		Readat:           "readat",
+	},
+	Outboxes: outboxColumnNames{
+		Eventid:       "eventid",
+		Eventuuid:     "eventuuid",
+		Eventtype:     "eventtype",
+		Payload:       "payload",
+		Attempts:      "attempts",
+		Nextattemptat: "nextattemptat",
+		Publishedat:   "publishedat",
+		Createdat:     "createdat",
+	},
+	Parkinglocations: parkinglocationColumnNames{
+		Parkinglocationid:   "parkinglocationid",
+		Parkinglocationuuid: "parkinglocationuuid",
+		Userid:              "userid",
+		Longitude:           "longitude",
+		Latitude:            "latitude",
+		Createdat:           "createdat",
+	},
 	Parkingspots: parkingspotColumnNames{
 		Parkingspotid:      "parkingspotid",
 		Userid:             "userid",
@@ -85,26 +239,113 @@ var ColumnNames = struct {
 		Hasplugin:          "hasplugin",
 		Haschargingstation: "haschargingstation",
 		Priceperhour:       "priceperhour",
+		Ratingcount:        "ratingcount",
+		Ratingtotal:        "ratingtotal",
+		Parkinglocationid:  "parkinglocationid",
+		Label:              "label",
+	},
+	Parkingspotphotos: parkingspotphotoColumnNames{
+		Parkingspotphotoid:   "parkingspotphotoid",
+		Parkingspotphotouuid: "parkingspotphotouuid",
+		Parkingspotid:        "parkingspotid",
+		Position:             "position",
+		Contenttype:          "contenttype",
+		Imagekey:             "imagekey",
+		Thumbnailkey:         "thumbnailkey",
+		Width:                "width",
+		Height:               "height",
+		Createdat:            "createdat",
 	},
 	Preferencespots: preferencespotColumnNames{
 		Preferencespotid: "preferencespotid",
 		Userid:           "userid",
 		Parkingspotid:    "parkingspotid",
 	},
+	Pricingrules: pricingruleColumnNames{
+		Pricingruleid: "pricingruleid",
+		Parkingspotid: "parkingspotid",
+		Position:      "position",
+		Ruledate:      "ruledate",
+		Weekdays:      "weekdays",
+		Startminute:   "startminute",
+		Endminute:     "endminute",
+		Priceperhour:  "priceperhour",
+	},
+	Promocodes: promocodeColumnNames{
+		Promocodeid:    "promocodeid",
+		Promocodeuuid:  "promocodeuuid",
+		Code:           "code",
+		Discounttype:   "discounttype",
+		Discountvalue:  "discountvalue",
+		Minimumspend:   "minimumspend",
+		Expiresat:      "expiresat",
+		Maxuses:        "maxuses",
+		Maxusesperuser: "maxusesperuser",
+		Parkingspotid:  "parkingspotid",
+		Ownerid:        "ownerid",
+		Createdat:      "createdat",
+	},
+	Quotes: quoteColumnNames{
+		Quoteuuid:     "quoteuuid",
+		Userid:        "userid",
+		Parkingspotid: "parkingspotid",
+		Promocodeid:   "promocodeid",
+		Promocode:     "promocode",
+		Bookedtimes:   "bookedtimes",
+		Total:         "total",
+		Discount:      "discount",
+		Payout:        "payout",
+		Expiresat:     "expiresat",
+		Createdat:     "createdat",
+	},
 	Resettokens: resettokenColumnNames{
 		Token:    "token",
 		Authuuid: "authuuid",
 		Expiry:   "expiry",
 	},
+	Reviews: reviewColumnNames{
+		Reviewid:   "reviewid",
+		Reviewuuid: "reviewuuid",
+		Bookingid:  "bookingid",
+		Reviewerid: "reviewerid",
+		Subject:    "subject",
+		Rating:     "rating",
+		Comment:    "comment",
+		Createdat:  "createdat",
+	},
+	Savedsearches: savedsearchColumnNames{
+		Savedsearchid:      "savedsearchid",
+		Savedsearchuuid:    "savedsearchuuid",
+		Userid:             "userid",
+		Name:               "name",
+		Longitude:          "longitude",
+		Latitude:           "latitude",
+		Distance:           "distance",
+		Weekdays:           "weekdays",
+		Startminute:        "startminute",
+		Endminute:          "endminute",
+		Maxpriceperhour:    "maxpriceperhour",
+		Hasshelter:         "hasshelter",
+		Hasplugin:          "hasplugin",
+		Haschargingstation: "haschargingstation",
+		Lastnotifiedat:     "lastnotifiedat",
+		Createdat:          "createdat",
+	},
 	Sessions: sessionColumnNames{
 		Token:  "token",
 		Data:   "data",
 		Expiry: "expiry",
 	},
+	Spotpricings: spotpricingColumnNames{
+		Parkingspotid: "parkingspotid",
+		Minimumcharge: "minimumcharge",
+		Dailymaximum:  "dailymaximum",
+	},
 	Timeunits: timeunitColumnNames{
 		Timerange:     "timerange",
 		Parkingspotid: "parkingspotid",
 		Bookingid:     "bookingid",
+		Holdid:        "holdid",
 	},
 	Users: userColumnNames{
 		Userid:     "userid",
@@ -115,6 +356,30 @@ var ColumnNames = struct {
 		Isverified: "isverified",
 		Addedat:    "addedat",
 	},
+	Webhooks: webhookColumnNames{
+		Webhookid:   "webhookid",
+		Webhookuuid: "webhookuuid",
+		Userid:      "userid",
+		URL:         "url",
+		Secret:      "secret",
+		Events:      "events",
+		Createdat:   "createdat",
+	},
+	Webhookdeliveries: webhookdeliveryColumnNames{
+		Deliveryid:     "deliveryid",
+		Deliveryuuid:   "deliveryuuid",
+		Webhookid:      "webhookid",
+		Eventid:        "eventid",
+		Eventtype:      "eventtype",
+		Payload:        "payload",
+		Status:         "status",
+		Attempts:       "attempts",
+		Nextattemptat:  "nextattemptat",
+		Lastattemptat:  "lastattemptat",
+		Laststatuscode: "laststatuscode",
+		Lasterror:      "lasterror",
+		Createdat:      "createdat",
+	},
 }
 
 var (
@@ -125,36 +390,96 @@ var (
 )
 
 func Where[Q psql.Filterable]() struct {
-	Auths           authWhere[Q]
-	Bookings        bookingWhere[Q]
-	Cars            carWhere[Q]
-	Parkingspots    parkingspotWhere[Q]
-	Preferencespots preferencespotWhere[Q]
-	Resettokens     resettokenWhere[Q]
-	Sessions        sessionWhere[Q]
-	Timeunits       timeunitWhere[Q]
-	Users           userWhere[Q]
+	Administrators     administratorWhere[Q]
+	Auths              authWhere[Q]
+	Availabilityalerts availabilityalertWhere[Q]
+	Bookings           bookingWhere[Q]
+	Calendarfeeds      calendarfeedWhere[Q]
+	Calendarimports    calendarimportWhere[Q]
+	Cars               carWhere[Q]
+	Devices            deviceWhere[Q]
+	Geocodecaches      geocodecacheWhere[Q]
+	Holds              holdWhere[Q]
+	Messages           messageWhere[Q]
+	Notifications      notificationWhere[Q]
+	Outboxes           outboxWhere[Q]
+	Parkinglocations   parkinglocationWhere[Q]
+	Parkingspots       parkingspotWhere[Q]
+	Parkingspotphotos  parkingspotphotoWhere[Q]
+	Preferencespots    preferencespotWhere[Q]
+	Pricingrules       pricingruleWhere[Q]
+	Promocodes         promocodeWhere[Q]
+	Quotes             quoteWhere[Q]
+	Resettokens        resettokenWhere[Q]
+	Reviews            reviewWhere[Q]
+	Savedsearches      savedsearchWhere[Q]
+	Sessions           sessionWhere[Q]
+	Spotpricings       spotpricingWhere[Q]
+	Timeunits          timeunitWhere[Q]
+	Users              userWhere[Q]
+	Webhooks           webhookWhere[Q]
+	Webhookdeliveries  webhookdeliveryWhere[Q]
 } {
 	return struct {
-		Auths           authWhere[Q]
-		Bookings        bookingWhere[Q]
-		Cars            carWhere[Q]
-		Parkingspots    parkingspotWhere[Q]
-		Preferencespots preferencespotWhere[Q]
-		Resettokens     resettokenWhere[Q]
-		Sessions        sessionWhere[Q]
-		Timeunits       timeunitWhere[Q]
-		Users           userWhere[Q]
+		Administrators     administratorWhere[Q]
+		Auths              authWhere[Q]
+		Availabilityalerts availabilityalertWhere[Q]
+		Bookings           bookingWhere[Q]
+		Calendarfeeds      calendarfeedWhere[Q]
+		Calendarimports    calendarimportWhere[Q]
+		Cars               carWhere[Q]
+		Devices            deviceWhere[Q]
+		Geocodecaches      geocodecacheWhere[Q]
+		Holds              holdWhere[Q]
+		Messages           messageWhere[Q]
+		Notifications      notificationWhere[Q]
+		Outboxes           outboxWhere[Q]
+		Parkinglocations   parkinglocationWhere[Q]
+		Parkingspots       parkingspotWhere[Q]
+		Parkingspotphotos  parkingspotphotoWhere[Q]
+		Preferencespots    preferencespotWhere[Q]
+		Pricingrules       pricingruleWhere[Q]
+		Promocodes         promocodeWhere[Q]
+		Quotes             quoteWhere[Q]
+		Resettokens        resettokenWhere[Q]
+		Reviews            reviewWhere[Q]
+		Savedsearches      savedsearchWhere[Q]
+		Sessions           sessionWhere[Q]
+		Spotpricings       spotpricingWhere[Q]
+		Timeunits          timeunitWhere[Q]
+		Users              userWhere[Q]
+		Webhooks           webhookWhere[Q]
+		Webhookdeliveries  webhookdeliveryWhere[Q]
 	}{
-		Auths:           buildAuthWhere[Q](AuthColumns),
-		Bookings:        buildBookingWhere[Q](BookingColumns),
-		Cars:            buildCarWhere[Q](CarColumns),
-		Parkingspots:    buildParkingspotWhere[Q](ParkingspotColumns),
-		Preferencespots: buildPreferencespotWhere[Q](PreferencespotColumns),
-		Resettokens:     buildResettokenWhere[Q](ResettokenColumns),
-		Sessions:        buildSessionWhere[Q](SessionColumns),
-		Timeunits:       buildTimeunitWhere[Q](TimeunitColumns),
-		Users:           buildUserWhere[Q](UserColumns),
+		Administrators:     buildAdministratorWhere[Q](AdministratorColumns),
+		Auths:              buildAuthWhere[Q](AuthColumns),
+		Availabilityalerts: buildAvailabilityalertWhere[Q](AvailabilityalertColumns),
+		Bookings:           buildBookingWhere[Q](BookingColumns),
+		Calendarfeeds:      buildCalendarfeedWhere[Q](CalendarfeedColumns),
+		Calendarimports:    buildCalendarimportWhere[Q](CalendarimportColumns),
+		Cars:               buildCarWhere[Q](CarColumns),
+		Devices:            buildDeviceWhere[Q](DeviceColumns),
+		Geocodecaches:      buildGeocodecacheWhere[Q](GeocodecacheColumns),
+		Holds:              buildHoldWhere[Q](HoldColumns),
+		Messages:           buildMessageWhere[Q](MessageColumns),
+		Notifications:      buildNotificationWhere[Q](NotificationColumns),
+		Outboxes:           buildOutboxWhere[Q](OutboxColumns),
+		Parkinglocations:   buildParkinglocationWhere[Q](ParkinglocationColumns),
+		Parkingspots:       buildParkingspotWhere[Q](ParkingspotColumns),
+		Parkingspotphotos:  buildParkingspotphotoWhere[Q](ParkingspotphotoColumns),
+		Preferencespots:    buildPreferencespotWhere[Q](PreferencespotColumns),
+		Pricingrules:       buildPricingruleWhere[Q](PricingruleColumns),
+		Promocodes:         buildPromocodeWhere[Q](PromocodeColumns),
+		Quotes:             buildQuoteWhere[Q](QuoteColumns),
+		Resettokens:        buildResettokenWhere[Q](ResettokenColumns),
+		Reviews:            buildReviewWhere[Q](ReviewColumns),
+		Savedsearches:      buildSavedsearchWhere[Q](SavedsearchColumns),
+		Sessions:           buildSessionWhere[Q](SessionColumns),
+		Spotpricings:       buildSpotpricingWhere[Q](SpotpricingColumns),
+		Timeunits:          buildTimeunitWhere[Q](TimeunitColumns),
+		Users:              buildUserWhere[Q](UserColumns),
+		Webhooks:           buildWebhookWhere[Q](WebhookColumns),
+		Webhookdeliveries:  buildWebhookdeliveryWhere[Q](WebhookdeliveryColumns),
 	}
 }
 
@@ -179,14 +504,31 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 }
 
 type joins[Q dialect.Joinable] struct {
-	Auths           joinSet[authJoins[Q]]
-	Bookings        joinSet[bookingJoins[Q]]
-	Cars            joinSet[carJoins[Q]]
-	Parkingspots    joinSet[parkingspotJoins[Q]]
-	Preferencespots joinSet[preferencespotJoins[Q]]
-	Resettokens     joinSet[resettokenJoins[Q]]
-	Timeunits       joinSet[timeunitJoins[Q]]
-	Users           joinSet[userJoins[Q]]
+	Administrators     joinSet[administratorJoins[Q]]
+	Auths              joinSet[authJoins[Q]]
+	Availabilityalerts joinSet[availabilityalertJoins[Q]]
+	Bookings           joinSet[bookingJoins[Q]]
+	Calendarfeeds      joinSet[calendarfeedJoins[Q]]
+	Calendarimports    joinSet[calendarimportJoins[Q]]
+	Cars               joinSet[carJoins[Q]]
+	Devices            joinSet[deviceJoins[Q]]
+	Holds              joinSet[holdJoins[Q]]
+	Messages           joinSet[messageJoins[Q]]
+	Notifications      joinSet[notificationJoins[Q]]
+	Parkinglocations   joinSet[parkinglocationJoins[Q]]
+	Parkingspots       joinSet[parkingspotJoins[Q]]
+	Parkingspotphotos  joinSet[parkingspotphotoJoins[Q]]
+	Preferencespots    joinSet[preferencespotJoins[Q]]
+	Pricingrules       joinSet[pricingruleJoins[Q]]
+	Promocodes         joinSet[promocodeJoins[Q]]
+	Resettokens        joinSet[resettokenJoins[Q]]
+	Reviews            joinSet[reviewJoins[Q]]
+	Savedsearches      joinSet[savedsearchJoins[Q]]
+	Spotpricings       joinSet[spotpricingJoins[Q]]
+	Timeunits          joinSet[timeunitJoins[Q]]
+	Users              joinSet[userJoins[Q]]
+	Webhooks           joinSet[webhookJoins[Q]]
+	Webhookdeliveries  joinSet[webhookdeliveryJoins[Q]]
 }
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
@@ -199,14 +541,31 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
 	return joins[Q]{
-		Auths:           buildJoinSet[authJoins[Q]](AuthColumns, buildAuthJoins),
-		Bookings:        buildJoinSet[bookingJoins[Q]](BookingColumns, buildBookingJoins),
-		Cars:            buildJoinSet[carJoins[Q]](CarColumns, buildCarJoins),
-		Parkingspots:    buildJoinSet[parkingspotJoins[Q]](ParkingspotColumns, buildParkingspotJoins),
-		Preferencespots: buildJoinSet[preferencespotJoins[Q]](PreferencespotColumns, buildPreferencespotJoins),
-		Resettokens:     buildJoinSet[resettokenJoins[Q]](ResettokenColumns, buildResettokenJoins),
-		Timeunits:       buildJoinSet[timeunitJoins[Q]](TimeunitColumns, buildTimeunitJoins),
-		Users:           buildJoinSet[userJoins[Q]](UserColumns, buildUserJoins),
+		Administrators:     buildJoinSet[administratorJoins[Q]](AdministratorColumns, buildAdministratorJoins),
+		Auths:              buildJoinSet[authJoins[Q]](AuthColumns, buildAuthJoins),
+		Availabilityalerts: buildJoinSet[availabilityalertJoins[Q]](AvailabilityalertColumns, buildAvailabilityalertJoins),
+		Bookings:           buildJoinSet[bookingJoins[Q]](BookingColumns, buildBookingJoins),
+		Calendarfeeds:      buildJoinSet[calendarfeedJoins[Q]](CalendarfeedColumns, buildCalendarfeedJoins),
+		Calendarimports:    buildJoinSet[calendarimportJoins[Q]](CalendarimportColumns, buildCalendarimportJoins),
+		Cars:               buildJoinSet[carJoins[Q]](CarColumns, buildCarJoins),
+		Devices:            buildJoinSet[deviceJoins[Q]](DeviceColumns, buildDeviceJoins),
+		Holds:              buildJoinSet[holdJoins[Q]](HoldColumns, buildHoldJoins),
+		Messages:           buildJoinSet[messageJoins[Q]](MessageColumns, buildMessageJoins),
+		Notifications:      buildJoinSet[notificationJoins[Q]](NotificationColumns, buildNotificationJoins),
+		Parkinglocations:   buildJoinSet[parkinglocationJoins[Q]](ParkinglocationColumns, buildParkinglocationJoins),
+		Parkingspots:       buildJoinSet[parkingspotJoins[Q]](ParkingspotColumns, buildParkingspotJoins),
+		Parkingspotphotos:  buildJoinSet[parkingspotphotoJoins[Q]](ParkingspotphotoColumns, buildParkingspotphotoJoins),
+		Preferencespots:    buildJoinSet[preferencespotJoins[Q]](PreferencespotColumns, buildPreferencespotJoins),
+		Pricingrules:       buildJoinSet[pricingruleJoins[Q]](PricingruleColumns, buildPricingruleJoins),
+		Promocodes:         buildJoinSet[promocodeJoins[Q]](PromocodeColumns, buildPromocodeJoins),
+		Resettokens:        buildJoinSet[resettokenJoins[Q]](ResettokenColumns, buildResettokenJoins),
+		Reviews:            buildJoinSet[reviewJoins[Q]](ReviewColumns, buildReviewJoins),
+		Savedsearches:      buildJoinSet[savedsearchJoins[Q]](SavedsearchColumns, buildSavedsearchJoins),
+		Spotpricings:       buildJoinSet[spotpricingJoins[Q]](SpotpricingColumns, buildSpotpricingJoins),
+		Timeunits:          buildJoinSet[timeunitJoins[Q]](TimeunitColumns, buildTimeunitJoins),
+		Users:              buildJoinSet[userJoins[Q]](UserColumns, buildUserJoins),
+		Webhooks:           buildJoinSet[webhookJoins[Q]](WebhookColumns, buildWebhookJoins),
+		Webhookdeliveries:  buildJoinSet[webhookdeliveryJoins[Q]](WebhookdeliveryColumns, buildWebhookdeliveryJoins),
 	}
 }
 
@@ -13,33 +13,93 @@ import (
 	"github.com/stephenafamo/bob"
 )
 
+// Make sure the type Administrator runs hooks after queries
+var _ bob.HookableType = &Administrator{}
+
 // Make sure the type Auth runs hooks after queries
 var _ bob.HookableType = &Auth{}
 
+// Make sure the type Availabilityalert runs hooks after queries
+var _ bob.HookableType = &Availabilityalert{}
+
 // Make sure the type Booking runs hooks after queries
 var _ bob.HookableType = &Booking{}
 
+// Make sure the type Calendarfeed runs hooks after queries
+var _ bob.HookableType = &Calendarfeed{}
+
+// Make sure the type Calendarimport runs hooks after queries
+var _ bob.HookableType = &Calendarimport{}
+
 // Make sure the type Car runs hooks after queries
 var _ bob.HookableType = &Car{}
 
+// Make sure the type Device runs hooks after queries
+var _ bob.HookableType = &Device{}
+
+// Make sure the type Geocodecache runs hooks after queries
+var _ bob.HookableType = &Geocodecache{}
+
+// Make sure the type Hold runs hooks after queries
+var _ bob.HookableType = &Hold{}
+
+// Make sure the type Message runs hooks after queries
+var _ bob.HookableType = &Message{}
+
+// Make sure the type Notification runs hooks after queries
+var _ bob.HookableType = &Notification{}
+
+// Make sure the type Outbox runs hooks after queries
+var _ bob.HookableType = &Outbox{}
+
+// Make sure the type Parkinglocation runs hooks after queries
+var _ bob.HookableType = &Parkinglocation{}
+
 // Make sure the type Parkingspot runs hooks after queries
 var _ bob.HookableType = &Parkingspot{}
 
+// Make sure the type Parkingspotphoto runs hooks after queries
+var _ bob.HookableType = &Parkingspotphoto{}
+
 // Make sure the type Preferencespot runs hooks after queries
 var _ bob.HookableType = &Preferencespot{}
 
+// Make sure the type Pricingrule runs hooks after queries
+var _ bob.HookableType = &Pricingrule{}
+
+// Make sure the type Promocode runs hooks after queries
+var _ bob.HookableType = &Promocode{}
+
+// Make sure the type Quote runs hooks after queries
+var _ bob.HookableType = &Quote{}
+
 // Make sure the type Resettoken runs hooks after queries
 var _ bob.HookableType = &Resettoken{}
 
+// Make sure the type Review runs hooks after queries
+var _ bob.HookableType = &Review{}
+
+// Make sure the type Savedsearch runs hooks after queries
+var _ bob.HookableType = &Savedsearch{}
+
 // Make sure the type Session runs hooks after queries
 var _ bob.HookableType = &Session{}
 
+// Make sure the type Spotpricing runs hooks after queries
+var _ bob.HookableType = &Spotpricing{}
+
 // Make sure the type Timeunit runs hooks after queries
 var _ bob.HookableType = &Timeunit{}
 
 // Make sure the type User runs hooks after queries
 var _ bob.HookableType = &User{}
 
+// Make sure the type Webhook runs hooks after queries
+var _ bob.HookableType = &Webhook{}
+
+// Make sure the type Webhookdelivery runs hooks after queries
+var _ bob.HookableType = &Webhookdelivery{}
+
 // Make sure the type uuid.UUID satisfies database/sql.Scanner
 var _ sql.Scanner = (*uuid.UUID)(nil)
 
@@ -11,6 +11,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/aarondl/opt/null"
 	"github.com/aarondl/opt/omit"
 	"github.com/aarondl/opt/omitnull"
 	"github.com/google/uuid"
@@ -28,13 +29,17 @@ import (
 
 // Booking is an object representing the database table.
 type Booking struct {
-	Bookingid     int64           `db:"bookingid,pk" `
-	Bookinguuid   uuid.UUID       `db:"bookinguuid" `
-	Userid        int64           `db:"userid" `
-	Parkingspotid int64           `db:"parkingspotid" `
-	Carid         int64           `db:"carid" `
-	Paidamount    decimal.Decimal `db:"paidamount" `
-	Createdat     time.Time       `db:"createdat" `
+	Bookingid      int64               `db:"bookingid,pk" `
+	Bookinguuid    uuid.UUID           `db:"bookinguuid" `
+	Userid         int64               `db:"userid" `
+	Parkingspotid  int64               `db:"parkingspotid" `
+	Carid          int64               `db:"carid" `
+	Paidamount     decimal.Decimal     `db:"paidamount" `
+	Createdat      time.Time           `db:"createdat" `
+	Promocodeid    null.Val[int64]     `db:"promocodeid" `
+	Discountamount decimal.Decimal     `db:"discountamount" `
+	Payoutamount   decimal.Decimal     `db:"payoutamount" `
+	Remindedat     null.Val[time.Time] `db:"remindedat" `
 
 	R bookingR `db:"-" `
 }
@@ -53,31 +58,42 @@ type BookingsQuery = *psql.ViewQuery[*Booking, BookingSlice]
 type bookingR struct {
 	CaridCar                 *Car          // booking.booking_carid_fkey
 	ParkingspotidParkingspot *Parkingspot  // booking.booking_parkingspotid_fkey
+	PromocodeidPromocode     *Promocode    // booking.booking_promocodeid_fkey
 	UseridUser               *User         // booking.booking_userid_fkey
+	BookingidMessages        MessageSlice  // message.message_bookingid_fkey
+	BookingidReviews         ReviewSlice   // review.review_bookingid_fkey
 	BookingidTimeunits       TimeunitSlice // timeunit.timeunit_bookingid_fkey
 }
 
 type bookingColumnNames struct {
-	Bookingid     string
-	Bookinguuid   string
-	Userid        string
-	Parkingspotid string
-	Carid         string
-	Paidamount    string
-	Createdat     string
+	Bookingid      string
+	Bookinguuid    string
+	Userid         string
+	Parkingspotid  string
+	Carid          string
+	Paidamount     string
+	Createdat      string
+	Promocodeid    string
+	Discountamount string
+	Payoutamount   string
+	Remindedat     string
 }
 
 var BookingColumns = buildBookingColumns("booking")
 
 type bookingColumns struct {
-	tableAlias    string
-	Bookingid     psql.Expression
-	Bookinguuid   psql.Expression
-	Userid        psql.Expression
-	Parkingspotid psql.Expression
-	Carid         psql.Expression
-	Paidamount    psql.Expression
-	Createdat     psql.Expression
+	tableAlias     string
+	Bookingid      psql.Expression
+	Bookinguuid    psql.Expression
+	Userid         psql.Expression
+	Parkingspotid  psql.Expression
+	Carid          psql.Expression
+	Paidamount     psql.Expression
+	Createdat      psql.Expression
+	Promocodeid    psql.Expression
+	Discountamount psql.Expression
+	Payoutamount   psql.Expression
+	Remindedat     psql.Expression
 }
 
 func (c bookingColumns) Alias() string {
@@ -90,25 +106,33 @@ func (bookingColumns) AliasedAs(alias string) bookingColumns {
 
 func buildBookingColumns(alias string) bookingColumns {
 	return bookingColumns{
-		tableAlias:    alias,
-		Bookingid:     psql.Quote(alias, "bookingid"),
-		Bookinguuid:   psql.Quote(alias, "bookinguuid"),
-		Userid:        psql.Quote(alias, "userid"),
-		Parkingspotid: psql.Quote(alias, "parkingspotid"),
-		Carid:         psql.Quote(alias, "carid"),
-		Paidamount:    psql.Quote(alias, "paidamount"),
-		Createdat:     psql.Quote(alias, "createdat"),
+		tableAlias:     alias,
+		Bookingid:      psql.Quote(alias, "bookingid"),
+		Bookinguuid:    psql.Quote(alias, "bookinguuid"),
+		Userid:         psql.Quote(alias, "userid"),
+		Parkingspotid:  psql.Quote(alias, "parkingspotid"),
+		Carid:          psql.Quote(alias, "carid"),
+		Paidamount:     psql.Quote(alias, "paidamount"),
+		Createdat:      psql.Quote(alias, "createdat"),
+		Promocodeid:    psql.Quote(alias, "promocodeid"),
+		Discountamount: psql.Quote(alias, "discountamount"),
+		Payoutamount:   psql.Quote(alias, "payoutamount"),
+		Remindedat:     psql.Quote(alias, "remindedat"),
 	}
 }
 
 type bookingWhere[Q psql.Filterable] struct {
-	Bookingid     psql.WhereMod[Q, int64]
-	Bookinguuid   psql.WhereMod[Q, uuid.UUID]
-	Userid        psql.WhereMod[Q, int64]
-	Parkingspotid psql.WhereMod[Q, int64]
-	Carid         psql.WhereMod[Q, int64]
-	Paidamount    psql.WhereMod[Q, decimal.Decimal]
-	Createdat     psql.WhereMod[Q, time.Time]
+	Bookingid      psql.WhereMod[Q, int64]
+	Bookinguuid    psql.WhereMod[Q, uuid.UUID]
+	Userid         psql.WhereMod[Q, int64]
+	Parkingspotid  psql.WhereMod[Q, int64]
+	Carid          psql.WhereMod[Q, int64]
+	Paidamount     psql.WhereMod[Q, decimal.Decimal]
+	Createdat      psql.WhereMod[Q, time.Time]
+	Promocodeid    psql.WhereNullMod[Q, int64]
+	Discountamount psql.WhereMod[Q, decimal.Decimal]
+	Payoutamount   psql.WhereMod[Q, decimal.Decimal]
+	Remindedat     psql.WhereNullMod[Q, time.Time]
 }
 
 func (bookingWhere[Q]) AliasedAs(alias string) bookingWhere[Q] {
@@ -117,13 +141,17 @@ func (bookingWhere[Q]) AliasedAs(alias string) bookingWhere[Q] {
 
 func buildBookingWhere[Q psql.Filterable](cols bookingColumns) bookingWhere[Q] {
 	return bookingWhere[Q]{
-		Bookingid:     psql.Where[Q, int64](cols.Bookingid),
-		Bookinguuid:   psql.Where[Q, uuid.UUID](cols.Bookinguuid),
-		Userid:        psql.Where[Q, int64](cols.Userid),
-		Parkingspotid: psql.Where[Q, int64](cols.Parkingspotid),
-		Carid:         psql.Where[Q, int64](cols.Carid),
-		Paidamount:    psql.Where[Q, decimal.Decimal](cols.Paidamount),
-		Createdat:     psql.Where[Q, time.Time](cols.Createdat),
+		Bookingid:      psql.Where[Q, int64](cols.Bookingid),
+		Bookinguuid:    psql.Where[Q, uuid.UUID](cols.Bookinguuid),
+		Userid:         psql.Where[Q, int64](cols.Userid),
+		Parkingspotid:  psql.Where[Q, int64](cols.Parkingspotid),
+		Carid:          psql.Where[Q, int64](cols.Carid),
+		Paidamount:     psql.Where[Q, decimal.Decimal](cols.Paidamount),
+		Createdat:      psql.Where[Q, time.Time](cols.Createdat),
+		Promocodeid:    psql.WhereNull[Q, int64](cols.Promocodeid),
+		Discountamount: psql.Where[Q, decimal.Decimal](cols.Discountamount),
+		Payoutamount:   psql.Where[Q, decimal.Decimal](cols.Payoutamount),
+		Remindedat:     psql.WhereNull[Q, time.Time](cols.Remindedat),
 	}
 }
 
@@ -139,17 +167,21 @@ type bookingErrors struct {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type BookingSetter struct {
-	Bookingid     omit.Val[int64]           `db:"bookingid,pk" `
-	Bookinguuid   omit.Val[uuid.UUID]       `db:"bookinguuid" `
-	Userid        omit.Val[int64]           `db:"userid" `
-	Parkingspotid omit.Val[int64]           `db:"parkingspotid" `
-	Carid         omit.Val[int64]           `db:"carid" `
-	Paidamount    omit.Val[decimal.Decimal] `db:"paidamount" `
-	Createdat     omit.Val[time.Time]       `db:"createdat" `
+	Bookingid      omit.Val[int64]           `db:"bookingid,pk" `
+	Bookinguuid    omit.Val[uuid.UUID]       `db:"bookinguuid" `
+	Userid         omit.Val[int64]           `db:"userid" `
+	Parkingspotid  omit.Val[int64]           `db:"parkingspotid" `
+	Carid          omit.Val[int64]           `db:"carid" `
+	Paidamount     omit.Val[decimal.Decimal] `db:"paidamount" `
+	Createdat      omit.Val[time.Time]       `db:"createdat" `
+	Promocodeid    omitnull.Val[int64]       `db:"promocodeid" `
+	Discountamount omit.Val[decimal.Decimal] `db:"discountamount" `
+	Payoutamount   omit.Val[decimal.Decimal] `db:"payoutamount" `
+	Remindedat     omitnull.Val[time.Time]   `db:"remindedat" `
 }
 
 func (s BookingSetter) SetColumns() []string {
-	vals := make([]string, 0, 7)
+	vals := make([]string, 0, 11)
 	if !s.Bookingid.IsUnset() {
 		vals = append(vals, "bookingid")
 	}
@@ -178,6 +210,22 @@ func (s BookingSetter) SetColumns() []string {
 		vals = append(vals, "createdat")
 	}
 
+	if !s.Promocodeid.IsUnset() {
+		vals = append(vals, "promocodeid")
+	}
+
+	if !s.Discountamount.IsUnset() {
+		vals = append(vals, "discountamount")
+	}
+
+	if !s.Payoutamount.IsUnset() {
+		vals = append(vals, "payoutamount")
+	}
+
+	if !s.Remindedat.IsUnset() {
+		vals = append(vals, "remindedat")
+	}
+
 	return vals
 }
 
@@ -203,6 +251,18 @@ func (s BookingSetter) Overwrite(t *Booking) {
 	if !s.Createdat.IsUnset() {
 		t.Createdat, _ = s.Createdat.Get()
 	}
+	if !s.Promocodeid.IsUnset() {
+		t.Promocodeid, _ = s.Promocodeid.GetNull()
+	}
+	if !s.Discountamount.IsUnset() {
+		t.Discountamount, _ = s.Discountamount.Get()
+	}
+	if !s.Payoutamount.IsUnset() {
+		t.Payoutamount, _ = s.Payoutamount.Get()
+	}
+	if !s.Remindedat.IsUnset() {
+		t.Remindedat, _ = s.Remindedat.GetNull()
+	}
 }
 
 func (s *BookingSetter) Apply(q *dialect.InsertQuery) {
@@ -211,7 +271,7 @@ func (s *BookingSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 7)
+		vals := make([]bob.Expression, 11)
 		if s.Bookingid.IsUnset() {
 			vals[0] = psql.Raw("DEFAULT")
 		} else {
@@ -254,6 +314,30 @@ func (s *BookingSetter) Apply(q *dialect.InsertQuery) {
 			vals[6] = psql.Arg(s.Createdat)
 		}
 
+		if s.Promocodeid.IsUnset() {
+			vals[7] = psql.Raw("DEFAULT")
+		} else {
+			vals[7] = psql.Arg(s.Promocodeid)
+		}
+
+		if s.Discountamount.IsUnset() {
+			vals[8] = psql.Raw("DEFAULT")
+		} else {
+			vals[8] = psql.Arg(s.Discountamount)
+		}
+
+		if s.Payoutamount.IsUnset() {
+			vals[9] = psql.Raw("DEFAULT")
+		} else {
+			vals[9] = psql.Arg(s.Payoutamount)
+		}
+
+		if s.Remindedat.IsUnset() {
+			vals[10] = psql.Raw("DEFAULT")
+		} else {
+			vals[10] = psql.Arg(s.Remindedat)
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -263,7 +347,7 @@ func (s BookingSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s BookingSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 7)
+	exprs := make([]bob.Expression, 0, 11)
 
 	if !s.Bookingid.IsUnset() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -314,6 +398,34 @@ func (s BookingSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.Promocodeid.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "promocodeid")...),
+			psql.Arg(s.Promocodeid),
+		}})
+	}
+
+	if !s.Discountamount.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "discountamount")...),
+			psql.Arg(s.Discountamount),
+		}})
+	}
+
+	if !s.Payoutamount.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "payoutamount")...),
+			psql.Arg(s.Payoutamount),
+		}})
+	}
+
+	if !s.Remindedat.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "remindedat")...),
+			psql.Arg(s.Remindedat),
+		}})
+	}
+
 	return exprs
 }
 
@@ -528,7 +640,10 @@ type bookingJoins[Q dialect.Joinable] struct {
 	typ                      string
 	CaridCar                 func(context.Context) modAs[Q, carColumns]
 	ParkingspotidParkingspot func(context.Context) modAs[Q, parkingspotColumns]
+	PromocodeidPromocode     func(context.Context) modAs[Q, promocodeColumns]
 	UseridUser               func(context.Context) modAs[Q, userColumns]
+	BookingidMessages        func(context.Context) modAs[Q, messageColumns]
+	BookingidReviews         func(context.Context) modAs[Q, reviewColumns]
 	BookingidTimeunits       func(context.Context) modAs[Q, timeunitColumns]
 }
 
@@ -541,7 +656,10 @@ func buildBookingJoins[Q dialect.Joinable](cols bookingColumns, typ string) book
 		typ:                      typ,
 		CaridCar:                 bookingsJoinCaridCar[Q](cols, typ),
 		ParkingspotidParkingspot: bookingsJoinParkingspotidParkingspot[Q](cols, typ),
+		PromocodeidPromocode:     bookingsJoinPromocodeidPromocode[Q](cols, typ),
 		UseridUser:               bookingsJoinUseridUser[Q](cols, typ),
+		BookingidMessages:        bookingsJoinBookingidMessages[Q](cols, typ),
+		BookingidReviews:         bookingsJoinBookingidReviews[Q](cols, typ),
 		BookingidTimeunits:       bookingsJoinBookingidTimeunits[Q](cols, typ),
 	}
 }
@@ -584,6 +702,25 @@ func bookingsJoinParkingspotidParkingspot[Q dialect.Joinable](from bookingColumn
 	}
 }
 
+func bookingsJoinPromocodeidPromocode[Q dialect.Joinable](from bookingColumns, typ string) func(context.Context) modAs[Q, promocodeColumns] {
+	return func(ctx context.Context) modAs[Q, promocodeColumns] {
+		return modAs[Q, promocodeColumns]{
+			c: PromocodeColumns,
+			f: func(to promocodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Promocodes.Name().As(to.Alias())).On(
+						to.Promocodeid.EQ(from.Promocodeid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
 func bookingsJoinUseridUser[Q dialect.Joinable](from bookingColumns, typ string) func(context.Context) modAs[Q, userColumns] {
 	return func(ctx context.Context) modAs[Q, userColumns] {
 		return modAs[Q, userColumns]{
@@ -603,6 +740,44 @@ func bookingsJoinUseridUser[Q dialect.Joinable](from bookingColumns, typ string)
 	}
 }
 
+func bookingsJoinBookingidMessages[Q dialect.Joinable](from bookingColumns, typ string) func(context.Context) modAs[Q, messageColumns] {
+	return func(ctx context.Context) modAs[Q, messageColumns] {
+		return modAs[Q, messageColumns]{
+			c: MessageColumns,
+			f: func(to messageColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Messages.Name().As(to.Alias())).On(
+						to.Bookingid.EQ(from.Bookingid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func bookingsJoinBookingidReviews[Q dialect.Joinable](from bookingColumns, typ string) func(context.Context) modAs[Q, reviewColumns] {
+	return func(ctx context.Context) modAs[Q, reviewColumns] {
+		return modAs[Q, reviewColumns]{
+			c: ReviewColumns,
+			f: func(to reviewColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Reviews.Name().As(to.Alias())).On(
+						to.Bookingid.EQ(from.Bookingid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
 func bookingsJoinBookingidTimeunits[Q dialect.Joinable](from bookingColumns, typ string) func(context.Context) modAs[Q, timeunitColumns] {
 	return func(ctx context.Context) modAs[Q, timeunitColumns] {
 		return modAs[Q, timeunitColumns]{
@@ -658,6 +833,24 @@ func (os BookingSlice) ParkingspotidParkingspot(mods ...bob.Mod[*dialect.SelectQ
 	)...)
 }
 
+// PromocodeidPromocode starts a query for related objects on promocode
+func (o *Booking) PromocodeidPromocode(mods ...bob.Mod[*dialect.SelectQuery]) PromocodesQuery {
+	return Promocodes.Query(append(mods,
+		sm.Where(PromocodeColumns.Promocodeid.EQ(psql.Arg(o.Promocodeid))),
+	)...)
+}
+
+func (os BookingSlice) PromocodeidPromocode(mods ...bob.Mod[*dialect.SelectQuery]) PromocodesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Promocodeid)
+	}
+
+	return Promocodes.Query(append(mods,
+		sm.Where(psql.Group(PromocodeColumns.Promocodeid).In(PKArgs...)),
+	)...)
+}
+
 // UseridUser starts a query for related objects on users
 func (o *Booking) UseridUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
 	return Users.Query(append(mods,
@@ -676,6 +869,42 @@ func (os BookingSlice) UseridUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQu
 	)...)
 }
 
+// BookingidMessages starts a query for related objects on message
+func (o *Booking) BookingidMessages(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	return Messages.Query(append(mods,
+		sm.Where(MessageColumns.Bookingid.EQ(psql.Arg(o.Bookingid))),
+	)...)
+}
+
+func (os BookingSlice) BookingidMessages(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Bookingid)
+	}
+
+	return Messages.Query(append(mods,
+		sm.Where(psql.Group(MessageColumns.Bookingid).In(PKArgs...)),
+	)...)
+}
+
+// BookingidReviews starts a query for related objects on review
+func (o *Booking) BookingidReviews(mods ...bob.Mod[*dialect.SelectQuery]) ReviewsQuery {
+	return Reviews.Query(append(mods,
+		sm.Where(ReviewColumns.Bookingid.EQ(psql.Arg(o.Bookingid))),
+	)...)
+}
+
+func (os BookingSlice) BookingidReviews(mods ...bob.Mod[*dialect.SelectQuery]) ReviewsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Bookingid)
+	}
+
+	return Reviews.Query(append(mods,
+		sm.Where(psql.Group(ReviewColumns.Bookingid).In(PKArgs...)),
+	)...)
+}
+
 // BookingidTimeunits starts a query for related objects on timeunit
 func (o *Booking) BookingidTimeunits(mods ...bob.Mod[*dialect.SelectQuery]) TimeunitsQuery {
 	return Timeunits.Query(append(mods,
@@ -724,6 +953,18 @@ func (o *Booking) Preload(name string, retrieved any) error {
 			rel.R.ParkingspotidBookings = BookingSlice{o}
 		}
 		return nil
+	case "PromocodeidPromocode":
+		rel, ok := retrieved.(*Promocode)
+		if !ok {
+			return fmt.Errorf("booking cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.PromocodeidPromocode = rel
+
+		if rel != nil {
+			rel.R.PromocodeidBookings = BookingSlice{o}
+		}
+		return nil
 	case "UseridUser":
 		rel, ok := retrieved.(*User)
 		if !ok {
@@ -736,6 +977,34 @@ func (o *Booking) Preload(name string, retrieved any) error {
 			rel.R.UseridBookings = BookingSlice{o}
 		}
 		return nil
+	case "BookingidMessages":
+		rels, ok := retrieved.(MessageSlice)
+		if !ok {
+			return fmt.Errorf("booking cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.BookingidMessages = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.BookingidBooking = o
+			}
+		}
+		return nil
+	case "BookingidReviews":
+		rels, ok := retrieved.(ReviewSlice)
+		if !ok {
+			return fmt.Errorf("booking cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.BookingidReviews = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.BookingidBooking = o
+			}
+		}
+		return nil
 	case "BookingidTimeunits":
 		rels, ok := retrieved.(TimeunitSlice)
 		if !ok {
@@ -925,6 +1194,91 @@ func (os BookingSlice) LoadBookingParkingspotidParkingspot(ctx context.Context,
 	return nil
 }
 
+func PreloadBookingPromocodeidPromocode(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Promocode, PromocodeSlice](orm.Relationship{
+		Name: "PromocodeidPromocode",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Bookings,
+				To:   TableNames.Promocodes,
+				FromColumns: []string{
+					ColumnNames.Bookings.Promocodeid,
+				},
+				ToColumns: []string{
+					ColumnNames.Promocodes.Promocodeid,
+				},
+			},
+		},
+	}, Promocodes.Columns().Names(), opts...)
+}
+
+func ThenLoadBookingPromocodeidPromocode(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadBookingPromocodeidPromocode(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load BookingPromocodeidPromocode", retrieved)
+		}
+
+		err := loader.LoadBookingPromocodeidPromocode(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadBookingPromocodeidPromocode loads the booking's PromocodeidPromocode into the .R struct
+func (o *Booking) LoadBookingPromocodeidPromocode(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.PromocodeidPromocode = nil
+
+	related, err := o.PromocodeidPromocode(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.PromocodeidBookings = BookingSlice{o}
+
+	o.R.PromocodeidPromocode = related
+	return nil
+}
+
+// LoadBookingPromocodeidPromocode loads the booking's PromocodeidPromocode into the .R struct
+func (os BookingSlice) LoadBookingPromocodeidPromocode(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	promocodes, err := os.PromocodeidPromocode(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range promocodes {
+			if o.Promocodeid.GetOrZero() != rel.Promocodeid {
+				continue
+			}
+
+			rel.R.PromocodeidBookings = append(rel.R.PromocodeidBookings, o)
+
+			o.R.PromocodeidPromocode = rel
+			break
+		}
+	}
+
+	return nil
+}
+
 func PreloadBookingUseridUser(opts ...psql.PreloadOption) psql.Preloader {
 	return psql.Preload[*User, UserSlice](orm.Relationship{
 		Name: "UseridUser",
@@ -1010,6 +1364,150 @@ func (os BookingSlice) LoadBookingUseridUser(ctx context.Context, exec bob.Execu
 	return nil
 }
 
+func ThenLoadBookingBookingidMessages(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadBookingBookingidMessages(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load BookingBookingidMessages", retrieved)
+		}
+
+		err := loader.LoadBookingBookingidMessages(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadBookingBookingidMessages loads the booking's BookingidMessages into the .R struct
+func (o *Booking) LoadBookingBookingidMessages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.BookingidMessages = nil
+
+	related, err := o.BookingidMessages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.BookingidBooking = o
+	}
+
+	o.R.BookingidMessages = related
+	return nil
+}
+
+// LoadBookingBookingidMessages loads the booking's BookingidMessages into the .R struct
+func (os BookingSlice) LoadBookingBookingidMessages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	messages, err := os.BookingidMessages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.BookingidMessages = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range messages {
+			if o.Bookingid != rel.Bookingid {
+				continue
+			}
+
+			rel.R.BookingidBooking = o
+
+			o.R.BookingidMessages = append(o.R.BookingidMessages, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadBookingBookingidReviews(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadBookingBookingidReviews(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load BookingBookingidReviews", retrieved)
+		}
+
+		err := loader.LoadBookingBookingidReviews(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadBookingBookingidReviews loads the booking's BookingidReviews into the .R struct
+func (o *Booking) LoadBookingBookingidReviews(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.BookingidReviews = nil
+
+	related, err := o.BookingidReviews(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.BookingidBooking = o
+	}
+
+	o.R.BookingidReviews = related
+	return nil
+}
+
+// LoadBookingBookingidReviews loads the booking's BookingidReviews into the .R struct
+func (os BookingSlice) LoadBookingBookingidReviews(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	reviews, err := os.BookingidReviews(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.BookingidReviews = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range reviews {
+			if o.Bookingid != rel.Bookingid {
+				continue
+			}
+
+			rel.R.BookingidBooking = o
+
+			o.R.BookingidReviews = append(o.R.BookingidReviews, rel)
+		}
+	}
+
+	return nil
+}
+
 func ThenLoadBookingBookingidTimeunits(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
 	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
@@ -1174,6 +1672,52 @@ func (booking0 *Booking) AttachParkingspotidParkingspot(ctx context.Context, exe
 	return nil
 }
 
+func attachBookingPromocodeidPromocode0(ctx context.Context, exec bob.Executor, count int, booking0 *Booking, promocode1 *Promocode) (*Booking, error) {
+	setter := &BookingSetter{
+		Promocodeid: omitnull.From(promocode1.Promocodeid),
+	}
+
+	err := booking0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachBookingPromocodeidPromocode0: %w", err)
+	}
+
+	return booking0, nil
+}
+
+func (booking0 *Booking) InsertPromocodeidPromocode(ctx context.Context, exec bob.Executor, related *PromocodeSetter) error {
+	promocode1, err := Promocodes.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachBookingPromocodeidPromocode0(ctx, exec, 1, booking0, promocode1)
+	if err != nil {
+		return err
+	}
+
+	booking0.R.PromocodeidPromocode = promocode1
+
+	promocode1.R.PromocodeidBookings = append(promocode1.R.PromocodeidBookings, booking0)
+
+	return nil
+}
+
+func (booking0 *Booking) AttachPromocodeidPromocode(ctx context.Context, exec bob.Executor, promocode1 *Promocode) error {
+	var err error
+
+	_, err = attachBookingPromocodeidPromocode0(ctx, exec, 1, booking0, promocode1)
+	if err != nil {
+		return err
+	}
+
+	booking0.R.PromocodeidPromocode = promocode1
+
+	promocode1.R.PromocodeidBookings = append(promocode1.R.PromocodeidBookings, booking0)
+
+	return nil
+}
+
 func attachBookingUseridUser0(ctx context.Context, exec bob.Executor, count int, booking0 *Booking, user1 *User) (*Booking, error) {
 	setter := &BookingSetter{
 		Userid: omit.From(user1.Userid),
@@ -1220,6 +1764,142 @@ func (booking0 *Booking) AttachUseridUser(ctx context.Context, exec bob.Executor
 	return nil
 }
 
+func insertBookingBookingidMessages0(ctx context.Context, exec bob.Executor, messages1 []*MessageSetter, booking0 *Booking) (MessageSlice, error) {
+	for i := range messages1 {
+		messages1[i].Bookingid = omit.From(booking0.Bookingid)
+	}
+
+	ret, err := Messages.Insert(bob.ToMods(messages1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertBookingBookingidMessages0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachBookingBookingidMessages0(ctx context.Context, exec bob.Executor, count int, messages1 MessageSlice, booking0 *Booking) (MessageSlice, error) {
+	setter := &MessageSetter{
+		Bookingid: omit.From(booking0.Bookingid),
+	}
+
+	err := messages1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachBookingBookingidMessages0: %w", err)
+	}
+
+	return messages1, nil
+}
+
+func (booking0 *Booking) InsertBookingidMessages(ctx context.Context, exec bob.Executor, related ...*MessageSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	messages1, err := insertBookingBookingidMessages0(ctx, exec, related, booking0)
+	if err != nil {
+		return err
+	}
+
+	booking0.R.BookingidMessages = append(booking0.R.BookingidMessages, messages1...)
+
+	for _, rel := range messages1 {
+		rel.R.BookingidBooking = booking0
+	}
+	return nil
+}
+
+func (booking0 *Booking) AttachBookingidMessages(ctx context.Context, exec bob.Executor, related ...*Message) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	messages1 := MessageSlice(related)
+
+	_, err = attachBookingBookingidMessages0(ctx, exec, len(related), messages1, booking0)
+	if err != nil {
+		return err
+	}
+
+	booking0.R.BookingidMessages = append(booking0.R.BookingidMessages, messages1...)
+
+	for _, rel := range related {
+		rel.R.BookingidBooking = booking0
+	}
+
+	return nil
+}
+
+func insertBookingBookingidReviews0(ctx context.Context, exec bob.Executor, reviews1 []*ReviewSetter, booking0 *Booking) (ReviewSlice, error) {
+	for i := range reviews1 {
+		reviews1[i].Bookingid = omit.From(booking0.Bookingid)
+	}
+
+	ret, err := Reviews.Insert(bob.ToMods(reviews1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertBookingBookingidReviews0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachBookingBookingidReviews0(ctx context.Context, exec bob.Executor, count int, reviews1 ReviewSlice, booking0 *Booking) (ReviewSlice, error) {
+	setter := &ReviewSetter{
+		Bookingid: omit.From(booking0.Bookingid),
+	}
+
+	err := reviews1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachBookingBookingidReviews0: %w", err)
+	}
+
+	return reviews1, nil
+}
+
+func (booking0 *Booking) InsertBookingidReviews(ctx context.Context, exec bob.Executor, related ...*ReviewSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	reviews1, err := insertBookingBookingidReviews0(ctx, exec, related, booking0)
+	if err != nil {
+		return err
+	}
+
+	booking0.R.BookingidReviews = append(booking0.R.BookingidReviews, reviews1...)
+
+	for _, rel := range reviews1 {
+		rel.R.BookingidBooking = booking0
+	}
+	return nil
+}
+
+func (booking0 *Booking) AttachBookingidReviews(ctx context.Context, exec bob.Executor, related ...*Review) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	reviews1 := ReviewSlice(related)
+
+	_, err = attachBookingBookingidReviews0(ctx, exec, len(related), reviews1, booking0)
+	if err != nil {
+		return err
+	}
+
+	booking0.R.BookingidReviews = append(booking0.R.BookingidReviews, reviews1...)
+
+	for _, rel := range related {
+		rel.R.BookingidBooking = booking0
+	}
+
+	return nil
+}
+
 func insertBookingBookingidTimeunits0(ctx context.Context, exec bob.Executor, timeunits1 []*TimeunitSetter, booking0 *Booking) (TimeunitSlice, error) {
 	for i := range timeunits1 {
 		timeunits1[i].Bookingid = omitnull.From(booking0.Bookingid)
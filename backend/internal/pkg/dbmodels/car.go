@@ -32,6 +32,12 @@ type Car struct {
 	Make         string    `db:"make" `
 	Model        string    `db:"model" `
 	Color        string    `db:"color" `
+	Vehicleclass string    `db:"vehicleclass" `
+	Height       int32     `db:"height" `
+	Length       int32     `db:"length" `
+	Width        int32     `db:"width" `
+	Countrycode  string    `db:"countrycode" `
+	State        string    `db:"state" `
 
 	R carR `db:"-" `
 }
@@ -60,6 +66,12 @@ type carColumnNames struct {
 	Make         string
 	Model        string
 	Color        string
+	Vehicleclass string
+	Height       string
+	Length       string
+	Width        string
+	Countrycode  string
+	State        string
 }
 
 var CarColumns = buildCarColumns("car")
@@ -73,6 +85,12 @@ type carColumns struct {
 	Make         psql.Expression
 	Model        psql.Expression
 	Color        psql.Expression
+	Vehicleclass psql.Expression
+	Height       psql.Expression
+	Length       psql.Expression
+	Width        psql.Expression
+	Countrycode  psql.Expression
+	State        psql.Expression
 }
 
 func (c carColumns) Alias() string {
@@ -93,6 +111,12 @@ func buildCarColumns(alias string) carColumns {
 		Make:         psql.Quote(alias, "make"),
 		Model:        psql.Quote(alias, "model"),
 		Color:        psql.Quote(alias, "color"),
+		Vehicleclass: psql.Quote(alias, "vehicleclass"),
+		Height:       psql.Quote(alias, "height"),
+		Length:       psql.Quote(alias, "length"),
+		Width:        psql.Quote(alias, "width"),
+		Countrycode:  psql.Quote(alias, "countrycode"),
+		State:        psql.Quote(alias, "state"),
 	}
 }
 
@@ -104,6 +128,12 @@ type carWhere[Q psql.Filterable] struct {
 	Make         psql.WhereMod[Q, string]
 	Model        psql.WhereMod[Q, string]
 	Color        psql.WhereMod[Q, string]
+	Vehicleclass psql.WhereMod[Q, string]
+	Height       psql.WhereMod[Q, int32]
+	Length       psql.WhereMod[Q, int32]
+	Width        psql.WhereMod[Q, int32]
+	Countrycode  psql.WhereMod[Q, string]
+	State        psql.WhereMod[Q, string]
 }
 
 func (carWhere[Q]) AliasedAs(alias string) carWhere[Q] {
@@ -119,6 +149,12 @@ func buildCarWhere[Q psql.Filterable](cols carColumns) carWhere[Q] {
 		Make:         psql.Where[Q, string](cols.Make),
 		Model:        psql.Where[Q, string](cols.Model),
 		Color:        psql.Where[Q, string](cols.Color),
+		Vehicleclass: psql.Where[Q, string](cols.Vehicleclass),
+		Height:       psql.Where[Q, int32](cols.Height),
+		Length:       psql.Where[Q, int32](cols.Length),
+		Width:        psql.Where[Q, int32](cols.Width),
+		Countrycode:  psql.Where[Q, string](cols.Countrycode),
+		State:        psql.Where[Q, string](cols.State),
 	}
 }
 
@@ -141,10 +177,16 @@ type CarSetter struct {
 	Make         omit.Val[string]    `db:"make" `
 	Model        omit.Val[string]    `db:"model" `
 	Color        omit.Val[string]    `db:"color" `
+	Vehicleclass omit.Val[string]    `db:"vehicleclass" `
+	Height       omit.Val[int32]     `db:"height" `
+	Length       omit.Val[int32]     `db:"length" `
+	Width        omit.Val[int32]     `db:"width" `
+	Countrycode  omit.Val[string]    `db:"countrycode" `
+	State        omit.Val[string]    `db:"state" `
 }
 
 func (s CarSetter) SetColumns() []string {
-	vals := make([]string, 0, 7)
+	vals := make([]string, 0, 13)
 	if !s.Carid.IsUnset() {
 		vals = append(vals, "carid")
 	}
@@ -173,6 +215,30 @@ func (s CarSetter) SetColumns() []string {
 		vals = append(vals, "color")
 	}
 
+	if !s.Vehicleclass.IsUnset() {
+		vals = append(vals, "vehicleclass")
+	}
+
+	if !s.Height.IsUnset() {
+		vals = append(vals, "height")
+	}
+
+	if !s.Length.IsUnset() {
+		vals = append(vals, "length")
+	}
+
+	if !s.Width.IsUnset() {
+		vals = append(vals, "width")
+	}
+
+	if !s.Countrycode.IsUnset() {
+		vals = append(vals, "countrycode")
+	}
+
+	if !s.State.IsUnset() {
+		vals = append(vals, "state")
+	}
+
 	return vals
 }
 
@@ -198,6 +264,24 @@ func (s CarSetter) Overwrite(t *Car) {
 	if !s.Color.IsUnset() {
 		t.Color, _ = s.Color.Get()
 	}
+	if !s.Vehicleclass.IsUnset() {
+		t.Vehicleclass, _ = s.Vehicleclass.Get()
+	}
+	if !s.Height.IsUnset() {
+		t.Height, _ = s.Height.Get()
+	}
+	if !s.Length.IsUnset() {
+		t.Length, _ = s.Length.Get()
+	}
+	if !s.Width.IsUnset() {
+		t.Width, _ = s.Width.Get()
+	}
+	if !s.Countrycode.IsUnset() {
+		t.Countrycode, _ = s.Countrycode.Get()
+	}
+	if !s.State.IsUnset() {
+		t.State, _ = s.State.Get()
+	}
 }
 
 func (s *CarSetter) Apply(q *dialect.InsertQuery) {
@@ -206,7 +290,7 @@ func (s *CarSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 7)
+		vals := make([]bob.Expression, 13)
 		if s.Carid.IsUnset() {
 			vals[0] = psql.Raw("DEFAULT")
 		} else {
@@ -249,6 +333,42 @@ func (s *CarSetter) Apply(q *dialect.InsertQuery) {
 			vals[6] = psql.Arg(s.Color)
 		}
 
+		if s.Vehicleclass.IsUnset() {
+			vals[7] = psql.Raw("DEFAULT")
+		} else {
+			vals[7] = psql.Arg(s.Vehicleclass)
+		}
+
+		if s.Height.IsUnset() {
+			vals[8] = psql.Raw("DEFAULT")
+		} else {
+			vals[8] = psql.Arg(s.Height)
+		}
+
+		if s.Length.IsUnset() {
+			vals[9] = psql.Raw("DEFAULT")
+		} else {
+			vals[9] = psql.Arg(s.Length)
+		}
+
+		if s.Width.IsUnset() {
+			vals[10] = psql.Raw("DEFAULT")
+		} else {
+			vals[10] = psql.Arg(s.Width)
+		}
+
+		if s.Countrycode.IsUnset() {
+			vals[11] = psql.Raw("DEFAULT")
+		} else {
+			vals[11] = psql.Arg(s.Countrycode)
+		}
+
+		if s.State.IsUnset() {
+			vals[12] = psql.Raw("DEFAULT")
+		} else {
+			vals[12] = psql.Arg(s.State)
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -258,7 +378,7 @@ func (s CarSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s CarSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 7)
+	exprs := make([]bob.Expression, 0, 13)
 
 	if !s.Carid.IsUnset() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -309,6 +429,48 @@ func (s CarSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.Vehicleclass.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "vehicleclass")...),
+			psql.Arg(s.Vehicleclass),
+		}})
+	}
+
+	if !s.Height.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "height")...),
+			psql.Arg(s.Height),
+		}})
+	}
+
+	if !s.Length.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "length")...),
+			psql.Arg(s.Length),
+		}})
+	}
+
+	if !s.Width.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "width")...),
+			psql.Arg(s.Width),
+		}})
+	}
+
+	if !s.Countrycode.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "countrycode")...),
+			psql.Arg(s.Countrycode),
+		}})
+	}
+
+	if !s.State.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "state")...),
+			psql.Arg(s.State),
+		}})
+	}
+
 	return exprs
 }
 
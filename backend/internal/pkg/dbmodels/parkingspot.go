@@ -11,6 +11,7 @@ import (
 	"io"
 
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/google/uuid"
 	"github.com/govalues/decimal"
 	"github.com/stephenafamo/bob"
@@ -40,6 +41,19 @@ type Parkingspot struct {
 	Hasplugin          bool            `db:"hasplugin" `
 	Haschargingstation bool            `db:"haschargingstation" `
 	Priceperhour       decimal.Decimal `db:"priceperhour" `
+	Ratingcount        int32           `db:"ratingcount" `
+	Ratingtotal        int32           `db:"ratingtotal" `
+	Parkinglocationid  int64           `db:"parkinglocationid" `
+	Label              string          `db:"label" `
+	Description        string          `db:"description" `
+	Accessinstructions string          `db:"accessinstructions" `
+	Maxheight          int32           `db:"maxheight" `
+	Maxlength          int32           `db:"maxlength" `
+	Notrucks           bool            `db:"notrucks" `
+	Isaccessible       bool            `db:"isaccessible" `
+	Isstepfree         bool            `db:"isstepfree" `
+	Iswelllit          bool            `db:"iswelllit" `
+	Maxwidth           int32           `db:"maxwidth" `
 
 	R parkingspotR `db:"-" `
 }
@@ -56,10 +70,18 @@ type ParkingspotsQuery = *psql.ViewQuery[*Parkingspot, ParkingspotSlice]
 
 // parkingspotR is where relationships are stored.
 type parkingspotR struct {
-	ParkingspotidBookings        BookingSlice        // booking.booking_parkingspotid_fkey
-	UseridUser                   *User               // parkingspot.parkingspot_userid_fkey
-	ParkingspotidPreferencespots PreferencespotSlice // preferencespot.preferencespot_parkingspotid_fkey
-	ParkingspotidTimeunits       TimeunitSlice       // timeunit.timeunit_parkingspotid_fkey
+	ParkingspotidAvailabilityalerts  AvailabilityalertSlice // availabilityalert.availabilityalert_parkingspotid_fkey
+	ParkingspotidBookings            BookingSlice           // booking.booking_parkingspotid_fkey
+	ParkingspotidCalendarimport      *Calendarimport        // calendarimport.calendarimport_parkingspotid_fkey
+	ParkingspotidHolds               HoldSlice              // hold.hold_parkingspotid_fkey
+	ParkinglocationidParkinglocation *Parkinglocation       // parkingspot.parkingspot_parkinglocationid_fkey
+	UseridUser                       *User                  // parkingspot.parkingspot_userid_fkey
+	ParkingspotidPreferencespots     PreferencespotSlice    // preferencespot.preferencespot_parkingspotid_fkey
+	ParkingspotidParkingspotphotos   ParkingspotphotoSlice  // parkingspotphoto.parkingspotphoto_parkingspotid_fkey
+	ParkingspotidPricingrules        PricingruleSlice       // pricingrule.pricingrule_parkingspotid_fkey
+	ParkingspotidPromocodes          PromocodeSlice         // promocode.promocode_parkingspotid_fkey
+	ParkingspotidSpotpricing         *Spotpricing           // spotpricing.spotpricing_parkingspotid_fkey
+	ParkingspotidTimeunits           TimeunitSlice          // timeunit.timeunit_parkingspotid_fkey
 }
 
 type parkingspotColumnNames struct {
@@ -77,6 +99,19 @@ type parkingspotColumnNames struct {
 	Hasplugin          string
 	Haschargingstation string
 	Priceperhour       string
+	Ratingcount        string
+	Ratingtotal        string
+	Parkinglocationid  string
+	Label              string
+	Description        string
+	Accessinstructions string
+	Maxheight          string
+	Maxlength          string
+	Notrucks           string
+	Isaccessible       string
+	Isstepfree         string
+	Iswelllit          string
+	Maxwidth           string
 }
 
 var ParkingspotColumns = buildParkingspotColumns("parkingspot")
@@ -97,6 +132,19 @@ type parkingspotColumns struct {
 	Hasplugin          psql.Expression
 	Haschargingstation psql.Expression
 	Priceperhour       psql.Expression
+	Ratingcount        psql.Expression
+	Ratingtotal        psql.Expression
+	Parkinglocationid  psql.Expression
+	Label              psql.Expression
+	Description        psql.Expression
+	Accessinstructions psql.Expression
+	Maxheight          psql.Expression
+	Maxlength          psql.Expression
+	Notrucks           psql.Expression
+	Isaccessible       psql.Expression
+	Isstepfree         psql.Expression
+	Iswelllit          psql.Expression
+	Maxwidth           psql.Expression
 }
 
 func (c parkingspotColumns) Alias() string {
@@ -124,6 +172,19 @@ func buildParkingspotColumns(alias string) parkingspotColumns {
 		Hasplugin:          psql.Quote(alias, "hasplugin"),
 		Haschargingstation: psql.Quote(alias, "haschargingstation"),
 		Priceperhour:       psql.Quote(alias, "priceperhour"),
+		Ratingcount:        psql.Quote(alias, "ratingcount"),
+		Ratingtotal:        psql.Quote(alias, "ratingtotal"),
+		Parkinglocationid:  psql.Quote(alias, "parkinglocationid"),
+		Label:              psql.Quote(alias, "label"),
+		Description:        psql.Quote(alias, "description"),
+		Accessinstructions: psql.Quote(alias, "accessinstructions"),
+		Maxheight:          psql.Quote(alias, "maxheight"),
+		Maxlength:          psql.Quote(alias, "maxlength"),
+		Notrucks:           psql.Quote(alias, "notrucks"),
+		Isaccessible:       psql.Quote(alias, "isaccessible"),
+		Isstepfree:         psql.Quote(alias, "isstepfree"),
+		Iswelllit:          psql.Quote(alias, "iswelllit"),
+		Maxwidth:           psql.Quote(alias, "maxwidth"),
 	}
 }
 
@@ -142,6 +203,19 @@ type parkingspotWhere[Q psql.Filterable] struct {
 	Hasplugin          psql.WhereMod[Q, bool]
 	Haschargingstation psql.WhereMod[Q, bool]
 	Priceperhour       psql.WhereMod[Q, decimal.Decimal]
+	Ratingcount        psql.WhereMod[Q, int32]
+	Ratingtotal        psql.WhereMod[Q, int32]
+	Parkinglocationid  psql.WhereMod[Q, int64]
+	Label              psql.WhereMod[Q, string]
+	Description        psql.WhereMod[Q, string]
+	Accessinstructions psql.WhereMod[Q, string]
+	Maxheight          psql.WhereMod[Q, int32]
+	Maxlength          psql.WhereMod[Q, int32]
+	Notrucks           psql.WhereMod[Q, bool]
+	Isaccessible       psql.WhereMod[Q, bool]
+	Isstepfree         psql.WhereMod[Q, bool]
+	Iswelllit          psql.WhereMod[Q, bool]
+	Maxwidth           psql.WhereMod[Q, int32]
 }
 
 func (parkingspotWhere[Q]) AliasedAs(alias string) parkingspotWhere[Q] {
@@ -164,6 +238,19 @@ func buildParkingspotWhere[Q psql.Filterable](cols parkingspotColumns) parkingsp
 		Hasplugin:          psql.Where[Q, bool](cols.Hasplugin),
 		Haschargingstation: psql.Where[Q, bool](cols.Haschargingstation),
 		Priceperhour:       psql.Where[Q, decimal.Decimal](cols.Priceperhour),
+		Ratingcount:        psql.Where[Q, int32](cols.Ratingcount),
+		Ratingtotal:        psql.Where[Q, int32](cols.Ratingtotal),
+		Parkinglocationid:  psql.Where[Q, int64](cols.Parkinglocationid),
+		Label:              psql.Where[Q, string](cols.Label),
+		Description:        psql.Where[Q, string](cols.Description),
+		Accessinstructions: psql.Where[Q, string](cols.Accessinstructions),
+		Maxheight:          psql.Where[Q, int32](cols.Maxheight),
+		Maxlength:          psql.Where[Q, int32](cols.Maxlength),
+		Notrucks:           psql.Where[Q, bool](cols.Notrucks),
+		Isaccessible:       psql.Where[Q, bool](cols.Isaccessible),
+		Isstepfree:         psql.Where[Q, bool](cols.Isstepfree),
+		Iswelllit:          psql.Where[Q, bool](cols.Iswelllit),
+		Maxwidth:           psql.Where[Q, int32](cols.Maxwidth),
 	}
 }
 
@@ -193,10 +280,23 @@ type ParkingspotSetter struct {
 	Hasplugin          omit.Val[bool]            `db:"hasplugin" `
 	Haschargingstation omit.Val[bool]            `db:"haschargingstation" `
 	Priceperhour       omit.Val[decimal.Decimal] `db:"priceperhour" `
+	Ratingcount        omit.Val[int32]           `db:"ratingcount" `
+	Ratingtotal        omit.Val[int32]           `db:"ratingtotal" `
+	Parkinglocationid  omit.Val[int64]           `db:"parkinglocationid" `
+	Label              omit.Val[string]          `db:"label" `
+	Description        omit.Val[string]          `db:"description" `
+	Accessinstructions omit.Val[string]          `db:"accessinstructions" `
+	Maxheight          omit.Val[int32]           `db:"maxheight" `
+	Maxlength          omit.Val[int32]           `db:"maxlength" `
+	Notrucks           omit.Val[bool]            `db:"notrucks" `
+	Isaccessible       omit.Val[bool]            `db:"isaccessible" `
+	Isstepfree         omit.Val[bool]            `db:"isstepfree" `
+	Iswelllit          omit.Val[bool]            `db:"iswelllit" `
+	Maxwidth           omit.Val[int32]           `db:"maxwidth" `
 }
 
 func (s ParkingspotSetter) SetColumns() []string {
-	vals := make([]string, 0, 14)
+	vals := make([]string, 0, 27)
 	if !s.Parkingspotid.IsUnset() {
 		vals = append(vals, "parkingspotid")
 	}
@@ -253,6 +353,58 @@ func (s ParkingspotSetter) SetColumns() []string {
 		vals = append(vals, "priceperhour")
 	}
 
+	if !s.Ratingcount.IsUnset() {
+		vals = append(vals, "ratingcount")
+	}
+
+	if !s.Ratingtotal.IsUnset() {
+		vals = append(vals, "ratingtotal")
+	}
+
+	if !s.Parkinglocationid.IsUnset() {
+		vals = append(vals, "parkinglocationid")
+	}
+
+	if !s.Label.IsUnset() {
+		vals = append(vals, "label")
+	}
+
+	if !s.Description.IsUnset() {
+		vals = append(vals, "description")
+	}
+
+	if !s.Accessinstructions.IsUnset() {
+		vals = append(vals, "accessinstructions")
+	}
+
+	if !s.Maxheight.IsUnset() {
+		vals = append(vals, "maxheight")
+	}
+
+	if !s.Maxlength.IsUnset() {
+		vals = append(vals, "maxlength")
+	}
+
+	if !s.Notrucks.IsUnset() {
+		vals = append(vals, "notrucks")
+	}
+
+	if !s.Isaccessible.IsUnset() {
+		vals = append(vals, "isaccessible")
+	}
+
+	if !s.Isstepfree.IsUnset() {
+		vals = append(vals, "isstepfree")
+	}
+
+	if !s.Iswelllit.IsUnset() {
+		vals = append(vals, "iswelllit")
+	}
+
+	if !s.Maxwidth.IsUnset() {
+		vals = append(vals, "maxwidth")
+	}
+
 	return vals
 }
 
@@ -299,6 +451,45 @@ func (s ParkingspotSetter) Overwrite(t *Parkingspot) {
 	if !s.Priceperhour.IsUnset() {
 		t.Priceperhour, _ = s.Priceperhour.Get()
 	}
+	if !s.Ratingcount.IsUnset() {
+		t.Ratingcount, _ = s.Ratingcount.Get()
+	}
+	if !s.Ratingtotal.IsUnset() {
+		t.Ratingtotal, _ = s.Ratingtotal.Get()
+	}
+	if !s.Parkinglocationid.IsUnset() {
+		t.Parkinglocationid, _ = s.Parkinglocationid.Get()
+	}
+	if !s.Label.IsUnset() {
+		t.Label, _ = s.Label.Get()
+	}
+	if !s.Description.IsUnset() {
+		t.Description, _ = s.Description.Get()
+	}
+	if !s.Accessinstructions.IsUnset() {
+		t.Accessinstructions, _ = s.Accessinstructions.Get()
+	}
+	if !s.Maxheight.IsUnset() {
+		t.Maxheight, _ = s.Maxheight.Get()
+	}
+	if !s.Maxlength.IsUnset() {
+		t.Maxlength, _ = s.Maxlength.Get()
+	}
+	if !s.Notrucks.IsUnset() {
+		t.Notrucks, _ = s.Notrucks.Get()
+	}
+	if !s.Isaccessible.IsUnset() {
+		t.Isaccessible, _ = s.Isaccessible.Get()
+	}
+	if !s.Isstepfree.IsUnset() {
+		t.Isstepfree, _ = s.Isstepfree.Get()
+	}
+	if !s.Iswelllit.IsUnset() {
+		t.Iswelllit, _ = s.Iswelllit.Get()
+	}
+	if !s.Maxwidth.IsUnset() {
+		t.Maxwidth, _ = s.Maxwidth.Get()
+	}
 }
 
 func (s *ParkingspotSetter) Apply(q *dialect.InsertQuery) {
@@ -307,7 +498,7 @@ func (s *ParkingspotSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 14)
+		vals := make([]bob.Expression, 27)
 		if s.Parkingspotid.IsUnset() {
 			vals[0] = psql.Raw("DEFAULT")
 		} else {
@@ -392,6 +583,84 @@ func (s *ParkingspotSetter) Apply(q *dialect.InsertQuery) {
 			vals[13] = psql.Arg(s.Priceperhour)
 		}
 
+		if s.Ratingcount.IsUnset() {
+			vals[14] = psql.Raw("DEFAULT")
+		} else {
+			vals[14] = psql.Arg(s.Ratingcount)
+		}
+
+		if s.Ratingtotal.IsUnset() {
+			vals[15] = psql.Raw("DEFAULT")
+		} else {
+			vals[15] = psql.Arg(s.Ratingtotal)
+		}
+
+		if s.Parkinglocationid.IsUnset() {
+			vals[16] = psql.Raw("DEFAULT")
+		} else {
+			vals[16] = psql.Arg(s.Parkinglocationid)
+		}
+
+		if s.Label.IsUnset() {
+			vals[17] = psql.Raw("DEFAULT")
+		} else {
+			vals[17] = psql.Arg(s.Label)
+		}
+
+		if s.Description.IsUnset() {
+			vals[18] = psql.Raw("DEFAULT")
+		} else {
+			vals[18] = psql.Arg(s.Description)
+		}
+
+		if s.Accessinstructions.IsUnset() {
+			vals[19] = psql.Raw("DEFAULT")
+		} else {
+			vals[19] = psql.Arg(s.Accessinstructions)
+		}
+
+		if s.Maxheight.IsUnset() {
+			vals[20] = psql.Raw("DEFAULT")
+		} else {
+			vals[20] = psql.Arg(s.Maxheight)
+		}
+
+		if s.Maxlength.IsUnset() {
+			vals[21] = psql.Raw("DEFAULT")
+		} else {
+			vals[21] = psql.Arg(s.Maxlength)
+		}
+
+		if s.Notrucks.IsUnset() {
+			vals[22] = psql.Raw("DEFAULT")
+		} else {
+			vals[22] = psql.Arg(s.Notrucks)
+		}
+
+		if s.Isaccessible.IsUnset() {
+			vals[23] = psql.Raw("DEFAULT")
+		} else {
+			vals[23] = psql.Arg(s.Isaccessible)
+		}
+
+		if s.Isstepfree.IsUnset() {
+			vals[24] = psql.Raw("DEFAULT")
+		} else {
+			vals[24] = psql.Arg(s.Isstepfree)
+		}
+
+		if s.Iswelllit.IsUnset() {
+			vals[25] = psql.Raw("DEFAULT")
+		} else {
+			vals[25] = psql.Arg(s.Iswelllit)
+		}
+
+		if s.Maxwidth.IsUnset() {
+			vals[26] = psql.Raw("DEFAULT")
+		} else {
+			vals[26] = psql.Arg(s.Maxwidth)
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -401,7 +670,7 @@ func (s ParkingspotSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s ParkingspotSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 14)
+	exprs := make([]bob.Expression, 0, 27)
 
 	if !s.Parkingspotid.IsUnset() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -501,6 +770,97 @@ func (s ParkingspotSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.Ratingcount.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ratingcount")...),
+			psql.Arg(s.Ratingcount),
+		}})
+	}
+
+	if !s.Ratingtotal.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ratingtotal")...),
+			psql.Arg(s.Ratingtotal),
+		}})
+	}
+
+	if !s.Parkinglocationid.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "parkinglocationid")...),
+			psql.Arg(s.Parkinglocationid),
+		}})
+	}
+
+	if !s.Label.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "label")...),
+			psql.Arg(s.Label),
+		}})
+	}
+
+	if !s.Description.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "description")...),
+			psql.Arg(s.Description),
+		}})
+	}
+
+	if !s.Accessinstructions.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "accessinstructions")...),
+			psql.Arg(s.Accessinstructions),
+		}})
+	}
+
+	if !s.Maxheight.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "maxheight")...),
+			psql.Arg(s.Maxheight),
+		}})
+	}
+
+	if !s.Maxlength.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "maxlength")...),
+			psql.Arg(s.Maxlength),
+		}})
+	}
+
+	if !s.Notrucks.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "notrucks")...),
+			psql.Arg(s.Notrucks),
+		}})
+	}
+
+	if !s.Isaccessible.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "isaccessible")...),
+			psql.Arg(s.Isaccessible),
+		}})
+	}
+
+	if !s.Isstepfree.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "isstepfree")...),
+			psql.Arg(s.Isstepfree),
+		}})
+	}
+
+	if !s.Iswelllit.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "iswelllit")...),
+			psql.Arg(s.Iswelllit),
+		}})
+	}
+
+	if !s.Maxwidth.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "maxwidth")...),
+			psql.Arg(s.Maxwidth),
+		}})
+	}
+
 	return exprs
 }
 
@@ -712,11 +1072,19 @@ func (o ParkingspotSlice) ReloadAll(ctx context.Context, exec bob.Executor) erro
 }
 
 type parkingspotJoins[Q dialect.Joinable] struct {
-	typ                          string
-	ParkingspotidBookings        func(context.Context) modAs[Q, bookingColumns]
-	UseridUser                   func(context.Context) modAs[Q, userColumns]
-	ParkingspotidPreferencespots func(context.Context) modAs[Q, preferencespotColumns]
-	ParkingspotidTimeunits       func(context.Context) modAs[Q, timeunitColumns]
+	typ                              string
+	ParkingspotidAvailabilityalerts  func(context.Context) modAs[Q, availabilityalertColumns]
+	ParkingspotidBookings            func(context.Context) modAs[Q, bookingColumns]
+	ParkingspotidCalendarimport      func(context.Context) modAs[Q, calendarimportColumns]
+	ParkingspotidHolds               func(context.Context) modAs[Q, holdColumns]
+	ParkinglocationidParkinglocation func(context.Context) modAs[Q, parkinglocationColumns]
+	UseridUser                       func(context.Context) modAs[Q, userColumns]
+	ParkingspotidPreferencespots     func(context.Context) modAs[Q, preferencespotColumns]
+	ParkingspotidParkingspotphotos   func(context.Context) modAs[Q, parkingspotphotoColumns]
+	ParkingspotidPricingrules        func(context.Context) modAs[Q, pricingruleColumns]
+	ParkingspotidPromocodes          func(context.Context) modAs[Q, promocodeColumns]
+	ParkingspotidSpotpricing         func(context.Context) modAs[Q, spotpricingColumns]
+	ParkingspotidTimeunits           func(context.Context) modAs[Q, timeunitColumns]
 }
 
 func (j parkingspotJoins[Q]) aliasedAs(alias string) parkingspotJoins[Q] {
@@ -725,11 +1093,38 @@ func (j parkingspotJoins[Q]) aliasedAs(alias string) parkingspotJoins[Q] {
 
 func buildParkingspotJoins[Q dialect.Joinable](cols parkingspotColumns, typ string) parkingspotJoins[Q] {
 	return parkingspotJoins[Q]{
-		typ:                          typ,
-		ParkingspotidBookings:        parkingspotsJoinParkingspotidBookings[Q](cols, typ),
-		UseridUser:                   parkingspotsJoinUseridUser[Q](cols, typ),
-		ParkingspotidPreferencespots: parkingspotsJoinParkingspotidPreferencespots[Q](cols, typ),
-		ParkingspotidTimeunits:       parkingspotsJoinParkingspotidTimeunits[Q](cols, typ),
+		typ:                              typ,
+		ParkingspotidAvailabilityalerts:  parkingspotsJoinParkingspotidAvailabilityalerts[Q](cols, typ),
+		ParkingspotidBookings:            parkingspotsJoinParkingspotidBookings[Q](cols, typ),
+		ParkingspotidCalendarimport:      parkingspotsJoinParkingspotidCalendarimport[Q](cols, typ),
+		ParkingspotidHolds:               parkingspotsJoinParkingspotidHolds[Q](cols, typ),
+		ParkinglocationidParkinglocation: parkingspotsJoinParkinglocationidParkinglocation[Q](cols, typ),
+		UseridUser:                       parkingspotsJoinUseridUser[Q](cols, typ),
+		ParkingspotidPreferencespots:     parkingspotsJoinParkingspotidPreferencespots[Q](cols, typ),
+		ParkingspotidParkingspotphotos:   parkingspotsJoinParkingspotidParkingspotphotos[Q](cols, typ),
+		ParkingspotidPricingrules:        parkingspotsJoinParkingspotidPricingrules[Q](cols, typ),
+		ParkingspotidPromocodes:          parkingspotsJoinParkingspotidPromocodes[Q](cols, typ),
+		ParkingspotidSpotpricing:         parkingspotsJoinParkingspotidSpotpricing[Q](cols, typ),
+		ParkingspotidTimeunits:           parkingspotsJoinParkingspotidTimeunits[Q](cols, typ),
+	}
+}
+
+func parkingspotsJoinParkingspotidAvailabilityalerts[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, availabilityalertColumns] {
+	return func(ctx context.Context) modAs[Q, availabilityalertColumns] {
+		return modAs[Q, availabilityalertColumns]{
+			c: AvailabilityalertColumns,
+			f: func(to availabilityalertColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Availabilityalerts.Name().As(to.Alias())).On(
+						to.Parkingspotid.EQ(from.Parkingspotid),
+					))
+				}
+
+				return mods
+			},
+		}
 	}
 }
 
@@ -752,6 +1147,63 @@ func parkingspotsJoinParkingspotidBookings[Q dialect.Joinable](from parkingspotC
 	}
 }
 
+func parkingspotsJoinParkingspotidCalendarimport[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, calendarimportColumns] {
+	return func(ctx context.Context) modAs[Q, calendarimportColumns] {
+		return modAs[Q, calendarimportColumns]{
+			c: CalendarimportColumns,
+			f: func(to calendarimportColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Calendarimports.Name().As(to.Alias())).On(
+						to.Parkingspotid.EQ(from.Parkingspotid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func parkingspotsJoinParkingspotidHolds[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, holdColumns] {
+	return func(ctx context.Context) modAs[Q, holdColumns] {
+		return modAs[Q, holdColumns]{
+			c: HoldColumns,
+			f: func(to holdColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Holds.Name().As(to.Alias())).On(
+						to.Parkingspotid.EQ(from.Parkingspotid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func parkingspotsJoinParkinglocationidParkinglocation[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, parkinglocationColumns] {
+	return func(ctx context.Context) modAs[Q, parkinglocationColumns] {
+		return modAs[Q, parkinglocationColumns]{
+			c: ParkinglocationColumns,
+			f: func(to parkinglocationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Parkinglocations.Name().As(to.Alias())).On(
+						to.Parkinglocationid.EQ(from.Parkinglocationid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
 func parkingspotsJoinUseridUser[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, userColumns] {
 	return func(ctx context.Context) modAs[Q, userColumns] {
 		return modAs[Q, userColumns]{
@@ -790,6 +1242,82 @@ func parkingspotsJoinParkingspotidPreferencespots[Q dialect.Joinable](from parki
 	}
 }
 
+func parkingspotsJoinParkingspotidParkingspotphotos[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, parkingspotphotoColumns] {
+	return func(ctx context.Context) modAs[Q, parkingspotphotoColumns] {
+		return modAs[Q, parkingspotphotoColumns]{
+			c: ParkingspotphotoColumns,
+			f: func(to parkingspotphotoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Parkingspotphotos.Name().As(to.Alias())).On(
+						to.Parkingspotid.EQ(from.Parkingspotid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func parkingspotsJoinParkingspotidPricingrules[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, pricingruleColumns] {
+	return func(ctx context.Context) modAs[Q, pricingruleColumns] {
+		return modAs[Q, pricingruleColumns]{
+			c: PricingruleColumns,
+			f: func(to pricingruleColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Pricingrules.Name().As(to.Alias())).On(
+						to.Parkingspotid.EQ(from.Parkingspotid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func parkingspotsJoinParkingspotidPromocodes[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, promocodeColumns] {
+	return func(ctx context.Context) modAs[Q, promocodeColumns] {
+		return modAs[Q, promocodeColumns]{
+			c: PromocodeColumns,
+			f: func(to promocodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Promocodes.Name().As(to.Alias())).On(
+						to.Parkingspotid.EQ(from.Parkingspotid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func parkingspotsJoinParkingspotidSpotpricing[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, spotpricingColumns] {
+	return func(ctx context.Context) modAs[Q, spotpricingColumns] {
+		return modAs[Q, spotpricingColumns]{
+			c: SpotpricingColumns,
+			f: func(to spotpricingColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Spotpricings.Name().As(to.Alias())).On(
+						to.Parkingspotid.EQ(from.Parkingspotid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
 func parkingspotsJoinParkingspotidTimeunits[Q dialect.Joinable](from parkingspotColumns, typ string) func(context.Context) modAs[Q, timeunitColumns] {
 	return func(ctx context.Context) modAs[Q, timeunitColumns] {
 		return modAs[Q, timeunitColumns]{
@@ -809,6 +1337,24 @@ func parkingspotsJoinParkingspotidTimeunits[Q dialect.Joinable](from parkingspot
 	}
 }
 
+// ParkingspotidAvailabilityalerts starts a query for related objects on availabilityalert
+func (o *Parkingspot) ParkingspotidAvailabilityalerts(mods ...bob.Mod[*dialect.SelectQuery]) AvailabilityalertsQuery {
+	return Availabilityalerts.Query(append(mods,
+		sm.Where(AvailabilityalertColumns.Parkingspotid.EQ(psql.Arg(o.Parkingspotid))),
+	)...)
+}
+
+func (os ParkingspotSlice) ParkingspotidAvailabilityalerts(mods ...bob.Mod[*dialect.SelectQuery]) AvailabilityalertsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Parkingspotid)
+	}
+
+	return Availabilityalerts.Query(append(mods,
+		sm.Where(psql.Group(AvailabilityalertColumns.Parkingspotid).In(PKArgs...)),
+	)...)
+}
+
 // ParkingspotidBookings starts a query for related objects on booking
 func (o *Parkingspot) ParkingspotidBookings(mods ...bob.Mod[*dialect.SelectQuery]) BookingsQuery {
 	return Bookings.Query(append(mods,
@@ -827,42 +1373,168 @@ func (os ParkingspotSlice) ParkingspotidBookings(mods ...bob.Mod[*dialect.Select
 	)...)
 }
 
-// UseridUser starts a query for related objects on users
-func (o *Parkingspot) UseridUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
-	return Users.Query(append(mods,
-		sm.Where(UserColumns.Userid.EQ(psql.Arg(o.Userid))),
+// ParkingspotidCalendarimport starts a query for related objects on calendarimport
+func (o *Parkingspot) ParkingspotidCalendarimport(mods ...bob.Mod[*dialect.SelectQuery]) CalendarimportsQuery {
+	return Calendarimports.Query(append(mods,
+		sm.Where(CalendarimportColumns.Parkingspotid.EQ(psql.Arg(o.Parkingspotid))),
 	)...)
 }
 
-func (os ParkingspotSlice) UseridUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+func (os ParkingspotSlice) ParkingspotidCalendarimport(mods ...bob.Mod[*dialect.SelectQuery]) CalendarimportsQuery {
 	PKArgs := make([]bob.Expression, len(os))
 	for i, o := range os {
-		PKArgs[i] = psql.ArgGroup(o.Userid)
+		PKArgs[i] = psql.ArgGroup(o.Parkingspotid)
 	}
 
-	return Users.Query(append(mods,
-		sm.Where(psql.Group(UserColumns.Userid).In(PKArgs...)),
+	return Calendarimports.Query(append(mods,
+		sm.Where(psql.Group(CalendarimportColumns.Parkingspotid).In(PKArgs...)),
 	)...)
 }
 
-// ParkingspotidPreferencespots starts a query for related objects on preferencespot
-func (o *Parkingspot) ParkingspotidPreferencespots(mods ...bob.Mod[*dialect.SelectQuery]) PreferencespotsQuery {
-	return Preferencespots.Query(append(mods,
-		sm.Where(PreferencespotColumns.Parkingspotid.EQ(psql.Arg(o.Parkingspotid))),
+// ParkingspotidHolds starts a query for related objects on hold
+func (o *Parkingspot) ParkingspotidHolds(mods ...bob.Mod[*dialect.SelectQuery]) HoldsQuery {
+	return Holds.Query(append(mods,
+		sm.Where(HoldColumns.Parkingspotid.EQ(psql.Arg(o.Parkingspotid))),
 	)...)
 }
 
-func (os ParkingspotSlice) ParkingspotidPreferencespots(mods ...bob.Mod[*dialect.SelectQuery]) PreferencespotsQuery {
+func (os ParkingspotSlice) ParkingspotidHolds(mods ...bob.Mod[*dialect.SelectQuery]) HoldsQuery {
 	PKArgs := make([]bob.Expression, len(os))
 	for i, o := range os {
 		PKArgs[i] = psql.ArgGroup(o.Parkingspotid)
 	}
 
-	return Preferencespots.Query(append(mods,
+	return Holds.Query(append(mods,
+		sm.Where(psql.Group(HoldColumns.Parkingspotid).In(PKArgs...)),
+	)...)
+}
+
+// ParkinglocationidParkinglocation starts a query for related objects on parkinglocation
+func (o *Parkingspot) ParkinglocationidParkinglocation(mods ...bob.Mod[*dialect.SelectQuery]) ParkinglocationsQuery {
+	return Parkinglocations.Query(append(mods,
+		sm.Where(ParkinglocationColumns.Parkinglocationid.EQ(psql.Arg(o.Parkinglocationid))),
+	)...)
+}
+
+// UseridUser starts a query for related objects on users
+func (o *Parkingspot) UseridUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(UserColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os ParkingspotSlice) ParkinglocationidParkinglocation(mods ...bob.Mod[*dialect.SelectQuery]) ParkinglocationsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Parkinglocationid)
+	}
+
+	return Parkinglocations.Query(append(mods,
+		sm.Where(psql.Group(ParkinglocationColumns.Parkinglocationid).In(PKArgs...)),
+	)...)
+}
+
+func (os ParkingspotSlice) UseridUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(UserColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
+// ParkingspotidPreferencespots starts a query for related objects on preferencespot
+func (o *Parkingspot) ParkingspotidPreferencespots(mods ...bob.Mod[*dialect.SelectQuery]) PreferencespotsQuery {
+	return Preferencespots.Query(append(mods,
+		sm.Where(PreferencespotColumns.Parkingspotid.EQ(psql.Arg(o.Parkingspotid))),
+	)...)
+}
+
+func (os ParkingspotSlice) ParkingspotidPreferencespots(mods ...bob.Mod[*dialect.SelectQuery]) PreferencespotsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Parkingspotid)
+	}
+
+	return Preferencespots.Query(append(mods,
 		sm.Where(psql.Group(PreferencespotColumns.Parkingspotid).In(PKArgs...)),
 	)...)
 }
 
+// ParkingspotidParkingspotphotos starts a query for related objects on parkingspotphoto
+func (o *Parkingspot) ParkingspotidParkingspotphotos(mods ...bob.Mod[*dialect.SelectQuery]) ParkingspotphotosQuery {
+	return Parkingspotphotos.Query(append(mods,
+		sm.Where(ParkingspotphotoColumns.Parkingspotid.EQ(psql.Arg(o.Parkingspotid))),
+	)...)
+}
+
+// ParkingspotidPricingrules starts a query for related objects on pricingrule
+func (o *Parkingspot) ParkingspotidPricingrules(mods ...bob.Mod[*dialect.SelectQuery]) PricingrulesQuery {
+	return Pricingrules.Query(append(mods,
+		sm.Where(PricingruleColumns.Parkingspotid.EQ(psql.Arg(o.Parkingspotid))),
+	)...)
+}
+
+func (os ParkingspotSlice) ParkingspotidParkingspotphotos(mods ...bob.Mod[*dialect.SelectQuery]) ParkingspotphotosQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Parkingspotid)
+	}
+
+	return Parkingspotphotos.Query(append(mods,
+		sm.Where(psql.Group(ParkingspotphotoColumns.Parkingspotid).In(PKArgs...)),
+	)...)
+}
+
+func (os ParkingspotSlice) ParkingspotidPricingrules(mods ...bob.Mod[*dialect.SelectQuery]) PricingrulesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Parkingspotid)
+	}
+
+	return Pricingrules.Query(append(mods,
+		sm.Where(psql.Group(PricingruleColumns.Parkingspotid).In(PKArgs...)),
+	)...)
+}
+
+// ParkingspotidPromocodes starts a query for related objects on promocode
+func (o *Parkingspot) ParkingspotidPromocodes(mods ...bob.Mod[*dialect.SelectQuery]) PromocodesQuery {
+	return Promocodes.Query(append(mods,
+		sm.Where(PromocodeColumns.Parkingspotid.EQ(psql.Arg(o.Parkingspotid))),
+	)...)
+}
+
+func (os ParkingspotSlice) ParkingspotidPromocodes(mods ...bob.Mod[*dialect.SelectQuery]) PromocodesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Parkingspotid)
+	}
+
+	return Promocodes.Query(append(mods,
+		sm.Where(psql.Group(PromocodeColumns.Parkingspotid).In(PKArgs...)),
+	)...)
+}
+
+// ParkingspotidSpotpricing starts a query for related objects on spotpricing
+func (o *Parkingspot) ParkingspotidSpotpricing(mods ...bob.Mod[*dialect.SelectQuery]) SpotpricingsQuery {
+	return Spotpricings.Query(append(mods,
+		sm.Where(SpotpricingColumns.Parkingspotid.EQ(psql.Arg(o.Parkingspotid))),
+	)...)
+}
+
+func (os ParkingspotSlice) ParkingspotidSpotpricing(mods ...bob.Mod[*dialect.SelectQuery]) SpotpricingsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Parkingspotid)
+	}
+
+	return Spotpricings.Query(append(mods,
+		sm.Where(psql.Group(SpotpricingColumns.Parkingspotid).In(PKArgs...)),
+	)...)
+}
+
 // ParkingspotidTimeunits starts a query for related objects on timeunit
 func (o *Parkingspot) ParkingspotidTimeunits(mods ...bob.Mod[*dialect.SelectQuery]) TimeunitsQuery {
 	return Timeunits.Query(append(mods,
@@ -887,6 +1559,20 @@ func (o *Parkingspot) Preload(name string, retrieved any) error {
 	}
 
 	switch name {
+	case "ParkingspotidAvailabilityalerts":
+		rels, ok := retrieved.(AvailabilityalertSlice)
+		if !ok {
+			return fmt.Errorf("parkingspot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ParkingspotidAvailabilityalerts = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ParkingspotidParkingspot = o
+			}
+		}
+		return nil
 	case "ParkingspotidBookings":
 		rels, ok := retrieved.(BookingSlice)
 		if !ok {
@@ -901,6 +1587,44 @@ func (o *Parkingspot) Preload(name string, retrieved any) error {
 			}
 		}
 		return nil
+	case "ParkingspotidCalendarimport":
+		rel, ok := retrieved.(*Calendarimport)
+		if !ok {
+			return fmt.Errorf("parkingspot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ParkingspotidCalendarimport = rel
+
+		if rel != nil {
+			rel.R.ParkingspotidParkingspot = o
+		}
+		return nil
+	case "ParkingspotidHolds":
+		rels, ok := retrieved.(HoldSlice)
+		if !ok {
+			return fmt.Errorf("parkingspot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ParkingspotidHolds = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ParkingspotidParkingspot = o
+			}
+		}
+		return nil
+	case "ParkinglocationidParkinglocation":
+		rel, ok := retrieved.(*Parkinglocation)
+		if !ok {
+			return fmt.Errorf("parkingspot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ParkinglocationidParkinglocation = rel
+
+		if rel != nil {
+			rel.R.ParkinglocationidParkingspots = ParkingspotSlice{o}
+		}
+		return nil
 	case "UseridUser":
 		rel, ok := retrieved.(*User)
 		if !ok {
@@ -927,6 +1651,60 @@ func (o *Parkingspot) Preload(name string, retrieved any) error {
 			}
 		}
 		return nil
+	case "ParkingspotidParkingspotphotos":
+		rels, ok := retrieved.(ParkingspotphotoSlice)
+		if !ok {
+			return fmt.Errorf("parkingspot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ParkingspotidParkingspotphotos = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ParkingspotidParkingspot = o
+			}
+		}
+		return nil
+	case "ParkingspotidPricingrules":
+		rels, ok := retrieved.(PricingruleSlice)
+		if !ok {
+			return fmt.Errorf("parkingspot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ParkingspotidPricingrules = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ParkingspotidParkingspot = o
+			}
+		}
+		return nil
+	case "ParkingspotidPromocodes":
+		rels, ok := retrieved.(PromocodeSlice)
+		if !ok {
+			return fmt.Errorf("parkingspot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ParkingspotidPromocodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ParkingspotidParkingspot = o
+			}
+		}
+		return nil
+	case "ParkingspotidSpotpricing":
+		rel, ok := retrieved.(*Spotpricing)
+		if !ok {
+			return fmt.Errorf("parkingspot cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ParkingspotidSpotpricing = rel
+
+		if rel != nil {
+			rel.R.ParkingspotidParkingspot = o
+		}
+		return nil
 	case "ParkingspotidTimeunits":
 		rels, ok := retrieved.(TimeunitSlice)
 		if !ok {
@@ -946,6 +1724,78 @@ func (o *Parkingspot) Preload(name string, retrieved any) error {
 	}
 }
 
+func ThenLoadParkingspotParkingspotidAvailabilityalerts(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadParkingspotParkingspotidAvailabilityalerts(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load ParkingspotParkingspotidAvailabilityalerts", retrieved)
+		}
+
+		err := loader.LoadParkingspotParkingspotidAvailabilityalerts(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadParkingspotParkingspotidAvailabilityalerts loads the parkingspot's ParkingspotidAvailabilityalerts into the .R struct
+func (o *Parkingspot) LoadParkingspotParkingspotidAvailabilityalerts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ParkingspotidAvailabilityalerts = nil
+
+	related, err := o.ParkingspotidAvailabilityalerts(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = o
+	}
+
+	o.R.ParkingspotidAvailabilityalerts = related
+	return nil
+}
+
+// LoadParkingspotParkingspotidAvailabilityalerts loads the parkingspot's ParkingspotidAvailabilityalerts into the .R struct
+func (os ParkingspotSlice) LoadParkingspotParkingspotidAvailabilityalerts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	availabilityalerts, err := os.ParkingspotidAvailabilityalerts(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.ParkingspotidAvailabilityalerts = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range availabilityalerts {
+			if o.Parkingspotid != rel.Parkingspotid.GetOrZero() {
+				continue
+			}
+
+			rel.R.ParkingspotidParkingspot = o
+
+			o.R.ParkingspotidAvailabilityalerts = append(o.R.ParkingspotidAvailabilityalerts, rel)
+		}
+	}
+
+	return nil
+}
+
 func ThenLoadParkingspotParkingspotidBookings(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
 	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
@@ -1018,34 +1868,34 @@ func (os ParkingspotSlice) LoadParkingspotParkingspotidBookings(ctx context.Cont
 	return nil
 }
 
-func PreloadParkingspotUseridUser(opts ...psql.PreloadOption) psql.Preloader {
-	return psql.Preload[*User, UserSlice](orm.Relationship{
-		Name: "UseridUser",
+func PreloadParkingspotParkingspotidCalendarimport(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Calendarimport, CalendarimportSlice](orm.Relationship{
+		Name: "ParkingspotidCalendarimport",
 		Sides: []orm.RelSide{
 			{
 				From: TableNames.Parkingspots,
-				To:   TableNames.Users,
+				To:   TableNames.Calendarimports,
 				FromColumns: []string{
-					ColumnNames.Parkingspots.Userid,
+					ColumnNames.Parkingspots.Parkingspotid,
 				},
 				ToColumns: []string{
-					ColumnNames.Users.Userid,
+					ColumnNames.Calendarimports.Parkingspotid,
 				},
 			},
 		},
-	}, Users.Columns().Names(), opts...)
+	}, Calendarimports.Columns().Names(), opts...)
 }
 
-func ThenLoadParkingspotUseridUser(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+func ThenLoadParkingspotParkingspotidCalendarimport(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
 	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadParkingspotUseridUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadParkingspotParkingspotidCalendarimport(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load ParkingspotUseridUser", retrieved)
+			return fmt.Errorf("object %T cannot load ParkingspotParkingspotidCalendarimport", retrieved)
 		}
 
-		err := loader.LoadParkingspotUseridUser(ctx, exec, queryMods...)
+		err := loader.LoadParkingspotParkingspotidCalendarimport(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -1056,46 +1906,46 @@ func ThenLoadParkingspotUseridUser(queryMods ...bob.Mod[*dialect.SelectQuery]) p
 	})
 }
 
-// LoadParkingspotUseridUser loads the parkingspot's UseridUser into the .R struct
-func (o *Parkingspot) LoadParkingspotUseridUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadParkingspotParkingspotidCalendarimport loads the parkingspot's ParkingspotidCalendarimport into the .R struct
+func (o *Parkingspot) LoadParkingspotParkingspotidCalendarimport(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.UseridUser = nil
+	o.R.ParkingspotidCalendarimport = nil
 
-	related, err := o.UseridUser(mods...).One(ctx, exec)
+	related, err := o.ParkingspotidCalendarimport(mods...).One(ctx, exec)
 	if err != nil {
 		return err
 	}
 
-	related.R.UseridParkingspots = ParkingspotSlice{o}
+	related.R.ParkingspotidParkingspot = o
 
-	o.R.UseridUser = related
+	o.R.ParkingspotidCalendarimport = related
 	return nil
 }
 
-// LoadParkingspotUseridUser loads the parkingspot's UseridUser into the .R struct
-func (os ParkingspotSlice) LoadParkingspotUseridUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadParkingspotParkingspotidCalendarimport loads the parkingspot's ParkingspotidCalendarimport into the .R struct
+func (os ParkingspotSlice) LoadParkingspotParkingspotidCalendarimport(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	users, err := os.UseridUser(mods...).All(ctx, exec)
+	calendarimports, err := os.ParkingspotidCalendarimport(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		for _, rel := range users {
-			if o.Userid != rel.Userid {
+		for _, rel := range calendarimports {
+			if o.Parkingspotid != rel.Parkingspotid {
 				continue
 			}
 
-			rel.R.UseridParkingspots = append(rel.R.UseridParkingspots, o)
+			rel.R.ParkingspotidParkingspot = o
 
-			o.R.UseridUser = rel
+			o.R.ParkingspotidCalendarimport = rel
 			break
 		}
 	}
@@ -1103,16 +1953,258 @@ func (os ParkingspotSlice) LoadParkingspotUseridUser(ctx context.Context, exec b
 	return nil
 }
 
-func ThenLoadParkingspotParkingspotidPreferencespots(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+func ThenLoadParkingspotParkingspotidHolds(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
 	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadParkingspotParkingspotidPreferencespots(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadParkingspotParkingspotidHolds(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load ParkingspotParkingspotidPreferencespots", retrieved)
+			return fmt.Errorf("object %T cannot load ParkingspotParkingspotidHolds", retrieved)
 		}
 
-		err := loader.LoadParkingspotParkingspotidPreferencespots(ctx, exec, queryMods...)
+		err := loader.LoadParkingspotParkingspotidHolds(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadParkingspotParkingspotidHolds loads the parkingspot's ParkingspotidHolds into the .R struct
+func (o *Parkingspot) LoadParkingspotParkingspotidHolds(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ParkingspotidHolds = nil
+
+	related, err := o.ParkingspotidHolds(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = o
+	}
+
+	o.R.ParkingspotidHolds = related
+	return nil
+}
+
+// LoadParkingspotParkingspotidHolds loads the parkingspot's ParkingspotidHolds into the .R struct
+func (os ParkingspotSlice) LoadParkingspotParkingspotidHolds(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	holds, err := os.ParkingspotidHolds(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.ParkingspotidHolds = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range holds {
+			if o.Parkingspotid != rel.Parkingspotid {
+				continue
+			}
+
+			rel.R.ParkingspotidParkingspot = o
+
+			o.R.ParkingspotidHolds = append(o.R.ParkingspotidHolds, rel)
+		}
+	}
+
+	return nil
+}
+
+func PreloadParkingspotParkinglocationidParkinglocation(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Parkinglocation, ParkinglocationSlice](orm.Relationship{
+		Name: "ParkinglocationidParkinglocation",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Parkingspots,
+				To:   TableNames.Parkinglocations,
+				FromColumns: []string{
+					ColumnNames.Parkingspots.Parkinglocationid,
+				},
+				ToColumns: []string{
+					ColumnNames.Parkinglocations.Parkinglocationid,
+				},
+			},
+		},
+	}, Parkinglocations.Columns().Names(), opts...)
+}
+
+func PreloadParkingspotUseridUser(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*User, UserSlice](orm.Relationship{
+		Name: "UseridUser",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Parkingspots,
+				To:   TableNames.Users,
+				FromColumns: []string{
+					ColumnNames.Parkingspots.Userid,
+				},
+				ToColumns: []string{
+					ColumnNames.Users.Userid,
+				},
+			},
+		},
+	}, Users.Columns().Names(), opts...)
+}
+
+func ThenLoadParkingspotParkinglocationidParkinglocation(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadParkingspotParkinglocationidParkinglocation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load ParkingspotParkinglocationidParkinglocation", retrieved)
+		}
+
+		err := loader.LoadParkingspotParkinglocationidParkinglocation(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+func ThenLoadParkingspotUseridUser(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadParkingspotUseridUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load ParkingspotUseridUser", retrieved)
+		}
+
+		err := loader.LoadParkingspotUseridUser(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadParkingspotParkinglocationidParkinglocation loads the parkingspot's ParkinglocationidParkinglocation into the .R struct
+func (o *Parkingspot) LoadParkingspotParkinglocationidParkinglocation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ParkinglocationidParkinglocation = nil
+
+	related, err := o.ParkinglocationidParkinglocation(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ParkinglocationidParkingspots = ParkingspotSlice{o}
+
+	o.R.ParkinglocationidParkinglocation = related
+	return nil
+}
+
+// LoadParkingspotUseridUser loads the parkingspot's UseridUser into the .R struct
+func (o *Parkingspot) LoadParkingspotUseridUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridUser = nil
+
+	related, err := o.UseridUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.UseridParkingspots = ParkingspotSlice{o}
+
+	o.R.UseridUser = related
+	return nil
+}
+
+// LoadParkingspotParkinglocationidParkinglocation loads the parkingspot's ParkinglocationidParkinglocation into the .R struct
+func (os ParkingspotSlice) LoadParkingspotParkinglocationidParkinglocation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	parkinglocations, err := os.ParkinglocationidParkinglocation(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range parkinglocations {
+			if o.Parkinglocationid != rel.Parkinglocationid {
+				continue
+			}
+
+			rel.R.ParkinglocationidParkingspots = append(rel.R.ParkinglocationidParkingspots, o)
+
+			o.R.ParkinglocationidParkinglocation = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadParkingspotUseridUser loads the parkingspot's UseridUser into the .R struct
+func (os ParkingspotSlice) LoadParkingspotUseridUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.UseridUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range users {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridParkingspots = append(rel.R.UseridParkingspots, o)
+
+			o.R.UseridUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadParkingspotParkingspotidPreferencespots(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadParkingspotParkingspotidPreferencespots(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load ParkingspotParkingspotidPreferencespots", retrieved)
+		}
+
+		err := loader.LoadParkingspotParkingspotidPreferencespots(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -1130,9 +2222,225 @@ func (o *Parkingspot) LoadParkingspotParkingspotidPreferencespots(ctx context.Co
 	}
 
 	// Reset the relationship
-	o.R.ParkingspotidPreferencespots = nil
+	o.R.ParkingspotidPreferencespots = nil
+
+	related, err := o.ParkingspotidPreferencespots(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = o
+	}
+
+	o.R.ParkingspotidPreferencespots = related
+	return nil
+}
+
+// LoadParkingspotParkingspotidPreferencespots loads the parkingspot's ParkingspotidPreferencespots into the .R struct
+func (os ParkingspotSlice) LoadParkingspotParkingspotidPreferencespots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	preferencespots, err := os.ParkingspotidPreferencespots(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.ParkingspotidPreferencespots = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range preferencespots {
+			if o.Parkingspotid != rel.Parkingspotid {
+				continue
+			}
+
+			rel.R.ParkingspotidParkingspot = o
+
+			o.R.ParkingspotidPreferencespots = append(o.R.ParkingspotidPreferencespots, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadParkingspotParkingspotidParkingspotphotos(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadParkingspotParkingspotidParkingspotphotos(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load ParkingspotParkingspotidParkingspotphotos", retrieved)
+		}
+
+		err := loader.LoadParkingspotParkingspotidParkingspotphotos(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+func ThenLoadParkingspotParkingspotidPricingrules(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadParkingspotParkingspotidPricingrules(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load ParkingspotParkingspotidPricingrules", retrieved)
+		}
+
+		err := loader.LoadParkingspotParkingspotidPricingrules(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadParkingspotParkingspotidParkingspotphotos loads the parkingspot's ParkingspotidParkingspotphotos into the .R struct
+func (o *Parkingspot) LoadParkingspotParkingspotidParkingspotphotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ParkingspotidParkingspotphotos = nil
+
+	related, err := o.ParkingspotidParkingspotphotos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = o
+	}
+
+	o.R.ParkingspotidParkingspotphotos = related
+	return nil
+}
+
+// LoadParkingspotParkingspotidPricingrules loads the parkingspot's ParkingspotidPricingrules into the .R struct
+func (o *Parkingspot) LoadParkingspotParkingspotidPricingrules(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ParkingspotidPricingrules = nil
+
+	related, err := o.ParkingspotidPricingrules(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = o
+	}
+
+	o.R.ParkingspotidPricingrules = related
+	return nil
+}
+
+// LoadParkingspotParkingspotidParkingspotphotos loads the parkingspot's ParkingspotidParkingspotphotos into the .R struct
+func (os ParkingspotSlice) LoadParkingspotParkingspotidParkingspotphotos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	parkingspotphotos, err := os.ParkingspotidParkingspotphotos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.ParkingspotidParkingspotphotos = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range parkingspotphotos {
+			if o.Parkingspotid != rel.Parkingspotid {
+				continue
+			}
+
+			rel.R.ParkingspotidParkingspot = o
+
+			o.R.ParkingspotidParkingspotphotos = append(o.R.ParkingspotidParkingspotphotos, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadParkingspotParkingspotidPricingrules loads the parkingspot's ParkingspotidPricingrules into the .R struct
+func (os ParkingspotSlice) LoadParkingspotParkingspotidPricingrules(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	pricingrules, err := os.ParkingspotidPricingrules(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.ParkingspotidPricingrules = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range pricingrules {
+			if o.Parkingspotid != rel.Parkingspotid {
+				continue
+			}
+
+			rel.R.ParkingspotidParkingspot = o
+
+			o.R.ParkingspotidPricingrules = append(o.R.ParkingspotidPricingrules, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadParkingspotParkingspotidPromocodes(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadParkingspotParkingspotidPromocodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load ParkingspotParkingspotidPromocodes", retrieved)
+		}
+
+		err := loader.LoadParkingspotParkingspotidPromocodes(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadParkingspotParkingspotidPromocodes loads the parkingspot's ParkingspotidPromocodes into the .R struct
+func (o *Parkingspot) LoadParkingspotParkingspotidPromocodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ParkingspotidPromocodes = nil
 
-	related, err := o.ParkingspotidPreferencespots(mods...).All(ctx, exec)
+	related, err := o.ParkingspotidPromocodes(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -1141,34 +2449,119 @@ func (o *Parkingspot) LoadParkingspotParkingspotidPreferencespots(ctx context.Co
 		rel.R.ParkingspotidParkingspot = o
 	}
 
-	o.R.ParkingspotidPreferencespots = related
+	o.R.ParkingspotidPromocodes = related
 	return nil
 }
 
-// LoadParkingspotParkingspotidPreferencespots loads the parkingspot's ParkingspotidPreferencespots into the .R struct
-func (os ParkingspotSlice) LoadParkingspotParkingspotidPreferencespots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadParkingspotParkingspotidPromocodes loads the parkingspot's ParkingspotidPromocodes into the .R struct
+func (os ParkingspotSlice) LoadParkingspotParkingspotidPromocodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	preferencespots, err := os.ParkingspotidPreferencespots(mods...).All(ctx, exec)
+	promocodes, err := os.ParkingspotidPromocodes(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.ParkingspotidPreferencespots = nil
+		o.R.ParkingspotidPromocodes = nil
 	}
 
 	for _, o := range os {
-		for _, rel := range preferencespots {
+		for _, rel := range promocodes {
+			if o.Parkingspotid != rel.Parkingspotid.GetOrZero() {
+				continue
+			}
+
+			rel.R.ParkingspotidParkingspot = o
+
+			o.R.ParkingspotidPromocodes = append(o.R.ParkingspotidPromocodes, rel)
+		}
+	}
+
+	return nil
+}
+
+func PreloadParkingspotParkingspotidSpotpricing(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Spotpricing, SpotpricingSlice](orm.Relationship{
+		Name: "ParkingspotidSpotpricing",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Parkingspots,
+				To:   TableNames.Spotpricings,
+				FromColumns: []string{
+					ColumnNames.Parkingspots.Parkingspotid,
+				},
+				ToColumns: []string{
+					ColumnNames.Spotpricings.Parkingspotid,
+				},
+			},
+		},
+	}, Spotpricings.Columns().Names(), opts...)
+}
+
+func ThenLoadParkingspotParkingspotidSpotpricing(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadParkingspotParkingspotidSpotpricing(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load ParkingspotParkingspotidSpotpricing", retrieved)
+		}
+
+		err := loader.LoadParkingspotParkingspotidSpotpricing(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadParkingspotParkingspotidSpotpricing loads the parkingspot's ParkingspotidSpotpricing into the .R struct
+func (o *Parkingspot) LoadParkingspotParkingspotidSpotpricing(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ParkingspotidSpotpricing = nil
+
+	related, err := o.ParkingspotidSpotpricing(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ParkingspotidParkingspot = o
+
+	o.R.ParkingspotidSpotpricing = related
+	return nil
+}
+
+// LoadParkingspotParkingspotidSpotpricing loads the parkingspot's ParkingspotidSpotpricing into the .R struct
+func (os ParkingspotSlice) LoadParkingspotParkingspotidSpotpricing(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	spotpricings, err := os.ParkingspotidSpotpricing(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range spotpricings {
 			if o.Parkingspotid != rel.Parkingspotid {
 				continue
 			}
 
 			rel.R.ParkingspotidParkingspot = o
 
-			o.R.ParkingspotidPreferencespots = append(o.R.ParkingspotidPreferencespots, rel)
+			o.R.ParkingspotidSpotpricing = rel
+			break
 		}
 	}
 
@@ -1223,90 +2616,278 @@ func (os ParkingspotSlice) LoadParkingspotParkingspotidTimeunits(ctx context.Con
 		return nil
 	}
 
-	timeunits, err := os.ParkingspotidTimeunits(mods...).All(ctx, exec)
+	timeunits, err := os.ParkingspotidTimeunits(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.ParkingspotidTimeunits = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range timeunits {
+			if o.Parkingspotid != rel.Parkingspotid {
+				continue
+			}
+
+			rel.R.ParkingspotidParkingspot = o
+
+			o.R.ParkingspotidTimeunits = append(o.R.ParkingspotidTimeunits, rel)
+		}
+	}
+
+	return nil
+}
+
+func insertParkingspotParkingspotidAvailabilityalerts0(ctx context.Context, exec bob.Executor, availabilityalerts1 []*AvailabilityalertSetter, parkingspot0 *Parkingspot) (AvailabilityalertSlice, error) {
+	for i := range availabilityalerts1 {
+		availabilityalerts1[i].Parkingspotid = omitnull.From(parkingspot0.Parkingspotid)
+	}
+
+	ret, err := Availabilityalerts.Insert(bob.ToMods(availabilityalerts1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertParkingspotParkingspotidAvailabilityalerts0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachParkingspotParkingspotidAvailabilityalerts0(ctx context.Context, exec bob.Executor, count int, availabilityalerts1 AvailabilityalertSlice, parkingspot0 *Parkingspot) (AvailabilityalertSlice, error) {
+	setter := &AvailabilityalertSetter{
+		Parkingspotid: omitnull.From(parkingspot0.Parkingspotid),
+	}
+
+	err := availabilityalerts1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachParkingspotParkingspotidAvailabilityalerts0: %w", err)
+	}
+
+	return availabilityalerts1, nil
+}
+
+func (parkingspot0 *Parkingspot) InsertParkingspotidAvailabilityalerts(ctx context.Context, exec bob.Executor, related ...*AvailabilityalertSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	availabilityalerts1, err := insertParkingspotParkingspotidAvailabilityalerts0(ctx, exec, related, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidAvailabilityalerts = append(parkingspot0.R.ParkingspotidAvailabilityalerts, availabilityalerts1...)
+
+	for _, rel := range availabilityalerts1 {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+	return nil
+}
+
+func (parkingspot0 *Parkingspot) AttachParkingspotidAvailabilityalerts(ctx context.Context, exec bob.Executor, related ...*Availabilityalert) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	availabilityalerts1 := AvailabilityalertSlice(related)
+
+	_, err = attachParkingspotParkingspotidAvailabilityalerts0(ctx, exec, len(related), availabilityalerts1, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidAvailabilityalerts = append(parkingspot0.R.ParkingspotidAvailabilityalerts, availabilityalerts1...)
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+
+	return nil
+}
+
+func insertParkingspotParkingspotidBookings0(ctx context.Context, exec bob.Executor, bookings1 []*BookingSetter, parkingspot0 *Parkingspot) (BookingSlice, error) {
+	for i := range bookings1 {
+		bookings1[i].Parkingspotid = omit.From(parkingspot0.Parkingspotid)
+	}
+
+	ret, err := Bookings.Insert(bob.ToMods(bookings1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertParkingspotParkingspotidBookings0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachParkingspotParkingspotidBookings0(ctx context.Context, exec bob.Executor, count int, bookings1 BookingSlice, parkingspot0 *Parkingspot) (BookingSlice, error) {
+	setter := &BookingSetter{
+		Parkingspotid: omit.From(parkingspot0.Parkingspotid),
+	}
+
+	err := bookings1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachParkingspotParkingspotidBookings0: %w", err)
+	}
+
+	return bookings1, nil
+}
+
+func (parkingspot0 *Parkingspot) InsertParkingspotidBookings(ctx context.Context, exec bob.Executor, related ...*BookingSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	bookings1, err := insertParkingspotParkingspotidBookings0(ctx, exec, related, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidBookings = append(parkingspot0.R.ParkingspotidBookings, bookings1...)
+
+	for _, rel := range bookings1 {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+	return nil
+}
+
+func (parkingspot0 *Parkingspot) AttachParkingspotidBookings(ctx context.Context, exec bob.Executor, related ...*Booking) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	bookings1 := BookingSlice(related)
+
+	_, err = attachParkingspotParkingspotidBookings0(ctx, exec, len(related), bookings1, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidBookings = append(parkingspot0.R.ParkingspotidBookings, bookings1...)
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+
+	return nil
+}
+
+func insertParkingspotParkingspotidCalendarimport0(ctx context.Context, exec bob.Executor, calendarimport1 *CalendarimportSetter, parkingspot0 *Parkingspot) (*Calendarimport, error) {
+	calendarimport1.Parkingspotid = omit.From(parkingspot0.Parkingspotid)
+
+	ret, err := Calendarimports.Insert(calendarimport1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertParkingspotParkingspotidCalendarimport0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachParkingspotParkingspotidCalendarimport0(ctx context.Context, exec bob.Executor, count int, calendarimport1 *Calendarimport, parkingspot0 *Parkingspot) (*Calendarimport, error) {
+	setter := &CalendarimportSetter{
+		Parkingspotid: omit.From(parkingspot0.Parkingspotid),
+	}
+
+	err := calendarimport1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachParkingspotParkingspotidCalendarimport0: %w", err)
+	}
+
+	return calendarimport1, nil
+}
+
+func (parkingspot0 *Parkingspot) InsertParkingspotidCalendarimport(ctx context.Context, exec bob.Executor, related *CalendarimportSetter) error {
+	calendarimport1, err := insertParkingspotParkingspotidCalendarimport0(ctx, exec, related, parkingspot0)
 	if err != nil {
 		return err
 	}
 
-	for _, o := range os {
-		o.R.ParkingspotidTimeunits = nil
-	}
+	parkingspot0.R.ParkingspotidCalendarimport = calendarimport1
 
-	for _, o := range os {
-		for _, rel := range timeunits {
-			if o.Parkingspotid != rel.Parkingspotid {
-				continue
-			}
+	calendarimport1.R.ParkingspotidParkingspot = parkingspot0
 
-			rel.R.ParkingspotidParkingspot = o
+	return nil
+}
 
-			o.R.ParkingspotidTimeunits = append(o.R.ParkingspotidTimeunits, rel)
-		}
+func (parkingspot0 *Parkingspot) AttachParkingspotidCalendarimport(ctx context.Context, exec bob.Executor, calendarimport1 *Calendarimport) error {
+	var err error
+
+	_, err = attachParkingspotParkingspotidCalendarimport0(ctx, exec, 1, calendarimport1, parkingspot0)
+	if err != nil {
+		return err
 	}
 
+	parkingspot0.R.ParkingspotidCalendarimport = calendarimport1
+
+	calendarimport1.R.ParkingspotidParkingspot = parkingspot0
+
 	return nil
 }
 
-func insertParkingspotParkingspotidBookings0(ctx context.Context, exec bob.Executor, bookings1 []*BookingSetter, parkingspot0 *Parkingspot) (BookingSlice, error) {
-	for i := range bookings1 {
-		bookings1[i].Parkingspotid = omit.From(parkingspot0.Parkingspotid)
+func insertParkingspotParkingspotidHolds0(ctx context.Context, exec bob.Executor, holds1 []*HoldSetter, parkingspot0 *Parkingspot) (HoldSlice, error) {
+	for i := range holds1 {
+		holds1[i].Parkingspotid = omit.From(parkingspot0.Parkingspotid)
 	}
 
-	ret, err := Bookings.Insert(bob.ToMods(bookings1...)).All(ctx, exec)
+	ret, err := Holds.Insert(bob.ToMods(holds1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertParkingspotParkingspotidBookings0: %w", err)
+		return ret, fmt.Errorf("insertParkingspotParkingspotidHolds0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachParkingspotParkingspotidBookings0(ctx context.Context, exec bob.Executor, count int, bookings1 BookingSlice, parkingspot0 *Parkingspot) (BookingSlice, error) {
-	setter := &BookingSetter{
+func attachParkingspotParkingspotidHolds0(ctx context.Context, exec bob.Executor, count int, holds1 HoldSlice, parkingspot0 *Parkingspot) (HoldSlice, error) {
+	setter := &HoldSetter{
 		Parkingspotid: omit.From(parkingspot0.Parkingspotid),
 	}
 
-	err := bookings1.UpdateAll(ctx, exec, *setter)
+	err := holds1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachParkingspotParkingspotidBookings0: %w", err)
+		return nil, fmt.Errorf("attachParkingspotParkingspotidHolds0: %w", err)
 	}
 
-	return bookings1, nil
+	return holds1, nil
 }
 
-func (parkingspot0 *Parkingspot) InsertParkingspotidBookings(ctx context.Context, exec bob.Executor, related ...*BookingSetter) error {
+func (parkingspot0 *Parkingspot) InsertParkingspotidHolds(ctx context.Context, exec bob.Executor, related ...*HoldSetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	bookings1, err := insertParkingspotParkingspotidBookings0(ctx, exec, related, parkingspot0)
+	holds1, err := insertParkingspotParkingspotidHolds0(ctx, exec, related, parkingspot0)
 	if err != nil {
 		return err
 	}
 
-	parkingspot0.R.ParkingspotidBookings = append(parkingspot0.R.ParkingspotidBookings, bookings1...)
+	parkingspot0.R.ParkingspotidHolds = append(parkingspot0.R.ParkingspotidHolds, holds1...)
 
-	for _, rel := range bookings1 {
+	for _, rel := range holds1 {
 		rel.R.ParkingspotidParkingspot = parkingspot0
 	}
 	return nil
 }
 
-func (parkingspot0 *Parkingspot) AttachParkingspotidBookings(ctx context.Context, exec bob.Executor, related ...*Booking) error {
+func (parkingspot0 *Parkingspot) AttachParkingspotidHolds(ctx context.Context, exec bob.Executor, related ...*Hold) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	bookings1 := BookingSlice(related)
+	holds1 := HoldSlice(related)
 
-	_, err = attachParkingspotParkingspotidBookings0(ctx, exec, len(related), bookings1, parkingspot0)
+	_, err = attachParkingspotParkingspotidHolds0(ctx, exec, len(related), holds1, parkingspot0)
 	if err != nil {
 		return err
 	}
 
-	parkingspot0.R.ParkingspotidBookings = append(parkingspot0.R.ParkingspotidBookings, bookings1...)
+	parkingspot0.R.ParkingspotidHolds = append(parkingspot0.R.ParkingspotidHolds, holds1...)
 
 	for _, rel := range related {
 		rel.R.ParkingspotidParkingspot = parkingspot0
@@ -1315,6 +2896,19 @@ func (parkingspot0 *Parkingspot) AttachParkingspotidBookings(ctx context.Context
 	return nil
 }
 
+func attachParkingspotParkinglocationidParkinglocation0(ctx context.Context, exec bob.Executor, count int, parkingspot0 *Parkingspot, parkinglocation1 *Parkinglocation) (*Parkingspot, error) {
+	setter := &ParkingspotSetter{
+		Parkinglocationid: omit.From(parkinglocation1.Parkinglocationid),
+	}
+
+	err := parkingspot0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachParkingspotParkinglocationidParkinglocation0: %w", err)
+	}
+
+	return parkingspot0, nil
+}
+
 func attachParkingspotUseridUser0(ctx context.Context, exec bob.Executor, count int, parkingspot0 *Parkingspot, user1 *User) (*Parkingspot, error) {
 	setter := &ParkingspotSetter{
 		Userid: omit.From(user1.Userid),
@@ -1328,6 +2922,24 @@ func attachParkingspotUseridUser0(ctx context.Context, exec bob.Executor, count
 	return parkingspot0, nil
 }
 
+func (parkingspot0 *Parkingspot) InsertParkinglocationidParkinglocation(ctx context.Context, exec bob.Executor, related *ParkinglocationSetter) error {
+	parkinglocation1, err := Parkinglocations.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachParkingspotParkinglocationidParkinglocation0(ctx, exec, 1, parkingspot0, parkinglocation1)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkinglocationidParkinglocation = parkinglocation1
+
+	parkinglocation1.R.ParkinglocationidParkingspots = append(parkinglocation1.R.ParkinglocationidParkingspots, parkingspot0)
+
+	return nil
+}
+
 func (parkingspot0 *Parkingspot) InsertUseridUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
 	user1, err := Users.Insert(related).One(ctx, exec)
 	if err != nil {
@@ -1346,6 +2958,21 @@ func (parkingspot0 *Parkingspot) InsertUseridUser(ctx context.Context, exec bob.
 	return nil
 }
 
+func (parkingspot0 *Parkingspot) AttachParkinglocationidParkinglocation(ctx context.Context, exec bob.Executor, parkinglocation1 *Parkinglocation) error {
+	var err error
+
+	_, err = attachParkingspotParkinglocationidParkinglocation0(ctx, exec, 1, parkingspot0, parkinglocation1)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkinglocationidParkinglocation = parkinglocation1
+
+	parkinglocation1.R.ParkinglocationidParkingspots = append(parkinglocation1.R.ParkinglocationidParkingspots, parkingspot0)
+
+	return nil
+}
+
 func (parkingspot0 *Parkingspot) AttachUseridUser(ctx context.Context, exec bob.Executor, user1 *User) error {
 	var err error
 
@@ -1429,6 +3056,262 @@ func (parkingspot0 *Parkingspot) AttachParkingspotidPreferencespots(ctx context.
 	return nil
 }
 
+func insertParkingspotParkingspotidParkingspotphotos0(ctx context.Context, exec bob.Executor, parkingspotphotos1 []*ParkingspotphotoSetter, parkingspot0 *Parkingspot) (ParkingspotphotoSlice, error) {
+	for i := range parkingspotphotos1 {
+		parkingspotphotos1[i].Parkingspotid = omit.From(parkingspot0.Parkingspotid)
+	}
+
+	ret, err := Parkingspotphotos.Insert(bob.ToMods(parkingspotphotos1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertParkingspotParkingspotidParkingspotphotos0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func insertParkingspotParkingspotidPricingrules0(ctx context.Context, exec bob.Executor, pricingrules1 []*PricingruleSetter, parkingspot0 *Parkingspot) (PricingruleSlice, error) {
+	for i := range pricingrules1 {
+		pricingrules1[i].Parkingspotid = omit.From(parkingspot0.Parkingspotid)
+	}
+
+	ret, err := Pricingrules.Insert(bob.ToMods(pricingrules1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertParkingspotParkingspotidPricingrules0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachParkingspotParkingspotidParkingspotphotos0(ctx context.Context, exec bob.Executor, count int, parkingspotphotos1 ParkingspotphotoSlice, parkingspot0 *Parkingspot) (ParkingspotphotoSlice, error) {
+	setter := &ParkingspotphotoSetter{
+		Parkingspotid: omit.From(parkingspot0.Parkingspotid),
+	}
+
+	err := parkingspotphotos1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachParkingspotParkingspotidParkingspotphotos0: %w", err)
+	}
+
+	return parkingspotphotos1, nil
+}
+
+func attachParkingspotParkingspotidPricingrules0(ctx context.Context, exec bob.Executor, count int, pricingrules1 PricingruleSlice, parkingspot0 *Parkingspot) (PricingruleSlice, error) {
+	setter := &PricingruleSetter{
+		Parkingspotid: omit.From(parkingspot0.Parkingspotid),
+	}
+
+	err := pricingrules1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachParkingspotParkingspotidPricingrules0: %w", err)
+	}
+
+	return pricingrules1, nil
+}
+
+func (parkingspot0 *Parkingspot) InsertParkingspotidParkingspotphotos(ctx context.Context, exec bob.Executor, related ...*ParkingspotphotoSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	parkingspotphotos1, err := insertParkingspotParkingspotidParkingspotphotos0(ctx, exec, related, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidParkingspotphotos = append(parkingspot0.R.ParkingspotidParkingspotphotos, parkingspotphotos1...)
+
+	for _, rel := range parkingspotphotos1 {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+	return nil
+}
+
+func (parkingspot0 *Parkingspot) InsertParkingspotidPricingrules(ctx context.Context, exec bob.Executor, related ...*PricingruleSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	pricingrules1, err := insertParkingspotParkingspotidPricingrules0(ctx, exec, related, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidPricingrules = append(parkingspot0.R.ParkingspotidPricingrules, pricingrules1...)
+
+	for _, rel := range pricingrules1 {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+	return nil
+}
+
+func (parkingspot0 *Parkingspot) AttachParkingspotidParkingspotphotos(ctx context.Context, exec bob.Executor, related ...*Parkingspotphoto) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	parkingspotphotos1 := ParkingspotphotoSlice(related)
+
+	_, err = attachParkingspotParkingspotidParkingspotphotos0(ctx, exec, len(related), parkingspotphotos1, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidParkingspotphotos = append(parkingspot0.R.ParkingspotidParkingspotphotos, parkingspotphotos1...)
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+
+	return nil
+}
+
+func (parkingspot0 *Parkingspot) AttachParkingspotidPricingrules(ctx context.Context, exec bob.Executor, related ...*Pricingrule) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	pricingrules1 := PricingruleSlice(related)
+
+	_, err = attachParkingspotParkingspotidPricingrules0(ctx, exec, len(related), pricingrules1, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidPricingrules = append(parkingspot0.R.ParkingspotidPricingrules, pricingrules1...)
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+
+	return nil
+}
+
+func insertParkingspotParkingspotidPromocodes0(ctx context.Context, exec bob.Executor, promocodes1 []*PromocodeSetter, parkingspot0 *Parkingspot) (PromocodeSlice, error) {
+	for i := range promocodes1 {
+		promocodes1[i].Parkingspotid = omitnull.From(parkingspot0.Parkingspotid)
+	}
+
+	ret, err := Promocodes.Insert(bob.ToMods(promocodes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertParkingspotParkingspotidPromocodes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachParkingspotParkingspotidPromocodes0(ctx context.Context, exec bob.Executor, count int, promocodes1 PromocodeSlice, parkingspot0 *Parkingspot) (PromocodeSlice, error) {
+	setter := &PromocodeSetter{
+		Parkingspotid: omitnull.From(parkingspot0.Parkingspotid),
+	}
+
+	err := promocodes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachParkingspotParkingspotidPromocodes0: %w", err)
+	}
+
+	return promocodes1, nil
+}
+
+func (parkingspot0 *Parkingspot) InsertParkingspotidPromocodes(ctx context.Context, exec bob.Executor, related ...*PromocodeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	promocodes1, err := insertParkingspotParkingspotidPromocodes0(ctx, exec, related, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidPromocodes = append(parkingspot0.R.ParkingspotidPromocodes, promocodes1...)
+
+	for _, rel := range promocodes1 {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+	return nil
+}
+
+func (parkingspot0 *Parkingspot) AttachParkingspotidPromocodes(ctx context.Context, exec bob.Executor, related ...*Promocode) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	promocodes1 := PromocodeSlice(related)
+
+	_, err = attachParkingspotParkingspotidPromocodes0(ctx, exec, len(related), promocodes1, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidPromocodes = append(parkingspot0.R.ParkingspotidPromocodes, promocodes1...)
+
+	for _, rel := range related {
+		rel.R.ParkingspotidParkingspot = parkingspot0
+	}
+
+	return nil
+}
+
+func insertParkingspotParkingspotidSpotpricing0(ctx context.Context, exec bob.Executor, spotpricing1 *SpotpricingSetter, parkingspot0 *Parkingspot) (*Spotpricing, error) {
+	spotpricing1.Parkingspotid = omit.From(parkingspot0.Parkingspotid)
+
+	ret, err := Spotpricings.Insert(spotpricing1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertParkingspotParkingspotidSpotpricing0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachParkingspotParkingspotidSpotpricing0(ctx context.Context, exec bob.Executor, count int, spotpricing1 *Spotpricing, parkingspot0 *Parkingspot) (*Spotpricing, error) {
+	setter := &SpotpricingSetter{
+		Parkingspotid: omit.From(parkingspot0.Parkingspotid),
+	}
+
+	err := spotpricing1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachParkingspotParkingspotidSpotpricing0: %w", err)
+	}
+
+	return spotpricing1, nil
+}
+
+func (parkingspot0 *Parkingspot) InsertParkingspotidSpotpricing(ctx context.Context, exec bob.Executor, related *SpotpricingSetter) error {
+	spotpricing1, err := insertParkingspotParkingspotidSpotpricing0(ctx, exec, related, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidSpotpricing = spotpricing1
+
+	spotpricing1.R.ParkingspotidParkingspot = parkingspot0
+
+	return nil
+}
+
+func (parkingspot0 *Parkingspot) AttachParkingspotidSpotpricing(ctx context.Context, exec bob.Executor, spotpricing1 *Spotpricing) error {
+	var err error
+
+	_, err = attachParkingspotParkingspotidSpotpricing0(ctx, exec, 1, spotpricing1, parkingspot0)
+	if err != nil {
+		return err
+	}
+
+	parkingspot0.R.ParkingspotidSpotpricing = spotpricing1
+
+	spotpricing1.R.ParkingspotidParkingspot = parkingspot0
+
+	return nil
+}
+
 func insertParkingspotParkingspotidTimeunits0(ctx context.Context, exec bob.Executor, timeunits1 []*TimeunitSetter, parkingspot0 *Parkingspot) (TimeunitSlice, error) {
 	for i := range timeunits1 {
 		timeunits1[i].Parkingspotid = omit.From(parkingspot0.Parkingspotid)
@@ -30,6 +30,7 @@ type Timeunit struct {
 	Timerange     dbtype.Tstzrange `db:"timerange,pk" `
 	Parkingspotid int64            `db:"parkingspotid,pk" `
 	Bookingid     null.Val[int64]  `db:"bookingid" `
+	Holdid        null.Val[int64]  `db:"holdid" `
 
 	R timeunitR `db:"-" `
 }
@@ -47,6 +48,7 @@ type TimeunitsQuery = *psql.ViewQuery[*Timeunit, TimeunitSlice]
 // timeunitR is where relationships are stored.
 type timeunitR struct {
 	BookingidBooking         *Booking     // timeunit.timeunit_bookingid_fkey
+	HoldidHold               *Hold        // timeunit.timeunit_holdid_fkey
 	ParkingspotidParkingspot *Parkingspot // timeunit.timeunit_parkingspotid_fkey
 }
 
@@ -54,6 +56,7 @@ type timeunitColumnNames struct {
 	Timerange     string
 	Parkingspotid string
 	Bookingid     string
+	Holdid        string
 }
 
 var TimeunitColumns = buildTimeunitColumns("timeunit")
@@ -63,6 +66,7 @@ type timeunitColumns struct {
 	Timerange     psql.Expression
 	Parkingspotid psql.Expression
 	Bookingid     psql.Expression
+	Holdid        psql.Expression
 }
 
 func (c timeunitColumns) Alias() string {
@@ -79,6 +83,7 @@ func buildTimeunitColumns(alias string) timeunitColumns {
 		Timerange:     psql.Quote(alias, "timerange"),
 		Parkingspotid: psql.Quote(alias, "parkingspotid"),
 		Bookingid:     psql.Quote(alias, "bookingid"),
+		Holdid:        psql.Quote(alias, "holdid"),
 	}
 }
 
@@ -86,6 +91,7 @@ type timeunitWhere[Q psql.Filterable] struct {
 	Timerange     psql.WhereMod[Q, dbtype.Tstzrange]
 	Parkingspotid psql.WhereMod[Q, int64]
 	Bookingid     psql.WhereNullMod[Q, int64]
+	Holdid        psql.WhereNullMod[Q, int64]
 }
 
 func (timeunitWhere[Q]) AliasedAs(alias string) timeunitWhere[Q] {
@@ -97,6 +103,7 @@ func buildTimeunitWhere[Q psql.Filterable](cols timeunitColumns) timeunitWhere[Q
 		Timerange:     psql.Where[Q, dbtype.Tstzrange](cols.Timerange),
 		Parkingspotid: psql.Where[Q, int64](cols.Parkingspotid),
 		Bookingid:     psql.WhereNull[Q, int64](cols.Bookingid),
+		Holdid:        psql.WhereNull[Q, int64](cols.Holdid),
 	}
 }
 
@@ -107,10 +114,11 @@ type TimeunitSetter struct {
 	Timerange     omit.Val[dbtype.Tstzrange] `db:"timerange,pk" `
 	Parkingspotid omit.Val[int64]            `db:"parkingspotid,pk" `
 	Bookingid     omitnull.Val[int64]        `db:"bookingid" `
+	Holdid        omitnull.Val[int64]        `db:"holdid" `
 }
 
 func (s TimeunitSetter) SetColumns() []string {
-	vals := make([]string, 0, 3)
+	vals := make([]string, 0, 4)
 	if !s.Timerange.IsUnset() {
 		vals = append(vals, "timerange")
 	}
@@ -123,6 +131,10 @@ func (s TimeunitSetter) SetColumns() []string {
 		vals = append(vals, "bookingid")
 	}
 
+	if !s.Holdid.IsUnset() {
+		vals = append(vals, "holdid")
+	}
+
 	return vals
 }
 
@@ -136,6 +148,9 @@ func (s TimeunitSetter) Overwrite(t *Timeunit) {
 	if !s.Bookingid.IsUnset() {
 		t.Bookingid, _ = s.Bookingid.GetNull()
 	}
+	if !s.Holdid.IsUnset() {
+		t.Holdid, _ = s.Holdid.GetNull()
+	}
 }
 
 func (s *TimeunitSetter) Apply(q *dialect.InsertQuery) {
@@ -144,7 +159,7 @@ func (s *TimeunitSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 3)
+		vals := make([]bob.Expression, 4)
 		if s.Timerange.IsUnset() {
 			vals[0] = psql.Raw("DEFAULT")
 		} else {
@@ -163,6 +178,12 @@ func (s *TimeunitSetter) Apply(q *dialect.InsertQuery) {
 			vals[2] = psql.Arg(s.Bookingid)
 		}
 
+		if s.Holdid.IsUnset() {
+			vals[3] = psql.Raw("DEFAULT")
+		} else {
+			vals[3] = psql.Arg(s.Holdid)
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -172,7 +193,7 @@ func (s TimeunitSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s TimeunitSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 3)
+	exprs := make([]bob.Expression, 0, 4)
 
 	if !s.Timerange.IsUnset() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -195,6 +216,13 @@ func (s TimeunitSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.Holdid.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "holdid")...),
+			psql.Arg(s.Holdid),
+		}})
+	}
+
 	return exprs
 }
 
@@ -418,6 +446,7 @@ func (o TimeunitSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 type timeunitJoins[Q dialect.Joinable] struct {
 	typ                      string
 	BookingidBooking         func(context.Context) modAs[Q, bookingColumns]
+	HoldidHold               func(context.Context) modAs[Q, holdColumns]
 	ParkingspotidParkingspot func(context.Context) modAs[Q, parkingspotColumns]
 }
 
@@ -429,6 +458,7 @@ func buildTimeunitJoins[Q dialect.Joinable](cols timeunitColumns, typ string) ti
 	return timeunitJoins[Q]{
 		typ:                      typ,
 		BookingidBooking:         timeunitsJoinBookingidBooking[Q](cols, typ),
+		HoldidHold:               timeunitsJoinHoldidHold[Q](cols, typ),
 		ParkingspotidParkingspot: timeunitsJoinParkingspotidParkingspot[Q](cols, typ),
 	}
 }
@@ -452,6 +482,25 @@ func timeunitsJoinBookingidBooking[Q dialect.Joinable](from timeunitColumns, typ
 	}
 }
 
+func timeunitsJoinHoldidHold[Q dialect.Joinable](from timeunitColumns, typ string) func(context.Context) modAs[Q, holdColumns] {
+	return func(ctx context.Context) modAs[Q, holdColumns] {
+		return modAs[Q, holdColumns]{
+			c: HoldColumns,
+			f: func(to holdColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Holds.Name().As(to.Alias())).On(
+						to.Holdid.EQ(from.Holdid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
 func timeunitsJoinParkingspotidParkingspot[Q dialect.Joinable](from timeunitColumns, typ string) func(context.Context) modAs[Q, parkingspotColumns] {
 	return func(ctx context.Context) modAs[Q, parkingspotColumns] {
 		return modAs[Q, parkingspotColumns]{
@@ -489,6 +538,24 @@ func (os TimeunitSlice) BookingidBooking(mods ...bob.Mod[*dialect.SelectQuery])
 	)...)
 }
 
+// HoldidHold starts a query for related objects on hold
+func (o *Timeunit) HoldidHold(mods ...bob.Mod[*dialect.SelectQuery]) HoldsQuery {
+	return Holds.Query(append(mods,
+		sm.Where(HoldColumns.Holdid.EQ(psql.Arg(o.Holdid))),
+	)...)
+}
+
+func (os TimeunitSlice) HoldidHold(mods ...bob.Mod[*dialect.SelectQuery]) HoldsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Holdid)
+	}
+
+	return Holds.Query(append(mods,
+		sm.Where(psql.Group(HoldColumns.Holdid).In(PKArgs...)),
+	)...)
+}
+
 // ParkingspotidParkingspot starts a query for related objects on parkingspot
 func (o *Timeunit) ParkingspotidParkingspot(mods ...bob.Mod[*dialect.SelectQuery]) ParkingspotsQuery {
 	return Parkingspots.Query(append(mods,
@@ -525,6 +592,18 @@ func (o *Timeunit) Preload(name string, retrieved any) error {
 			rel.R.BookingidTimeunits = TimeunitSlice{o}
 		}
 		return nil
+	case "HoldidHold":
+		rel, ok := retrieved.(*Hold)
+		if !ok {
+			return fmt.Errorf("timeunit cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.HoldidHold = rel
+
+		if rel != nil {
+			rel.R.HoldidTimeunits = TimeunitSlice{o}
+		}
+		return nil
 	case "ParkingspotidParkingspot":
 		rel, ok := retrieved.(*Parkingspot)
 		if !ok {
@@ -627,6 +706,91 @@ func (os TimeunitSlice) LoadTimeunitBookingidBooking(ctx context.Context, exec b
 	return nil
 }
 
+func PreloadTimeunitHoldidHold(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Hold, HoldSlice](orm.Relationship{
+		Name: "HoldidHold",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Timeunits,
+				To:   TableNames.Holds,
+				FromColumns: []string{
+					ColumnNames.Timeunits.Holdid,
+				},
+				ToColumns: []string{
+					ColumnNames.Holds.Holdid,
+				},
+			},
+		},
+	}, Holds.Columns().Names(), opts...)
+}
+
+func ThenLoadTimeunitHoldidHold(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadTimeunitHoldidHold(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load TimeunitHoldidHold", retrieved)
+		}
+
+		err := loader.LoadTimeunitHoldidHold(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadTimeunitHoldidHold loads the timeunit's HoldidHold into the .R struct
+func (o *Timeunit) LoadTimeunitHoldidHold(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.HoldidHold = nil
+
+	related, err := o.HoldidHold(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.HoldidTimeunits = TimeunitSlice{o}
+
+	o.R.HoldidHold = related
+	return nil
+}
+
+// LoadTimeunitHoldidHold loads the timeunit's HoldidHold into the .R struct
+func (os TimeunitSlice) LoadTimeunitHoldidHold(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	holds, err := os.HoldidHold(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range holds {
+			if o.Holdid.GetOrZero() != rel.Holdid {
+				continue
+			}
+
+			rel.R.HoldidTimeunits = append(rel.R.HoldidTimeunits, o)
+
+			o.R.HoldidHold = rel
+			break
+		}
+	}
+
+	return nil
+}
+
 func PreloadTimeunitParkingspotidParkingspot(opts ...psql.PreloadOption) psql.Preloader {
 	return psql.Preload[*Parkingspot, ParkingspotSlice](orm.Relationship{
 		Name: "ParkingspotidParkingspot",
@@ -758,6 +922,52 @@ func (timeunit0 *Timeunit) AttachBookingidBooking(ctx context.Context, exec bob.
 	return nil
 }
 
+func attachTimeunitHoldidHold0(ctx context.Context, exec bob.Executor, count int, timeunit0 *Timeunit, hold1 *Hold) (*Timeunit, error) {
+	setter := &TimeunitSetter{
+		Holdid: omitnull.From(hold1.Holdid),
+	}
+
+	err := timeunit0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTimeunitHoldidHold0: %w", err)
+	}
+
+	return timeunit0, nil
+}
+
+func (timeunit0 *Timeunit) InsertHoldidHold(ctx context.Context, exec bob.Executor, related *HoldSetter) error {
+	hold1, err := Holds.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTimeunitHoldidHold0(ctx, exec, 1, timeunit0, hold1)
+	if err != nil {
+		return err
+	}
+
+	timeunit0.R.HoldidHold = hold1
+
+	hold1.R.HoldidTimeunits = append(hold1.R.HoldidTimeunits, timeunit0)
+
+	return nil
+}
+
+func (timeunit0 *Timeunit) AttachHoldidHold(ctx context.Context, exec bob.Executor, hold1 *Hold) error {
+	var err error
+
+	_, err = attachTimeunitHoldidHold0(ctx, exec, 1, timeunit0, hold1)
+	if err != nil {
+		return err
+	}
+
+	timeunit0.R.HoldidHold = hold1
+
+	hold1.R.HoldidTimeunits = append(hold1.R.HoldidTimeunits, timeunit0)
+
+	return nil
+}
+
 func attachTimeunitParkingspotidParkingspot0(ctx context.Context, exec bob.Executor, count int, timeunit0 *Timeunit, parkingspot1 *Parkingspot) (*Timeunit, error) {
 	setter := &TimeunitSetter{
 		Parkingspotid: omit.From(parkingspot1.Parkingspotid),
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/google/uuid"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
@@ -49,11 +50,23 @@ type UsersQuery = *psql.ViewQuery[*User, UserSlice]
 
 // userR is where relationships are stored.
 type userR struct {
-	UseridBookings        BookingSlice        // booking.booking_userid_fkey
-	UseridCars            CarSlice            // car.car_userid_fkey
-	UseridParkingspots    ParkingspotSlice    // parkingspot.parkingspot_userid_fkey
-	UseridPreferencespots PreferencespotSlice // preferencespot.preferencespot_userid_fkey
-	AuthuuidAuth          *Auth               // users.users_authuuid_fkey
+	UseridAdministrator      *Administrator         // administrator.administrator_userid_fkey
+	UseridAvailabilityalerts AvailabilityalertSlice // availabilityalert.availabilityalert_userid_fkey
+	UseridBookings           BookingSlice           // booking.booking_userid_fkey
+	UseridCalendarfeed       *Calendarfeed          // calendarfeed.calendarfeed_userid_fkey
+	UseridCars               CarSlice               // car.car_userid_fkey
+	UseridDevices            DeviceSlice            // device.device_userid_fkey
+	UseridHolds              HoldSlice              // hold.hold_userid_fkey
+	SenderidMessages         MessageSlice           // message.message_senderid_fkey
+	UseridNotifications      NotificationSlice      // notification.notification_userid_fkey
+	UseridParkinglocations   ParkinglocationSlice   // parkinglocation.parkinglocation_userid_fkey
+	UseridParkingspots       ParkingspotSlice       // parkingspot.parkingspot_userid_fkey
+	UseridPreferencespots    PreferencespotSlice    // preferencespot.preferencespot_userid_fkey
+	OwneridPromocodes        PromocodeSlice         // promocode.promocode_ownerid_fkey
+	RevieweridReviews        ReviewSlice            // review.review_reviewerid_fkey
+	UseridSavedsearches      SavedsearchSlice       // savedsearch.savedsearch_userid_fkey
+	AuthuuidAuth             *Auth                  // users.users_authuuid_fkey
+	UseridWebhooks           WebhookSlice           // webhook.webhook_userid_fkey
 }
 
 type userColumnNames struct {
@@ -532,12 +545,24 @@ func (o UserSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 }
 
 type userJoins[Q dialect.Joinable] struct {
-	typ                   string
-	UseridBookings        func(context.Context) modAs[Q, bookingColumns]
-	UseridCars            func(context.Context) modAs[Q, carColumns]
-	UseridParkingspots    func(context.Context) modAs[Q, parkingspotColumns]
-	UseridPreferencespots func(context.Context) modAs[Q, preferencespotColumns]
-	AuthuuidAuth          func(context.Context) modAs[Q, authColumns]
+	typ                      string
+	UseridAdministrator      func(context.Context) modAs[Q, administratorColumns]
+	UseridAvailabilityalerts func(context.Context) modAs[Q, availabilityalertColumns]
+	UseridBookings           func(context.Context) modAs[Q, bookingColumns]
+	UseridCalendarfeed       func(context.Context) modAs[Q, calendarfeedColumns]
+	UseridCars               func(context.Context) modAs[Q, carColumns]
+	UseridDevices            func(context.Context) modAs[Q, deviceColumns]
+	UseridHolds              func(context.Context) modAs[Q, holdColumns]
+	SenderidMessages         func(context.Context) modAs[Q, messageColumns]
+	UseridNotifications      func(context.Context) modAs[Q, notificationColumns]
+	UseridParkinglocations   func(context.Context) modAs[Q, parkinglocationColumns]
+	UseridParkingspots       func(context.Context) modAs[Q, parkingspotColumns]
+	UseridPreferencespots    func(context.Context) modAs[Q, preferencespotColumns]
+	OwneridPromocodes        func(context.Context) modAs[Q, promocodeColumns]
+	RevieweridReviews        func(context.Context) modAs[Q, reviewColumns]
+	UseridSavedsearches      func(context.Context) modAs[Q, savedsearchColumns]
+	AuthuuidAuth             func(context.Context) modAs[Q, authColumns]
+	UseridWebhooks           func(context.Context) modAs[Q, webhookColumns]
 }
 
 func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
@@ -546,12 +571,62 @@ func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
 
 func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[Q] {
 	return userJoins[Q]{
-		typ:                   typ,
-		UseridBookings:        usersJoinUseridBookings[Q](cols, typ),
-		UseridCars:            usersJoinUseridCars[Q](cols, typ),
-		UseridParkingspots:    usersJoinUseridParkingspots[Q](cols, typ),
-		UseridPreferencespots: usersJoinUseridPreferencespots[Q](cols, typ),
-		AuthuuidAuth:          usersJoinAuthuuidAuth[Q](cols, typ),
+		typ:                      typ,
+		UseridAdministrator:      usersJoinUseridAdministrator[Q](cols, typ),
+		UseridAvailabilityalerts: usersJoinUseridAvailabilityalerts[Q](cols, typ),
+		UseridBookings:           usersJoinUseridBookings[Q](cols, typ),
+		UseridCalendarfeed:       usersJoinUseridCalendarfeed[Q](cols, typ),
+		UseridCars:               usersJoinUseridCars[Q](cols, typ),
+		UseridDevices:            usersJoinUseridDevices[Q](cols, typ),
+		UseridHolds:              usersJoinUseridHolds[Q](cols, typ),
+		SenderidMessages:         usersJoinSenderidMessages[Q](cols, typ),
+		UseridNotifications:      usersJoinUseridNotifications[Q](cols, typ),
+		UseridParkinglocations:   usersJoinUseridParkinglocations[Q](cols, typ),
+		UseridParkingspots:       usersJoinUseridParkingspots[Q](cols, typ),
+		UseridPreferencespots:    usersJoinUseridPreferencespots[Q](cols, typ),
+		OwneridPromocodes:        usersJoinOwneridPromocodes[Q](cols, typ),
+		RevieweridReviews:        usersJoinRevieweridReviews[Q](cols, typ),
+		UseridSavedsearches:      usersJoinUseridSavedsearches[Q](cols, typ),
+		AuthuuidAuth:             usersJoinAuthuuidAuth[Q](cols, typ),
+		UseridWebhooks:           usersJoinUseridWebhooks[Q](cols, typ),
+	}
+}
+
+func usersJoinUseridAdministrator[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, administratorColumns] {
+	return func(ctx context.Context) modAs[Q, administratorColumns] {
+		return modAs[Q, administratorColumns]{
+			c: AdministratorColumns,
+			f: func(to administratorColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Administrators.Name().As(to.Alias())).On(
+						to.Userid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func usersJoinUseridAvailabilityalerts[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, availabilityalertColumns] {
+	return func(ctx context.Context) modAs[Q, availabilityalertColumns] {
+		return modAs[Q, availabilityalertColumns]{
+			c: AvailabilityalertColumns,
+			f: func(to availabilityalertColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Availabilityalerts.Name().As(to.Alias())).On(
+						to.Userid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
 	}
 }
 
@@ -574,6 +649,25 @@ func usersJoinUseridBookings[Q dialect.Joinable](from userColumns, typ string) f
 	}
 }
 
+func usersJoinUseridCalendarfeed[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, calendarfeedColumns] {
+	return func(ctx context.Context) modAs[Q, calendarfeedColumns] {
+		return modAs[Q, calendarfeedColumns]{
+			c: CalendarfeedColumns,
+			f: func(to calendarfeedColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Calendarfeeds.Name().As(to.Alias())).On(
+						to.Userid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
 func usersJoinUseridCars[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, carColumns] {
 	return func(ctx context.Context) modAs[Q, carColumns] {
 		return modAs[Q, carColumns]{
@@ -593,6 +687,101 @@ func usersJoinUseridCars[Q dialect.Joinable](from userColumns, typ string) func(
 	}
 }
 
+func usersJoinUseridDevices[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, deviceColumns] {
+	return func(ctx context.Context) modAs[Q, deviceColumns] {
+		return modAs[Q, deviceColumns]{
+			c: DeviceColumns,
+			f: func(to deviceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Devices.Name().As(to.Alias())).On(
+						to.Userid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func usersJoinUseridHolds[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, holdColumns] {
+	return func(ctx context.Context) modAs[Q, holdColumns] {
+		return modAs[Q, holdColumns]{
+			c: HoldColumns,
+			f: func(to holdColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Holds.Name().As(to.Alias())).On(
+						to.Userid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func usersJoinSenderidMessages[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, messageColumns] {
+	return func(ctx context.Context) modAs[Q, messageColumns] {
+		return modAs[Q, messageColumns]{
+			c: MessageColumns,
+			f: func(to messageColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Messages.Name().As(to.Alias())).On(
+						to.Senderid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func usersJoinUseridNotifications[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, notificationColumns] {
+	return func(ctx context.Context) modAs[Q, notificationColumns] {
+		return modAs[Q, notificationColumns]{
+			c: NotificationColumns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.Userid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func usersJoinUseridParkinglocations[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, parkinglocationColumns] {
+	return func(ctx context.Context) modAs[Q, parkinglocationColumns] {
+		return modAs[Q, parkinglocationColumns]{
+			c: ParkinglocationColumns,
+			f: func(to parkinglocationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Parkinglocations.Name().As(to.Alias())).On(
+						to.Userid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
 func usersJoinUseridParkingspots[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, parkingspotColumns] {
 	return func(ctx context.Context) modAs[Q, parkingspotColumns] {
 		return modAs[Q, parkingspotColumns]{
@@ -631,6 +820,63 @@ func usersJoinUseridPreferencespots[Q dialect.Joinable](from userColumns, typ st
 	}
 }
 
+func usersJoinOwneridPromocodes[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, promocodeColumns] {
+	return func(ctx context.Context) modAs[Q, promocodeColumns] {
+		return modAs[Q, promocodeColumns]{
+			c: PromocodeColumns,
+			f: func(to promocodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Promocodes.Name().As(to.Alias())).On(
+						to.Ownerid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func usersJoinRevieweridReviews[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, reviewColumns] {
+	return func(ctx context.Context) modAs[Q, reviewColumns] {
+		return modAs[Q, reviewColumns]{
+			c: ReviewColumns,
+			f: func(to reviewColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Reviews.Name().As(to.Alias())).On(
+						to.Reviewerid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+func usersJoinUseridSavedsearches[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, savedsearchColumns] {
+	return func(ctx context.Context) modAs[Q, savedsearchColumns] {
+		return modAs[Q, savedsearchColumns]{
+			c: SavedsearchColumns,
+			f: func(to savedsearchColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Savedsearches.Name().As(to.Alias())).On(
+						to.Userid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
 func usersJoinAuthuuidAuth[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, authColumns] {
 	return func(ctx context.Context) modAs[Q, authColumns] {
 		return modAs[Q, authColumns]{
@@ -650,6 +896,61 @@ func usersJoinAuthuuidAuth[Q dialect.Joinable](from userColumns, typ string) fun
 	}
 }
 
+func usersJoinUseridWebhooks[Q dialect.Joinable](from userColumns, typ string) func(context.Context) modAs[Q, webhookColumns] {
+	return func(ctx context.Context) modAs[Q, webhookColumns] {
+		return modAs[Q, webhookColumns]{
+			c: WebhookColumns,
+			f: func(to webhookColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Webhooks.Name().As(to.Alias())).On(
+						to.Userid.EQ(from.Userid),
+					))
+				}
+
+				return mods
+			},
+		}
+	}
+}
+
+// UseridAdministrator starts a query for related objects on administrator
+func (o *User) UseridAdministrator(mods ...bob.Mod[*dialect.SelectQuery]) AdministratorsQuery {
+	return Administrators.Query(append(mods,
+		sm.Where(AdministratorColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) UseridAdministrator(mods ...bob.Mod[*dialect.SelectQuery]) AdministratorsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Administrators.Query(append(mods,
+		sm.Where(psql.Group(AdministratorColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
+// UseridAvailabilityalerts starts a query for related objects on availabilityalert
+func (o *User) UseridAvailabilityalerts(mods ...bob.Mod[*dialect.SelectQuery]) AvailabilityalertsQuery {
+	return Availabilityalerts.Query(append(mods,
+		sm.Where(AvailabilityalertColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) UseridAvailabilityalerts(mods ...bob.Mod[*dialect.SelectQuery]) AvailabilityalertsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Availabilityalerts.Query(append(mods,
+		sm.Where(psql.Group(AvailabilityalertColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
 // UseridBookings starts a query for related objects on booking
 func (o *User) UseridBookings(mods ...bob.Mod[*dialect.SelectQuery]) BookingsQuery {
 	return Bookings.Query(append(mods,
@@ -668,6 +969,24 @@ func (os UserSlice) UseridBookings(mods ...bob.Mod[*dialect.SelectQuery]) Bookin
 	)...)
 }
 
+// UseridCalendarfeed starts a query for related objects on calendarfeed
+func (o *User) UseridCalendarfeed(mods ...bob.Mod[*dialect.SelectQuery]) CalendarfeedsQuery {
+	return Calendarfeeds.Query(append(mods,
+		sm.Where(CalendarfeedColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) UseridCalendarfeed(mods ...bob.Mod[*dialect.SelectQuery]) CalendarfeedsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Calendarfeeds.Query(append(mods,
+		sm.Where(psql.Group(CalendarfeedColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
 // UseridCars starts a query for related objects on car
 func (o *User) UseridCars(mods ...bob.Mod[*dialect.SelectQuery]) CarsQuery {
 	return Cars.Query(append(mods,
@@ -686,6 +1005,85 @@ func (os UserSlice) UseridCars(mods ...bob.Mod[*dialect.SelectQuery]) CarsQuery
 	)...)
 }
 
+// UseridDevices starts a query for related objects on device
+func (o *User) UseridDevices(mods ...bob.Mod[*dialect.SelectQuery]) DevicesQuery {
+	return Devices.Query(append(mods,
+		sm.Where(DeviceColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) UseridDevices(mods ...bob.Mod[*dialect.SelectQuery]) DevicesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Devices.Query(append(mods,
+		sm.Where(psql.Group(DeviceColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
+// UseridHolds starts a query for related objects on hold
+func (o *User) UseridHolds(mods ...bob.Mod[*dialect.SelectQuery]) HoldsQuery {
+	return Holds.Query(append(mods,
+		sm.Where(HoldColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) UseridHolds(mods ...bob.Mod[*dialect.SelectQuery]) HoldsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Holds.Query(append(mods,
+		sm.Where(psql.Group(HoldColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
+// SenderidMessages starts a query for related objects on message
+func (o *User) SenderidMessages(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	return Messages.Query(append(mods,
+		sm.Where(MessageColumns.Senderid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) SenderidMessages(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Messages.Query(append(mods,
+		sm.Where(psql.Group(MessageColumns.Senderid).In(PKArgs...)),
+	)...)
+}
+
+// UseridNotifications starts a query for related objects on notification
+func (o *User) UseridNotifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(NotificationColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) UseridNotifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Notifications.Query(append(mods,
+		sm.Where(psql.Group(NotificationColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
+// UseridParkinglocations starts a query for related objects on parkinglocation
+func (o *User) UseridParkinglocations(mods ...bob.Mod[*dialect.SelectQuery]) ParkinglocationsQuery {
+	return Parkinglocations.Query(append(mods,
+		sm.Where(ParkinglocationColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
 // UseridParkingspots starts a query for related objects on parkingspot
 func (o *User) UseridParkingspots(mods ...bob.Mod[*dialect.SelectQuery]) ParkingspotsQuery {
 	return Parkingspots.Query(append(mods,
@@ -693,6 +1091,17 @@ func (o *User) UseridParkingspots(mods ...bob.Mod[*dialect.SelectQuery]) Parking
 	)...)
 }
 
+func (os UserSlice) UseridParkinglocations(mods ...bob.Mod[*dialect.SelectQuery]) ParkinglocationsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Parkinglocations.Query(append(mods,
+		sm.Where(psql.Group(ParkinglocationColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
 func (os UserSlice) UseridParkingspots(mods ...bob.Mod[*dialect.SelectQuery]) ParkingspotsQuery {
 	PKArgs := make([]bob.Expression, len(os))
 	for i, o := range os {
@@ -722,30 +1131,128 @@ func (os UserSlice) UseridPreferencespots(mods ...bob.Mod[*dialect.SelectQuery])
 	)...)
 }
 
-// AuthuuidAuth starts a query for related objects on auth
-func (o *User) AuthuuidAuth(mods ...bob.Mod[*dialect.SelectQuery]) AuthsQuery {
-	return Auths.Query(append(mods,
-		sm.Where(AuthColumns.Authuuid.EQ(psql.Arg(o.Authuuid))),
+// OwneridPromocodes starts a query for related objects on promocode
+func (o *User) OwneridPromocodes(mods ...bob.Mod[*dialect.SelectQuery]) PromocodesQuery {
+	return Promocodes.Query(append(mods,
+		sm.Where(PromocodeColumns.Ownerid.EQ(psql.Arg(o.Userid))),
 	)...)
 }
 
-func (os UserSlice) AuthuuidAuth(mods ...bob.Mod[*dialect.SelectQuery]) AuthsQuery {
+func (os UserSlice) OwneridPromocodes(mods ...bob.Mod[*dialect.SelectQuery]) PromocodesQuery {
 	PKArgs := make([]bob.Expression, len(os))
 	for i, o := range os {
-		PKArgs[i] = psql.ArgGroup(o.Authuuid)
+		PKArgs[i] = psql.ArgGroup(o.Userid)
 	}
 
-	return Auths.Query(append(mods,
-		sm.Where(psql.Group(AuthColumns.Authuuid).In(PKArgs...)),
+	return Promocodes.Query(append(mods,
+		sm.Where(psql.Group(PromocodeColumns.Ownerid).In(PKArgs...)),
 	)...)
 }
 
-func (o *User) Preload(name string, retrieved any) error {
-	if o == nil {
-		return nil
+// RevieweridReviews starts a query for related objects on review
+func (o *User) RevieweridReviews(mods ...bob.Mod[*dialect.SelectQuery]) ReviewsQuery {
+	return Reviews.Query(append(mods,
+		sm.Where(ReviewColumns.Reviewerid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) RevieweridReviews(mods ...bob.Mod[*dialect.SelectQuery]) ReviewsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Reviews.Query(append(mods,
+		sm.Where(psql.Group(ReviewColumns.Reviewerid).In(PKArgs...)),
+	)...)
+}
+
+// UseridSavedsearches starts a query for related objects on savedsearch
+func (o *User) UseridSavedsearches(mods ...bob.Mod[*dialect.SelectQuery]) SavedsearchesQuery {
+	return Savedsearches.Query(append(mods,
+		sm.Where(SavedsearchColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) UseridSavedsearches(mods ...bob.Mod[*dialect.SelectQuery]) SavedsearchesQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Savedsearches.Query(append(mods,
+		sm.Where(psql.Group(SavedsearchColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
+// AuthuuidAuth starts a query for related objects on auth
+func (o *User) AuthuuidAuth(mods ...bob.Mod[*dialect.SelectQuery]) AuthsQuery {
+	return Auths.Query(append(mods,
+		sm.Where(AuthColumns.Authuuid.EQ(psql.Arg(o.Authuuid))),
+	)...)
+}
+
+func (os UserSlice) AuthuuidAuth(mods ...bob.Mod[*dialect.SelectQuery]) AuthsQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Authuuid)
+	}
+
+	return Auths.Query(append(mods,
+		sm.Where(psql.Group(AuthColumns.Authuuid).In(PKArgs...)),
+	)...)
+}
+
+// UseridWebhooks starts a query for related objects on webhook
+func (o *User) UseridWebhooks(mods ...bob.Mod[*dialect.SelectQuery]) WebhooksQuery {
+	return Webhooks.Query(append(mods,
+		sm.Where(WebhookColumns.Userid.EQ(psql.Arg(o.Userid))),
+	)...)
+}
+
+func (os UserSlice) UseridWebhooks(mods ...bob.Mod[*dialect.SelectQuery]) WebhooksQuery {
+	PKArgs := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgs[i] = psql.ArgGroup(o.Userid)
+	}
+
+	return Webhooks.Query(append(mods,
+		sm.Where(psql.Group(WebhookColumns.Userid).In(PKArgs...)),
+	)...)
+}
+
+func (o *User) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
 	}
 
 	switch name {
+	case "UseridAdministrator":
+		rel, ok := retrieved.(*Administrator)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UseridAdministrator = rel
+
+		if rel != nil {
+			rel.R.UseridUser = o
+		}
+		return nil
+	case "UseridAvailabilityalerts":
+		rels, ok := retrieved.(AvailabilityalertSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UseridAvailabilityalerts = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.UseridUser = o
+			}
+		}
+		return nil
 	case "UseridBookings":
 		rels, ok := retrieved.(BookingSlice)
 		if !ok {
@@ -760,6 +1267,18 @@ func (o *User) Preload(name string, retrieved any) error {
 			}
 		}
 		return nil
+	case "UseridCalendarfeed":
+		rel, ok := retrieved.(*Calendarfeed)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UseridCalendarfeed = rel
+
+		if rel != nil {
+			rel.R.UseridUser = o
+		}
+		return nil
 	case "UseridCars":
 		rels, ok := retrieved.(CarSlice)
 		if !ok {
@@ -768,6 +1287,76 @@ func (o *User) Preload(name string, retrieved any) error {
 
 		o.R.UseridCars = rels
 
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.UseridUser = o
+			}
+		}
+		return nil
+	case "UseridDevices":
+		rels, ok := retrieved.(DeviceSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UseridDevices = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.UseridUser = o
+			}
+		}
+		return nil
+	case "UseridHolds":
+		rels, ok := retrieved.(HoldSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UseridHolds = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.UseridUser = o
+			}
+		}
+		return nil
+	case "SenderidMessages":
+		rels, ok := retrieved.(MessageSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.SenderidMessages = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.SenderidUser = o
+			}
+		}
+		return nil
+	case "UseridNotifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UseridNotifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.UseridUser = o
+			}
+		}
+		return nil
+	case "UseridParkinglocations":
+		rels, ok := retrieved.(ParkinglocationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UseridParkinglocations = rels
+
 		for _, rel := range rels {
 			if rel != nil {
 				rel.R.UseridUser = o
@@ -796,6 +1385,48 @@ func (o *User) Preload(name string, retrieved any) error {
 
 		o.R.UseridPreferencespots = rels
 
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.UseridUser = o
+			}
+		}
+		return nil
+	case "OwneridPromocodes":
+		rels, ok := retrieved.(PromocodeSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OwneridPromocodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.OwneridUser = o
+			}
+		}
+		return nil
+	case "RevieweridReviews":
+		rels, ok := retrieved.(ReviewSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.RevieweridReviews = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.RevieweridUser = o
+			}
+		}
+		return nil
+	case "UseridSavedsearches":
+		rels, ok := retrieved.(SavedsearchSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UseridSavedsearches = rels
+
 		for _, rel := range rels {
 			if rel != nil {
 				rel.R.UseridUser = o
@@ -814,21 +1445,53 @@ func (o *User) Preload(name string, retrieved any) error {
 			rel.R.AuthuuidUser = o
 		}
 		return nil
+	case "UseridWebhooks":
+		rels, ok := retrieved.(WebhookSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UseridWebhooks = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.UseridUser = o
+			}
+		}
+		return nil
 	default:
 		return fmt.Errorf("user has no relationship %q", name)
 	}
 }
 
-func ThenLoadUserUseridBookings(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+func PreloadUserUseridAdministrator(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Administrator, AdministratorSlice](orm.Relationship{
+		Name: "UseridAdministrator",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Users,
+				To:   TableNames.Administrators,
+				FromColumns: []string{
+					ColumnNames.Users.Userid,
+				},
+				ToColumns: []string{
+					ColumnNames.Administrators.Userid,
+				},
+			},
+		},
+	}, Administrators.Columns().Names(), opts...)
+}
+
+func ThenLoadUserUseridAdministrator(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
 	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadUserUseridBookings(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadUserUseridAdministrator(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserUseridBookings", retrieved)
+			return fmt.Errorf("object %T cannot load UserUseridAdministrator", retrieved)
 		}
 
-		err := loader.LoadUserUseridBookings(ctx, exec, queryMods...)
+		err := loader.LoadUserUseridAdministrator(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -839,68 +1502,63 @@ func ThenLoadUserUseridBookings(queryMods ...bob.Mod[*dialect.SelectQuery]) psql
 	})
 }
 
-// LoadUserUseridBookings loads the user's UseridBookings into the .R struct
-func (o *User) LoadUserUseridBookings(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserUseridAdministrator loads the user's UseridAdministrator into the .R struct
+func (o *User) LoadUserUseridAdministrator(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.UseridBookings = nil
+	o.R.UseridAdministrator = nil
 
-	related, err := o.UseridBookings(mods...).All(ctx, exec)
+	related, err := o.UseridAdministrator(mods...).One(ctx, exec)
 	if err != nil {
 		return err
 	}
 
-	for _, rel := range related {
-		rel.R.UseridUser = o
-	}
+	related.R.UseridUser = o
 
-	o.R.UseridBookings = related
+	o.R.UseridAdministrator = related
 	return nil
 }
 
-// LoadUserUseridBookings loads the user's UseridBookings into the .R struct
-func (os UserSlice) LoadUserUseridBookings(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserUseridAdministrator loads the user's UseridAdministrator into the .R struct
+func (os UserSlice) LoadUserUseridAdministrator(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	bookings, err := os.UseridBookings(mods...).All(ctx, exec)
+	administrators, err := os.UseridAdministrator(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.UseridBookings = nil
-	}
-
-	for _, o := range os {
-		for _, rel := range bookings {
+		for _, rel := range administrators {
 			if o.Userid != rel.Userid {
 				continue
 			}
 
 			rel.R.UseridUser = o
 
-			o.R.UseridBookings = append(o.R.UseridBookings, rel)
+			o.R.UseridAdministrator = rel
+			break
 		}
 	}
 
 	return nil
 }
 
-func ThenLoadUserUseridCars(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+func ThenLoadUserUseridAvailabilityalerts(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
 	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadUserUseridCars(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadUserUseridAvailabilityalerts(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserUseridCars", retrieved)
+			return fmt.Errorf("object %T cannot load UserUseridAvailabilityalerts", retrieved)
 		}
 
-		err := loader.LoadUserUseridCars(ctx, exec, queryMods...)
+		err := loader.LoadUserUseridAvailabilityalerts(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -911,16 +1569,16 @@ func ThenLoadUserUseridCars(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loa
 	})
 }
 
-// LoadUserUseridCars loads the user's UseridCars into the .R struct
-func (o *User) LoadUserUseridCars(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserUseridAvailabilityalerts loads the user's UseridAvailabilityalerts into the .R struct
+func (o *User) LoadUserUseridAvailabilityalerts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.UseridCars = nil
+	o.R.UseridAvailabilityalerts = nil
 
-	related, err := o.UseridCars(mods...).All(ctx, exec)
+	related, err := o.UseridAvailabilityalerts(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -929,50 +1587,50 @@ func (o *User) LoadUserUseridCars(ctx context.Context, exec bob.Executor, mods .
 		rel.R.UseridUser = o
 	}
 
-	o.R.UseridCars = related
+	o.R.UseridAvailabilityalerts = related
 	return nil
 }
 
-// LoadUserUseridCars loads the user's UseridCars into the .R struct
-func (os UserSlice) LoadUserUseridCars(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserUseridAvailabilityalerts loads the user's UseridAvailabilityalerts into the .R struct
+func (os UserSlice) LoadUserUseridAvailabilityalerts(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	cars, err := os.UseridCars(mods...).All(ctx, exec)
+	availabilityalerts, err := os.UseridAvailabilityalerts(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.UseridCars = nil
+		o.R.UseridAvailabilityalerts = nil
 	}
 
 	for _, o := range os {
-		for _, rel := range cars {
+		for _, rel := range availabilityalerts {
 			if o.Userid != rel.Userid {
 				continue
 			}
 
 			rel.R.UseridUser = o
 
-			o.R.UseridCars = append(o.R.UseridCars, rel)
+			o.R.UseridAvailabilityalerts = append(o.R.UseridAvailabilityalerts, rel)
 		}
 	}
 
 	return nil
 }
 
-func ThenLoadUserUseridParkingspots(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+func ThenLoadUserUseridBookings(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
 	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
 		loader, isLoader := retrieved.(interface {
-			LoadUserUseridParkingspots(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+			LoadUserUseridBookings(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserUseridParkingspots", retrieved)
+			return fmt.Errorf("object %T cannot load UserUseridBookings", retrieved)
 		}
 
-		err := loader.LoadUserUseridParkingspots(ctx, exec, queryMods...)
+		err := loader.LoadUserUseridBookings(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -983,16 +1641,16 @@ func ThenLoadUserUseridParkingspots(queryMods ...bob.Mod[*dialect.SelectQuery])
 	})
 }
 
-// LoadUserUseridParkingspots loads the user's UseridParkingspots into the .R struct
-func (o *User) LoadUserUseridParkingspots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserUseridBookings loads the user's UseridBookings into the .R struct
+func (o *User) LoadUserUseridBookings(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.UseridParkingspots = nil
+	o.R.UseridBookings = nil
 
-	related, err := o.UseridParkingspots(mods...).All(ctx, exec)
+	related, err := o.UseridBookings(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -1001,50 +1659,68 @@ func (o *User) LoadUserUseridParkingspots(ctx context.Context, exec bob.Executor
 		rel.R.UseridUser = o
 	}
 
-	o.R.UseridParkingspots = related
+	o.R.UseridBookings = related
 	return nil
 }
 
-// LoadUserUseridParkingspots loads the user's UseridParkingspots into the .R struct
-func (os UserSlice) LoadUserUseridParkingspots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserUseridBookings loads the user's UseridBookings into the .R struct
+func (os UserSlice) LoadUserUseridBookings(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	parkingspots, err := os.UseridParkingspots(mods...).All(ctx, exec)
+	bookings, err := os.UseridBookings(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.UseridParkingspots = nil
+		o.R.UseridBookings = nil
 	}
 
 	for _, o := range os {
-		for _, rel := range parkingspots {
+		for _, rel := range bookings {
 			if o.Userid != rel.Userid {
 				continue
 			}
 
 			rel.R.UseridUser = o
 
-			o.R.UseridParkingspots = append(o.R.UseridParkingspots, rel)
+			o.R.UseridBookings = append(o.R.UseridBookings, rel)
 		}
 	}
 
 	return nil
 }
 
-func ThenLoadUserUseridPreferencespots(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
-	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
-		loader, isLoader := retrieved.(interface {
-			LoadUserUseridPreferencespots(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
-		})
+func PreloadUserUseridCalendarfeed(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Calendarfeed, CalendarfeedSlice](orm.Relationship{
+		Name: "UseridCalendarfeed",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Users,
+				To:   TableNames.Calendarfeeds,
+				FromColumns: []string{
+					ColumnNames.Users.Userid,
+				},
+				ToColumns: []string{
+					ColumnNames.Calendarfeeds.Userid,
+				},
+			},
+		},
+	}, Calendarfeeds.Columns().Names(), opts...)
+}
+
+func ThenLoadUserUseridCalendarfeed(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridCalendarfeed(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
 		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserUseridPreferencespots", retrieved)
+			return fmt.Errorf("object %T cannot load UserUseridCalendarfeed", retrieved)
 		}
 
-		err := loader.LoadUserUseridPreferencespots(ctx, exec, queryMods...)
+		err := loader.LoadUserUseridCalendarfeed(ctx, exec, queryMods...)
 
 		// Don't cause an issue due to missing relationships
 		if errors.Is(err, sql.ErrNoRows) {
@@ -1055,16 +1731,227 @@ func ThenLoadUserUseridPreferencespots(queryMods ...bob.Mod[*dialect.SelectQuery
 	})
 }
 
-// LoadUserUseridPreferencespots loads the user's UseridPreferencespots into the .R struct
-func (o *User) LoadUserUseridPreferencespots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadUserUseridCalendarfeed loads the user's UseridCalendarfeed into the .R struct
+func (o *User) LoadUserUseridCalendarfeed(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.UseridPreferencespots = nil
+	o.R.UseridCalendarfeed = nil
 
-	related, err := o.UseridPreferencespots(mods...).All(ctx, exec)
+	related, err := o.UseridCalendarfeed(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.UseridUser = o
+
+	o.R.UseridCalendarfeed = related
+	return nil
+}
+
+// LoadUserUseridCalendarfeed loads the user's UseridCalendarfeed into the .R struct
+func (os UserSlice) LoadUserUseridCalendarfeed(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	calendarfeeds, err := os.UseridCalendarfeed(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range calendarfeeds {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridCalendarfeed = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserUseridCars(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridCars(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUseridCars", retrieved)
+		}
+
+		err := loader.LoadUserUseridCars(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUseridCars loads the user's UseridCars into the .R struct
+func (o *User) LoadUserUseridCars(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridCars = nil
+
+	related, err := o.UseridCars(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.UseridUser = o
+	}
+
+	o.R.UseridCars = related
+	return nil
+}
+
+// LoadUserUseridCars loads the user's UseridCars into the .R struct
+func (os UserSlice) LoadUserUseridCars(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	cars, err := os.UseridCars(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UseridCars = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range cars {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridCars = append(o.R.UseridCars, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserUseridDevices(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridDevices(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUseridDevices", retrieved)
+		}
+
+		err := loader.LoadUserUseridDevices(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUseridDevices loads the user's UseridDevices into the .R struct
+func (o *User) LoadUserUseridDevices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridDevices = nil
+
+	related, err := o.UseridDevices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.UseridUser = o
+	}
+
+	o.R.UseridDevices = related
+	return nil
+}
+
+// LoadUserUseridDevices loads the user's UseridDevices into the .R struct
+func (os UserSlice) LoadUserUseridDevices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	devices, err := os.UseridDevices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UseridDevices = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range devices {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridDevices = append(o.R.UseridDevices, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserUseridHolds(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridHolds(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUseridHolds", retrieved)
+		}
+
+		err := loader.LoadUserUseridHolds(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUseridHolds loads the user's UseridHolds into the .R struct
+func (o *User) LoadUserUseridHolds(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridHolds = nil
+
+	related, err := o.UseridHolds(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -1073,185 +1960,1549 @@ func (o *User) LoadUserUseridPreferencespots(ctx context.Context, exec bob.Execu
 		rel.R.UseridUser = o
 	}
 
-	o.R.UseridPreferencespots = related
-	return nil
+	o.R.UseridHolds = related
+	return nil
+}
+
+// LoadUserUseridHolds loads the user's UseridHolds into the .R struct
+func (os UserSlice) LoadUserUseridHolds(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	holds, err := os.UseridHolds(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UseridHolds = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range holds {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridHolds = append(o.R.UseridHolds, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserSenderidMessages(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserSenderidMessages(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserSenderidMessages", retrieved)
+		}
+
+		err := loader.LoadUserSenderidMessages(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserSenderidMessages loads the user's SenderidMessages into the .R struct
+func (o *User) LoadUserSenderidMessages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.SenderidMessages = nil
+
+	related, err := o.SenderidMessages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.SenderidUser = o
+	}
+
+	o.R.SenderidMessages = related
+	return nil
+}
+
+// LoadUserSenderidMessages loads the user's SenderidMessages into the .R struct
+func (os UserSlice) LoadUserSenderidMessages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	messages, err := os.SenderidMessages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.SenderidMessages = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range messages {
+			if o.Userid != rel.Senderid {
+				continue
+			}
+
+			rel.R.SenderidUser = o
+
+			o.R.SenderidMessages = append(o.R.SenderidMessages, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserUseridNotifications(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUseridNotifications", retrieved)
+		}
+
+		err := loader.LoadUserUseridNotifications(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUseridNotifications loads the user's UseridNotifications into the .R struct
+func (o *User) LoadUserUseridNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridNotifications = nil
+
+	related, err := o.UseridNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.UseridUser = o
+	}
+
+	o.R.UseridNotifications = related
+	return nil
+}
+
+// LoadUserUseridNotifications loads the user's UseridNotifications into the .R struct
+func (os UserSlice) LoadUserUseridNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.UseridNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UseridNotifications = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range notifications {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridNotifications = append(o.R.UseridNotifications, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserUseridParkinglocations(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridParkinglocations(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUseridParkinglocations", retrieved)
+		}
+
+		err := loader.LoadUserUseridParkinglocations(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+func ThenLoadUserUseridParkingspots(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridParkingspots(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUseridParkingspots", retrieved)
+		}
+
+		err := loader.LoadUserUseridParkingspots(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUseridParkinglocations loads the user's UseridParkinglocations into the .R struct
+func (o *User) LoadUserUseridParkinglocations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridParkinglocations = nil
+
+	related, err := o.UseridParkinglocations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.UseridUser = o
+	}
+
+	o.R.UseridParkinglocations = related
+	return nil
+}
+
+// LoadUserUseridParkingspots loads the user's UseridParkingspots into the .R struct
+func (o *User) LoadUserUseridParkingspots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridParkingspots = nil
+
+	related, err := o.UseridParkingspots(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.UseridUser = o
+	}
+
+	o.R.UseridParkingspots = related
+	return nil
+}
+
+// LoadUserUseridParkinglocations loads the user's UseridParkinglocations into the .R struct
+func (os UserSlice) LoadUserUseridParkinglocations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	parkinglocations, err := os.UseridParkinglocations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UseridParkinglocations = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range parkinglocations {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridParkinglocations = append(o.R.UseridParkinglocations, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUserUseridParkingspots loads the user's UseridParkingspots into the .R struct
+func (os UserSlice) LoadUserUseridParkingspots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	parkingspots, err := os.UseridParkingspots(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UseridParkingspots = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range parkingspots {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridParkingspots = append(o.R.UseridParkingspots, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserUseridPreferencespots(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridPreferencespots(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUseridPreferencespots", retrieved)
+		}
+
+		err := loader.LoadUserUseridPreferencespots(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUseridPreferencespots loads the user's UseridPreferencespots into the .R struct
+func (o *User) LoadUserUseridPreferencespots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridPreferencespots = nil
+
+	related, err := o.UseridPreferencespots(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.UseridUser = o
+	}
+
+	o.R.UseridPreferencespots = related
+	return nil
+}
+
+// LoadUserUseridPreferencespots loads the user's UseridPreferencespots into the .R struct
+func (os UserSlice) LoadUserUseridPreferencespots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	preferencespots, err := os.UseridPreferencespots(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UseridPreferencespots = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range preferencespots {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridPreferencespots = append(o.R.UseridPreferencespots, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserOwneridPromocodes(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserOwneridPromocodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserOwneridPromocodes", retrieved)
+		}
+
+		err := loader.LoadUserOwneridPromocodes(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserOwneridPromocodes loads the user's OwneridPromocodes into the .R struct
+func (o *User) LoadUserOwneridPromocodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.OwneridPromocodes = nil
+
+	related, err := o.OwneridPromocodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.OwneridUser = o
+	}
+
+	o.R.OwneridPromocodes = related
+	return nil
+}
+
+// LoadUserOwneridPromocodes loads the user's OwneridPromocodes into the .R struct
+func (os UserSlice) LoadUserOwneridPromocodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	promocodes, err := os.OwneridPromocodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.OwneridPromocodes = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range promocodes {
+			if o.Userid != rel.Ownerid.GetOrZero() {
+				continue
+			}
+
+			rel.R.OwneridUser = o
+
+			o.R.OwneridPromocodes = append(o.R.OwneridPromocodes, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserRevieweridReviews(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserRevieweridReviews(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserRevieweridReviews", retrieved)
+		}
+
+		err := loader.LoadUserRevieweridReviews(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserRevieweridReviews loads the user's RevieweridReviews into the .R struct
+func (o *User) LoadUserRevieweridReviews(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.RevieweridReviews = nil
+
+	related, err := o.RevieweridReviews(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.RevieweridUser = o
+	}
+
+	o.R.RevieweridReviews = related
+	return nil
+}
+
+// LoadUserRevieweridReviews loads the user's RevieweridReviews into the .R struct
+func (os UserSlice) LoadUserRevieweridReviews(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	reviews, err := os.RevieweridReviews(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.RevieweridReviews = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range reviews {
+			if o.Userid != rel.Reviewerid {
+				continue
+			}
+
+			rel.R.RevieweridUser = o
+
+			o.R.RevieweridReviews = append(o.R.RevieweridReviews, rel)
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserUseridSavedsearches(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridSavedsearches(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUseridSavedsearches", retrieved)
+		}
+
+		err := loader.LoadUserUseridSavedsearches(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUseridSavedsearches loads the user's UseridSavedsearches into the .R struct
+func (o *User) LoadUserUseridSavedsearches(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridSavedsearches = nil
+
+	related, err := o.UseridSavedsearches(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.UseridUser = o
+	}
+
+	o.R.UseridSavedsearches = related
+	return nil
+}
+
+// LoadUserUseridSavedsearches loads the user's UseridSavedsearches into the .R struct
+func (os UserSlice) LoadUserUseridSavedsearches(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	savedsearches, err := os.UseridSavedsearches(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UseridSavedsearches = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range savedsearches {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridSavedsearches = append(o.R.UseridSavedsearches, rel)
+		}
+	}
+
+	return nil
+}
+
+func PreloadUserAuthuuidAuth(opts ...psql.PreloadOption) psql.Preloader {
+	return psql.Preload[*Auth, AuthSlice](orm.Relationship{
+		Name: "AuthuuidAuth",
+		Sides: []orm.RelSide{
+			{
+				From: TableNames.Users,
+				To:   TableNames.Auths,
+				FromColumns: []string{
+					ColumnNames.Users.Authuuid,
+				},
+				ToColumns: []string{
+					ColumnNames.Auths.Authuuid,
+				},
+			},
+		},
+	}, Auths.Columns().Names(), opts...)
+}
+
+func ThenLoadUserAuthuuidAuth(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserAuthuuidAuth(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserAuthuuidAuth", retrieved)
+		}
+
+		err := loader.LoadUserAuthuuidAuth(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserAuthuuidAuth loads the user's AuthuuidAuth into the .R struct
+func (o *User) LoadUserAuthuuidAuth(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AuthuuidAuth = nil
+
+	related, err := o.AuthuuidAuth(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.AuthuuidUser = o
+
+	o.R.AuthuuidAuth = related
+	return nil
+}
+
+// LoadUserAuthuuidAuth loads the user's AuthuuidAuth into the .R struct
+func (os UserSlice) LoadUserAuthuuidAuth(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	auths, err := os.AuthuuidAuth(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range auths {
+			if o.Authuuid != rel.Authuuid {
+				continue
+			}
+
+			rel.R.AuthuuidUser = o
+
+			o.R.AuthuuidAuth = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func ThenLoadUserUseridWebhooks(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
+	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+		loader, isLoader := retrieved.(interface {
+			LoadUserUseridWebhooks(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+		})
+		if !isLoader {
+			return fmt.Errorf("object %T cannot load UserUseridWebhooks", retrieved)
+		}
+
+		err := loader.LoadUserUseridWebhooks(ctx, exec, queryMods...)
+
+		// Don't cause an issue due to missing relationships
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// LoadUserUseridWebhooks loads the user's UseridWebhooks into the .R struct
+func (o *User) LoadUserUseridWebhooks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UseridWebhooks = nil
+
+	related, err := o.UseridWebhooks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.UseridUser = o
+	}
+
+	o.R.UseridWebhooks = related
+	return nil
+}
+
+// LoadUserUseridWebhooks loads the user's UseridWebhooks into the .R struct
+func (os UserSlice) LoadUserUseridWebhooks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	webhooks, err := os.UseridWebhooks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.UseridWebhooks = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range webhooks {
+			if o.Userid != rel.Userid {
+				continue
+			}
+
+			rel.R.UseridUser = o
+
+			o.R.UseridWebhooks = append(o.R.UseridWebhooks, rel)
+		}
+	}
+
+	return nil
+}
+
+func insertUserUseridAdministrator0(ctx context.Context, exec bob.Executor, administrator1 *AdministratorSetter, user0 *User) (*Administrator, error) {
+	administrator1.Userid = omit.From(user0.Userid)
+
+	ret, err := Administrators.Insert(administrator1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridAdministrator0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridAdministrator0(ctx context.Context, exec bob.Executor, count int, administrator1 *Administrator, user0 *User) (*Administrator, error) {
+	setter := &AdministratorSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := administrator1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridAdministrator0: %w", err)
+	}
+
+	return administrator1, nil
+}
+
+func (user0 *User) InsertUseridAdministrator(ctx context.Context, exec bob.Executor, related *AdministratorSetter) error {
+	administrator1, err := insertUserUseridAdministrator0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridAdministrator = administrator1
+
+	administrator1.R.UseridUser = user0
+
+	return nil
+}
+
+func (user0 *User) AttachUseridAdministrator(ctx context.Context, exec bob.Executor, administrator1 *Administrator) error {
+	var err error
+
+	_, err = attachUserUseridAdministrator0(ctx, exec, 1, administrator1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridAdministrator = administrator1
+
+	administrator1.R.UseridUser = user0
+
+	return nil
+}
+
+func insertUserUseridAvailabilityalerts0(ctx context.Context, exec bob.Executor, availabilityalerts1 []*AvailabilityalertSetter, user0 *User) (AvailabilityalertSlice, error) {
+	for i := range availabilityalerts1 {
+		availabilityalerts1[i].Userid = omit.From(user0.Userid)
+	}
+
+	ret, err := Availabilityalerts.Insert(bob.ToMods(availabilityalerts1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridAvailabilityalerts0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridAvailabilityalerts0(ctx context.Context, exec bob.Executor, count int, availabilityalerts1 AvailabilityalertSlice, user0 *User) (AvailabilityalertSlice, error) {
+	setter := &AvailabilityalertSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := availabilityalerts1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridAvailabilityalerts0: %w", err)
+	}
+
+	return availabilityalerts1, nil
+}
+
+func (user0 *User) InsertUseridAvailabilityalerts(ctx context.Context, exec bob.Executor, related ...*AvailabilityalertSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	availabilityalerts1, err := insertUserUseridAvailabilityalerts0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridAvailabilityalerts = append(user0.R.UseridAvailabilityalerts, availabilityalerts1...)
+
+	for _, rel := range availabilityalerts1 {
+		rel.R.UseridUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUseridAvailabilityalerts(ctx context.Context, exec bob.Executor, related ...*Availabilityalert) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	availabilityalerts1 := AvailabilityalertSlice(related)
+
+	_, err = attachUserUseridAvailabilityalerts0(ctx, exec, len(related), availabilityalerts1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridAvailabilityalerts = append(user0.R.UseridAvailabilityalerts, availabilityalerts1...)
+
+	for _, rel := range related {
+		rel.R.UseridUser = user0
+	}
+
+	return nil
+}
+
+func insertUserUseridBookings0(ctx context.Context, exec bob.Executor, bookings1 []*BookingSetter, user0 *User) (BookingSlice, error) {
+	for i := range bookings1 {
+		bookings1[i].Userid = omit.From(user0.Userid)
+	}
+
+	ret, err := Bookings.Insert(bob.ToMods(bookings1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridBookings0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridBookings0(ctx context.Context, exec bob.Executor, count int, bookings1 BookingSlice, user0 *User) (BookingSlice, error) {
+	setter := &BookingSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := bookings1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridBookings0: %w", err)
+	}
+
+	return bookings1, nil
+}
+
+func (user0 *User) InsertUseridBookings(ctx context.Context, exec bob.Executor, related ...*BookingSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	bookings1, err := insertUserUseridBookings0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridBookings = append(user0.R.UseridBookings, bookings1...)
+
+	for _, rel := range bookings1 {
+		rel.R.UseridUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUseridBookings(ctx context.Context, exec bob.Executor, related ...*Booking) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	bookings1 := BookingSlice(related)
+
+	_, err = attachUserUseridBookings0(ctx, exec, len(related), bookings1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridBookings = append(user0.R.UseridBookings, bookings1...)
+
+	for _, rel := range related {
+		rel.R.UseridUser = user0
+	}
+
+	return nil
+}
+
+func insertUserUseridCalendarfeed0(ctx context.Context, exec bob.Executor, calendarfeed1 *CalendarfeedSetter, user0 *User) (*Calendarfeed, error) {
+	calendarfeed1.Userid = omit.From(user0.Userid)
+
+	ret, err := Calendarfeeds.Insert(calendarfeed1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridCalendarfeed0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridCalendarfeed0(ctx context.Context, exec bob.Executor, count int, calendarfeed1 *Calendarfeed, user0 *User) (*Calendarfeed, error) {
+	setter := &CalendarfeedSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := calendarfeed1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridCalendarfeed0: %w", err)
+	}
+
+	return calendarfeed1, nil
+}
+
+func (user0 *User) InsertUseridCalendarfeed(ctx context.Context, exec bob.Executor, related *CalendarfeedSetter) error {
+	calendarfeed1, err := insertUserUseridCalendarfeed0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridCalendarfeed = calendarfeed1
+
+	calendarfeed1.R.UseridUser = user0
+
+	return nil
+}
+
+func (user0 *User) AttachUseridCalendarfeed(ctx context.Context, exec bob.Executor, calendarfeed1 *Calendarfeed) error {
+	var err error
+
+	_, err = attachUserUseridCalendarfeed0(ctx, exec, 1, calendarfeed1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridCalendarfeed = calendarfeed1
+
+	calendarfeed1.R.UseridUser = user0
+
+	return nil
+}
+
+func insertUserUseridCars0(ctx context.Context, exec bob.Executor, cars1 []*CarSetter, user0 *User) (CarSlice, error) {
+	for i := range cars1 {
+		cars1[i].Userid = omit.From(user0.Userid)
+	}
+
+	ret, err := Cars.Insert(bob.ToMods(cars1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridCars0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridCars0(ctx context.Context, exec bob.Executor, count int, cars1 CarSlice, user0 *User) (CarSlice, error) {
+	setter := &CarSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := cars1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridCars0: %w", err)
+	}
+
+	return cars1, nil
+}
+
+func (user0 *User) InsertUseridCars(ctx context.Context, exec bob.Executor, related ...*CarSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	cars1, err := insertUserUseridCars0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridCars = append(user0.R.UseridCars, cars1...)
+
+	for _, rel := range cars1 {
+		rel.R.UseridUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUseridCars(ctx context.Context, exec bob.Executor, related ...*Car) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	cars1 := CarSlice(related)
+
+	_, err = attachUserUseridCars0(ctx, exec, len(related), cars1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridCars = append(user0.R.UseridCars, cars1...)
+
+	for _, rel := range related {
+		rel.R.UseridUser = user0
+	}
+
+	return nil
+}
+
+func insertUserUseridDevices0(ctx context.Context, exec bob.Executor, devices1 []*DeviceSetter, user0 *User) (DeviceSlice, error) {
+	for i := range devices1 {
+		devices1[i].Userid = omit.From(user0.Userid)
+	}
+
+	ret, err := Devices.Insert(bob.ToMods(devices1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridDevices0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridDevices0(ctx context.Context, exec bob.Executor, count int, devices1 DeviceSlice, user0 *User) (DeviceSlice, error) {
+	setter := &DeviceSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := devices1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridDevices0: %w", err)
+	}
+
+	return devices1, nil
+}
+
+func (user0 *User) InsertUseridDevices(ctx context.Context, exec bob.Executor, related ...*DeviceSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	devices1, err := insertUserUseridDevices0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridDevices = append(user0.R.UseridDevices, devices1...)
+
+	for _, rel := range devices1 {
+		rel.R.UseridUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUseridDevices(ctx context.Context, exec bob.Executor, related ...*Device) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	devices1 := DeviceSlice(related)
+
+	_, err = attachUserUseridDevices0(ctx, exec, len(related), devices1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridDevices = append(user0.R.UseridDevices, devices1...)
+
+	for _, rel := range related {
+		rel.R.UseridUser = user0
+	}
+
+	return nil
+}
+
+func insertUserUseridHolds0(ctx context.Context, exec bob.Executor, holds1 []*HoldSetter, user0 *User) (HoldSlice, error) {
+	for i := range holds1 {
+		holds1[i].Userid = omit.From(user0.Userid)
+	}
+
+	ret, err := Holds.Insert(bob.ToMods(holds1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridHolds0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridHolds0(ctx context.Context, exec bob.Executor, count int, holds1 HoldSlice, user0 *User) (HoldSlice, error) {
+	setter := &HoldSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := holds1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridHolds0: %w", err)
+	}
+
+	return holds1, nil
+}
+
+func (user0 *User) InsertUseridHolds(ctx context.Context, exec bob.Executor, related ...*HoldSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	holds1, err := insertUserUseridHolds0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridHolds = append(user0.R.UseridHolds, holds1...)
+
+	for _, rel := range holds1 {
+		rel.R.UseridUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUseridHolds(ctx context.Context, exec bob.Executor, related ...*Hold) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	holds1 := HoldSlice(related)
+
+	_, err = attachUserUseridHolds0(ctx, exec, len(related), holds1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridHolds = append(user0.R.UseridHolds, holds1...)
+
+	for _, rel := range related {
+		rel.R.UseridUser = user0
+	}
+
+	return nil
+}
+
+func insertUserSenderidMessages0(ctx context.Context, exec bob.Executor, messages1 []*MessageSetter, user0 *User) (MessageSlice, error) {
+	for i := range messages1 {
+		messages1[i].Senderid = omit.From(user0.Userid)
+	}
+
+	ret, err := Messages.Insert(bob.ToMods(messages1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserSenderidMessages0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserSenderidMessages0(ctx context.Context, exec bob.Executor, count int, messages1 MessageSlice, user0 *User) (MessageSlice, error) {
+	setter := &MessageSetter{
+		Senderid: omit.From(user0.Userid),
+	}
+
+	err := messages1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserSenderidMessages0: %w", err)
+	}
+
+	return messages1, nil
+}
+
+func (user0 *User) InsertSenderidMessages(ctx context.Context, exec bob.Executor, related ...*MessageSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	messages1, err := insertUserSenderidMessages0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.SenderidMessages = append(user0.R.SenderidMessages, messages1...)
+
+	for _, rel := range messages1 {
+		rel.R.SenderidUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachSenderidMessages(ctx context.Context, exec bob.Executor, related ...*Message) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	messages1 := MessageSlice(related)
+
+	_, err = attachUserSenderidMessages0(ctx, exec, len(related), messages1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.SenderidMessages = append(user0.R.SenderidMessages, messages1...)
+
+	for _, rel := range related {
+		rel.R.SenderidUser = user0
+	}
+
+	return nil
+}
+
+func insertUserUseridNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, user0 *User) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].Userid = omit.From(user0.Userid)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, user0 *User) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (user0 *User) InsertUseridNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertUserUseridNotifications0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridNotifications = append(user0.R.UseridNotifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.UseridUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUseridNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachUserUseridNotifications0(ctx, exec, len(related), notifications1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridNotifications = append(user0.R.UseridNotifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.UseridUser = user0
+	}
+
+	return nil
+}
+
+func insertUserUseridParkinglocations0(ctx context.Context, exec bob.Executor, parkinglocations1 []*ParkinglocationSetter, user0 *User) (ParkinglocationSlice, error) {
+	for i := range parkinglocations1 {
+		parkinglocations1[i].Userid = omit.From(user0.Userid)
+	}
+
+	ret, err := Parkinglocations.Insert(bob.ToMods(parkinglocations1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridParkinglocations0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func insertUserUseridParkingspots0(ctx context.Context, exec bob.Executor, parkingspots1 []*ParkingspotSetter, user0 *User) (ParkingspotSlice, error) {
+	for i := range parkingspots1 {
+		parkingspots1[i].Userid = omit.From(user0.Userid)
+	}
+
+	ret, err := Parkingspots.Insert(bob.ToMods(parkingspots1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridParkingspots0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridParkinglocations0(ctx context.Context, exec bob.Executor, count int, parkinglocations1 ParkinglocationSlice, user0 *User) (ParkinglocationSlice, error) {
+	setter := &ParkinglocationSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := parkinglocations1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridParkinglocations0: %w", err)
+	}
+
+	return parkinglocations1, nil
+}
+
+func attachUserUseridParkingspots0(ctx context.Context, exec bob.Executor, count int, parkingspots1 ParkingspotSlice, user0 *User) (ParkingspotSlice, error) {
+	setter := &ParkingspotSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := parkingspots1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridParkingspots0: %w", err)
+	}
+
+	return parkingspots1, nil
 }
 
-// LoadUserUseridPreferencespots loads the user's UseridPreferencespots into the .R struct
-func (os UserSlice) LoadUserUseridPreferencespots(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
-	if len(os) == 0 {
+func (user0 *User) InsertUseridParkinglocations(ctx context.Context, exec bob.Executor, related ...*ParkinglocationSetter) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	preferencespots, err := os.UseridPreferencespots(mods...).All(ctx, exec)
+	var err error
+
+	parkinglocations1, err := insertUserUseridParkinglocations0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	for _, o := range os {
-		o.R.UseridPreferencespots = nil
-	}
-
-	for _, o := range os {
-		for _, rel := range preferencespots {
-			if o.Userid != rel.Userid {
-				continue
-			}
-
-			rel.R.UseridUser = o
+	user0.R.UseridParkinglocations = append(user0.R.UseridParkinglocations, parkinglocations1...)
 
-			o.R.UseridPreferencespots = append(o.R.UseridPreferencespots, rel)
-		}
+	for _, rel := range parkinglocations1 {
+		rel.R.UseridUser = user0
 	}
-
 	return nil
 }
 
-func PreloadUserAuthuuidAuth(opts ...psql.PreloadOption) psql.Preloader {
-	return psql.Preload[*Auth, AuthSlice](orm.Relationship{
-		Name: "AuthuuidAuth",
-		Sides: []orm.RelSide{
-			{
-				From: TableNames.Users,
-				To:   TableNames.Auths,
-				FromColumns: []string{
-					ColumnNames.Users.Authuuid,
-				},
-				ToColumns: []string{
-					ColumnNames.Auths.Authuuid,
-				},
-			},
-		},
-	}, Auths.Columns().Names(), opts...)
-}
+func (user0 *User) InsertUseridParkingspots(ctx context.Context, exec bob.Executor, related ...*ParkingspotSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
 
-func ThenLoadUserAuthuuidAuth(queryMods ...bob.Mod[*dialect.SelectQuery]) psql.Loader {
-	return psql.Loader(func(ctx context.Context, exec bob.Executor, retrieved any) error {
-		loader, isLoader := retrieved.(interface {
-			LoadUserAuthuuidAuth(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
-		})
-		if !isLoader {
-			return fmt.Errorf("object %T cannot load UserAuthuuidAuth", retrieved)
-		}
+	var err error
 
-		err := loader.LoadUserAuthuuidAuth(ctx, exec, queryMods...)
+	parkingspots1, err := insertUserUseridParkingspots0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
 
-		// Don't cause an issue due to missing relationships
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil
-		}
+	user0.R.UseridParkingspots = append(user0.R.UseridParkingspots, parkingspots1...)
 
-		return err
-	})
+	for _, rel := range parkingspots1 {
+		rel.R.UseridUser = user0
+	}
+	return nil
 }
 
-// LoadUserAuthuuidAuth loads the user's AuthuuidAuth into the .R struct
-func (o *User) LoadUserAuthuuidAuth(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
-	if o == nil {
+func (user0 *User) AttachUseridParkinglocations(ctx context.Context, exec bob.Executor, related ...*Parkinglocation) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	// Reset the relationship
-	o.R.AuthuuidAuth = nil
+	var err error
+	parkinglocations1 := ParkinglocationSlice(related)
 
-	related, err := o.AuthuuidAuth(mods...).One(ctx, exec)
+	_, err = attachUserUseridParkinglocations0(ctx, exec, len(related), parkinglocations1, user0)
 	if err != nil {
 		return err
 	}
 
-	related.R.AuthuuidUser = o
+	user0.R.UseridParkinglocations = append(user0.R.UseridParkinglocations, parkinglocations1...)
+
+	for _, rel := range related {
+		rel.R.UseridUser = user0
+	}
 
-	o.R.AuthuuidAuth = related
 	return nil
 }
 
-// LoadUserAuthuuidAuth loads the user's AuthuuidAuth into the .R struct
-func (os UserSlice) LoadUserAuthuuidAuth(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
-	if len(os) == 0 {
+func (user0 *User) AttachUseridParkingspots(ctx context.Context, exec bob.Executor, related ...*Parkingspot) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	auths, err := os.AuthuuidAuth(mods...).All(ctx, exec)
+	var err error
+	parkingspots1 := ParkingspotSlice(related)
+
+	_, err = attachUserUseridParkingspots0(ctx, exec, len(related), parkingspots1, user0)
 	if err != nil {
 		return err
 	}
 
-	for _, o := range os {
-		for _, rel := range auths {
-			if o.Authuuid != rel.Authuuid {
-				continue
-			}
-
-			rel.R.AuthuuidUser = o
+	user0.R.UseridParkingspots = append(user0.R.UseridParkingspots, parkingspots1...)
 
-			o.R.AuthuuidAuth = rel
-			break
-		}
+	for _, rel := range related {
+		rel.R.UseridUser = user0
 	}
 
 	return nil
 }
 
-func insertUserUseridBookings0(ctx context.Context, exec bob.Executor, bookings1 []*BookingSetter, user0 *User) (BookingSlice, error) {
-	for i := range bookings1 {
-		bookings1[i].Userid = omit.From(user0.Userid)
+func insertUserUseridPreferencespots0(ctx context.Context, exec bob.Executor, preferencespots1 []*PreferencespotSetter, user0 *User) (PreferencespotSlice, error) {
+	for i := range preferencespots1 {
+		preferencespots1[i].Userid = omit.From(user0.Userid)
 	}
 
-	ret, err := Bookings.Insert(bob.ToMods(bookings1...)).All(ctx, exec)
+	ret, err := Preferencespots.Insert(bob.ToMods(preferencespots1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserUseridBookings0: %w", err)
+		return ret, fmt.Errorf("insertUserUseridPreferencespots0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserUseridBookings0(ctx context.Context, exec bob.Executor, count int, bookings1 BookingSlice, user0 *User) (BookingSlice, error) {
-	setter := &BookingSetter{
+func attachUserUseridPreferencespots0(ctx context.Context, exec bob.Executor, count int, preferencespots1 PreferencespotSlice, user0 *User) (PreferencespotSlice, error) {
+	setter := &PreferencespotSetter{
 		Userid: omit.From(user0.Userid),
 	}
 
-	err := bookings1.UpdateAll(ctx, exec, *setter)
+	err := preferencespots1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserUseridBookings0: %w", err)
+		return nil, fmt.Errorf("attachUserUseridPreferencespots0: %w", err)
 	}
 
-	return bookings1, nil
+	return preferencespots1, nil
 }
 
-func (user0 *User) InsertUseridBookings(ctx context.Context, exec bob.Executor, related ...*BookingSetter) error {
+func (user0 *User) InsertUseridPreferencespots(ctx context.Context, exec bob.Executor, related ...*PreferencespotSetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	bookings1, err := insertUserUseridBookings0(ctx, exec, related, user0)
+	preferencespots1, err := insertUserUseridPreferencespots0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UseridBookings = append(user0.R.UseridBookings, bookings1...)
+	user0.R.UseridPreferencespots = append(user0.R.UseridPreferencespots, preferencespots1...)
 
-	for _, rel := range bookings1 {
+	for _, rel := range preferencespots1 {
 		rel.R.UseridUser = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachUseridBookings(ctx context.Context, exec bob.Executor, related ...*Booking) error {
+func (user0 *User) AttachUseridPreferencespots(ctx context.Context, exec bob.Executor, related ...*Preferencespot) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	bookings1 := BookingSlice(related)
+	preferencespots1 := PreferencespotSlice(related)
 
-	_, err = attachUserUseridBookings0(ctx, exec, len(related), bookings1, user0)
+	_, err = attachUserUseridPreferencespots0(ctx, exec, len(related), preferencespots1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UseridBookings = append(user0.R.UseridBookings, bookings1...)
+	user0.R.UseridPreferencespots = append(user0.R.UseridPreferencespots, preferencespots1...)
 
 	for _, rel := range related {
 		rel.R.UseridUser = user0
@@ -1260,202 +3511,202 @@ func (user0 *User) AttachUseridBookings(ctx context.Context, exec bob.Executor,
 	return nil
 }
 
-func insertUserUseridCars0(ctx context.Context, exec bob.Executor, cars1 []*CarSetter, user0 *User) (CarSlice, error) {
-	for i := range cars1 {
-		cars1[i].Userid = omit.From(user0.Userid)
+func insertUserOwneridPromocodes0(ctx context.Context, exec bob.Executor, promocodes1 []*PromocodeSetter, user0 *User) (PromocodeSlice, error) {
+	for i := range promocodes1 {
+		promocodes1[i].Ownerid = omitnull.From(user0.Userid)
 	}
 
-	ret, err := Cars.Insert(bob.ToMods(cars1...)).All(ctx, exec)
+	ret, err := Promocodes.Insert(bob.ToMods(promocodes1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserUseridCars0: %w", err)
+		return ret, fmt.Errorf("insertUserOwneridPromocodes0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserUseridCars0(ctx context.Context, exec bob.Executor, count int, cars1 CarSlice, user0 *User) (CarSlice, error) {
-	setter := &CarSetter{
-		Userid: omit.From(user0.Userid),
+func attachUserOwneridPromocodes0(ctx context.Context, exec bob.Executor, count int, promocodes1 PromocodeSlice, user0 *User) (PromocodeSlice, error) {
+	setter := &PromocodeSetter{
+		Ownerid: omitnull.From(user0.Userid),
 	}
 
-	err := cars1.UpdateAll(ctx, exec, *setter)
+	err := promocodes1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserUseridCars0: %w", err)
+		return nil, fmt.Errorf("attachUserOwneridPromocodes0: %w", err)
 	}
 
-	return cars1, nil
+	return promocodes1, nil
 }
 
-func (user0 *User) InsertUseridCars(ctx context.Context, exec bob.Executor, related ...*CarSetter) error {
+func (user0 *User) InsertOwneridPromocodes(ctx context.Context, exec bob.Executor, related ...*PromocodeSetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	cars1, err := insertUserUseridCars0(ctx, exec, related, user0)
+	promocodes1, err := insertUserOwneridPromocodes0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UseridCars = append(user0.R.UseridCars, cars1...)
+	user0.R.OwneridPromocodes = append(user0.R.OwneridPromocodes, promocodes1...)
 
-	for _, rel := range cars1 {
-		rel.R.UseridUser = user0
+	for _, rel := range promocodes1 {
+		rel.R.OwneridUser = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachUseridCars(ctx context.Context, exec bob.Executor, related ...*Car) error {
+func (user0 *User) AttachOwneridPromocodes(ctx context.Context, exec bob.Executor, related ...*Promocode) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	cars1 := CarSlice(related)
+	promocodes1 := PromocodeSlice(related)
 
-	_, err = attachUserUseridCars0(ctx, exec, len(related), cars1, user0)
+	_, err = attachUserOwneridPromocodes0(ctx, exec, len(related), promocodes1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UseridCars = append(user0.R.UseridCars, cars1...)
+	user0.R.OwneridPromocodes = append(user0.R.OwneridPromocodes, promocodes1...)
 
 	for _, rel := range related {
-		rel.R.UseridUser = user0
+		rel.R.OwneridUser = user0
 	}
 
 	return nil
 }
 
-func insertUserUseridParkingspots0(ctx context.Context, exec bob.Executor, parkingspots1 []*ParkingspotSetter, user0 *User) (ParkingspotSlice, error) {
-	for i := range parkingspots1 {
-		parkingspots1[i].Userid = omit.From(user0.Userid)
+func insertUserRevieweridReviews0(ctx context.Context, exec bob.Executor, reviews1 []*ReviewSetter, user0 *User) (ReviewSlice, error) {
+	for i := range reviews1 {
+		reviews1[i].Reviewerid = omit.From(user0.Userid)
 	}
 
-	ret, err := Parkingspots.Insert(bob.ToMods(parkingspots1...)).All(ctx, exec)
+	ret, err := Reviews.Insert(bob.ToMods(reviews1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserUseridParkingspots0: %w", err)
+		return ret, fmt.Errorf("insertUserRevieweridReviews0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserUseridParkingspots0(ctx context.Context, exec bob.Executor, count int, parkingspots1 ParkingspotSlice, user0 *User) (ParkingspotSlice, error) {
-	setter := &ParkingspotSetter{
-		Userid: omit.From(user0.Userid),
+func attachUserRevieweridReviews0(ctx context.Context, exec bob.Executor, count int, reviews1 ReviewSlice, user0 *User) (ReviewSlice, error) {
+	setter := &ReviewSetter{
+		Reviewerid: omit.From(user0.Userid),
 	}
 
-	err := parkingspots1.UpdateAll(ctx, exec, *setter)
+	err := reviews1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserUseridParkingspots0: %w", err)
+		return nil, fmt.Errorf("attachUserRevieweridReviews0: %w", err)
 	}
 
-	return parkingspots1, nil
+	return reviews1, nil
 }
 
-func (user0 *User) InsertUseridParkingspots(ctx context.Context, exec bob.Executor, related ...*ParkingspotSetter) error {
+func (user0 *User) InsertRevieweridReviews(ctx context.Context, exec bob.Executor, related ...*ReviewSetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	parkingspots1, err := insertUserUseridParkingspots0(ctx, exec, related, user0)
+	reviews1, err := insertUserRevieweridReviews0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UseridParkingspots = append(user0.R.UseridParkingspots, parkingspots1...)
+	user0.R.RevieweridReviews = append(user0.R.RevieweridReviews, reviews1...)
 
-	for _, rel := range parkingspots1 {
-		rel.R.UseridUser = user0
+	for _, rel := range reviews1 {
+		rel.R.RevieweridUser = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachUseridParkingspots(ctx context.Context, exec bob.Executor, related ...*Parkingspot) error {
+func (user0 *User) AttachRevieweridReviews(ctx context.Context, exec bob.Executor, related ...*Review) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	parkingspots1 := ParkingspotSlice(related)
+	reviews1 := ReviewSlice(related)
 
-	_, err = attachUserUseridParkingspots0(ctx, exec, len(related), parkingspots1, user0)
+	_, err = attachUserRevieweridReviews0(ctx, exec, len(related), reviews1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UseridParkingspots = append(user0.R.UseridParkingspots, parkingspots1...)
+	user0.R.RevieweridReviews = append(user0.R.RevieweridReviews, reviews1...)
 
 	for _, rel := range related {
-		rel.R.UseridUser = user0
+		rel.R.RevieweridUser = user0
 	}
 
 	return nil
 }
 
-func insertUserUseridPreferencespots0(ctx context.Context, exec bob.Executor, preferencespots1 []*PreferencespotSetter, user0 *User) (PreferencespotSlice, error) {
-	for i := range preferencespots1 {
-		preferencespots1[i].Userid = omit.From(user0.Userid)
+func insertUserUseridSavedsearches0(ctx context.Context, exec bob.Executor, savedsearches1 []*SavedsearchSetter, user0 *User) (SavedsearchSlice, error) {
+	for i := range savedsearches1 {
+		savedsearches1[i].Userid = omit.From(user0.Userid)
 	}
 
-	ret, err := Preferencespots.Insert(bob.ToMods(preferencespots1...)).All(ctx, exec)
+	ret, err := Savedsearches.Insert(bob.ToMods(savedsearches1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserUseridPreferencespots0: %w", err)
+		return ret, fmt.Errorf("insertUserUseridSavedsearches0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserUseridPreferencespots0(ctx context.Context, exec bob.Executor, count int, preferencespots1 PreferencespotSlice, user0 *User) (PreferencespotSlice, error) {
-	setter := &PreferencespotSetter{
+func attachUserUseridSavedsearches0(ctx context.Context, exec bob.Executor, count int, savedsearches1 SavedsearchSlice, user0 *User) (SavedsearchSlice, error) {
+	setter := &SavedsearchSetter{
 		Userid: omit.From(user0.Userid),
 	}
 
-	err := preferencespots1.UpdateAll(ctx, exec, *setter)
+	err := savedsearches1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserUseridPreferencespots0: %w", err)
+		return nil, fmt.Errorf("attachUserUseridSavedsearches0: %w", err)
 	}
 
-	return preferencespots1, nil
+	return savedsearches1, nil
 }
 
-func (user0 *User) InsertUseridPreferencespots(ctx context.Context, exec bob.Executor, related ...*PreferencespotSetter) error {
+func (user0 *User) InsertUseridSavedsearches(ctx context.Context, exec bob.Executor, related ...*SavedsearchSetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	preferencespots1, err := insertUserUseridPreferencespots0(ctx, exec, related, user0)
+	savedsearches1, err := insertUserUseridSavedsearches0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UseridPreferencespots = append(user0.R.UseridPreferencespots, preferencespots1...)
+	user0.R.UseridSavedsearches = append(user0.R.UseridSavedsearches, savedsearches1...)
 
-	for _, rel := range preferencespots1 {
+	for _, rel := range savedsearches1 {
 		rel.R.UseridUser = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachUseridPreferencespots(ctx context.Context, exec bob.Executor, related ...*Preferencespot) error {
+func (user0 *User) AttachUseridSavedsearches(ctx context.Context, exec bob.Executor, related ...*Savedsearch) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	preferencespots1 := PreferencespotSlice(related)
+	savedsearches1 := SavedsearchSlice(related)
 
-	_, err = attachUserUseridPreferencespots0(ctx, exec, len(related), preferencespots1, user0)
+	_, err = attachUserUseridSavedsearches0(ctx, exec, len(related), savedsearches1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UseridPreferencespots = append(user0.R.UseridPreferencespots, preferencespots1...)
+	user0.R.UseridSavedsearches = append(user0.R.UseridSavedsearches, savedsearches1...)
 
 	for _, rel := range related {
 		rel.R.UseridUser = user0
@@ -1509,3 +3760,71 @@ func (user0 *User) AttachAuthuuidAuth(ctx context.Context, exec bob.Executor, au
 
 	return nil
 }
+
+func insertUserUseridWebhooks0(ctx context.Context, exec bob.Executor, webhooks1 []*WebhookSetter, user0 *User) (WebhookSlice, error) {
+	for i := range webhooks1 {
+		webhooks1[i].Userid = omit.From(user0.Userid)
+	}
+
+	ret, err := Webhooks.Insert(bob.ToMods(webhooks1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUseridWebhooks0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUseridWebhooks0(ctx context.Context, exec bob.Executor, count int, webhooks1 WebhookSlice, user0 *User) (WebhookSlice, error) {
+	setter := &WebhookSetter{
+		Userid: omit.From(user0.Userid),
+	}
+
+	err := webhooks1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUseridWebhooks0: %w", err)
+	}
+
+	return webhooks1, nil
+}
+
+func (user0 *User) InsertUseridWebhooks(ctx context.Context, exec bob.Executor, related ...*WebhookSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	webhooks1, err := insertUserUseridWebhooks0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridWebhooks = append(user0.R.UseridWebhooks, webhooks1...)
+
+	for _, rel := range webhooks1 {
+		rel.R.UseridUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUseridWebhooks(ctx context.Context, exec bob.Executor, related ...*Webhook) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	webhooks1 := WebhookSlice(related)
+
+	_, err = attachUserUseridWebhooks0(ctx, exec, len(related), webhooks1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UseridWebhooks = append(user0.R.UseridWebhooks, webhooks1...)
+
+	for _, rel := range related {
+		rel.R.UseridUser = user0
+	}
+
+	return nil
+}
@@ -0,0 +1,568 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Largest number of busy occurrences read from a calendar
+const MaxOccurrences = 10000
+
+var (
+	ErrInvalidCalendar    = errors.New("invalid iCalendar data")
+	ErrTooManyOccurrences = fmt.Errorf("calendar has more than %d busy occurrences", MaxOccurrences)
+)
+
+// Longest content line accepted when decoding, after unfolding
+const maxDecodedLineLength = 64 * 1024
+
+const dateFormat = "20060102"
+
+// Busy times read from a calendar
+type Busy struct {
+	// Occurrences of busy events overlapping the period read, ordered by start
+	Events []Event
+	// Recurring events with a rule that could not be expanded.
+	//
+	// Only the first occurrence of these events is in `Events`.
+	Unexpanded []Event
+}
+
+// Read the times marked as busy between `from` and `to` from the iCalendar data in `r`.
+//
+// Recurring events are expanded, events that are cancelled or marked as transparent are skipped.
+// Times without a time zone, and times in time zones not known by name, are read in `loc`.
+func DecodeBusy(r io.Reader, loc *time.Location, from, to time.Time) (Busy, error) {
+	d := decoder{loc: loc}
+	err := d.read(r)
+	if err != nil {
+		return Busy{}, err
+	}
+
+	// Modified occurrences replace the occurrence of the recurring event they override
+	overridden := make(map[string][]time.Time)
+	for idx := range d.events {
+		event := &d.events[idx]
+		if !event.recurrenceID.IsZero() {
+			overridden[event.UID] = append(overridden[event.UID], event.recurrenceID)
+		}
+	}
+
+	var result Busy
+	for idx := range d.events {
+		event := &d.events[idx]
+		if !event.busy {
+			continue
+		}
+		if event.rule == nil || !event.recurrenceID.IsZero() {
+			if event.Start.Before(to) && event.End.After(from) {
+				result.Events = append(result.Events, event.Event)
+			}
+		} else {
+			event.exceptions = append(event.exceptions, overridden[event.UID]...)
+			expanded := event.expand(from, to, MaxOccurrences-len(result.Events)+1)
+			if !expanded {
+				result.Unexpanded = append(result.Unexpanded, event.Event)
+				if event.Start.Before(to) && event.End.After(from) {
+					result.Events = append(result.Events, event.Event)
+				}
+			}
+			result.Events = append(result.Events, event.occurrences...)
+		}
+		if len(result.Events) > MaxOccurrences {
+			return Busy{}, ErrTooManyOccurrences
+		}
+	}
+
+	slices.SortStableFunc(result.Events, func(a, b Event) int {
+		return a.Start.Compare(b.Start)
+	})
+	return result, nil
+}
+
+// An event being decoded
+type decodedEvent struct {
+	recurrenceID time.Time
+	rule         *recurrence
+	exceptions   []time.Time
+	occurrences  []Event
+	Event
+	duration time.Duration
+	hasEnd   bool
+	allDay   bool
+	busy     bool
+}
+
+type decoder struct {
+	loc     *time.Location
+	current *decodedEvent
+	events  []decodedEvent
+	// Names of the components being read, innermost last
+	components []string
+}
+
+func (d *decoder) read(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxDecodedLineLength)
+
+	var line strings.Builder
+	seenCalendar := false
+	for scanner.Scan() {
+		raw := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.HasPrefix(raw, " ") || strings.HasPrefix(raw, "\t") {
+			// Continuation of a folded line
+			if line.Len()+len(raw) > maxDecodedLineLength {
+				return fmt.Errorf("%w: content line too long", ErrInvalidCalendar)
+			}
+			line.WriteString(raw[1:])
+			continue
+		}
+		if line.Len() > 0 {
+			err := d.handle(line.String())
+			if err != nil {
+				return err
+			}
+			seenCalendar = true
+		}
+		line.Reset()
+		line.WriteString(raw)
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return fmt.Errorf("%w: content line too long", ErrInvalidCalendar)
+		}
+		return err
+	}
+	if line.Len() > 0 {
+		err := d.handle(line.String())
+		if err != nil {
+			return err
+		}
+		seenCalendar = true
+	}
+
+	if !seenCalendar || len(d.components) > 0 {
+		return fmt.Errorf("%w: incomplete calendar", ErrInvalidCalendar)
+	}
+	return nil
+}
+
+// Process a content line
+func (d *decoder) handle(line string) error {
+	name, params, value, err := parseContentLine(line)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "BEGIN":
+		component := strings.ToUpper(value)
+		if len(d.components) == 0 && component != "VCALENDAR" {
+			return fmt.Errorf("%w: expected VCALENDAR, got %v", ErrInvalidCalendar, value)
+		}
+		d.components = append(d.components, component)
+		if component == "VEVENT" && d.current == nil {
+			d.current = &decodedEvent{busy: true}
+		}
+		return nil
+	case "END":
+		if len(d.components) == 0 || d.components[len(d.components)-1] != strings.ToUpper(value) {
+			return fmt.Errorf("%w: unexpected END:%v", ErrInvalidCalendar, value)
+		}
+		d.components = d.components[:len(d.components)-1]
+		if strings.ToUpper(value) == "VEVENT" && d.current != nil {
+			err := d.finishEvent()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Only properties of events are used, not those of their alarms
+	if d.current == nil || d.components[len(d.components)-1] != "VEVENT" {
+		return nil
+	}
+	return d.current.set(name, params, value, d.loc)
+}
+
+func (d *decoder) finishEvent() error {
+	event := d.current
+	d.current = nil
+
+	if event.Start.IsZero() {
+		return fmt.Errorf("%w: event %q has no start", ErrInvalidCalendar, event.UID)
+	}
+	switch {
+	case event.hasEnd:
+	case event.duration != 0:
+		event.End = event.Start.Add(event.duration)
+	case event.allDay:
+		// All-day events without an end last for the day
+		event.End = event.Start.AddDate(0, 0, 1)
+	default:
+		event.End = event.Start
+	}
+	if !event.End.After(event.Start) {
+		// Instants do not occupy any time
+		event.busy = false
+	}
+
+	d.events = append(d.events, *event)
+	return nil
+}
+
+// Set the property `name` of the event
+func (e *decodedEvent) set(name string, params map[string]string, value string, loc *time.Location) error {
+	var err error
+	switch name {
+	case "UID":
+		e.UID = unescapeText(value)
+	case "SUMMARY":
+		e.Summary = unescapeText(value)
+	case "DTSTART":
+		e.Start, e.allDay, err = parseDateTime(value, params, loc)
+	case "DTEND":
+		e.End, _, err = parseDateTime(value, params, loc)
+		e.hasEnd = true
+	case "DURATION":
+		e.duration, err = parseDuration(value)
+	case "RECURRENCE-ID":
+		e.recurrenceID, _, err = parseDateTime(value, params, loc)
+	case "RRULE":
+		e.rule, err = parseRecurrence(value, params, loc)
+	case "EXDATE":
+		for _, part := range strings.Split(value, ",") {
+			exception, _, err := parseDateTime(part, params, loc)
+			if err != nil {
+				return err
+			}
+			e.exceptions = append(e.exceptions, exception)
+		}
+	case "TRANSP":
+		if strings.EqualFold(value, "TRANSPARENT") {
+			e.busy = false
+		}
+	case "STATUS":
+		if strings.EqualFold(value, "CANCELLED") {
+			e.busy = false
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%w: invalid %v of event %q: %w", ErrInvalidCalendar, name, e.UID, err)
+	}
+	return nil
+}
+
+// A recurrence rule
+type recurrence struct {
+	until    time.Time
+	freq     string
+	byDay    []time.Weekday
+	interval int
+	count    int
+	// Whether the rule uses parts that can not be expanded
+	unsupported bool
+}
+
+// Longest span of occurrences of an event expanded, so rules without an end stay bounded
+const maxExpandedPeriods = 100000
+
+// Expand the occurrences of the event overlapping `from` and `to` into `e.occurrences`, stopping
+// after `limit` occurrences.
+//
+// Returns false if the rule of the event is not supported.
+func (e *decodedEvent) expand(from, to time.Time, limit int) bool {
+	rule := e.rule
+	if rule.unsupported {
+		return false
+	}
+
+	duration := e.End.Sub(e.Start)
+	count := 0
+	for period := 0; period < maxExpandedPeriods; period++ {
+		for _, start := range rule.periodStarts(e.Start, period) {
+			if start.Before(e.Start) {
+				continue
+			}
+			if !rule.until.IsZero() && start.After(rule.until) {
+				return true
+			}
+			count++
+			if rule.count > 0 && count > rule.count {
+				return true
+			}
+			if !start.Before(to) {
+				return true
+			}
+			if slices.ContainsFunc(e.exceptions, start.Equal) {
+				continue
+			}
+			end := start.Add(duration)
+			if e.allDay {
+				// All-day events span whole days regardless of daylight saving changes
+				end = start.AddDate(0, 0, int(duration.Round(24*time.Hour)/(24*time.Hour)))
+			}
+			if end.After(from) {
+				occurrence := e.Event
+				occurrence.Start = start
+				occurrence.End = end
+				e.occurrences = append(e.occurrences, occurrence)
+				if len(e.occurrences) >= limit {
+					return true
+				}
+			}
+		}
+	}
+	return true
+}
+
+// Returns the starts of occurrences in the `period`-th period of the rule, in chronological order
+func (r *recurrence) periodStarts(start time.Time, period int) []time.Time {
+	step := period * r.interval
+	switch r.freq {
+	case "DAILY":
+		return []time.Time{start.AddDate(0, 0, step)}
+	case "WEEKLY":
+		if len(r.byDay) == 0 {
+			return []time.Time{start.AddDate(0, 0, 7*step)}
+		}
+		// Weeks start on Monday
+		weekStart := start.AddDate(0, 0, -((int(start.Weekday())+6)%7)+7*step)
+		result := make([]time.Time, 0, len(r.byDay))
+		for _, day := range r.byDay {
+			result = append(result, weekStart.AddDate(0, 0, (int(day)+6)%7))
+		}
+		return result
+	case "MONTHLY":
+		next := start.AddDate(0, step, 0)
+		if next.Day() != start.Day() {
+			// Months without the day of the event are skipped
+			return nil
+		}
+		return []time.Time{next}
+	case "YEARLY":
+		next := start.AddDate(step, 0, 0)
+		if next.Day() != start.Day() {
+			return nil
+		}
+		return []time.Time{next}
+	}
+	return nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func parseRecurrence(value string, params map[string]string, loc *time.Location) (*recurrence, error) {
+	rule := recurrence{interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed rule part %q", part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.freq = strings.ToUpper(val)
+			switch rule.freq {
+			case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+			default:
+				rule.unsupported = true
+			}
+		case "INTERVAL":
+			rule.interval, err = strconv.Atoi(val)
+			if err == nil && rule.interval < 1 {
+				err = fmt.Errorf("interval %d is not positive", rule.interval)
+			}
+		case "COUNT":
+			rule.count, err = strconv.Atoi(val)
+		case "UNTIL":
+			rule.until, _, err = parseDateTime(val, params, loc)
+		case "BYDAY":
+			for _, day := range strings.Split(strings.ToUpper(val), ",") {
+				weekday, ok := weekdays[day]
+				if !ok {
+					// Days with an ordinal, such as 1MO, are not supported
+					rule.unsupported = true
+					continue
+				}
+				rule.byDay = append(rule.byDay, weekday)
+			}
+		case "WKST":
+			if strings.ToUpper(val) != "MO" {
+				rule.unsupported = true
+			}
+		default:
+			// Other parts limit or extend the occurrences in ways not supported
+			rule.unsupported = true
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.freq == "" {
+		return nil, errors.New("missing frequency")
+	}
+	if len(rule.byDay) > 0 && rule.freq != "WEEKLY" {
+		rule.unsupported = true
+	}
+	slices.SortFunc(rule.byDay, func(a, b time.Weekday) int {
+		return (int(a)+6)%7 - (int(b)+6)%7
+	})
+	return &rule, nil
+}
+
+// Parse a DATE or DATE-TIME value, returning whether it is a date
+func parseDateTime(value string, params map[string]string, loc *time.Location) (time.Time, bool, error) {
+	if strings.EqualFold(params["VALUE"], "DATE") || len(value) == len(dateFormat) {
+		t, err := time.ParseInLocation(dateFormat, value, loc)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(utcFormat, value)
+		return t, false, err
+	}
+
+	if tzid, ok := params["TZID"]; ok {
+		// Some producers prefix globally unique time zone names with a slash
+		if tz, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			loc = tz
+		}
+	}
+	t, err := time.ParseInLocation(localFormat, value, loc)
+	return t, false, err
+}
+
+// Parse a DURATION value
+func parseDuration(value string) (time.Duration, error) {
+	rest := value
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(rest, "-"):
+		sign = -1
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "+"):
+		rest = rest[1:]
+	}
+	if !strings.HasPrefix(rest, "P") || len(rest) < 3 {
+		return 0, fmt.Errorf("malformed duration %q", value)
+	}
+	rest = rest[1:]
+
+	var result time.Duration
+	inTime := false
+	for len(rest) > 0 {
+		if rest[0] == 'T' {
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+		end := strings.IndexFunc(rest, func(r rune) bool { return r < '0' || r > '9' })
+		if end <= 0 {
+			return 0, fmt.Errorf("malformed duration %q", value)
+		}
+		n, err := strconv.Atoi(rest[:end])
+		if err != nil {
+			return 0, err
+		}
+
+		var unit time.Duration
+		switch {
+		case rest[end] == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case rest[end] == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case rest[end] == 'H' && inTime:
+			unit = time.Hour
+		case rest[end] == 'M' && inTime:
+			unit = time.Minute
+		case rest[end] == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("malformed duration %q", value)
+		}
+		result += time.Duration(n) * unit
+		rest = rest[end+1:]
+	}
+	return sign * result, nil
+}
+
+// Split a content line into its name, parameters and value
+func parseContentLine(line string) (string, map[string]string, string, error) {
+	var params map[string]string
+
+	nameEnd := strings.IndexAny(line, ";:")
+	if nameEnd <= 0 {
+		return "", nil, "", fmt.Errorf("%w: malformed content line %q", ErrInvalidCalendar, line)
+	}
+	name := strings.ToUpper(line[:nameEnd])
+	rest := line[nameEnd:]
+
+	for rest[0] == ';' {
+		rest = rest[1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return "", nil, "", fmt.Errorf("%w: malformed parameter in %q", ErrInvalidCalendar, line)
+		}
+		paramName := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var paramValue string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return "", nil, "", fmt.Errorf("%w: unterminated quote in %q", ErrInvalidCalendar, line)
+			}
+			paramValue = rest[1 : end+1]
+			rest = rest[end+2:]
+		} else {
+			end := strings.IndexAny(rest, ";:")
+			if end < 0 {
+				return "", nil, "", fmt.Errorf("%w: malformed content line %q", ErrInvalidCalendar, line)
+			}
+			paramValue = rest[:end]
+			rest = rest[end:]
+		}
+		if params == nil {
+			params = make(map[string]string, 2)
+		}
+		params[paramName] = paramValue
+
+		if rest == "" {
+			return "", nil, "", fmt.Errorf("%w: malformed content line %q", ErrInvalidCalendar, line)
+		}
+	}
+	if rest[0] != ':' {
+		return "", nil, "", fmt.Errorf("%w: malformed content line %q", ErrInvalidCalendar, line)
+	}
+	return name, params, rest[1:], nil
+}
+
+// Reverse `escapeText`
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
+
+var textUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, ";",
+	`\,`, ",",
+	`\n`, "\n",
+	`\N`, "\n",
+)
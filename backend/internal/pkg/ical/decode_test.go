@@ -0,0 +1,263 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func calendarData(lines ...string) *strings.Reader {
+	all := append([]string{"BEGIN:VCALENDAR", "VERSION:2.0"}, lines...)
+	all = append(all, "END:VCALENDAR")
+	return strings.NewReader(strings.Join(all, "\r\n") + "\r\n")
+}
+
+func TestDecodeBusy(t *testing.T) {
+	t.Parallel()
+
+	winnipeg, err := time.LoadLocation("America/Winnipeg")
+	require.NoError(t, err)
+	from := time.Date(2024, time.November, 1, 0, 0, 0, 0, winnipeg)
+	to := time.Date(2024, time.December, 1, 0, 0, 0, 0, winnipeg)
+
+	t.Run("single events", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := DecodeBusy(calendarData(
+			"BEGIN:VEVENT",
+			"UID:tz@example",
+			`SUMMARY:Dentist\, downtown`,
+			"DTSTART;TZID=America/Toronto:20241104T090000",
+			"DTEND;TZID=America/Toronto:20241104T100000",
+			"BEGIN:VALARM",
+			"TRIGGER:-PT15M",
+			"DTSTART:20241101T000000Z",
+			"END:VALARM",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:utc@example",
+			"DTSTART:20241105T150000Z",
+			"DURATION:PT1H30M",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:floating@example",
+			"DTSTART:20241106T080000",
+			"DTEND:20241106T090000",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:all-day@example",
+			"DTSTART;VALUE=DATE:20241107",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:outside@example",
+			"DTSTART:20241201T080000Z",
+			"DTEND:20241201T090000Z",
+			"END:VEVENT",
+		), winnipeg, from, to)
+		require.NoError(t, err)
+		require.Len(t, result.Events, 4)
+		assert.Empty(t, result.Unexpanded)
+
+		toronto, err := time.LoadLocation("America/Toronto")
+		require.NoError(t, err)
+
+		assert.Equal(t, "tz@example", result.Events[0].UID)
+		assert.Equal(t, "Dentist, downtown", result.Events[0].Summary)
+		assert.True(t, result.Events[0].Start.Equal(time.Date(2024, time.November, 4, 9, 0, 0, 0, toronto)))
+		assert.True(t, result.Events[0].End.Equal(time.Date(2024, time.November, 4, 10, 0, 0, 0, toronto)))
+
+		assert.True(t, result.Events[1].Start.Equal(time.Date(2024, time.November, 5, 15, 0, 0, 0, time.UTC)))
+		assert.True(t, result.Events[1].End.Equal(time.Date(2024, time.November, 5, 16, 30, 0, 0, time.UTC)))
+
+		// Times without a zone are in the given location
+		assert.True(t, result.Events[2].Start.Equal(time.Date(2024, time.November, 6, 8, 0, 0, 0, winnipeg)))
+
+		assert.True(t, result.Events[3].Start.Equal(time.Date(2024, time.November, 7, 0, 0, 0, 0, winnipeg)))
+		assert.True(t, result.Events[3].End.Equal(time.Date(2024, time.November, 8, 0, 0, 0, 0, winnipeg)))
+	})
+
+	t.Run("free and cancelled events are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := DecodeBusy(calendarData(
+			"BEGIN:VEVENT",
+			"UID:free@example",
+			"DTSTART:20241105T150000Z",
+			"DTEND:20241105T160000Z",
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:cancelled@example",
+			"DTSTART:20241105T150000Z",
+			"DTEND:20241105T160000Z",
+			"STATUS:CANCELLED",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:instant@example",
+			"DTSTART:20241105T150000Z",
+			"END:VEVENT",
+		), winnipeg, from, to)
+		require.NoError(t, err)
+		assert.Empty(t, result.Events)
+	})
+
+	t.Run("weekly recurrence", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := DecodeBusy(calendarData(
+			"BEGIN:VEVENT",
+			"UID:weekly@example",
+			"DTSTART;TZID=America/Winnipeg:20241001T170000",
+			"DTEND;TZID=America/Winnipeg:20241001T190000",
+			"RRULE:FREQ=WEEKLY;BYDAY=TH,TU;UNTIL=20241115T000000Z",
+			"EXDATE;TZID=America/Winnipeg:20241105T170000",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:weekly@example",
+			"RECURRENCE-ID;TZID=America/Winnipeg:20241112T170000",
+			"DTSTART;TZID=America/Winnipeg:20241112T180000",
+			"DTEND;TZID=America/Winnipeg:20241112T200000",
+			"END:VEVENT",
+		), winnipeg, from, to)
+		require.NoError(t, err)
+
+		starts := make([]time.Time, 0, len(result.Events))
+		for _, event := range result.Events {
+			starts = append(starts, event.Start)
+			assert.Equal(t, 2*time.Hour, event.End.Sub(event.Start))
+		}
+		// The wall time is kept across the switch to standard time
+		expected := []time.Time{
+			time.Date(2024, time.November, 7, 17, 0, 0, 0, winnipeg),
+			time.Date(2024, time.November, 12, 18, 0, 0, 0, winnipeg),
+			time.Date(2024, time.November, 14, 17, 0, 0, 0, winnipeg),
+		}
+		require.Len(t, starts, len(expected))
+		for idx := range expected {
+			assert.True(t, expected[idx].Equal(starts[idx]), "expected %v, got %v", expected[idx], starts[idx])
+		}
+	})
+
+	t.Run("daily recurrence with count", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := DecodeBusy(calendarData(
+			"BEGIN:VEVENT",
+			"UID:daily@example",
+			"DTSTART:20241029T120000Z",
+			"DTEND:20241029T130000Z",
+			"RRULE:FREQ=DAILY;INTERVAL=2;COUNT=5",
+			"END:VEVENT",
+		), winnipeg, from, to)
+		require.NoError(t, err)
+
+		// Occurrences before the period still count towards the rule
+		require.Len(t, result.Events, 3)
+		assert.True(t, result.Events[0].Start.Equal(time.Date(2024, time.November, 2, 12, 0, 0, 0, time.UTC)))
+		assert.True(t, result.Events[2].Start.Equal(time.Date(2024, time.November, 6, 12, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("unsupported recurrence", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := DecodeBusy(calendarData(
+			"BEGIN:VEVENT",
+			"UID:monthly@example",
+			"DTSTART:20241104T120000Z",
+			"DTEND:20241104T130000Z",
+			"RRULE:FREQ=MONTHLY;BYDAY=1MO",
+			"END:VEVENT",
+		), winnipeg, from, to)
+		require.NoError(t, err)
+		require.Len(t, result.Unexpanded, 1)
+		assert.Equal(t, "monthly@example", result.Unexpanded[0].UID)
+		require.Len(t, result.Events, 1)
+	})
+
+	t.Run("too many occurrences", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := DecodeBusy(calendarData(
+			"BEGIN:VEVENT",
+			"UID:often@example",
+			"DTSTART:20241101T000000Z",
+			"DURATION:PT1M",
+			"RRULE:FREQ=DAILY",
+			"END:VEVENT",
+		), winnipeg, from, from.AddDate(30, 0, 0))
+		require.ErrorIs(t, err, ErrTooManyOccurrences)
+	})
+}
+
+func TestDecodeBusyInvalid(t *testing.T) {
+	t.Parallel()
+
+	for name, data := range map[string]string{
+		"empty":        "",
+		"not calendar": "BEGIN:VCARD\r\nEND:VCARD\r\n",
+		"unterminated": "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VEVENT\r\n",
+		"no start":     "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:x\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+		"bad time":     "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART:tomorrow\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+		"bad line":     "BEGIN:VCALENDAR\r\nnonsense\r\nEND:VCALENDAR\r\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := DecodeBusy(strings.NewReader(data), time.UTC, time.Time{}, time.Now())
+			require.ErrorIs(t, err, ErrInvalidCalendar)
+		})
+	}
+}
+
+func TestDecodeEncoded(t *testing.T) {
+	t.Parallel()
+
+	winnipeg, err := time.LoadLocation("America/Winnipeg")
+	require.NoError(t, err)
+
+	cal := Calendar{
+		Events: []Event{
+			{
+				Start:   time.Date(2024, time.October, 30, 9, 0, 0, 0, winnipeg),
+				End:     time.Date(2024, time.October, 30, 10, 30, 0, 0, winnipeg),
+				UID:     "first@parkeasy",
+				Summary: strings.Repeat("Stationnement près de l'université; ", 5),
+			},
+		},
+	}
+	var sb strings.Builder
+	require.NoError(t, cal.Encode(&sb))
+
+	result, err := DecodeBusy(strings.NewReader(sb.String()), time.UTC, time.Time{}, cal.Events[0].End)
+	require.NoError(t, err)
+	require.Len(t, result.Events, 1)
+	assert.Equal(t, cal.Events[0].Summary, result.Events[0].Summary)
+	assert.True(t, cal.Events[0].Start.Equal(result.Events[0].Start))
+	assert.True(t, cal.Events[0].End.Equal(result.Events[0].End))
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	for value, expected := range map[string]time.Duration{
+		"PT1H30M":  90 * time.Minute,
+		"P1D":      24 * time.Hour,
+		"P1W":      7 * 24 * time.Hour,
+		"P1DT2H":   26 * time.Hour,
+		"-PT15M":   -15 * time.Minute,
+		"+PT0S":    0,
+		"PT1H2M3S": time.Hour + 2*time.Minute + 3*time.Second,
+	} {
+		result, err := parseDuration(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, result, value)
+	}
+
+	for _, value := range []string{"", "P", "PT", "1H", "P1H", "PT1D", "PTH"} {
+		_, err := parseDuration(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,246 @@
+// Encoding and decoding of iCalendar (RFC 5545) calendars
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// The media type of iCalendar data
+const ContentType = "text/calendar; charset=utf-8"
+
+// Identifier of the program producing the calendars
+const productID = "-//ParkEasy//ParkEasy//EN"
+
+// Longest content line in octets, excluding the line break
+const maxLineLength = 75
+
+const (
+	localFormat = "20060102T150405"
+	utcFormat   = "20060102T150405Z"
+)
+
+// An event occupying a time range
+type Event struct {
+	// Start of the event. Its location is used as the time zone of the event.
+	Start time.Time
+	// End of the event, in the same location as `Start`
+	End time.Time
+	// When the event was last changed
+	Stamp       time.Time
+	UID         string // Globally unique identifier of the event, stable across exports
+	Summary     string
+	Description string
+	Location    string
+	// Coordinates of the event, ignored if both are zero
+	Latitude  float64
+	Longitude float64
+}
+
+type Calendar struct {
+	Name   string // Display name of the calendar, empty if none
+	Events []Event
+}
+
+// Write `c` to `w` in the iCalendar format.
+//
+// A time zone definition covering all events is included for every time zone used by the events.
+func (c *Calendar) Encode(w io.Writer) error {
+	enc := encoder{w: bufio.NewWriter(w)}
+
+	enc.line("BEGIN", "VCALENDAR")
+	enc.line("VERSION", "2.0")
+	enc.line("PRODID", productID)
+	enc.line("CALSCALE", "GREGORIAN")
+	enc.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		enc.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+	for _, zone := range c.zones() {
+		zone.encode(&enc)
+	}
+	for idx := range c.Events {
+		c.Events[idx].encode(&enc)
+	}
+	enc.line("END", "VCALENDAR")
+
+	if enc.err != nil {
+		return enc.err
+	}
+	return enc.w.Flush()
+}
+
+func (e *Event) encode(enc *encoder) {
+	enc.line("BEGIN", "VEVENT")
+	enc.line("UID", escapeText(e.UID))
+	enc.line("DTSTAMP", e.Stamp.UTC().Format(utcFormat))
+	enc.dateTime("DTSTART", e.Start)
+	enc.dateTime("DTEND", e.End)
+	if e.Summary != "" {
+		enc.line("SUMMARY", escapeText(e.Summary))
+	}
+	if e.Description != "" {
+		enc.line("DESCRIPTION", escapeText(e.Description))
+	}
+	if e.Location != "" {
+		enc.line("LOCATION", escapeText(e.Location))
+	}
+	if e.Latitude != 0 || e.Longitude != 0 {
+		enc.line("GEO", strconv.FormatFloat(e.Latitude, 'f', -1, 64)+";"+strconv.FormatFloat(e.Longitude, 'f', -1, 64))
+	}
+	enc.line("TRANSP", "OPAQUE")
+	enc.line("END", "VEVENT")
+}
+
+// A time zone and the period it has to be defined for
+type zone struct {
+	from, to time.Time
+	loc      *time.Location
+}
+
+// Returns the time zones used by the events, in order of first use
+func (c *Calendar) zones() []zone {
+	var result []zone
+	for idx := range c.Events {
+		event := &c.Events[idx]
+		loc := event.Start.Location()
+		if isUTC(loc) {
+			continue
+		}
+
+		zoneIdx := slices.IndexFunc(result, func(z zone) bool { return z.loc.String() == loc.String() })
+		if zoneIdx < 0 {
+			result = append(result, zone{from: event.Start, to: event.End, loc: loc})
+			continue
+		}
+		z := &result[zoneIdx]
+		if event.Start.Before(z.from) {
+			z.from = event.Start
+		}
+		if event.End.After(z.to) {
+			z.to = event.End
+		}
+	}
+	return result
+}
+
+// Write the definition of `z` as observances of each offset in effect during the period
+func (z *zone) encode(enc *encoder) {
+	enc.line("BEGIN", "VTIMEZONE")
+	enc.line("TZID", z.loc.String())
+
+	at := z.from.In(z.loc)
+	// Guard against locations changing offset more often than expected
+	for range 1000 {
+		name, offset := at.Zone()
+		start, end := at.ZoneBounds()
+		prevOffset := offset
+		if start.IsZero() {
+			// The offset was always in effect, start the observance with the period
+			start = z.from
+		} else {
+			_, prevOffset = start.Add(-time.Second).Zone()
+		}
+
+		kind := "STANDARD"
+		if at.IsDST() {
+			kind = "DAYLIGHT"
+		}
+		enc.line("BEGIN", kind)
+		// The onset is in the local time in effect before it
+		enc.line("DTSTART", start.In(time.FixedZone("", prevOffset)).Format(localFormat))
+		enc.line("TZOFFSETFROM", formatOffset(prevOffset))
+		enc.line("TZOFFSETTO", formatOffset(offset))
+		if name != "" {
+			enc.line("TZNAME", escapeText(name))
+		}
+		enc.line("END", kind)
+
+		if end.IsZero() || !end.Before(z.to) {
+			break
+		}
+		at = end
+	}
+
+	enc.line("END", "VTIMEZONE")
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// Write a date-time property, in UTC or local time of its time zone
+func (enc *encoder) dateTime(name string, t time.Time) {
+	if isUTC(t.Location()) {
+		enc.line(name, t.Format(utcFormat))
+		return
+	}
+	enc.line(name+";TZID="+t.Location().String(), t.Format(localFormat))
+}
+
+// Write a content line, folding it so no line is longer than `maxLineLength` octets
+func (enc *encoder) line(name, value string) {
+	if enc.err != nil {
+		return
+	}
+
+	content := name + ":" + value
+	var sb strings.Builder
+	sb.Grow(len(content) + len(content)/maxLineLength*3 + 2)
+	limit := maxLineLength
+	for len(content) > limit {
+		cut := limit
+		// Never split a multi-octet character
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		sb.WriteString(content[:cut])
+		sb.WriteString("\r\n ")
+		content = content[cut:]
+		// Continuation lines start with a space
+		limit = maxLineLength - 1
+	}
+	sb.WriteString(content)
+	sb.WriteString("\r\n")
+
+	_, enc.err = enc.w.WriteString(sb.String())
+}
+
+// Escape `s` for use as a TEXT value
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// Format a UTC offset in seconds as a UTC-OFFSET value
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	hours, minutes, seconds := offset/3600, offset/60%60, offset%60
+	if seconds != 0 {
+		return fmt.Sprintf("%c%02d%02d%02d", sign, hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, hours, minutes)
+}
+
+func isUTC(loc *time.Location) bool {
+	return loc == time.UTC || loc.String() == "UTC"
+}
@@ -0,0 +1,138 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	winnipeg, err := time.LoadLocation("America/Winnipeg")
+	require.NoError(t, err)
+
+	stamp := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+	cal := Calendar{
+		Name: "Bookings",
+		Events: []Event{
+			{
+				Start:     time.Date(2024, time.October, 30, 9, 0, 0, 0, winnipeg),
+				End:       time.Date(2024, time.October, 30, 10, 30, 0, 0, winnipeg),
+				Stamp:     stamp,
+				UID:       "first@parkeasy",
+				Summary:   "Parking at 66 Chancellors Cir, Winnipeg",
+				Location:  "66 Chancellors Cir; Winnipeg",
+				Latitude:  49.8075,
+				Longitude: -97.1366,
+			},
+			{
+				Start:       time.Date(2024, time.November, 4, 9, 0, 0, 0, winnipeg),
+				End:         time.Date(2024, time.November, 4, 9, 30, 0, 0, winnipeg),
+				Stamp:       stamp,
+				UID:         "second@parkeasy",
+				Description: "Car: Red Honda Civic\nPlate: ABC123",
+			},
+			{
+				Start: time.Date(2024, time.November, 5, 9, 0, 0, 0, time.UTC),
+				End:   time.Date(2024, time.November, 5, 9, 30, 0, 0, time.UTC),
+				Stamp: stamp,
+				UID:   "third@parkeasy",
+			},
+		},
+	}
+
+	var sb strings.Builder
+	err = cal.Encode(&sb)
+	require.NoError(t, err)
+	result := sb.String()
+
+	assert.True(t, strings.HasPrefix(result, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(result, "END:VCALENDAR\r\n"))
+	assert.Contains(t, result, "X-WR-CALNAME:Bookings\r\n")
+
+	// The time zone is defined once, with the switch to standard time during the events
+	assert.Equal(t, 1, strings.Count(result, "BEGIN:VTIMEZONE"))
+	assert.Contains(t, result, "TZID:America/Winnipeg\r\n")
+	assert.Contains(t, result, "BEGIN:DAYLIGHT\r\nDTSTART:20240310T020000\r\nTZOFFSETFROM:-0600\r\nTZOFFSETTO:-0500\r\nTZNAME:CDT\r\nEND:DAYLIGHT\r\n")
+	assert.Contains(t, result, "BEGIN:STANDARD\r\nDTSTART:20241103T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0600\r\nTZNAME:CST\r\nEND:STANDARD\r\n")
+
+	assert.Contains(t, result, "UID:first@parkeasy\r\nDTSTAMP:20241001T120000Z\r\n")
+	assert.Contains(t, result, "DTSTART;TZID=America/Winnipeg:20241030T090000\r\nDTEND;TZID=America/Winnipeg:20241030T103000\r\n")
+	assert.Contains(t, result, `SUMMARY:Parking at 66 Chancellors Cir\, Winnipeg`+"\r\n")
+	assert.Contains(t, result, `LOCATION:66 Chancellors Cir\; Winnipeg`+"\r\n")
+	assert.Contains(t, result, "GEO:49.8075;-97.1366\r\n")
+	assert.Contains(t, result, `DESCRIPTION:Car: Red Honda Civic\nPlate: ABC123`+"\r\n")
+	assert.Contains(t, result, "DTSTART:20241105T090000Z\r\nDTEND:20241105T093000Z\r\n")
+}
+
+func TestEncodeWithoutDST(t *testing.T) {
+	t.Parallel()
+
+	regina, err := time.LoadLocation("America/Regina")
+	require.NoError(t, err)
+
+	cal := Calendar{
+		Events: []Event{
+			{
+				Start: time.Date(2024, time.July, 1, 9, 0, 0, 0, regina),
+				End:   time.Date(2024, time.July, 1, 10, 0, 0, 0, regina),
+				UID:   "event@parkeasy",
+			},
+		},
+	}
+
+	var sb strings.Builder
+	err = cal.Encode(&sb)
+	require.NoError(t, err)
+	result := sb.String()
+
+	// Only the last change of offset is defined
+	assert.Equal(t, 1, strings.Count(result, "BEGIN:STANDARD"))
+	assert.Contains(t, result, "DTSTART:19600424T020000\r\nTZOFFSETFROM:-0700\r\nTZOFFSETTO:-0600\r\n")
+	assert.NotContains(t, result, "DAYLIGHT")
+	assert.NotContains(t, result, "X-WR-CALNAME")
+}
+
+func TestLineFolding(t *testing.T) {
+	t.Parallel()
+
+	cal := Calendar{
+		Events: []Event{
+			{
+				UID:     "event@parkeasy",
+				Summary: strings.Repeat("Stationnement près de l'université ", 10),
+			},
+		},
+	}
+
+	var sb strings.Builder
+	err := cal.Encode(&sb)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(sb.String(), "\r\n"), "\r\n")
+	var summary strings.Builder
+	for idx, line := range lines {
+		assert.LessOrEqual(t, len(line), maxLineLength, "line %d is too long", idx)
+		assert.True(t, utf8.ValidString(line), "line %d splits a character", idx)
+		if strings.HasPrefix(line, "SUMMARY:") {
+			summary.WriteString(line)
+		} else if summary.Len() > 0 && strings.HasPrefix(line, " ") {
+			summary.WriteString(line[1:])
+		}
+	}
+	assert.Equal(t, "SUMMARY:"+cal.Events[0].Summary, summary.String())
+}
+
+func TestFormatOffset(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "+0000", formatOffset(0))
+	assert.Equal(t, "-0600", formatOffset(-6*3600))
+	assert.Equal(t, "-0230", formatOffset(-(2*3600 + 30*60)))
+	assert.Equal(t, "+053045", formatOffset(5*3600+30*60+45))
+}
@@ -0,0 +1,160 @@
+// Package media prepares uploaded images for publication.
+//
+// Images are decoded and encoded again, which drops any metadata they carry (EXIF, GPS coordinates, comments).
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+const (
+	// Largest accepted upload in bytes
+	MaxSize = 10 << 20
+	// Largest accepted width or height in pixels
+	MaxDimension = 8000
+	// Largest accepted number of pixels, limiting the memory used to decode an image
+	MaxPixels = 40_000_000
+	// Thumbnails fit in a square of this size in pixels
+	ThumbnailSize = 320
+	// Quality of encoded JPEG images
+	jpegQuality = 85
+)
+
+var (
+	ErrUnsupportedType = errors.New("unsupported image type")
+	ErrInvalidImage    = errors.New("invalid image")
+	ErrTooLarge        = errors.New("image too large")
+)
+
+// An image ready for publication, with a thumbnail
+type Image struct {
+	ContentType string
+	Data        []byte
+	Thumbnail   []byte
+	Width       int
+	Height      int
+}
+
+// Decode the JPEG or PNG image `data` and encode it again without metadata, along with a thumbnail.
+//
+// The type of the image is detected from its content. JPEG images are rotated upright following their EXIF orientation.
+func Process(data []byte) (Image, error) {
+	if len(data) > MaxSize {
+		return Image{}, ErrTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return Image{}, ErrUnsupportedType
+	}
+
+	// Check the size before decoding the whole image, small files can describe huge images
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, ErrInvalidImage
+	}
+	if config.Width > MaxDimension || config.Height > MaxDimension || config.Width*config.Height > MaxPixels {
+		return Image{}, ErrTooLarge
+	}
+
+	var img image.Image
+	if contentType == "image/jpeg" {
+		img, err = jpeg.Decode(bytes.NewReader(data))
+		if err == nil {
+			img = orient(img, jpegOrientation(data))
+		}
+	} else {
+		img, err = png.Decode(bytes.NewReader(data))
+	}
+	if err != nil {
+		return Image{}, ErrInvalidImage
+	}
+
+	result := Image{
+		ContentType: contentType,
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+	}
+	result.Data, err = encode(img, contentType)
+	if err != nil {
+		return Image{}, err
+	}
+	result.Thumbnail, err = encode(thumbnail(img, ThumbnailSize), contentType)
+	if err != nil {
+		return Image{}, err
+	}
+	return result, nil
+}
+
+func encode(img image.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if contentType == "image/jpeg" {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	} else {
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Returns `img` scaled down to fit in a `size` by `size` square, keeping its aspect ratio.
+//
+// Each pixel of the thumbnail is the average of the pixels it covers.
+func thumbnail(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= size && height <= size {
+		return img
+	}
+
+	thumbWidth, thumbHeight := size, size
+	if width > height {
+		thumbHeight = max(1, height*size/width)
+	} else {
+		thumbWidth = max(1, width*size/height)
+	}
+
+	src := toRGBA(img)
+	dst := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+	for y := range thumbHeight {
+		y0, y1 := y*height/thumbHeight, (y+1)*height/thumbHeight
+		for x := range thumbWidth {
+			x0, x1 := x*width/thumbWidth, (x+1)*width/thumbWidth
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					pixel := row[sx*4 : sx*4+4]
+					r += uint64(pixel[0])
+					g += uint64(pixel[1])
+					b += uint64(pixel[2])
+					a += uint64(pixel[3])
+					count++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / count)
+			dst.Pix[i+1] = uint8(g / count)
+			dst.Pix[i+2] = uint8(b / count)
+			dst.Pix[i+3] = uint8(a / count)
+		}
+	}
+	return dst
+}
+
+// Returns a copy of `img` as RGBA pixels, with its origin at (0, 0)
+func toRGBA(img image.Image) *image.RGBA {
+	bounds := img.Bounds()
+	result := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(result, result.Bounds(), img, bounds.Min, draw.Src)
+	return result
+}
@@ -0,0 +1,220 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	red  = color.RGBA{R: 255, A: 255}
+	blue = color.RGBA{B: 255, A: 255}
+)
+
+// Returns a `width` by `height` image, red on its left half and blue on its right half
+func halves(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			if x < width/2 {
+				img.Set(x, y, red)
+			} else {
+				img.Set(x, y, blue)
+			}
+		}
+	}
+	return img
+}
+
+// Returns an EXIF segment with `orientation` and GPS coordinates, using `order`
+func exifSegment(order binary.AppendByteOrder, orientation uint16) []byte {
+	tiff := make([]byte, 0, 64)
+	if order == binary.LittleEndian {
+		tiff = append(tiff, "II"...)
+	} else {
+		tiff = append(tiff, "MM"...)
+	}
+	tiff = order.AppendUint16(tiff, 42)
+	tiff = order.AppendUint32(tiff, 8)
+	// IFD0 with the orientation and a pointer to the GPS IFD
+	tiff = order.AppendUint16(tiff, 2)
+	tiff = order.AppendUint16(tiff, exifOrientationTag)
+	tiff = order.AppendUint16(tiff, 3) // SHORT
+	tiff = order.AppendUint32(tiff, 1)
+	tiff = order.AppendUint16(tiff, orientation)
+	tiff = order.AppendUint16(tiff, 0)
+	tiff = order.AppendUint16(tiff, 0x8825) // GPSInfo
+	tiff = order.AppendUint16(tiff, 4)      // LONG
+	tiff = order.AppendUint32(tiff, 1)
+	tiff = order.AppendUint32(tiff, 38)
+	tiff = order.AppendUint32(tiff, 0) // No next IFD
+	tiff = append(tiff, "GPS 49.8951N 97.1384W"...)
+
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(2+6+len(tiff)))
+	segment = append(segment, "Exif\x00\x00"...)
+	return append(segment, tiff...)
+}
+
+// Returns `img` encoded as a JPEG file carrying `exif`
+func jpegWithExif(t *testing.T, img image.Image, exif []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+	data := buf.Bytes()
+	// Insert the segment right after the start of image marker
+	return append(append(append([]byte{}, data[:2]...), exif...), data[2:]...)
+}
+
+func assertColor(t *testing.T, expected color.RGBA, actual color.Color) {
+	t.Helper()
+
+	r, g, b, _ := actual.RGBA()
+	assert.InDelta(t, expected.R, r>>8, 40)
+	assert.InDelta(t, expected.G, g>>8, 40)
+	assert.InDelta(t, expected.B, b>>8, 40)
+}
+
+func TestProcess(t *testing.T) {
+	t.Parallel()
+
+	t.Run("jpeg is rotated and stripped", func(t *testing.T) {
+		t.Parallel()
+
+		data := jpegWithExif(t, halves(40, 20), exifSegment(binary.BigEndian, 6))
+		require.Equal(t, 6, jpegOrientation(data))
+
+		result, err := Process(data)
+		require.NoError(t, err)
+		assert.Equal(t, "image/jpeg", result.ContentType)
+		assert.Equal(t, 20, result.Width)
+		assert.Equal(t, 40, result.Height)
+		assert.NotContains(t, string(result.Data), "Exif")
+		assert.NotContains(t, string(result.Data), "49.8951N 97.1384W")
+		assert.Equal(t, 1, jpegOrientation(result.Data))
+
+		// The left half is on top once turned clockwise
+		img, err := jpeg.Decode(bytes.NewReader(result.Data))
+		require.NoError(t, err)
+		assertColor(t, red, img.At(10, 5))
+		assertColor(t, blue, img.At(10, 35))
+
+		// Small images are their own thumbnail
+		thumb, err := jpeg.Decode(bytes.NewReader(result.Thumbnail))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 20, 40), thumb.Bounds())
+	})
+
+	t.Run("png thumbnail", func(t *testing.T) {
+		t.Parallel()
+
+		img := halves(1000, 500)
+		// Transparent bottom right corner
+		for y := 250; y < 500; y++ {
+			for x := 500; x < 1000; x++ {
+				img.Set(x, y, color.RGBA{})
+			}
+		}
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, img))
+
+		result, err := Process(buf.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", result.ContentType)
+		assert.Equal(t, 1000, result.Width)
+		assert.Equal(t, 500, result.Height)
+
+		thumb, err := png.Decode(bytes.NewReader(result.Thumbnail))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, ThumbnailSize, ThumbnailSize/2), thumb.Bounds())
+		assertColor(t, red, thumb.At(10, 10))
+		assertColor(t, blue, thumb.At(300, 10))
+		_, _, _, alpha := thumb.At(300, 150).RGBA()
+		assert.Zero(t, alpha)
+	})
+
+	t.Run("unsupported types", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Process([]byte("GIF89a\x01\x00\x01\x00\x00\x00\x00;"))
+		require.ErrorIs(t, err, ErrUnsupportedType)
+		_, err = Process([]byte("not an image"))
+		require.ErrorIs(t, err, ErrUnsupportedType)
+	})
+
+	t.Run("corrupted image", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Process([]byte("\xFF\xD8\xFF\xE0 definitely not a jpeg"))
+		require.ErrorIs(t, err, ErrInvalidImage)
+	})
+
+	t.Run("too many pixels", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, MaxDimension+1, 1))))
+		_, err := Process(buf.Bytes())
+		require.ErrorIs(t, err, ErrTooLarge)
+	})
+
+	t.Run("too many bytes", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Process(make([]byte, MaxSize+1))
+		require.ErrorIs(t, err, ErrTooLarge)
+	})
+}
+
+func TestOrient(t *testing.T) {
+	t.Parallel()
+
+	// Where the top left pixel of a 3 by 2 image ends up after each transformation
+	img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	img.Set(0, 0, red)
+	tests := []struct {
+		corner      image.Point
+		orientation int
+	}{
+		{orientation: 1, corner: image.Pt(0, 0)},
+		{orientation: 2, corner: image.Pt(2, 0)},
+		{orientation: 3, corner: image.Pt(2, 1)},
+		{orientation: 4, corner: image.Pt(0, 1)},
+		{orientation: 5, corner: image.Pt(0, 0)},
+		{orientation: 6, corner: image.Pt(1, 0)},
+		{orientation: 7, corner: image.Pt(1, 2)},
+		{orientation: 8, corner: image.Pt(0, 2)},
+	}
+	for _, test := range tests {
+		result := orient(img, test.orientation)
+		if test.orientation >= 5 {
+			assert.Equal(t, image.Rect(0, 0, 2, 3), result.Bounds(), "orientation %v", test.orientation)
+		} else {
+			assert.Equal(t, image.Rect(0, 0, 3, 2), result.Bounds(), "orientation %v", test.orientation)
+		}
+		assert.Equal(t, color.RGBAModel.Convert(red), color.RGBAModel.Convert(result.At(test.corner.X, test.corner.Y)), "orientation %v", test.orientation)
+	}
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	t.Parallel()
+
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	assert.Equal(t, 8, jpegOrientation(jpegWithExif(t, img, exifSegment(binary.LittleEndian, 8))))
+	assert.Equal(t, 3, jpegOrientation(jpegWithExif(t, img, exifSegment(binary.BigEndian, 3))))
+	// Invalid orientations are ignored
+	assert.Equal(t, 1, jpegOrientation(jpegWithExif(t, img, exifSegment(binary.BigEndian, 42))))
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	assert.Equal(t, 1, jpegOrientation(buf.Bytes()))
+	assert.Equal(t, 1, jpegOrientation([]byte("not a jpeg")))
+}
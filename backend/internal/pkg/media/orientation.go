@@ -0,0 +1,113 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+// EXIF tag of the image orientation
+const exifOrientationTag = 0x0112
+
+// Returns the EXIF orientation of the JPEG image `data`, from 1 to 8, or 1 if it has none.
+//
+// See the TIFF 6.0 specification for the meaning of each orientation.
+func jpegOrientation(data []byte) int {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	data = data[2:]
+	// Walk the segments before the image data, each is a marker followed by its big endian length
+	for len(data) >= 4 && data[0] == 0xFF {
+		marker := data[1]
+		if marker == 0xDA { // Start of scan, the image data follows
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[2:4]))
+		if length < 2 || len(data) < 2+length {
+			break
+		}
+		segment := data[4 : 2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		data = data[2+length:]
+	}
+	return 1
+}
+
+// Returns the orientation recorded in the first IFD of the TIFF structure `tiff`, or 1 if there is none
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	entries := tiff[offset+2:]
+	for i := 0; i < count && (i+1)*12 <= len(entries); i++ {
+		entry := entries[i*12 : (i+1)*12]
+		if order.Uint16(entry) != exifOrientationTag {
+			continue
+		}
+		// The orientation is a SHORT, stored at the start of the value field
+		orientation := int(order.Uint16(entry[8:10]))
+		if orientation < 1 || orientation > 8 {
+			return 1
+		}
+		return orientation
+	}
+	return 1
+}
+
+// Returns `img` transformed so it is upright, given its EXIF `orientation`
+func orient(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	src := toRGBA(img)
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		// The image is rotated by a quarter turn
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := range dstHeight {
+		for x := range dstWidth {
+			var sx, sy int
+			switch orientation {
+			case 2: // Mirrored horizontally
+				sx, sy = width-1-x, y
+			case 3: // Rotated by a half turn
+				sx, sy = width-1-x, height-1-y
+			case 4: // Mirrored vertically
+				sx, sy = x, height-1-y
+			case 5: // Mirrored along the top-left to bottom-right diagonal
+				sx, sy = y, x
+			case 6: // Needs a clockwise quarter turn
+				sx, sy = y, height-1-x
+			case 7: // Mirrored along the top-right to bottom-left diagonal
+				sx, sy = width-1-y, height-1-x
+			case 8: // Needs a counterclockwise quarter turn
+				sx, sy = width-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):][:4], src.Pix[src.PixOffset(sx, sy):][:4])
+		}
+	}
+	return dst
+}
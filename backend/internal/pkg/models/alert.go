@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAlertNotFound  = CodeNotFound.WithMsg("this alert does not exist")
+	ErrAlertDuplicate = CodeDuplicate.WithMsg("an alert for this parking spot already exists")
+	ErrTooManyAlerts  = CodeAlertInvalid.WithMsg("too many availability alerts")
+)
+
+// Largest number of availability alerts per user
+const MaximumAlertsPerUser = 50
+
+type AvailabilityAlertArea struct {
+	Longitude float64 `json:"longitude" minimum:"-180" maximum:"180" doc:"Longitude of the centre point"`
+	Latitude  float64 `json:"latitude" minimum:"-90" maximum:"90" doc:"Latitude of the centre point"`
+	Distance  int32   `json:"distance" minimum:"1" maximum:"10000" default:"250" doc:"Distance around the centre point in meters"`
+}
+
+type AvailabilityAlert struct {
+	CreatedAt time.Time              `json:"created_at" doc:"The time this alert was created"`
+	Area      *AvailabilityAlertArea `json:"area,omitempty" doc:"The area watched by this alert, if it is not for a parking spot"`
+	SpotID    uuid.UUID              `json:"parkingspot_id,omitempty" doc:"ID of the parking spot watched by this alert, if it is not for an area"`
+	ID        uuid.UUID              `json:"id" doc:"ID of this resource"`
+}
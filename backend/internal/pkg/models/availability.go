@@ -0,0 +1,34 @@
+package models
+
+import "github.com/google/uuid"
+
+// Largest distance in meters around the centre point of an availability area, matching the limit of
+// alerts and saved searches
+const MaxAreaDistance = 10000
+
+var ErrInvalidAreaDistance = CodeSpotInvalid.WithMsg("the specified distance is invalid, it must be between 1 and 10000 meters")
+
+const (
+	AvailabilityEventAdded    = "added"
+	AvailabilityEventRemoved  = "removed"
+	AvailabilityEventBooked   = "booked"
+	AvailabilityEventHeld     = "held"
+	AvailabilityEventReleased = "released"
+)
+
+// A change to the availability of a parking spot
+type AvailabilityEvent struct {
+	Type      string     `json:"type" enum:"added,removed,booked,held,released" doc:"Whether the times were added to or removed from the availability, booked, held or released from a hold"`
+	Times     []TimeUnit `json:"times" nullable:"false" doc:"The affected time slots"`
+	Longitude float64    `json:"longitude" doc:"The longitude of the parking spot"`
+	Latitude  float64    `json:"latitude" doc:"The latitude of the parking spot"`
+	SpotID    uuid.UUID  `json:"spot_id" doc:"ID of the parking spot"`
+	ID        uuid.UUID  `json:"id" doc:"ID of this event"`
+	Expired   bool       `json:"expired,omitempty" doc:"Whether the times were released because their hold expired"`
+}
+
+type AvailabilityAreaFilter struct {
+	Longitude float64 `query:"longitude" required:"true" doc:"Longitude of the centre point"`
+	Latitude  float64 `query:"latitude" required:"true" doc:"Latitude of the centre point"`
+	Distance  int32   `query:"distance" default:"250" minimum:"1" maximum:"10000" doc:"distance around the centre point in meters"`
+}
@@ -10,17 +10,20 @@ var (
 	ErrBookingNotFound   = CodeNotFound.WithMsg("this booking does not exist")
 	ErrEmptyBookingTimes = CodeBookingInvalid.WithMsg("can not create booking with no time slots")
 	ErrSpotNotOwned      = CodeForbidden.WithMsg("sellers can not view bookings for parking spots not owned")
-	ErrDuplicateBooking  = CodeDuplicate.WithMsg("one or more time slots are already booked")
+	ErrDuplicateBooking  = CodeDuplicate.WithMsg("one or more time slots are already booked or held by another user")
 	ErrInvalidPaidAmount = CodeBookingInvalid.WithMsg("the specified paid amount is invalid")
 	ErrCarNotOwned       = CodeForbidden.WithMsg("specified car is not owned by the user")
+	ErrCarDoesNotFit     = CodeBookingInvalid.WithMsg("the specified car does not fit the parking spot")
 )
 
 type Booking struct {
-	CreatedAt     time.Time `json:"booking_time" doc:"time when the booking was made"`
-	PaidAmount    float64   `json:"paid_amount" doc:"the amount paid for the booking"`
-	ID            uuid.UUID `json:"id" doc:"ID of this resource"`
-	ParkingSpotID uuid.UUID `json:"parkingspot_id" doc:"the ID of parking spot associated with booking"`
-	CarID         uuid.UUID `json:"car_id" doc:"the ID of car associated with booking"`
+	CreatedAt      time.Time `json:"booking_time" doc:"time when the booking was made"`
+	PaidAmount     float64   `json:"paid_amount" doc:"the amount paid for the booking"`
+	DiscountAmount float64   `json:"discount_amount" doc:"the amount taken off the booking subtotal by a promo code"`
+	PayoutAmount   float64   `json:"payout_amount" doc:"the amount paid out to the parking spot owner"`
+	ID             uuid.UUID `json:"id" doc:"ID of this resource"`
+	ParkingSpotID  uuid.UUID `json:"parkingspot_id" doc:"the ID of parking spot associated with booking"`
+	CarID          uuid.UUID `json:"car_id" doc:"the ID of car associated with booking"`
 }
 
 type BookingWithDetails struct {
@@ -30,7 +33,8 @@ type BookingWithDetails struct {
 }
 
 type BookingWithDetailsAndTimes struct {
-	BookedTimes []TimeUnit `json:"booked_times" nullable:"false" doc:"The booked times of this booking"`
+	AccessInstructions string     `json:"access_instructions,omitempty" doc:"How to get to the parking spot, as given by its owner"`
+	BookedTimes        []TimeUnit `json:"booked_times" nullable:"false" doc:"The booked times of this booking"`
 	BookingWithDetails
 }
 
@@ -40,8 +44,11 @@ type BookingWithTimes struct {
 }
 
 type BookingCreationInput struct {
+	PromoCode   string     `json:"promo_code,omitempty" required:"false" doc:"A promo code to apply to this booking. Must match the promo code of the quote if one is given."`
 	BookedTimes []TimeUnit `json:"booked_times" nullable:"false" doc:"The booked times of this booking"`
 	CarID       uuid.UUID  `json:"car_id" doc:"ID of the car for which parking spot being booked"`
+	QuoteID     uuid.UUID  `json:"quote_id,omitempty" required:"false" doc:"ID of a quote for the same time slots, guaranteeing the quoted price"`
+	HoldID      uuid.UUID  `json:"hold_id,omitempty" required:"false" doc:"ID of a hold on the booked time slots, which is converted into this booking"`
 }
 
 type BookingFilter struct {
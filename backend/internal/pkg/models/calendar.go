@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+var (
+	ErrCalendarFeedNotFound   = CodeNotFound.WithMsg("this calendar feed does not exist")
+	ErrCalendarImportNotFound = CodeNotFound.WithMsg("this parking spot does not import a calendar")
+	ErrInvalidCalendarURL     = CodeCalendarInvalid.WithMsg("the calendar URL must be an absolute HTTP, HTTPS or webcal URL")
+	ErrCalendarUnreadable     = CodeCalendarInvalid.WithMsg("the calendar is not a valid iCalendar file")
+	ErrCalendarFetch          = CodeCalendarInvalid.WithMsg("the calendar could not be fetched")
+	ErrCalendarTooLarge       = CodeCalendarInvalid.WithMsg("the calendar is too large")
+)
+
+type CalendarFeed struct {
+	CreatedAt time.Time `json:"created_at" doc:"The time this feed URL was created"`
+	URL       string    `json:"url" doc:"The secret URL of the iCalendar feed, anyone with this URL can see the bookings in the feed"`
+	Token     string    `json:"token" doc:"The secret token identifying the feed"`
+}
+
+type CalendarImportInput struct {
+	URL string `json:"url" format:"uri" maxLength:"2048" doc:"The URL of the iCalendar file to poll. webcal URLs are fetched over HTTPS."`
+}
+
+// A busy time of an imported calendar overlapping a booked or held slot
+type CalendarImportConflict struct {
+	EventStart time.Time `json:"event_start" doc:"The start of the busy event"`
+	EventEnd   time.Time `json:"event_end" doc:"The end of the busy event"`
+	Summary    string    `json:"summary,omitempty" doc:"The summary of the busy event"`
+	Slot       TimeUnit  `json:"slot" doc:"The slot that is kept since it is booked or held"`
+}
+
+// The outcome of applying an imported calendar to the availability of a parking spot
+type CalendarImportResult struct {
+	Removed   []TimeUnit               `json:"removed" doc:"The unbooked slots removed from the availability of the spot"`
+	Conflicts []CalendarImportConflict `json:"conflicts" doc:"The booked or held slots overlapping busy times, these are never removed"`
+	Warnings  []string                 `json:"warnings,omitempty" doc:"Parts of the calendar that could not be fully imported"`
+	Events    int                      `json:"events" doc:"The number of busy event occurrences read from the calendar"`
+}
+
+type CalendarImport struct {
+	CreatedAt    time.Time             `json:"created_at" doc:"The time this import was set up"`
+	NextSyncAt   time.Time             `json:"next_sync_at" doc:"The time the calendar is polled next"`
+	LastSyncedAt *time.Time            `json:"last_synced_at,omitempty" doc:"The time the calendar was last polled"`
+	LastResult   *CalendarImportResult `json:"last_result,omitempty" doc:"The outcome of the last successful poll"`
+	LastError    string                `json:"last_error,omitempty" doc:"Why the last poll failed, if it did"`
+	CalendarImportInput
+}
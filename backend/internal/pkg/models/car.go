@@ -9,13 +9,33 @@ var (
 	ErrInvalidMake         = CodeCarInvalid.WithMsg("the specified car make is invalid")
 	ErrInvalidModel        = CodeCarInvalid.WithMsg("the specified car model is invalid")
 	ErrInvalidColor        = CodeCarInvalid.WithMsg("the specified color is invalid")
+	ErrInvalidCarSize      = CodeCarInvalid.WithMsg("the specified car size is invalid")
+	ErrPlateStateInvalid   = CodeCarInvalid.WithMsg("the specified license plate province or state is not supported")
+	ErrCarDuplicate        = CodeDuplicate.WithMsg("a car with this license plate and jurisdiction is already registered")
+)
+
+// Vehicle classes of cars
+const (
+	VehicleClassCompact  = "compact"
+	VehicleClassMidsize  = "midsize"
+	VehicleClassFullsize = "fullsize"
+	VehicleClassSUV      = "suv"
+	VehicleClassVan      = "van"
+	VehicleClassTruck    = "truck"
 )
 
 type CarDetails struct {
-	LicensePlate string `json:"license_plate" doc:"The license plate of the car"`
+	LicensePlate string `json:"license_plate" doc:"The license plate of the car, stored in upper case without spaces or dashes"`
+	CountryCode  string `json:"country_code,omitempty" pattern:"[A-Z][A-Z]" doc:"The country that issued the license plate"`
+	State        string `json:"state,omitempty" doc:"The province or state that issued the license plate, required with the country. The plate format is checked against the jurisdiction if given."`
 	Make         string `json:"make" doc:"The make of the car"`
 	Model        string `json:"model" doc:"The model of the car"`
 	Color        string `json:"color" doc:"The color of the car"`
+	Class        string `json:"class,omitempty" enum:"compact,midsize,fullsize,suv,van,truck" doc:"The vehicle class of the car"`
+	// Sizes are in centimetres, 0 if unknown
+	Height int32 `json:"height,omitempty" minimum:"0" maximum:"1000" doc:"Height of the car in centimetres"`
+	Length int32 `json:"length,omitempty" minimum:"0" maximum:"3000" doc:"Length of the car in centimetres"`
+	Width  int32 `json:"width,omitempty" minimum:"0" maximum:"1000" doc:"Width of the car in centimetres"`
 }
 
 type Car struct {
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDeviceNotFound = CodeNotFound.WithMsg("this device does not exist")
+	ErrTooManyDevices = CodeDeviceInvalid.WithMsg("too many registered devices")
+)
+
+// Largest number of devices registered per user
+const MaximumDevicesPerUser = 20
+
+// Platforms of devices receiving push notifications
+const (
+	DevicePlatformAndroid = "android"
+	DevicePlatformIOS     = "ios"
+)
+
+type DeviceInput struct {
+	Platform string `json:"platform" enum:"android,ios" doc:"The platform of the device, Android devices are delivered through FCM and iOS devices through APNs"`
+	Token    string `json:"token" minLength:"1" maxLength:"4096" doc:"The push token issued to the device by the platform"`
+}
+
+type Device struct {
+	CreatedAt time.Time `json:"created_at" doc:"The time this device was registered"`
+	Platform  string    `json:"platform" enum:"android,ios" doc:"The platform of the device"`
+	ID        uuid.UUID `json:"id" doc:"ID of this resource"`
+}
@@ -22,6 +22,17 @@ var (
 	CodeNoProfile            = NewUserErrorCode("no-profile", "2024-10-13")
 	CodeUnhealthy            = NewUserErrorCode("unhealthy", "2024-10-14")
 	CodeBookingInvalid       = NewUserErrorCode("booking-invalid", "2024-10-28")
+	CodePromoCodeInvalid     = NewUserErrorCode("promocode-invalid", "2026-10-19")
+	CodeReviewInvalid        = NewUserErrorCode("review-invalid", "2026-10-19")
+	CodeMessageInvalid       = NewUserErrorCode("message-invalid", "2026-10-19")
+	CodeAlertInvalid         = NewUserErrorCode("alert-invalid", "2026-10-19")
+	CodeSavedSearchInvalid   = NewUserErrorCode("saved-search-invalid", "2026-10-19")
+	CodeDeviceInvalid        = NewUserErrorCode("device-invalid", "2026-10-19")
+	CodeWebhookInvalid       = NewUserErrorCode("webhook-invalid", "2026-10-19")
+	CodeCalendarInvalid      = NewUserErrorCode("calendar-invalid", "2026-10-19")
+	CodeRateLimited          = NewUserErrorCode("rate-limited", "2026-10-19")
+	CodeGeocodingUnavailable = NewUserErrorCode("geocoding-unavailable", "2026-10-19")
+	CodePhotoInvalid         = NewUserErrorCode("photo-invalid", "2026-10-19")
 )
 
 // Error code for clients.
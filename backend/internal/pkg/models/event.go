@@ -0,0 +1,31 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of domain events
+const (
+	// A booking was made, the data is a `BookingCreatedEvent`
+	EventBookingCreated = "booking.created"
+	// Time slots of a spot were added, removed, booked, held or released, the data is an `AvailabilityEvent`
+	EventAvailabilityChanged = "spot.availability_changed"
+)
+
+// A state change, recorded along with the change and delivered to subscribers at least once
+type DomainEvent struct {
+	OccurredAt time.Time
+	Type       string
+	Data       json.RawMessage // The JSON-encoded details, its format depends on the type
+	ID         uuid.UUID
+}
+
+type BookingCreatedEvent struct {
+	Location ParkingSpotLocation `json:"location"`
+	Booking  BookingWithTimes    `json:"booking"`
+	BookerID int64               `json:"booker_id"`
+	OwnerID  int64               `json:"owner_id"`
+}
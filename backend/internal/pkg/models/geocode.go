@@ -0,0 +1,13 @@
+package models
+
+var (
+	ErrRateLimited          = CodeRateLimited.WithMsg("too many requests, try again later")
+	ErrGeocodingUnavailable = CodeGeocodingUnavailable.WithMsg("addresses cannot be resolved at the moment, try again later")
+)
+
+// An address resolved by the geocoder
+type GeocodeResult struct {
+	FormattedAddress string `json:"formatted_address" doc:"The address formatted on a single line"`
+	ParkingSpotLocation
+	Accuracy float32 `json:"accuracy" minimum:"0" maximum:"1" doc:"How accurate the location is, from 0 to 1. Only addresses with an accuracy of 1 can be used to create a parking spot."`
+}
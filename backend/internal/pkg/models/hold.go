@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrHoldNotFound     = CodeNotFound.WithMsg("this hold does not exist")
+	ErrHoldExpired      = CodeBookingInvalid.WithMsg("the hold has expired")
+	ErrHoldSpotMismatch = CodeBookingInvalid.WithMsg("the hold is for a different parking spot")
+	ErrEmptyHoldTimes   = CodeBookingInvalid.WithMsg("can not hold no time slots")
+)
+
+type HoldCreationInput struct {
+	HeldTimes []TimeUnit `json:"held_times" nullable:"false" doc:"The time slots to hold"`
+}
+
+type Hold struct {
+	ExpiresAt time.Time  `json:"expires_at" doc:"The time after which the held time slots are released"`
+	HeldTimes []TimeUnit `json:"held_times" nullable:"false" doc:"The held time slots"`
+	SpotID    uuid.UUID  `json:"parkingspot_id" doc:"ID of the parking spot"`
+	ID        uuid.UUID  `json:"id" doc:"ID of this resource"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidMessage = CodeMessageInvalid.WithMsg("messages must be between 1 and 2000 bytes long")
+
+const (
+	MessageSenderDriver = "driver"
+	MessageSenderHost   = "host"
+)
+
+// Longest accepted message body, in bytes
+const MaximumMessageLength = 2000
+
+type MessageCreationInput struct {
+	Body string `json:"body" minLength:"1" maxLength:"2000" doc:"The message to send"`
+}
+
+type Message struct {
+	CreatedAt time.Time  `json:"created_at" doc:"The time this message was sent"`
+	ReadAt    *time.Time `json:"read_at,omitempty" doc:"The time this message was read by its recipient"`
+	Sender    string     `json:"sender" enum:"driver,host" doc:"Whether this message was sent by the driver or the host"`
+	Body      string     `json:"body" doc:"The message content"`
+	Redacted  bool       `json:"redacted,omitempty" doc:"Whether contact details were removed from this message"`
+	ID        uuid.UUID  `json:"id" doc:"ID of this resource"`
+}
+
+type UnreadMessageCount struct {
+	BookingID uuid.UUID `json:"booking_id" doc:"ID of the booking"`
+	Count     int64     `json:"count" doc:"Number of unread messages in the booking thread"`
+}
+
+type UnreadMessages struct {
+	Bookings []UnreadMessageCount `json:"bookings" nullable:"false" doc:"Unread message counts for each booking with unread messages"`
+	Total    int64                `json:"total" doc:"Total number of unread messages"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrNotificationNotFound = CodeNotFound.WithMsg("this notification does not exist")
+
+// Types of notifications
+const (
+	NotificationSpotAvailable    = "spot_available"
+	NotificationSavedSearchMatch = "saved_search_match"
+	NotificationBookingCreated   = "booking_created"
+	NotificationReviewReceived   = "review_received"
+	NotificationBookingConfirmed = "booking_confirmed"
+	NotificationBookingReminder  = "booking_reminder"
+	// The availability of a spot was changed without action of its owner
+	NotificationAvailabilityChanged = "availability_changed"
+)
+
+type NotificationInput struct {
+	Type      string
+	Title     string
+	Body      string
+	SubjectID uuid.UUID // ID of the resource this notification is about, if any
+	// ID of the domain event this notification is sent for, if any.
+	//
+	// At most one notification of each type is sent to a user for an event.
+	EventID uuid.UUID
+}
+
+type Notification struct {
+	CreatedAt time.Time  `json:"created_at" doc:"The time this notification was sent"`
+	ReadAt    *time.Time `json:"read_at,omitempty" doc:"The time this notification was read"`
+	Type      string     `json:"type" enum:"spot_available,saved_search_match,booking_created,review_received,booking_confirmed,booking_reminder,availability_changed" doc:"The kind of event this notification is about"`
+	Title     string     `json:"title" doc:"Short summary of the notification"`
+	Body      string     `json:"body" doc:"The notification content"`
+	SubjectID uuid.UUID  `json:"subject_id,omitempty" doc:"ID of the resource this notification is about, such as a parking spot"`
+	ID        uuid.UUID  `json:"id" doc:"ID of this resource"`
+}
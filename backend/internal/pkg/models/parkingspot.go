@@ -23,6 +23,13 @@ var (
 	ErrNoAvailability         = CodeSpotInvalid.WithMsg("at least one time slot must be passed")
 	ErrInvalidPricePerHour    = CodeSpotInvalid.WithMsg("the specified price per hour is not valid")
 	ErrBookedTimeUnitModified = CodeSpotInvalid.WithMsg("booked time unit cannot be modified")
+	ErrSearchLocationNotFound = CodeNotFound.WithMsg("the searched location could not be found")
+	ErrInvalidRestrictions    = CodeSpotInvalid.WithMsg("the specified vehicle restrictions are not valid")
+)
+
+const (
+	SpotSortDistance = "distance"
+	SpotSortRating   = "rating"
 )
 
 type ParkingSpotLocation struct {
@@ -41,17 +48,40 @@ type ParkingSpotFeatures struct {
 	ChargingStation bool `json:"charging_station,omitempty" doc:"Whether parking spot has an EV charging station"`
 }
 
+// Vehicles allowed on a parking spot, sizes are in centimetres
+type ParkingSpotRestrictions struct {
+	MaxHeight int32 `json:"max_height,omitempty" minimum:"0" maximum:"1000" doc:"Height of the tallest vehicle allowed in centimetres, no limit if omitted"`
+	MaxLength int32 `json:"max_length,omitempty" minimum:"0" maximum:"3000" doc:"Length of the longest vehicle allowed in centimetres, no limit if omitted"`
+	MaxWidth  int32 `json:"max_width,omitempty" minimum:"0" maximum:"1000" doc:"Width of the widest vehicle allowed in centimetres, no limit if omitted"`
+	NoTrucks  bool  `json:"no_trucks,omitempty" doc:"Whether trucks are not allowed"`
+}
+
+type ParkingSpotAccessibility struct {
+	Accessible bool `json:"accessible,omitempty" doc:"Whether the spot is a designated accessible space, wide enough to get in and out with a wheelchair"`
+	StepFree   bool `json:"step_free,omitempty" doc:"Whether the spot can be reached from the street without steps"`
+	WellLit    bool `json:"well_lit,omitempty" doc:"Whether the spot and the way to it are lit at night"`
+}
+
 type TimeUnit struct {
 	StartTime time.Time `json:"start_time" doc:"The start time for slot"`
 	EndTime   time.Time `json:"end_time" doc:"The end time for slot"`
-	Status    string    `json:"status,omitempty" readOnly:"true" enum:"booked,available" doc:"status of the parking spot"`
+	Status    string    `json:"status,omitempty" readOnly:"true" enum:"booked,held,available" doc:"status of the parking spot"`
 }
 
 type ParkingSpot struct {
-	Location     ParkingSpotLocation `json:"location"`
-	Features     ParkingSpotFeatures `json:"features,omitempty"`
-	PricePerHour float64             `json:"price_per_hour" doc:"price per hour"`
-	ID           uuid.UUID           `json:"id" doc:"ID of this resource"`
+	Photos             []ParkingSpotPhoto       `json:"photos,omitempty" readOnly:"true" doc:"Photos of the spot in display order, only included when getting a single spot"`
+	Label              string                   `json:"label,omitempty" doc:"Name telling the spot apart from the other spaces at its location"`
+	Description        string                   `json:"description,omitempty" doc:"Description of the spot shown to drivers"`
+	AccessInstructions string                   `json:"access_instructions,omitempty" doc:"How to get to the spot (gate code, which stall), only included for its owner"`
+	Location           ParkingSpotLocation      `json:"location"`
+	Restrictions       ParkingSpotRestrictions  `json:"restrictions,omitempty"`
+	Features           ParkingSpotFeatures      `json:"features,omitempty"`
+	Accessibility      ParkingSpotAccessibility `json:"accessibility,omitempty"`
+	RatingCount        int32                    `json:"rating_count" readOnly:"true" doc:"The number of reviews left by drivers"`
+	PricePerHour       float64                  `json:"price_per_hour" doc:"price per hour"`
+	Rating             float64                  `json:"rating,omitempty" readOnly:"true" doc:"The average rating left by drivers, omitted if the spot has not been reviewed"`
+	ID                 uuid.UUID                `json:"id" doc:"ID of this resource"`
+	LocationID         uuid.UUID                `json:"location_id" readOnly:"true" doc:"ID of the location this spot is a space of"`
 }
 
 type ParkingSpotWithDistance struct {
@@ -59,16 +89,30 @@ type ParkingSpotWithDistance struct {
 	DistanceToLocation float64 `json:"distance_to_location" doc:"Distance to centre point"`
 }
 
+// The spaces available at a location
+type ParkingLocationWithSpaces struct {
+	Spaces             []ParkingSpotWithDistance `json:"spaces" nullable:"false" doc:"The available spaces at this location"`
+	Location           ParkingSpotLocation       `json:"location"`
+	DistanceToLocation float64                   `json:"distance_to_location" doc:"Distance to centre point"`
+	AvailableSpaces    int                       `json:"available_spaces" doc:"The number of spaces available at this location"`
+	ID                 uuid.UUID                 `json:"id" doc:"ID of this location"`
+}
+
 type ParkingSpotWithAvailability struct {
 	Availability []TimeUnit `json:"availability,omitempty"`
 	ParkingSpot
 }
 
 type ParkingSpotCreationInput struct {
-	Availability []TimeUnit          `json:"availability" nullable:"false"`
-	Location     ParkingSpotLocation `json:"location"`
-	PricePerHour float64             `json:"price_per_hour" doc:"price per hour"`
-	Features     ParkingSpotFeatures `json:"features,omitempty"`
+	Label              string                   `json:"label,omitempty" maxLength:"50" doc:"Name telling the spot apart from the other spaces at the same address"`
+	Description        string                   `json:"description,omitempty" maxLength:"2000" doc:"Description of the spot shown to drivers"`
+	AccessInstructions string                   `json:"access_instructions,omitempty" maxLength:"1000" doc:"How to get to the spot (gate code, which stall), only shown to drivers who booked it"`
+	Availability       []TimeUnit               `json:"availability" nullable:"false"`
+	Location           ParkingSpotLocation      `json:"location"`
+	Restrictions       ParkingSpotRestrictions  `json:"restrictions,omitempty"`
+	PricePerHour       float64                  `json:"price_per_hour" doc:"price per hour"`
+	Features           ParkingSpotFeatures      `json:"features,omitempty"`
+	Accessibility      ParkingSpotAccessibility `json:"accessibility,omitempty"`
 }
 
 type ParkingSpotAvailabilityFilter struct {
@@ -78,14 +122,32 @@ type ParkingSpotAvailabilityFilter struct {
 
 type ParkingSpotFilter struct {
 	ParkingSpotAvailabilityFilter
-	Longitude float64 `query:"longitude" required:"true" doc:"Longitude of the centre point"`
-	Latitude  float64 `query:"latitude" required:"true" doc:"Latitude of the centre point"`
+	Sort      string  `query:"sort" enum:"distance,rating" default:"distance" doc:"Order of the results, closest first or highest rated first"`
+	Address   string  `query:"address" maxLength:"200" doc:"An address, postal code or city used as the centre point instead of the coordinates"`
+	Longitude float64 `query:"longitude" doc:"Longitude of the centre point, required unless an address is given"`
+	Latitude  float64 `query:"latitude" doc:"Latitude of the centre point, required unless an address is given"`
 	Distance  int32   `query:"distance" default:"250" doc:"distance around the centre point in meters"`
+	// Vehicle the spots must fit
+	VehicleHeight int32 `query:"vehicle_height" minimum:"0" doc:"Only include spots allowing vehicles of this height in centimetres"`
+	VehicleLength int32 `query:"vehicle_length" minimum:"0" doc:"Only include spots allowing vehicles of this length in centimetres"`
+	VehicleWidth  int32 `query:"vehicle_width" minimum:"0" doc:"Only include spots allowing vehicles of this width in centimetres"`
+	Truck         bool  `query:"truck" doc:"Only include spots allowing trucks"`
+	// Car of the user the spots must fit, replaces the vehicle size parameters
+	CarID uuid.UUID `query:"car_id" doc:"Only include spots fitting this car of the user, using the typical size of its class for unknown dimensions"`
+	// Required accessibility
+	Accessible bool `query:"accessible" doc:"Only include designated accessible spots"`
+	StepFree   bool `query:"step_free" doc:"Only include spots reachable without steps"`
+	WellLit    bool `query:"well_lit" doc:"Only include spots lit at night"`
 }
 
 type ParkingSpotUpdateInput struct {
-	PricePerHour float64             `json:"price_per_hour" doc:"price per hour"`
-	Features     ParkingSpotFeatures `json:"features,omitempty"`
+	Label              string                   `json:"label,omitempty" maxLength:"50" doc:"Name telling the spot apart from the other spaces at its location"`
+	Description        string                   `json:"description,omitempty" maxLength:"2000" doc:"Description of the spot shown to drivers"`
+	AccessInstructions string                   `json:"access_instructions,omitempty" maxLength:"1000" doc:"How to get to the spot (gate code, which stall), only shown to drivers who booked it"`
+	Restrictions       ParkingSpotRestrictions  `json:"restrictions,omitempty"`
+	PricePerHour       float64                  `json:"price_per_hour" doc:"price per hour"`
+	Features           ParkingSpotFeatures      `json:"features,omitempty"`
+	Accessibility      ParkingSpotAccessibility `json:"accessibility,omitempty"`
 }
 
 type ParkingSpotAvailUpdateInput struct {
@@ -0,0 +1,25 @@
+package models
+
+import "github.com/google/uuid"
+
+var (
+	ErrPhotoNotFound     = CodeNotFound.WithMsg("this photo does not exist")
+	ErrPhotoUnsupported  = CodePhotoInvalid.WithMsg("the photo must be a JPEG or PNG image")
+	ErrPhotoUnreadable   = CodePhotoInvalid.WithMsg("the photo is not a valid image")
+	ErrPhotoTooLarge     = CodePhotoInvalid.WithMsg("the photo is too large")
+	ErrTooManyPhotos     = CodePhotoInvalid.WithMsg("the parking spot has too many photos")
+	ErrInvalidPhotoOrder = CodePhotoInvalid.WithMsg("the order must list every photo of the parking spot exactly once")
+)
+
+// A photo of a parking spot
+type ParkingSpotPhoto struct {
+	URL          string    `json:"url" doc:"URL of the full size image"`
+	ThumbnailURL string    `json:"thumbnail_url" doc:"URL of a smaller version of the image, for previews"`
+	Width        int       `json:"width" doc:"Width of the full size image in pixels"`
+	Height       int       `json:"height" doc:"Height of the full size image in pixels"`
+	ID           uuid.UUID `json:"id"`
+}
+
+type ParkingSpotPhotoOrderInput struct {
+	Photos []uuid.UUID `json:"photos" nullable:"false" doc:"IDs of every photo of the parking spot, in the order they are shown"`
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidPricingRule    = CodeSpotInvalid.WithMsg("the specified pricing rule is invalid")
+	ErrInvalidPricingDate    = CodeSpotInvalid.WithMsg("the specified pricing rule date is invalid")
+	ErrInvalidPricingTime    = CodeSpotInvalid.WithMsg("the specified pricing rule time band is invalid, start time must be before end time")
+	ErrInvalidMinimumCharge  = CodeSpotInvalid.WithMsg("the specified minimum charge is invalid")
+	ErrInvalidDailyMaximum   = CodeSpotInvalid.WithMsg("the specified daily maximum is invalid")
+	ErrTooManyPricingRules   = CodeSpotInvalid.WithMsg("too many pricing rules specified")
+	ErrInvalidQuoteTimeRange = CodeBookingInvalid.WithMsg("the quoted time range is invalid, it must be a non-empty multiple of 30 minutes")
+	ErrTooManyQuotedTimes    = CodeBookingInvalid.WithMsg("too many time slots requested")
+	ErrQuoteInvalid          = CodeBookingInvalid.WithMsg("the specified quote is invalid or has expired")
+)
+
+// A rule setting the price of time slots matching it.
+//
+// All conditions are evaluated in the local time of the parking spot.
+type PricingRule struct {
+	Date         string   `json:"date,omitempty" format:"date" doc:"The date this rule applies to. Applies to all dates if omitted."`
+	StartTime    string   `json:"start_time,omitempty" pattern:"^([01][0-9]|2[0-3]):[0-5][0-9]$" doc:"Start of the time band (inclusive) in 24-hour HH:MM. Defaults to start of day."`
+	EndTime      string   `json:"end_time,omitempty" pattern:"^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$" doc:"End of the time band (exclusive) in 24-hour HH:MM. Defaults to end of day."`
+	Weekdays     []string `json:"weekdays,omitempty" enum:"sunday,monday,tuesday,wednesday,thursday,friday,saturday" doc:"Days of the week this rule applies to. Applies to all days if omitted."`
+	PricePerHour float64  `json:"price_per_hour" doc:"Price per hour of matching time slots"`
+}
+
+type ParkingSpotPricing struct {
+	Rules         []PricingRule `json:"rules" nullable:"false" doc:"Pricing rules. Rules with a date take precedence, then the first matching rule is used. The spot price per hour is used if no rule matches."`
+	MinimumCharge float64       `json:"minimum_charge" doc:"Minimum amount charged per booking"`
+	DailyMaximum  float64       `json:"daily_maximum" doc:"Maximum amount charged per day of a booking, 0 for no limit"`
+}
+
+type PriceQuoteItem struct {
+	StartTime    time.Time `json:"start_time" doc:"The start time for slot"`
+	EndTime      time.Time `json:"end_time" doc:"The end time for slot"`
+	PricePerHour float64   `json:"price_per_hour" doc:"The price per hour applied to this slot"`
+	Amount       float64   `json:"amount" doc:"The price of this slot"`
+}
+
+type PriceQuoteAdjustment struct {
+	Reason string  `json:"reason" enum:"minimum_charge,daily_maximum" doc:"The reason for this adjustment"`
+	Date   string  `json:"date,omitempty" format:"date" doc:"The day this adjustment applies to, if any"`
+	Amount float64 `json:"amount" doc:"The amount added to the total, negative for discounts"`
+}
+
+type PriceQuoteTax struct {
+	Name   string  `json:"name" enum:"GST,HST,PST,QST,Sales Tax" doc:"The name of the tax"`
+	Rate   float64 `json:"rate" doc:"The rate of the tax as a fraction"`
+	Amount float64 `json:"amount" doc:"The amount of tax charged"`
+}
+
+type PriceQuote struct {
+	Currency    string                 `json:"currency" enum:"CAD,USD" doc:"ISO 4217 code of the currency all amounts are in"`
+	Items       []PriceQuoteItem       `json:"items" nullable:"false" doc:"Price of each time slot"`
+	Adjustments []PriceQuoteAdjustment `json:"adjustments" nullable:"false" doc:"Adjustments applied on top of the slot prices"`
+	Taxes       []PriceQuoteTax        `json:"taxes" nullable:"false" doc:"Sales taxes charged on the discounted subtotal and service fee"`
+	Subtotal    float64                `json:"subtotal" doc:"The price of the time slots after adjustments"`
+	Discount    float64                `json:"discount" doc:"The amount taken off the subtotal by a promo code"`
+	ServiceFee  float64                `json:"service_fee" doc:"The platform service fee, charged on the discounted subtotal"`
+	Total       float64                `json:"total" doc:"The total price, including fees and taxes"`
+}
+
+// A price quote that can be used to create a booking at the quoted price
+type BookingQuote struct {
+	ExpiresAt time.Time `json:"expires_at" doc:"The time after which this quote can no longer be used"`
+	PriceQuote
+	ID uuid.UUID `json:"id" doc:"ID of this quote, to be passed when creating the booking"`
+}
+
+type BookingQuoteInput struct {
+	PromoCode   string     `json:"promo_code,omitempty" required:"false" doc:"A promo code to apply to the quote"`
+	BookedTimes []TimeUnit `json:"booked_times" nullable:"false" doc:"The time slots to be quoted"`
+}
+
+type PriceQuoteFilter struct {
+	StartTime time.Time `query:"start_time" required:"true" doc:"Start of the quoted time range"`
+	EndTime   time.Time `query:"end_time" required:"true" doc:"End of the quoted time range"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotAdministrator       = CodeForbidden.WithMsg("this operation requires administrator access")
+	ErrPromoCodeNotFound      = CodeNotFound.WithMsg("this promo code does not exist")
+	ErrPromoCodeExists        = CodeDuplicate.WithMsg("a promo code with this code already exists")
+	ErrInvalidPromoCode       = CodePromoCodeInvalid.WithMsg("the specified code is invalid")
+	ErrInvalidPromoCodeLimit  = CodePromoCodeInvalid.WithMsg("the specified usage limits are invalid")
+	ErrInvalidDiscount        = CodePromoCodeInvalid.WithMsg("the specified discount is invalid, percentages must be within (0, 100] and fixed amounts must be positive")
+	ErrInvalidMinimumSpend    = CodePromoCodeInvalid.WithMsg("the specified minimum spend is invalid")
+	ErrPromoCodeOwnerNotFound = CodePromoCodeInvalid.WithMsg("no user exists with the specified owner email")
+	ErrPromoCodeInvalid       = CodeBookingInvalid.WithMsg("the specified promo code is invalid or has expired")
+	ErrPromoCodeNotApplicable = CodeBookingInvalid.WithMsg("the specified promo code can not be used for this parking spot")
+	ErrPromoCodeMinimumSpend  = CodeBookingInvalid.WithMsg("the booking does not meet the minimum spend of the specified promo code")
+	ErrPromoCodeExhausted     = CodeBookingInvalid.WithMsg("the specified promo code has reached its usage limit")
+	ErrPromoCodeQuoteMismatch = CodeBookingInvalid.WithMsg("the specified promo code does not match the one used for the quote")
+)
+
+const (
+	DiscountPercentage = "percentage"
+	DiscountFixed      = "fixed"
+)
+
+type PromoCodeCreationInput struct {
+	ExpiresAt      *time.Time `json:"expires_at,omitempty" required:"false" doc:"The time after which this code can no longer be used. Never expires if omitted."`
+	Code           string     `json:"code" minLength:"3" maxLength:"32" pattern:"^[A-Za-z0-9_-]+$" doc:"The code entered by users, case-insensitive"`
+	DiscountType   string     `json:"discount_type" enum:"percentage,fixed" doc:"Whether the discount is a percentage or a fixed amount"`
+	OwnerEmail     string     `json:"owner_email,omitempty" format:"email" required:"false" doc:"Restrict this code to spots owned by the user with this email"`
+	DiscountValue  float64    `json:"discount_value" doc:"The percentage or amount taken off the booking subtotal"`
+	MinimumSpend   float64    `json:"minimum_spend,omitempty" required:"false" doc:"The minimum booking subtotal for this code to apply"`
+	MaxUses        int32      `json:"max_uses,omitempty" minimum:"0" required:"false" doc:"Maximum number of bookings using this code, 0 for no limit"`
+	MaxUsesPerUser int32      `json:"max_uses_per_user,omitempty" minimum:"0" required:"false" doc:"Maximum number of bookings using this code per user, 0 for no limit"`
+	ParkingSpotID  uuid.UUID  `json:"parkingspot_id,omitempty" required:"false" doc:"Restrict this code to the parking spot with this ID"`
+}
+
+type PromoCode struct {
+	CreatedAt time.Time `json:"created_at" doc:"The time this code was created"`
+	PromoCodeCreationInput
+	Uses int64     `json:"uses" doc:"Number of bookings that used this code"`
+	ID   uuid.UUID `json:"id" doc:"ID of this resource"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrReviewDuplicate      = CodeDuplicate.WithMsg("this booking has already been reviewed")
+	ErrBookingNotCompleted  = CodeReviewInvalid.WithMsg("bookings can only be reviewed after all booked times have ended")
+	ErrInvalidRating        = CodeReviewInvalid.WithMsg("the specified rating is invalid, ratings must be between 1 and 5")
+	ErrInvalidReviewComment = CodeReviewInvalid.WithMsg("the specified comment is too long")
+)
+
+const (
+	ReviewSubjectSpot   = "spot"
+	ReviewSubjectDriver = "driver"
+)
+
+// Longest accepted review comment, in bytes
+const MaximumReviewCommentLength = 2000
+
+type ReviewCreationInput struct {
+	Comment string `json:"comment,omitempty" maxLength:"2000" required:"false" doc:"A written review"`
+	Rating  int32  `json:"rating" minimum:"1" maximum:"5" doc:"The rating given, from 1 to 5"`
+}
+
+type Review struct {
+	Subject   string    `json:"subject" enum:"spot,driver" doc:"Whether this review is of the parking spot by the driver, or of the driver by the host"`
+	CreatedAt time.Time `json:"created_at" doc:"The time this review was left"`
+	ReviewCreationInput
+	ID        uuid.UUID `json:"id" doc:"ID of this resource"`
+	BookingID uuid.UUID `json:"booking_id" doc:"ID of the reviewed booking"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSavedSearchNotFound    = CodeNotFound.WithMsg("this saved search does not exist")
+	ErrInvalidSavedSearchTime = CodeSavedSearchInvalid.WithMsg("the specified time band is invalid, start time must be before end time")
+	ErrInvalidMaxPrice        = CodeSavedSearchInvalid.WithMsg("the specified maximum price per hour is not valid")
+	ErrTooManySavedSearches   = CodeSavedSearchInvalid.WithMsg("too many saved searches")
+)
+
+// Largest number of saved searches per user
+const MaximumSavedSearchesPerUser = 20
+
+// Criteria of a saved search.
+//
+// Time conditions are evaluated in the local time of the parking spot.
+type SavedSearchInput struct {
+	Name            string              `json:"name" minLength:"1" maxLength:"100" doc:"Name of this search, used in notifications"`
+	StartTime       string              `json:"start_time,omitempty" pattern:"^([01][0-9]|2[0-3]):[0-5][0-9]$" doc:"Start of the wanted time band (inclusive) in 24-hour HH:MM. Defaults to start of day."`
+	EndTime         string              `json:"end_time,omitempty" pattern:"^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$" doc:"End of the wanted time band (exclusive) in 24-hour HH:MM. Defaults to end of day."`
+	Weekdays        []string            `json:"weekdays,omitempty" enum:"sunday,monday,tuesday,wednesday,thursday,friday,saturday" doc:"Wanted days of the week. Matches all days if omitted."`
+	Longitude       float64             `json:"longitude" minimum:"-180" maximum:"180" doc:"Longitude of the centre point"`
+	Latitude        float64             `json:"latitude" minimum:"-90" maximum:"90" doc:"Latitude of the centre point"`
+	MaxPricePerHour float64             `json:"max_price_per_hour,omitempty" minimum:"0" doc:"Highest price per hour of matching spots, no limit if omitted"`
+	Distance        int32               `json:"distance" minimum:"1" maximum:"10000" default:"500" doc:"Distance around the centre point in meters"`
+	Features        ParkingSpotFeatures `json:"features,omitempty" doc:"Features required from matching spots"`
+}
+
+type SavedSearch struct {
+	CreatedAt time.Time `json:"created_at" doc:"The time this search was saved"`
+	SavedSearchInput
+	ID uuid.UUID `json:"id" doc:"ID of this resource"`
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWebhookNotFound   = CodeNotFound.WithMsg("this webhook does not exist")
+	ErrInvalidWebhookURL = CodeWebhookInvalid.WithMsg("the webhook URL must be an absolute HTTPS URL")
+	ErrTooManyWebhooks   = CodeWebhookInvalid.WithMsg("too many webhooks")
+)
+
+// Largest number of webhooks per user
+const MaximumWebhooksPerUser = 10
+
+// Types of events delivered to webhooks
+const (
+	WebhookEventBookingCreated      = "booking.created"
+	WebhookEventAvailabilityChanged = "spot.availability_changed"
+	// Sent on request to verify an endpoint, webhooks can not subscribe to it
+	WebhookEventTest = "webhook.test"
+)
+
+// Status of webhook deliveries
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+type WebhookInput struct {
+	URL    string   `json:"url" format:"uri" maxLength:"2048" doc:"The HTTPS endpoint receiving events"`
+	Events []string `json:"events" minItems:"1" uniqueItems:"true" enum:"booking.created,spot.availability_changed" doc:"The event types delivered to the endpoint"`
+}
+
+type Webhook struct {
+	CreatedAt time.Time `json:"created_at" doc:"The time this webhook was created"`
+	WebhookInput
+	ID uuid.UUID `json:"id" doc:"ID of this resource"`
+}
+
+// A newly created webhook, along with its signing secret
+type WebhookWithSecret struct {
+	Secret string `json:"secret" doc:"Key used to sign the payloads delivered to this webhook. It is only shown on creation."`
+	Webhook
+}
+
+// The payload delivered to webhooks
+type WebhookEvent struct {
+	CreatedAt time.Time       `json:"created_at" doc:"The time this event happened"`
+	Type      string          `json:"type" doc:"The type of this event"`
+	Data      json.RawMessage `json:"data" doc:"The resource this event is about, its format depends on the event type"`
+	ID        uuid.UUID       `json:"id" doc:"ID of this event, the same event can be delivered more than once"`
+}
+
+type WebhookDelivery struct {
+	CreatedAt      time.Time  `json:"created_at" doc:"The time this delivery was queued"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at,omitempty" doc:"The time of the last delivery attempt"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty" doc:"The time of the next delivery attempt, if the delivery is pending"`
+	EventType      string     `json:"event_type" doc:"The type of the delivered event"`
+	Status         string     `json:"status" enum:"pending,succeeded,failed" doc:"Whether the event was delivered, failed after all attempts or is waiting for an attempt"`
+	LastError      string     `json:"last_error,omitempty" doc:"The error of the last attempt, if it failed"`
+	Attempts       int32      `json:"attempts" doc:"The number of delivery attempts so far"`
+	LastStatusCode int32      `json:"last_status_code,omitempty" doc:"The HTTP status returned by the endpoint on the last attempt"`
+	EventID        uuid.UUID  `json:"event_id" doc:"ID of the delivered event"`
+	ID             uuid.UUID  `json:"id" doc:"ID of this resource"`
+}
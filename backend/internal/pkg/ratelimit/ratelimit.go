@@ -0,0 +1,88 @@
+// Rate limiting of requests
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter limits the rate of requests made for each key, such as a user.
+//
+// Each key has a bucket holding up to `burst` tokens, refilled at `rate` tokens per second. A request
+// takes a token and is rejected if there are none left.
+type Limiter[K comparable] struct {
+	lastSweep time.Time
+	buckets   map[K]*bucket
+	now       func() time.Time
+	rate      float64
+	burst     float64
+	mu        sync.Mutex
+}
+
+type bucket struct {
+	updatedAt time.Time
+	tokens    float64
+}
+
+// Create a Limiter allowing `rate` requests per second for each key, with bursts of `burst` requests
+func New[K comparable](rate float64, burst int) *Limiter[K] {
+	return &Limiter[K]{
+		buckets: make(map[K]*bucket),
+		now:     time.Now,
+		rate:    rate,
+		burst:   float64(max(burst, 1)),
+	}
+}
+
+// Takes a token for a request of `key`.
+//
+// Returns zero if the request is allowed, or how long to wait until it would be otherwise.
+func (l *Limiter[K]) Allow(key K) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst}
+		l.buckets[key] = b
+	} else {
+		b.tokens = l.refill(b, now)
+	}
+	b.updatedAt = now
+
+	if b.tokens < 1 {
+		if l.rate <= 0 {
+			return time.Duration(math.MaxInt64)
+		}
+		return time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+	b.tokens--
+	return 0
+}
+
+// Returns the tokens in `b` at `now`
+func (l *Limiter[K]) refill(b *bucket, now time.Time) float64 {
+	elapsed := now.Sub(b.updatedAt).Seconds()
+	return min(l.burst, b.tokens+max(elapsed, 0)*l.rate)
+}
+
+// Forget the keys whose bucket refilled, about once for every time it takes to refill a bucket
+func (l *Limiter[K]) sweep(now time.Time) {
+	if l.rate <= 0 {
+		return
+	}
+	refillTime := time.Duration(l.burst / l.rate * float64(time.Second))
+	if now.Sub(l.lastSweep) < refillTime {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+	limiter := New[int64](2, 3)
+	limiter.now = func() time.Time { return now }
+
+	for range 3 {
+		assert.Zero(t, limiter.Allow(1))
+	}
+	assert.Equal(t, 500*time.Millisecond, limiter.Allow(1))
+
+	// Keys are limited separately
+	assert.Zero(t, limiter.Allow(2))
+
+	now = now.Add(250 * time.Millisecond)
+	assert.Equal(t, 250*time.Millisecond, limiter.Allow(1))
+	now = now.Add(250 * time.Millisecond)
+	assert.Zero(t, limiter.Allow(1))
+	assert.Positive(t, limiter.Allow(1))
+
+	// Buckets never hold more than the burst
+	now = now.Add(time.Hour)
+	for range 3 {
+		assert.Zero(t, limiter.Allow(1))
+	}
+	assert.Positive(t, limiter.Allow(1))
+
+	// Refilled buckets are forgotten
+	assert.Len(t, limiter.buckets, 1)
+}
@@ -0,0 +1,40 @@
+package region
+
+import "regexp"
+
+var gst = Tax{Name: "GST", Rate: 0.05}
+
+var canada = Country{
+	Code:       "CA",
+	Currency:   "CAD",
+	PostalCode: regexp.MustCompile("^[A-Z][0-9][A-Z][0-9][A-Z][0-9]$"),
+	// Personalized plates can be up to 8 characters in Ontario
+	LicensePlate: regexp.MustCompile("^[A-Z0-9]{2,8}$"),
+	Subdivisions: map[string]Subdivision{
+		"AB": {TimeZone: "America/Edmonton", Taxes: []Tax{gst}},
+		"BC": {
+			TimeZone:     "America/Vancouver",
+			Taxes:        []Tax{gst, {Name: "PST", Rate: 0.07}},
+			LicensePlate: regexp.MustCompile("^[A-Z0-9]{2,6}$"),
+		},
+		"MB": {TimeZone: "America/Winnipeg", Taxes: []Tax{gst, {Name: "PST", Rate: 0.07}}},
+		"NB": {TimeZone: "America/Moncton", Taxes: []Tax{{Name: "HST", Rate: 0.15}}},
+		"NL": {TimeZone: "America/St_Johns", Taxes: []Tax{{Name: "HST", Rate: 0.15}}},
+		"NS": {TimeZone: "America/Halifax", Taxes: []Tax{{Name: "HST", Rate: 0.14}}},
+		"NT": {TimeZone: "America/Yellowknife", Taxes: []Tax{gst}},
+		"NU": {TimeZone: "America/Iqaluit", Taxes: []Tax{gst}},
+		"ON": {TimeZone: "America/Toronto", Taxes: []Tax{{Name: "HST", Rate: 0.13}}},
+		"PE": {TimeZone: "America/Halifax", Taxes: []Tax{{Name: "HST", Rate: 0.15}}},
+		"QC": {
+			TimeZone:     "America/Montreal",
+			Taxes:        []Tax{gst, {Name: "QST", Rate: 0.09975}},
+			LicensePlate: regexp.MustCompile("^[A-Z0-9]{2,7}$"),
+		},
+		"SK": {TimeZone: "America/Regina", Taxes: []Tax{gst, {Name: "PST", Rate: 0.06}}},
+		"YT": {TimeZone: "America/Whitehorse", Taxes: []Tax{gst}},
+	},
+}
+
+func init() {
+	Register(&canada)
+}
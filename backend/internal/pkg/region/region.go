@@ -0,0 +1,119 @@
+// Information about the regions where parking spots can be listed
+package region
+
+import (
+	"regexp"
+	"time"
+	_ "time/tzdata" // embed time zone data so lookups do not depend on the host
+)
+
+// The rules of a country where parking spots can be listed.
+//
+// Countries are added by registering their rules with `Register`.
+type Country struct {
+	// Matches the postal codes of the country, written in upper case without spaces
+	PostalCode *regexp.Regexp
+	// Matches the license plates issued in the country, written in upper case without spaces or
+	// dashes, unless the subdivision has its own format
+	LicensePlate *regexp.Regexp
+	// Subdivisions of the country, such as provinces or states, by their ISO 3166-2 code without the
+	// country prefix
+	Subdivisions map[string]Subdivision
+	// ISO 3166-1 alpha-2 code of the country, such as CA
+	Code string
+	// ISO 4217 code of the currency prices are set in, such as CAD
+	Currency string
+}
+
+// The rules of a subdivision of a country
+type Subdivision struct {
+	// Matches the license plates issued in the subdivision, nil to use the format of the country
+	LicensePlate *regexp.Regexp
+	// IANA name of the time zone of the subdivision, or of most of it if it spans several
+	TimeZone string
+	// Sales taxes levied on parking in the subdivision
+	Taxes []Tax
+}
+
+// A sales tax levied on purchases
+type Tax struct {
+	Name string  // Short name of the tax, such as GST
+	Rate float64 // Rate of the tax as a fraction
+}
+
+var countries = make(map[string]*Country)
+
+// Register the rules of a country, replacing the rules registered for the same country.
+//
+// This is not safe to call concurrently with lookups, countries should be registered from `init`.
+func Register(country *Country) {
+	countries[country.Code] = country
+}
+
+// Returns the rules of the country with `code`, or nil if parking spots cannot be listed there
+func Lookup(code string) *Country {
+	return countries[code]
+}
+
+// Returns whether `postalCode` is a valid postal code in the country
+func (c *Country) ValidPostalCode(postalCode string) bool {
+	return c.PostalCode.MatchString(postalCode)
+}
+
+// Returns whether `plate` is a valid license plate issued in the subdivision `state` of the country.
+//
+// `plate` is written in upper case without spaces or dashes.
+func (c *Country) ValidLicensePlate(state, plate string) bool {
+	subdivision, ok := c.Subdivisions[state]
+	if !ok {
+		return false
+	}
+	if subdivision.LicensePlate != nil {
+		return subdivision.LicensePlate.MatchString(plate)
+	}
+	return c.LicensePlate.MatchString(plate)
+}
+
+// Returns the rules of the given subdivision in the given country, and whether it is known
+func lookupSubdivision(countryCode, state string) (Subdivision, bool) {
+	country := Lookup(countryCode)
+	if country == nil {
+		return Subdivision{}, false
+	}
+	subdivision, ok := country.Subdivisions[state]
+	return subdivision, ok
+}
+
+// Returns the time zone of the given province in the given country.
+//
+// Returns UTC if the region is not known.
+func TimeZone(countryCode, state string) *time.Location {
+	subdivision, ok := lookupSubdivision(countryCode, state)
+	if !ok {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(subdivision.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Returns the sales taxes levied in the given province in the given country.
+//
+// Returns no taxes if the region is not known.
+func SalesTaxes(countryCode, state string) []Tax {
+	subdivision, _ := lookupSubdivision(countryCode, state)
+	return subdivision.Taxes
+}
+
+// Returns the currency of prices in the given country.
+//
+// Returns an empty string if the country is not known.
+func Currency(countryCode string) string {
+	country := Lookup(countryCode)
+	if country == nil {
+		return ""
+	}
+	return country.Currency
+}
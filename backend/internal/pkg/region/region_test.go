@@ -0,0 +1,77 @@
+package region
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisteredCountries(t *testing.T) {
+	t.Parallel()
+
+	for _, country := range countries {
+		for code, subdivision := range country.Subdivisions {
+			_, err := time.LoadLocation(subdivision.TimeZone)
+			require.NoError(t, err, "%v-%v", country.Code, code)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	canada := Lookup("CA")
+	require.NotNil(t, canada)
+	assert.True(t, canada.ValidPostalCode("R3T2N2"))
+	assert.False(t, canada.ValidPostalCode("R3T 2N2"))
+	assert.False(t, canada.ValidPostalCode("10001"))
+
+	us := Lookup("US")
+	require.NotNil(t, us)
+	assert.True(t, us.ValidPostalCode("10001"))
+	assert.True(t, us.ValidPostalCode("10001-1234"))
+	assert.False(t, us.ValidPostalCode("R3T2N2"))
+	assert.Contains(t, us.Subdivisions, "NY")
+
+	assert.Nil(t, Lookup("FR"))
+}
+
+func TestTimeZone(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "America/Winnipeg", TimeZone("CA", "MB").String())
+	assert.Equal(t, "America/New_York", TimeZone("US", "NY").String())
+	assert.Equal(t, time.UTC, TimeZone("US", "MB"))
+	assert.Equal(t, time.UTC, TimeZone("FR", "IDF"))
+}
+
+func TestCurrency(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "CAD", Currency("CA"))
+	assert.Equal(t, "USD", Currency("US"))
+	assert.Empty(t, Currency("FR"))
+}
+
+func TestSalesTaxes(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []Tax{{Name: "HST", Rate: 0.13}}, SalesTaxes("CA", "ON"))
+	assert.Equal(t, []Tax{{Name: "Sales Tax", Rate: 0.0725}}, SalesTaxes("US", "CA"))
+	assert.Empty(t, SalesTaxes("US", "OR"))
+	assert.Empty(t, SalesTaxes("FR", "IDF"))
+}
+
+func TestValidLicensePlate(t *testing.T) {
+	t.Parallel()
+
+	canada := Lookup("CA")
+	require.NotNil(t, canada)
+	assert.True(t, canada.ValidLicensePlate("ON", "ABCD1234"))
+	assert.False(t, canada.ValidLicensePlate("BC", "ABCD1234"))
+	assert.True(t, canada.ValidLicensePlate("BC", "AB123C"))
+	assert.False(t, canada.ValidLicensePlate("ON", "ABC 123"))
+	assert.False(t, canada.ValidLicensePlate("NY", "ABC123"))
+}
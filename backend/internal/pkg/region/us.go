@@ -0,0 +1,80 @@
+package region
+
+import "regexp"
+
+// Returns the state sales tax at `rate`.
+//
+// Only the statewide rate is charged, local sales taxes depend on the address of the spot. Alaska,
+// Delaware, Montana, New Hampshire and Oregon have no state sales tax.
+func salesTax(rate float64) []Tax {
+	return []Tax{{Name: "Sales Tax", Rate: rate}}
+}
+
+var unitedStates = Country{
+	Code:       "US",
+	Currency:   "USD",
+	PostalCode: regexp.MustCompile("^[0-9]{5}(-[0-9]{4})?$"),
+	// Some states issue single character personalized plates
+	LicensePlate: regexp.MustCompile("^[A-Z0-9]{1,8}$"),
+	Subdivisions: map[string]Subdivision{
+		"AK": {TimeZone: "America/Anchorage"},
+		"AL": {TimeZone: "America/Chicago", Taxes: salesTax(0.04)},
+		"AR": {TimeZone: "America/Chicago", Taxes: salesTax(0.065)},
+		"AZ": {TimeZone: "America/Phoenix", Taxes: salesTax(0.056)},
+		"CA": {
+			TimeZone:     "America/Los_Angeles",
+			Taxes:        salesTax(0.0725),
+			LicensePlate: regexp.MustCompile("^[A-Z0-9]{2,7}$"),
+		},
+		"CO": {TimeZone: "America/Denver", Taxes: salesTax(0.029)},
+		"CT": {TimeZone: "America/New_York", Taxes: salesTax(0.0635)},
+		"DC": {TimeZone: "America/New_York", Taxes: salesTax(0.06)},
+		"DE": {TimeZone: "America/New_York"},
+		"FL": {TimeZone: "America/New_York", Taxes: salesTax(0.06)},
+		"GA": {TimeZone: "America/New_York", Taxes: salesTax(0.04)},
+		"HI": {TimeZone: "Pacific/Honolulu", Taxes: salesTax(0.04)},
+		"IA": {TimeZone: "America/Chicago", Taxes: salesTax(0.06)},
+		"ID": {TimeZone: "America/Boise", Taxes: salesTax(0.06)},
+		"IL": {TimeZone: "America/Chicago", Taxes: salesTax(0.0625)},
+		"IN": {TimeZone: "America/Indiana/Indianapolis", Taxes: salesTax(0.07)},
+		"KS": {TimeZone: "America/Chicago", Taxes: salesTax(0.065)},
+		"KY": {TimeZone: "America/New_York", Taxes: salesTax(0.06)},
+		"LA": {TimeZone: "America/Chicago", Taxes: salesTax(0.05)},
+		"MA": {TimeZone: "America/New_York", Taxes: salesTax(0.0625)},
+		"MD": {TimeZone: "America/New_York", Taxes: salesTax(0.06)},
+		"ME": {TimeZone: "America/New_York", Taxes: salesTax(0.055)},
+		"MI": {TimeZone: "America/Detroit", Taxes: salesTax(0.06)},
+		"MN": {TimeZone: "America/Chicago", Taxes: salesTax(0.06875)},
+		"MO": {TimeZone: "America/Chicago", Taxes: salesTax(0.04225)},
+		"MS": {TimeZone: "America/Chicago", Taxes: salesTax(0.07)},
+		"MT": {TimeZone: "America/Denver"},
+		"NC": {TimeZone: "America/New_York", Taxes: salesTax(0.0475)},
+		"ND": {TimeZone: "America/Chicago", Taxes: salesTax(0.05)},
+		"NE": {TimeZone: "America/Chicago", Taxes: salesTax(0.055)},
+		"NH": {TimeZone: "America/New_York"},
+		"NJ": {TimeZone: "America/New_York", Taxes: salesTax(0.06625)},
+		"NM": {TimeZone: "America/Denver", Taxes: salesTax(0.04875)},
+		"NV": {TimeZone: "America/Los_Angeles", Taxes: salesTax(0.0685)},
+		"NY": {TimeZone: "America/New_York", Taxes: salesTax(0.04)},
+		"OH": {TimeZone: "America/New_York", Taxes: salesTax(0.0575)},
+		"OK": {TimeZone: "America/Chicago", Taxes: salesTax(0.045)},
+		"OR": {TimeZone: "America/Los_Angeles"},
+		"PA": {TimeZone: "America/New_York", Taxes: salesTax(0.06)},
+		"RI": {TimeZone: "America/New_York", Taxes: salesTax(0.07)},
+		"SC": {TimeZone: "America/New_York", Taxes: salesTax(0.06)},
+		"SD": {TimeZone: "America/Chicago", Taxes: salesTax(0.042)},
+		"TN": {TimeZone: "America/Chicago", Taxes: salesTax(0.07)},
+		"TX": {TimeZone: "America/Chicago", Taxes: salesTax(0.0625)},
+		"UT": {TimeZone: "America/Denver", Taxes: salesTax(0.061)},
+		"VA": {TimeZone: "America/New_York", Taxes: salesTax(0.053)},
+		"VT": {TimeZone: "America/New_York", Taxes: salesTax(0.06)},
+		"WA": {TimeZone: "America/Los_Angeles", Taxes: salesTax(0.065)},
+		"WI": {TimeZone: "America/Chicago", Taxes: salesTax(0.05)},
+		"WV": {TimeZone: "America/New_York", Taxes: salesTax(0.06)},
+		"WY": {TimeZone: "America/Denver", Taxes: salesTax(0.04)},
+	},
+}
+
+func init() {
+	Register(&unitedStates)
+}
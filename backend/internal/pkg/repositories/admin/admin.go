@@ -0,0 +1,10 @@
+package admin
+
+import (
+	"context"
+)
+
+type Repository interface {
+	// Returns whether the user with internal ID `userID` is an administrator
+	IsAdmin(ctx context.Context, userID int64) (bool, error)
+}
@@ -0,0 +1,25 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/stephenafamo/bob"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+// IsAdmin implements Repository.
+func (p *PostgresRepository) IsAdmin(ctx context.Context, userID int64) (bool, error) {
+	return dbmodels.Administrators.Query(
+		dbmodels.SelectWhere.Administrators.Userid.EQ(userID),
+	).Exists(ctx, p.db)
+}
@@ -0,0 +1,49 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	models.AvailabilityAlert
+	InternalID int64 // The internal ID of this alert
+	UserID     int64 // The user subscribed to this alert
+	SpotID     int64 // The internal ID of the watched parking spot, 0 for area alerts
+}
+
+type CreateInput struct {
+	Area   *models.AvailabilityAlertArea // The watched area, nil for spot alerts
+	UserID int64
+	SpotID int64 // The internal ID of the watched parking spot, 0 for area alerts
+}
+
+// A parking spot with new availability
+type Target struct {
+	Longitude float64
+	Latitude  float64
+	SpotID    int64 // The internal ID of the parking spot
+	OwnerID   int64 // Alerts of the spot owner never match
+}
+
+var (
+	ErrNotFound  = errors.New("no alert found")
+	ErrDuplicate = errors.New("alert already exists")
+)
+
+type Repository interface {
+	// Create a new availability alert
+	Create(ctx context.Context, input *CreateInput) (Entry, error)
+	GetByUUID(ctx context.Context, alertID uuid.UUID) (Entry, error)
+	// Get all alerts of `userID`, newest first
+	GetMany(ctx context.Context, userID int64) ([]Entry, error)
+	DeleteByUUID(ctx context.Context, alertID uuid.UUID) error
+	// Get the alerts matching `target` that were not notified since `notifiedBefore`.
+	//
+	// The returned alerts are marked as notified at `now`, so concurrent callers never claim the same alert.
+	ClaimMatching(ctx context.Context, target *Target, notifiedBefore, now time.Time) ([]Entry, error)
+}
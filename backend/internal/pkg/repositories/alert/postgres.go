@@ -0,0 +1,182 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, input *CreateInput) (Entry, error) {
+	setter := dbmodels.AvailabilityalertSetter{
+		Userid: omit.From(input.UserID),
+	}
+	if input.Area != nil {
+		lon, err := decimal.NewFromFloat64(input.Area.Longitude)
+		if err != nil {
+			return Entry{}, err
+		}
+		lat, err := decimal.NewFromFloat64(input.Area.Latitude)
+		if err != nil {
+			return Entry{}, err
+		}
+		setter.Longitude = omitnull.From(lon)
+		setter.Latitude = omitnull.From(lat)
+		setter.Distance = omitnull.From(input.Area.Distance)
+	} else {
+		setter.Parkingspotid = omitnull.From(input.SpotID)
+	}
+
+	inserted, err := dbmodels.Availabilityalerts.Insert(&setter).One(ctx, p.db)
+	if err != nil {
+		if dbmodels.ErrUniqueConstraint.Is(err) {
+			err = ErrDuplicate
+		}
+		return Entry{}, err
+	}
+
+	err = inserted.LoadAvailabilityalertParkingspotidParkingspot(ctx, p.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return Entry{}, err
+	}
+
+	return entryFromDB(inserted), nil
+}
+
+func (p *PostgresRepository) GetByUUID(ctx context.Context, alertID uuid.UUID) (Entry, error) {
+	result, err := dbmodels.Availabilityalerts.Query(
+		dbmodels.SelectWhere.Availabilityalerts.Alertuuid.EQ(alertID),
+		dbmodels.PreloadAvailabilityalertParkingspotidParkingspot(),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(result), nil
+}
+
+func (p *PostgresRepository) GetMany(ctx context.Context, userID int64) ([]Entry, error) {
+	alerts, err := dbmodels.Availabilityalerts.Query(
+		dbmodels.SelectWhere.Availabilityalerts.Userid.EQ(userID),
+		dbmodels.PreloadAvailabilityalertParkingspotidParkingspot(),
+		sm.OrderBy(dbmodels.AvailabilityalertColumns.Alertid).Desc(),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(alerts))
+	for _, model := range alerts {
+		result = append(result, entryFromDB(model))
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) DeleteByUUID(ctx context.Context, alertID uuid.UUID) error {
+	deleted, err := dbmodels.Availabilityalerts.Delete(
+		dbmodels.DeleteWhere.Availabilityalerts.Alertuuid.EQ(alertID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgresRepository) ClaimMatching(ctx context.Context, target *Target, notifiedBefore, now time.Time) ([]Entry, error) {
+	// Only set for area alerts, the distance is NULL for spot alerts
+	distance := psql.F(
+		"earth_distance",
+		psql.F("ll_to_earth", dbmodels.AvailabilityalertColumns.Latitude, dbmodels.AvailabilityalertColumns.Longitude),
+		psql.F("ll_to_earth", psql.Arg(target.Latitude), psql.Arg(target.Longitude)),
+	)()
+
+	alerts, err := dbmodels.Availabilityalerts.Update(
+		um.SetCol(dbmodels.ColumnNames.Availabilityalerts.Lastnotifiedat).ToArg(now),
+		psql.WhereAnd(
+			dbmodels.UpdateWhere.Availabilityalerts.Userid.NE(target.OwnerID),
+			psql.WhereOr(
+				dbmodels.UpdateWhere.Availabilityalerts.Lastnotifiedat.IsNull(),
+				dbmodels.UpdateWhere.Availabilityalerts.Lastnotifiedat.LT(notifiedBefore),
+			),
+			psql.WhereOr(
+				dbmodels.UpdateWhere.Availabilityalerts.Parkingspotid.EQ(target.SpotID),
+				um.Where(distance.LTE(dbmodels.AvailabilityalertColumns.Distance)),
+			),
+		),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+
+	err = alerts.LoadAvailabilityalertParkingspotidParkingspot(ctx, p.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(alerts))
+	for _, model := range alerts {
+		result = append(result, entryFromDB(model))
+	}
+	return result, nil
+}
+
+func entryFromDB(model *dbmodels.Availabilityalert) Entry {
+	result := Entry{
+		AvailabilityAlert: models.AvailabilityAlert{
+			CreatedAt: model.Createdat,
+			ID:        model.Alertuuid,
+		},
+		InternalID: model.Alertid,
+		UserID:     model.Userid,
+	}
+
+	if spotID, ok := model.Parkingspotid.Get(); ok {
+		result.SpotID = spotID
+		if model.R.ParkingspotidParkingspot != nil {
+			result.AvailabilityAlert.SpotID = model.R.ParkingspotidParkingspot.Parkingspotuuid
+		}
+	} else {
+		lon, _ := model.Longitude.GetOrZero().Float64()
+		lat, _ := model.Latitude.GetOrZero().Float64()
+		result.Area = &models.AvailabilityAlertArea{
+			Longitude: lon,
+			Latitude:  lat,
+			Distance:  model.Distance.GetOrZero(),
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,179 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+	spotRepo := parkingspot.NewPostgres(db)
+
+	ownerProfile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	driverProfile := models.UserProfile{
+		FullName: "John Smith",
+		Email:    "j.smith@gmail.com",
+	}
+	ownerAuth, _ := authRepo.Create(ctx, ownerProfile.Email, models.HashedPassword("some hash"))
+	driverAuth, _ := authRepo.Create(ctx, driverProfile.Email, models.HashedPassword("some other hash"))
+	ownerID, _ := userRepo.Create(ctx, ownerAuth, ownerProfile)
+	driverID, _ := userRepo.Create(ctx, driverAuth, driverProfile)
+
+	spot, _, err := spotRepo.Create(ctx, ownerID, &models.ParkingSpotCreationInput{
+		Location: models.ParkingSpotLocation{
+			PostalCode:    "L2E6T2",
+			CountryCode:   "CA",
+			City:          "Niagara Falls",
+			StreetAddress: "5 Niagara Parkway",
+			State:         "ON",
+			Latitude:      43.07923,
+			Longitude:     -79.07887,
+		},
+		PricePerHour: 10.5,
+	})
+	require.NoError(t, err)
+
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	target := Target{
+		Longitude: spot.Location.Longitude,
+		Latitude:  spot.Location.Latitude,
+		SpotID:    spot.InternalID,
+		OwnerID:   ownerID,
+	}
+
+	t.Run("basic add, get & delete", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		spotAlert, err := repo.Create(ctx, &CreateInput{UserID: driverID, SpotID: spot.InternalID})
+		require.NoError(t, err)
+		assert.Equal(t, spot.ID, spotAlert.AvailabilityAlert.SpotID)
+		assert.Nil(t, spotAlert.Area)
+
+		_, err = repo.Create(ctx, &CreateInput{UserID: driverID, SpotID: spot.InternalID})
+		require.ErrorIs(t, err, ErrDuplicate)
+
+		area := models.AvailabilityAlertArea{
+			Longitude: -79.07,
+			Latitude:  43.07,
+			Distance:  2000,
+		}
+		areaAlert, err := repo.Create(ctx, &CreateInput{Area: &area, UserID: driverID})
+		require.NoError(t, err)
+		assert.Equal(t, &area, areaAlert.Area)
+
+		got, err := repo.GetByUUID(ctx, spotAlert.ID)
+		require.NoError(t, err)
+		assert.Equal(t, spotAlert, got)
+
+		alerts, err := repo.GetMany(ctx, driverID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{areaAlert, spotAlert}, alerts)
+
+		err = repo.DeleteByUUID(ctx, spotAlert.ID)
+		require.NoError(t, err)
+		err = repo.DeleteByUUID(ctx, spotAlert.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+		_, err = repo.GetByUUID(ctx, spotAlert.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("claim matching alerts", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		spotAlert, err := repo.Create(ctx, &CreateInput{UserID: driverID, SpotID: spot.InternalID})
+		require.NoError(t, err)
+		nearAlert, err := repo.Create(ctx, &CreateInput{
+			Area: &models.AvailabilityAlertArea{
+				Longitude: -79.07887,
+				Latitude:  43.07823,
+				Distance:  500,
+			},
+			UserID: driverID,
+		})
+		require.NoError(t, err)
+		// Too far away
+		_, err = repo.Create(ctx, &CreateInput{
+			Area: &models.AvailabilityAlertArea{
+				Longitude: -79.3832,
+				Latitude:  43.6532,
+				Distance:  500,
+			},
+			UserID: driverID,
+		})
+		require.NoError(t, err)
+		// The owner is never notified about their own spot
+		_, err = repo.Create(ctx, &CreateInput{UserID: ownerID, SpotID: spot.InternalID})
+		require.NoError(t, err)
+
+		now := time.Now()
+		claimed, err := repo.ClaimMatching(ctx, &target, now.Add(-time.Hour), now)
+		require.NoError(t, err)
+		claimedIDs := make([]int64, 0, len(claimed))
+		for _, entry := range claimed {
+			claimedIDs = append(claimedIDs, entry.InternalID)
+		}
+		assert.ElementsMatch(t, []int64{spotAlert.InternalID, nearAlert.InternalID}, claimedIDs)
+
+		// Alerts are not claimed again during the cooldown
+		claimed, err = repo.ClaimMatching(ctx, &target, now.Add(-time.Hour), now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Empty(t, claimed)
+
+		// But are afterwards
+		later := now.Add(2 * time.Hour)
+		claimed, err = repo.ClaimMatching(ctx, &target, later.Add(-time.Hour), later)
+		require.NoError(t, err)
+		assert.Len(t, claimed, 2)
+	})
+}
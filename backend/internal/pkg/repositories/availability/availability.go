@@ -0,0 +1,22 @@
+package availability
+
+import (
+	"context"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+)
+
+// Postgres channel on which availability events are published
+const Channel = "spot_availability"
+
+// Largest number of time slots carried by a single notification.
+//
+// Postgres limits notification payloads to 8000 bytes, so larger events are split.
+const MaximumTimesPerNotification = 50
+
+type Listener interface {
+	// Listen for availability events published by any server until `ctx` is cancelled.
+	//
+	// `handle` is called for each event received.
+	Listen(ctx context.Context, handle func(event *models.AvailabilityEvent)) error
+}
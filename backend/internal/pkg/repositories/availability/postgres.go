@@ -0,0 +1,85 @@
+package availability
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+// Publish `event` to all listeners.
+//
+// If `exec` is a transaction, the event is only delivered once it commits. Each published event, including
+// chunks of events with too many time slots, is assigned a new ID.
+func Notify(ctx context.Context, exec bob.Executor, event *models.AvailabilityEvent) error {
+	for start := 0; start < len(event.Times); start += MaximumTimesPerNotification {
+		chunk := *event
+		chunk.Times = event.Times[start:min(start+MaximumTimesPerNotification, len(event.Times))]
+		chunk.ID = uuid.New()
+
+		payload, err := json.Marshal(&chunk)
+		if err != nil {
+			return fmt.Errorf("could not encode availability event: %w", err)
+		}
+
+		_, err = psql.Select(
+			sm.Columns(psql.F("pg_notify", psql.Arg(Channel), psql.Arg(string(payload)))),
+		).Exec(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("could not publish availability event: %w", err)
+		}
+	}
+	return nil
+}
+
+type PostgresListener struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresListener(pool *pgxpool.Pool) *PostgresListener {
+	return &PostgresListener{
+		pool: pool,
+	}
+}
+
+func (p *PostgresListener) Listen(ctx context.Context, handle func(event *models.AvailabilityEvent)) error {
+	poolConn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("could not acquire connection: %w", err)
+	}
+	// The connection is dedicated to listening, so it is not returned to the pool
+	conn := poolConn.Hijack()
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{Channel}.Sanitize())
+	if err != nil {
+		return fmt.Errorf("could not listen to %v: %w", Channel, err)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		}
+
+		var event models.AvailabilityEvent
+		err = json.Unmarshal([]byte(notification.Payload), &event)
+		if err != nil {
+			log.Ctx(ctx).Err(err).Str("payload", notification.Payload).Msg("invalid availability event")
+			continue
+		}
+		handle(&event)
+	}
+}
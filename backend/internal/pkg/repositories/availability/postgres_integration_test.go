@@ -0,0 +1,98 @@
+package availability
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	listener := NewPostgresListener(pool)
+
+	t.Run("events are delivered once committed", func(t *testing.T) {
+		listenCtx, listenCancel := context.WithCancel(ctx)
+		t.Cleanup(listenCancel)
+
+		events := make(chan models.AvailabilityEvent, 8)
+		listening := make(chan error, 1)
+		go func() {
+			listening <- listener.Listen(listenCtx, func(event *models.AvailabilityEvent) {
+				events <- *event
+			})
+		}()
+		// Give the listener time to subscribe
+		time.Sleep(500 * time.Millisecond)
+
+		start := time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC)
+		times := make([]models.TimeUnit, 0, MaximumTimesPerNotification+1)
+		for idx := range MaximumTimesPerNotification + 1 {
+			slotStart := start.Add(time.Duration(idx) * 30 * time.Minute)
+			times = append(times, models.TimeUnit{
+				StartTime: slotStart,
+				EndTime:   slotStart.Add(30 * time.Minute),
+			})
+		}
+		event := models.AvailabilityEvent{
+			Type:      models.AvailabilityEventAdded,
+			Times:     times,
+			Longitude: -79.07887,
+			Latitude:  43.07923,
+			SpotID:    uuid.New(),
+		}
+
+		// Rolled back events are never delivered
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		err = Notify(ctx, tx, &models.AvailabilityEvent{Type: models.AvailabilityEventBooked, Times: times[:1]})
+		require.NoError(t, err)
+		require.NoError(t, tx.Rollback())
+
+		tx, err = db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		err = Notify(ctx, tx, &event)
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+
+		// Large events are split across notifications
+		received := make([]models.TimeUnit, 0, len(times))
+		for len(received) < len(times) {
+			select {
+			case result := <-events:
+				assert.Equal(t, event.Type, result.Type)
+				assert.Equal(t, event.SpotID, result.SpotID)
+				received = append(received, result.Times...)
+			case <-time.After(5 * time.Second):
+				t.Fatal("no event received")
+			}
+		}
+		assert.Len(t, received, len(times))
+
+		listenCancel()
+		require.NoError(t, <-listening)
+	})
+}
@@ -0,0 +1,22 @@
+package blob
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNotFound = errors.New("no object found")
+
+// BlobStore keeps binary objects by key.
+//
+// Keys are slash separated paths, like "spots/photo.jpg".
+type BlobStore interface {
+	// Store `data` under `key` as `contentType`, replacing any existing object.
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get the object stored under `key`.
+	//
+	// Returns ErrNotFound if there is none.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete the object stored under `key`. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,257 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	s3Algorithm = "AWS4-HMAC-SHA256"
+	s3Service   = "s3"
+	// Format of the request time in signatures
+	s3TimeFormat = "20060102T150405Z"
+	// Format of the request date in credential scopes
+	s3DateFormat = "20060102"
+)
+
+type S3Config struct {
+	// Base URL of the S3 API, like https://s3.us-east-1.amazonaws.com or http://localhost:9000 for MinIO
+	Endpoint  *url.URL
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// S3 keeps objects in a bucket of an S3 compatible object storage.
+//
+// Buckets are addressed by path (ie. https://endpoint/bucket/key), which every S3 compatible storage supports.
+type S3 struct {
+	client    *http.Client
+	now       func() time.Time
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	endpoint  url.URL
+}
+
+// Error returned by an S3 compatible storage
+type S3Error struct {
+	Code       string
+	Message    string
+	StatusCode int
+}
+
+func (e S3Error) Error() string {
+	return fmt.Sprintf("s3 request failed with status %v: %v: %v", e.StatusCode, e.Code, e.Message)
+}
+
+// Create a new S3 store sending requests with `client`.
+func NewS3(client *http.Client, config *S3Config) (*S3, error) {
+	if config.Endpoint == nil || config.Endpoint.Host == "" {
+		return nil, errors.New("missing S3 endpoint")
+	}
+	if config.Bucket == "" {
+		return nil, errors.New("missing S3 bucket")
+	}
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return nil, errors.New("missing S3 credentials")
+	}
+	region := config.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return &S3{
+		client:    client,
+		now:       time.Now,
+		endpoint:  *config.Endpoint,
+		region:    region,
+		bucket:    config.Bucket,
+		accessKey: config.AccessKey,
+		secretKey: config.SecretKey,
+	}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.sign(req, data)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return readS3Error(resp)
+	}
+	return nil
+}
+
+func (s *S3) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, nil)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	}
+	return nil, readS3Error(resp)
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	s.sign(req, nil)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	// Some storages report missing objects instead of ignoring them
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+	return readS3Error(resp)
+}
+
+// Create an unsigned request for the object `key`
+func (s *S3) newRequest(ctx context.Context, method, key string, body []byte) (*http.Request, error) {
+	if key == "" {
+		return nil, errInvalidKey
+	}
+	reqURL := s.endpoint
+	reqURL.Path = strings.TrimSuffix(reqURL.Path, "/") + "/" + s.bucket + "/" + key
+	reqURL.RawPath = s3EscapePath(reqURL.Path)
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+	return req, nil
+}
+
+// Sign `req` with AWS Signature Version 4.
+//
+// Every header set on `req` is signed, along with its host.
+func (s *S3) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	payloadHash := sha256.Sum256(body)
+	req.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+
+	scope := now.Format(s3DateFormat) + "/" + s.region + "/" + s3Service + "/aws4_request"
+	stringToSign := s3Algorithm + "\n" + now.Format(s3TimeFormat) + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	key := []byte("AWS4" + s.secretKey)
+	for _, part := range []string{now.Format(s3DateFormat), s.region, s3Service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%v Credential=%v/%v,SignedHeaders=%v,Signature=%v",
+		s3Algorithm, s.accessKey, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// Escape `path` as S3 expects in signatures: every byte but unreserved characters and slashes is percent-encoded
+func s3EscapePath(path string) string {
+	var result strings.Builder
+	for _, b := range []byte(path) {
+		if b == '/' || b == '-' || b == '.' || b == '_' || b == '~' ||
+			('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9') {
+			result.WriteByte(b)
+		} else {
+			fmt.Fprintf(&result, "%%%02X", b)
+		}
+	}
+	return result.String()
+}
+
+// Returns the error described by an unsuccessful S3 response
+func readS3Error(resp *http.Response) error {
+	var apiError struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	_ = xml.NewDecoder(resp.Body).Decode(&apiError)
+	if apiError.Code == "" {
+		apiError.Code = resp.Status
+	}
+	return S3Error{
+		Code:       apiError.Code,
+		Message:    apiError.Message,
+		StatusCode: resp.StatusCode,
+	}
+}
@@ -3,6 +3,7 @@ package booking
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
 	"github.com/aarondl/opt/omit"
@@ -26,6 +27,14 @@ type EntryWithTimes struct {
 	EntryWithDetails
 }
 
+// A booking starting soon
+type Upcoming struct {
+	StartTime           time.Time // Start of the earliest booked time slot
+	ParkingSpotLocation models.ParkingSpotLocation
+	BookingID           uuid.UUID
+	BookerID            int64
+}
+
 type Filter struct {
 	SpotID int64 // The internal ID of a parking spot
 }
@@ -36,17 +45,39 @@ type Cursor struct {
 }
 
 type CreateInput struct {
-	BookedTimes []models.TimeUnit
-	UserID      int64
-	SpotID      int64
-	CarID       int64
-	PaidAmount  float64
+	// The normalized promo code requested with the quote, empty if none.
+	//
+	// Must be the promo code applied to the quote if set.
+	QuotePromoCode string
+	BookedTimes    []models.TimeUnit
+	UserID         int64
+	SpotID         int64
+	CarID          int64
+	PaidAmount     float64
+	// The internal ID of the promo code used, 0 if none.
+	//
+	// The usage limits of the promo code are enforced when creating the booking.
+	PromoCodeID    int64
+	DiscountAmount float64
+	PayoutAmount   float64
+	// The internal ID of the hold converted into this booking, 0 if none.
+	//
+	// Time slots held by others can not be booked.
+	HoldID int64
+	// The quote paid by this booking, uuid.Nil if none.
+	//
+	// The quote must have been issued to the user for the same spot and time slots, and is consumed
+	// with the booking. Its amounts and promo code replace the ones above.
+	QuoteID uuid.UUID
 }
 
 var (
-	ErrTimeAlreadyBooked = errors.New("one or more times is already booked")
-	ErrNotFound          = errors.New("no booking found")
-	ErrInvalidPaidAmount = errors.New("paid amount not valid")
+	ErrTimeAlreadyBooked  = errors.New("one or more times is already booked")
+	ErrNotFound           = errors.New("no booking found")
+	ErrInvalidPaidAmount  = errors.New("paid amount not valid")
+	ErrPromoCodeExhausted = errors.New("promo code usage limit reached")
+	ErrQuoteInvalid       = errors.New("quote not found or issued for another booking")
+	ErrPromoCodeMismatch  = errors.New("promo code does not match the quote")
 )
 
 type Repository interface {
@@ -54,4 +85,12 @@ type Repository interface {
 	GetByUUID(ctx context.Context, bookingID uuid.UUID) (EntryWithTimes, error)
 	GetManyForOwner(ctx context.Context, limit int, after omit.Val[Cursor], userID int64, filter *Filter) ([]EntryWithDetails, error)
 	GetManyForBuyer(ctx context.Context, limit int, after omit.Val[Cursor], userID int64, filter *Filter) ([]EntryWithDetails, error)
+	// Get the bookings starting after `after` and no later than `before` that were not reminded of yet.
+	//
+	// The returned bookings are marked as reminded at `now`, so concurrent callers never claim the same booking.
+	ClaimUpcoming(ctx context.Context, after, before, now time.Time) ([]Upcoming, error)
+	// Get at most `limit` bookings made by `userID` or of spots owned by `userID` with time slots ending after `after`.
+	//
+	// The bookings are ordered by their earliest time slot and include all of their booked times.
+	GetManyForCalendar(ctx context.Context, userID int64, after time.Time, limit int) ([]EntryWithTimes, error)
 }
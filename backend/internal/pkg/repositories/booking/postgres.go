@@ -5,10 +5,16 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbtype"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/availability"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/hold"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/outbox"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/quote"
 	"github.com/aarondl/opt/omit"
 	"github.com/aarondl/opt/omitnull"
 	"github.com/google/uuid"
@@ -57,28 +63,71 @@ func (p *PostgresRepository) Create(ctx context.Context, booking *CreateInput) (
 	}
 	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
 
+	// The quote is consumed with the booking, so it can not pay for another one
+	if booking.QuoteID != uuid.Nil {
+		booking, err = payQuote(ctx, tx, booking)
+		if err != nil {
+			return EntryWithTimes{}, err
+		}
+	}
+
 	paidAmount, err := decimal.NewFromFloat64(booking.PaidAmount)
 	if err != nil {
 		return EntryWithTimes{}, ErrInvalidPaidAmount
 	}
+	discountAmount, err := decimal.NewFromFloat64(booking.DiscountAmount)
+	if err != nil {
+		return EntryWithTimes{}, ErrInvalidPaidAmount
+	}
+	payoutAmount, err := decimal.NewFromFloat64(booking.PayoutAmount)
+	if err != nil {
+		return EntryWithTimes{}, ErrInvalidPaidAmount
+	}
+
+	setter := dbmodels.BookingSetter{
+		Userid:         omit.From(booking.UserID),
+		Parkingspotid:  omit.From(booking.SpotID),
+		Carid:          omit.From(booking.CarID),
+		Paidamount:     omit.From(paidAmount),
+		Discountamount: omit.From(discountAmount),
+		Payoutamount:   omit.From(payoutAmount),
+	}
+	if booking.PromoCodeID != 0 {
+		err = checkPromoCodeUses(ctx, tx, booking.PromoCodeID, booking.UserID)
+		if err != nil {
+			return EntryWithTimes{}, err
+		}
+		setter.Promocodeid = omitnull.From(booking.PromoCodeID)
+	}
 
-	inserted, err := dbmodels.Bookings.Insert(&dbmodels.BookingSetter{
-		Userid:        omit.From(booking.UserID),
-		Parkingspotid: omit.From(booking.SpotID),
-		Carid:         omit.From(booking.CarID),
-		Paidamount:    omit.From(paidAmount),
-	}).One(ctx, tx)
+	inserted, err := dbmodels.Bookings.Insert(&setter).One(ctx, tx)
 	if err != nil {
 		return EntryWithTimes{}, fmt.Errorf("could not execute insert: %w", err)
 	}
 
+	// Expired holds on the spot no longer block booking
+	_, err = hold.Release(
+		ctx,
+		tx,
+		dbmodels.SelectWhere.Holds.Parkingspotid.EQ(booking.SpotID),
+		dbmodels.SelectWhere.Holds.Expiresat.LTE(time.Now()),
+	)
+	if err != nil {
+		return EntryWithTimes{}, err
+	}
+
 	//--------Update the corresponding time slots--------
+	holdCondition := dbmodels.UpdateWhere.Timeunits.Holdid.IsNull()
+	if booking.HoldID != 0 {
+		holdCondition = psql.WhereOr(holdCondition, dbmodels.UpdateWhere.Timeunits.Holdid.EQ(booking.HoldID))
+	}
 	query := dbmodels.Timeunits.Update(
 		dbmodels.TimeunitSetter{
 			Bookingid: omitnull.From(inserted.Bookingid),
 		}.UpdateMod(),
 		psql.WhereAnd(
 			dbmodels.UpdateWhere.Timeunits.Bookingid.IsNull(),
+			holdCondition,
 			dbmodels.UpdateWhere.Timeunits.Parkingspotid.EQ(booking.SpotID),
 			um.Where(timeSlotsToSQLExpr(booking.BookedTimes)),
 		),
@@ -115,9 +164,12 @@ func (p *PostgresRepository) Create(ctx context.Context, booking *CreateInput) (
 		return EntryWithTimes{}, fmt.Errorf("could not get car and spot data: %w", err)
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return EntryWithTimes{}, fmt.Errorf("could not commit transaction: %w", err)
+	// The hold has been converted, release whatever was not booked
+	if booking.HoldID != 0 {
+		_, err = hold.Release(ctx, tx, dbmodels.SelectWhere.Holds.Holdid.EQ(booking.HoldID))
+		if err != nil {
+			return EntryWithTimes{}, err
+		}
 	}
 
 	lat, _ := related.R.ParkingspotidParkingspot.Latitude.Float64()
@@ -149,9 +201,84 @@ func (p *PostgresRepository) Create(ctx context.Context, booking *CreateInput) (
 		BookedTimes: bookedSlots,
 	}
 
+	availabilityEvent := models.AvailabilityEvent{
+		Type:      models.AvailabilityEventBooked,
+		Times:     bookedSlots,
+		Longitude: long,
+		Latitude:  lat,
+		SpotID:    related.R.ParkingspotidParkingspot.Parkingspotuuid,
+	}
+	err = outbox.Write(ctx, tx, models.EventAvailabilityChanged, &availabilityEvent)
+	if err != nil {
+		return EntryWithTimes{}, err
+	}
+	err = availability.Notify(ctx, tx, &availabilityEvent)
+	if err != nil {
+		return EntryWithTimes{}, err
+	}
+
+	err = outbox.Write(ctx, tx, models.EventBookingCreated, &models.BookingCreatedEvent{
+		Booking: models.BookingWithTimes{
+			Booking:     entry.Booking,
+			BookedTimes: entry.BookedTimes,
+		},
+		Location: entry.ParkingSpotLocation,
+		BookerID: entry.BookerID,
+		OwnerID:  related.R.ParkingspotidParkingspot.Userid,
+	})
+	if err != nil {
+		return EntryWithTimes{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return EntryWithTimes{}, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
 	return entry, nil
 }
 
+// Check that the promo code with `promoCodeID` can be used once more by `userID`.
+//
+// The promo code is locked until `tx` completes so concurrent bookings can not exceed its limits.
+func checkPromoCodeUses(ctx context.Context, tx bob.Tx, promoCodeID, userID int64) error {
+	promoCode, err := dbmodels.Promocodes.Query(
+		sm.Columns(dbmodels.PromocodeColumns.Maxuses, dbmodels.PromocodeColumns.Maxusesperuser),
+		dbmodels.SelectWhere.Promocodes.Promocodeid.EQ(promoCodeID),
+		sm.ForUpdate(),
+	).One(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("could not lock promo code: %w", err)
+	}
+
+	if promoCode.Maxuses > 0 {
+		uses, err := dbmodels.Bookings.Query(
+			dbmodels.SelectWhere.Bookings.Promocodeid.EQ(promoCodeID),
+		).Count(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not count promo code uses: %w", err)
+		}
+		if uses >= int64(promoCode.Maxuses) {
+			return ErrPromoCodeExhausted
+		}
+	}
+
+	if promoCode.Maxusesperuser > 0 {
+		uses, err := dbmodels.Bookings.Query(
+			dbmodels.SelectWhere.Bookings.Promocodeid.EQ(promoCodeID),
+			dbmodels.SelectWhere.Bookings.Userid.EQ(userID),
+		).Count(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not count promo code uses: %w", err)
+		}
+		if uses >= int64(promoCode.Maxusesperuser) {
+			return ErrPromoCodeExhausted
+		}
+	}
+
+	return nil
+}
+
 func timeSlotsToSQLExpr(units []models.TimeUnit) dialect.Expression {
 	var expression dialect.Expression
 	for _, bookTime := range units {
@@ -171,6 +298,30 @@ func timeSlotsToSQLExpr(units []models.TimeUnit) dialect.Expression {
 	return expression
 }
 
+// Returns `booking` with the amounts and promo code of its quote, consuming the quote within `tx`
+func payQuote(ctx context.Context, tx bob.Tx, booking *CreateInput) (*CreateInput, error) {
+	quoted, err := quote.Consume(ctx, tx, booking.QuoteID, booking.UserID, booking.SpotID, time.Now())
+	if err != nil {
+		if errors.Is(err, quote.ErrNotFound) {
+			err = ErrQuoteInvalid
+		}
+		return nil, err
+	}
+	if !quoted.MatchesTimes(booking.BookedTimes) {
+		return nil, ErrQuoteInvalid
+	}
+	if booking.QuotePromoCode != "" && booking.QuotePromoCode != quoted.PromoCode {
+		return nil, ErrPromoCodeMismatch
+	}
+
+	result := *booking
+	result.PaidAmount = quoted.Total
+	result.DiscountAmount = quoted.Discount
+	result.PayoutAmount = quoted.Payout
+	result.PromoCodeID = quoted.PromoCodeID
+	return &result, nil
+}
+
 func (p *PostgresRepository) GetByUUID(ctx context.Context, bookingID uuid.UUID) (EntryWithTimes, error) {
 	bookingResult, err := dbmodels.Bookings.Query(
 		dbmodels.SelectWhere.Bookings.Bookinguuid.EQ(bookingID),
@@ -411,6 +562,156 @@ func (p *PostgresRepository) GetManyForOwner(ctx context.Context, limit int, aft
 	return result, nil
 }
 
+func (p *PostgresRepository) ClaimUpcoming(ctx context.Context, after, before, now time.Time) ([]Upcoming, error) {
+	startTime := psql.F("min", psql.F("lower", dbmodels.TimeunitColumns.Timerange))
+	upcoming := psql.Select(
+		sm.Columns(dbmodels.TimeunitColumns.Bookingid),
+		sm.From(dbmodels.Timeunits.Name()),
+		sm.Where(dbmodels.TimeunitColumns.Bookingid.IsNotNull()),
+		sm.GroupBy(dbmodels.TimeunitColumns.Bookingid),
+		sm.Having(startTime().GT(psql.Arg(after))),
+		sm.Having(startTime().LTE(psql.Arg(before))),
+	)
+
+	claimed, err := dbmodels.Bookings.Update(
+		um.SetCol(dbmodels.ColumnNames.Bookings.Remindedat).ToArg(now),
+		um.From(upcoming).As("upcoming"),
+		dbmodels.UpdateWhere.Bookings.Remindedat.IsNull(),
+		um.Where(dbmodels.BookingColumns.Bookingid.EQ(psql.Quote("upcoming", dbmodels.ColumnNames.Timeunits.Bookingid))),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Upcoming{}, nil
+		}
+		return nil, err
+	}
+	if len(claimed) == 0 {
+		return []Upcoming{}, nil
+	}
+
+	err = claimed.LoadBookingParkingspotidParkingspot(ctx, p.db)
+	if err != nil {
+		return nil, err
+	}
+	err = claimed.LoadBookingBookingidTimeunits(ctx, p.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Upcoming, 0, len(claimed))
+	for _, model := range claimed {
+		spot := model.R.ParkingspotidParkingspot
+		lat, _ := spot.Latitude.Float64()
+		long, _ := spot.Longitude.Float64()
+
+		entry := Upcoming{
+			ParkingSpotLocation: models.ParkingSpotLocation{
+				PostalCode:    spot.Postalcode,
+				CountryCode:   spot.Countrycode,
+				StreetAddress: spot.Streetaddress,
+				State:         spot.State,
+				City:          spot.City,
+				Latitude:      lat,
+				Longitude:     long,
+			},
+			BookingID: model.Bookinguuid,
+			BookerID:  model.Userid,
+		}
+		for _, unit := range model.R.BookingidTimeunits {
+			if entry.StartTime.IsZero() || unit.Timerange.Start.Before(entry.StartTime) {
+				entry.StartTime = unit.Timerange.Start
+			}
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) GetManyForCalendar(ctx context.Context, userID int64, after time.Time, limit int) ([]EntryWithTimes, error) {
+	query := psql.Select(
+		sm.Columns(dbmodels.BookingColumns.Bookingid),
+		sm.From(dbmodels.Bookings.Name()),
+		dbmodels.SelectJoins.Bookings.InnerJoin.ParkingspotidParkingspot(ctx),
+		dbmodels.SelectJoins.Bookings.InnerJoin.BookingidTimeunits(ctx),
+		sm.Where(psql.Or(
+			dbmodels.BookingColumns.Userid.EQ(psql.Arg(userID)),
+			dbmodels.ParkingspotColumns.Userid.EQ(psql.Arg(userID)),
+		)),
+		sm.GroupBy(dbmodels.BookingColumns.Bookingid),
+		sm.Having(psql.F("max", psql.F("upper", dbmodels.TimeunitColumns.Timerange))().GT(psql.Arg(after))),
+		sm.OrderBy(psql.F("min", psql.F("lower", dbmodels.TimeunitColumns.Timerange))()),
+		sm.OrderBy(dbmodels.BookingColumns.Bookingid),
+		sm.Limit(limit),
+	)
+
+	ids, err := bob.All(ctx, p.db, query, scan.SingleColumnMapper[int64])
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []EntryWithTimes{}, nil
+	}
+
+	bookings, err := dbmodels.Bookings.Query(
+		dbmodels.SelectWhere.Bookings.Bookingid.In(ids...),
+		dbmodels.PreloadBookingParkingspotidParkingspot(),
+		dbmodels.PreloadBookingCaridCar(),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, err
+	}
+	err = bookings.LoadBookingBookingidTimeunits(ctx, p.db)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int64]*dbmodels.Booking, len(bookings))
+	for _, model := range bookings {
+		byID[model.Bookingid] = model
+	}
+
+	result := make([]EntryWithTimes, 0, len(ids))
+	for _, id := range ids {
+		model, ok := byID[id]
+		if !ok {
+			// Deleted since the IDs were queried
+			continue
+		}
+		spot := model.R.ParkingspotidParkingspot
+		car := model.R.CaridCar
+		lat, _ := spot.Latitude.Float64()
+		long, _ := spot.Longitude.Float64()
+
+		bookedTimes := timeUnitsFromDB(model.R.BookingidTimeunits)
+		slices.SortFunc(bookedTimes, func(a, b models.TimeUnit) int {
+			return a.StartTime.Compare(b.StartTime)
+		})
+
+		result = append(result, EntryWithTimes{
+			EntryWithDetails: EntryWithDetails{
+				Entry: formEntry(model, spot.Parkingspotuuid, car.Caruuid),
+				ParkingSpotLocation: models.ParkingSpotLocation{
+					PostalCode:    spot.Postalcode,
+					CountryCode:   spot.Countrycode,
+					StreetAddress: spot.Streetaddress,
+					State:         spot.State,
+					City:          spot.City,
+					Latitude:      lat,
+					Longitude:     long,
+				},
+				CarDetails: models.CarDetails{
+					Make:         car.Make,
+					Model:        car.Model,
+					LicensePlate: car.Licenseplate,
+					Color:        car.Color,
+				},
+			},
+			BookedTimes: bookedTimes,
+		})
+	}
+	return result, nil
+}
+
 func timeUnitsFromDB(model []*dbmodels.Timeunit) []models.TimeUnit {
 	result := make([]models.TimeUnit, 0, len(model))
 	for _, unit := range model {
@@ -421,14 +722,18 @@ func timeUnitsFromDB(model []*dbmodels.Timeunit) []models.TimeUnit {
 
 func formEntry(entry *dbmodels.Booking, spotUUID, carUUID uuid.UUID) Entry {
 	amount, _ := entry.Paidamount.Float64()
+	discount, _ := entry.Discountamount.Float64()
+	payout, _ := entry.Payoutamount.Float64()
 
 	return Entry{
 		Booking: models.Booking{
-			CreatedAt:     entry.Createdat,
-			PaidAmount:    amount,
-			ID:            entry.Bookinguuid,
-			ParkingSpotID: spotUUID,
-			CarID:         carUUID,
+			CreatedAt:      entry.Createdat,
+			PaidAmount:     amount,
+			DiscountAmount: discount,
+			PayoutAmount:   payout,
+			ID:             entry.Bookinguuid,
+			ParkingSpotID:  spotUUID,
+			CarID:          carUUID,
 		},
 		InternalID: entry.Bookingid,
 		BookerID:   entry.Userid,
@@ -439,6 +744,8 @@ func timeUnitFromDB(model *dbmodels.Timeunit) models.TimeUnit {
 	var status string
 	if _, ok := model.Bookingid.Get(); ok {
 		status = "booked"
+	} else if _, ok := model.Holdid.Get(); ok {
+		status = "held"
 	} else {
 		status = "available"
 	}
@@ -8,7 +8,10 @@ import (
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/car"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/hold"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/promocode"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/quote"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
 	"github.com/aarondl/opt/omit"
@@ -316,6 +319,164 @@ func TestPostgresIntegration(t *testing.T) {
 		}
 	})
 
+	t.Run("held times can only be booked by the holder", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		holdRepo := hold.NewPostgres(db)
+		holdEntry, err := holdRepo.Create(ctx, &hold.CreateInput{
+			ExpiresAt: time.Now().Add(time.Minute),
+			HeldTimes: sampleTimeUnit[0:2],
+			UserID:    userID_1,
+			SpotID:    parkingSpotEntry.InternalID,
+		})
+		require.NoError(t, err)
+
+		input := bookingCreationInput
+		input.UserID = userID
+		_, err = repo.Create(ctx, &input)
+		if assert.Error(t, err, "booking a time held by another user should fail") {
+			assert.ErrorIs(t, err, ErrTimeAlreadyBooked)
+		}
+
+		input.UserID = userID_1
+		input.CarID = carEntry_1.InternalID
+		input.HoldID = holdEntry.InternalID
+		_, err = repo.Create(ctx, &input)
+		require.NoError(t, err)
+
+		// The hold is consumed by the booking
+		_, err = holdRepo.GetByUUID(ctx, holdEntry.ID)
+		assert.ErrorIs(t, err, hold.ErrNotFound)
+	})
+
+	t.Run("expired holds do not block booking", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		holdRepo := hold.NewPostgres(db)
+		_, err := holdRepo.Create(ctx, &hold.CreateInput{
+			ExpiresAt: time.Now().Add(-time.Minute),
+			HeldTimes: sampleTimeUnit[0:2],
+			UserID:    userID_1,
+			SpotID:    parkingSpotEntry.InternalID,
+		})
+		require.NoError(t, err)
+
+		input := bookingCreationInput
+		input.UserID = userID
+		_, err = repo.Create(ctx, &input)
+		require.NoError(t, err)
+	})
+
+	t.Run("promo code usage limits are enforced", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		promoCodeRepo := promocode.NewPostgres(db)
+		promoCode, err := promoCodeRepo.Create(ctx, &promocode.CreateInput{
+			PromoCodeCreationInput: models.PromoCodeCreationInput{
+				Code:           "ONCE",
+				DiscountType:   models.DiscountFixed,
+				DiscountValue:  5,
+				MaxUsesPerUser: 1,
+			},
+		})
+		require.NoError(t, err, "could not create promo code")
+
+		bookingCreationInput := bookingCreationInput
+		bookingCreationInput.UserID = userID
+		bookingCreationInput.PromoCodeID = promoCode.InternalID
+		bookingCreationInput.DiscountAmount = 5
+		bookingCreationInput.PayoutAmount = 90
+		created, err := repo.Create(ctx, &bookingCreationInput)
+		require.NoError(t, err)
+		assert.InDelta(t, 5, created.Entry.DiscountAmount, 0)
+		assert.InDelta(t, 90, created.Entry.PayoutAmount, 0)
+
+		promoCode, err = promoCodeRepo.GetByUUID(ctx, promoCode.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), promoCode.Uses)
+
+		bookingCreationInput.BookedTimes = sampleTimeUnit[2:3]
+		_, err = repo.Create(ctx, &bookingCreationInput)
+		assert.ErrorIs(t, err, ErrPromoCodeExhausted)
+
+		// Other users can still use the code
+		bookingCreationInput.UserID = userID_1
+		_, err = repo.Create(ctx, &bookingCreationInput)
+		assert.NoError(t, err)
+	})
+
+	t.Run("quotes pay for a single booking", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		quoteRepo := quote.NewPostgres(db)
+		quoted := quote.Entry{
+			PromoCode:   "SAVE5",
+			ExpiresAt:   time.Now().Add(time.Minute),
+			BookedTimes: []models.TimeUnit{sampleTimeUnit[1], sampleTimeUnit[0]},
+			Total:       95,
+			Discount:    5,
+			Payout:      85,
+			UserID:      userID,
+			SpotID:      parkingSpotEntry.InternalID,
+			ID:          uuid.New(),
+		}
+		err := quoteRepo.Create(ctx, &quoted)
+		require.NoError(t, err)
+
+		input := bookingCreationInput
+		input.QuoteID = quoted.ID
+
+		// Quotes are only usable by the user they were issued to
+		input.UserID = userID_1
+		_, err = repo.Create(ctx, &input)
+		require.ErrorIs(t, err, ErrQuoteInvalid)
+
+		input.UserID = userID
+		input.QuotePromoCode = "OTHER"
+		_, err = repo.Create(ctx, &input)
+		require.ErrorIs(t, err, ErrPromoCodeMismatch)
+
+		// Failed bookings do not consume the quote
+		input.QuotePromoCode = quoted.PromoCode
+		created, err := repo.Create(ctx, &input)
+		require.NoError(t, err)
+		assert.InDelta(t, quoted.Total, created.Entry.PaidAmount, 0)
+		assert.InDelta(t, quoted.Discount, created.Entry.DiscountAmount, 0)
+		assert.InDelta(t, quoted.Payout, created.Entry.PayoutAmount, 0)
+
+		input.BookedTimes = sampleTimeUnit[2:3]
+		_, err = repo.Create(ctx, &input)
+		assert.ErrorIs(t, err, ErrQuoteInvalid)
+	})
+
 	t.Run("get many bookings for buyer and seller with cursor", func(t *testing.T) {
 		t.Cleanup(func() {
 			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
@@ -579,6 +740,134 @@ func TestPostgresIntegration(t *testing.T) {
 		})
 	})
 
+	t.Run("claim upcoming bookings", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+			pool.Reset()
+		})
+
+		first, err := repo.Create(ctx, &CreateInput{
+			BookedTimes: sampleTimeUnit[0:2],
+			UserID:      userID_1,
+			SpotID:      parkingSpotEntry.InternalID,
+			CarID:       carEntry_1.InternalID,
+			PaidAmount:  paidAmount,
+		})
+		require.NoError(t, err)
+		second, err := repo.Create(ctx, &CreateInput{
+			BookedTimes: sampleTimeUnit[4:6],
+			UserID:      userID_1,
+			SpotID:      parkingSpotEntry.InternalID,
+			CarID:       carEntry_1.InternalID,
+			PaidAmount:  paidAmount,
+		})
+		require.NoError(t, err)
+		later, err := repo.Create(ctx, &CreateInput{
+			BookedTimes: sampleTimeUnit_1[2:4],
+			UserID:      userID_1,
+			SpotID:      parkingSpotEntry_1.InternalID,
+			CarID:       carEntry_1.InternalID,
+			PaidAmount:  paidAmount_1,
+		})
+		require.NoError(t, err)
+
+		now := time.Date(2024, time.October, 21, 14, 0, 0, 0, time.UTC)
+		claimed, err := repo.ClaimUpcoming(ctx, now, now.Add(24*time.Hour), now)
+		require.NoError(t, err)
+		if assert.Len(t, claimed, 2) {
+			byID := make(map[uuid.UUID]Upcoming, len(claimed))
+			for _, entry := range claimed {
+				byID[entry.BookingID] = entry
+			}
+			if assert.Contains(t, byID, first.Entry.ID) {
+				assert.Equal(t, userID_1, byID[first.Entry.ID].BookerID)
+				assert.True(t, sampleTimeUnit[0].StartTime.Equal(byID[first.Entry.ID].StartTime))
+				assert.Empty(t, cmp.Diff(sampleLocation, byID[first.Entry.ID].ParkingSpotLocation))
+			}
+			if assert.Contains(t, byID, second.Entry.ID) {
+				assert.True(t, sampleTimeUnit[4].StartTime.Equal(byID[second.Entry.ID].StartTime))
+			}
+		}
+
+		// Bookings are only claimed once
+		claimed, err = repo.ClaimUpcoming(ctx, now, now.Add(24*time.Hour), now)
+		require.NoError(t, err)
+		assert.Empty(t, claimed)
+
+		claimed, err = repo.ClaimUpcoming(ctx, now, now.Add(48*time.Hour), now)
+		require.NoError(t, err)
+		if assert.Len(t, claimed, 1) {
+			assert.Equal(t, later.Entry.ID, claimed[0].BookingID)
+		}
+	})
+
+	t.Run("get bookings and leasings for calendar", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+			pool.Reset()
+		})
+
+		// Created out of order to check ordering by start time
+		later, err := repo.Create(ctx, &CreateInput{
+			BookedTimes: sampleTimeUnit[4:6],
+			UserID:      userID_1,
+			SpotID:      parkingSpotEntry.InternalID,
+			CarID:       carEntry_1.InternalID,
+			PaidAmount:  paidAmount,
+		})
+		require.NoError(t, err)
+		first, err := repo.Create(ctx, &CreateInput{
+			BookedTimes: sampleTimeUnit[0:2],
+			UserID:      userID_1,
+			SpotID:      parkingSpotEntry.InternalID,
+			CarID:       carEntry_1.InternalID,
+			PaidAmount:  paidAmount,
+		})
+		require.NoError(t, err)
+		own, err := repo.Create(ctx, &CreateInput{
+			BookedTimes: sampleTimeUnit_1[0:2],
+			UserID:      userID,
+			SpotID:      parkingSpotEntry_1.InternalID,
+			CarID:       carEntry.InternalID,
+			PaidAmount:  paidAmount_1,
+		})
+		require.NoError(t, err)
+
+		// During the first booking
+		now := time.Date(2024, time.October, 21, 15, 10, 0, 0, time.UTC)
+		entries, err := repo.GetManyForCalendar(ctx, userID_1, now, 10)
+		require.NoError(t, err)
+		if assert.Len(t, entries, 2) {
+			assert.Equal(t, first.Entry.ID, entries[0].Entry.ID)
+			assert.Equal(t, later.Entry.ID, entries[1].Entry.ID)
+			assert.Empty(t, cmp.Diff(first.BookedTimes, entries[0].BookedTimes))
+			assert.Empty(t, cmp.Diff(sampleLocation, entries[0].ParkingSpotLocation))
+			assert.Equal(t, sampleCarDetails[1], entries[0].CarDetails)
+		}
+
+		// The spot owner sees leasings along with their own bookings
+		entries, err = repo.GetManyForCalendar(ctx, userID, now, 10)
+		require.NoError(t, err)
+		if assert.Len(t, entries, 3) {
+			assert.Equal(t, first.Entry.ID, entries[0].Entry.ID)
+			assert.Equal(t, later.Entry.ID, entries[1].Entry.ID)
+			assert.Equal(t, own.Entry.ID, entries[2].Entry.ID)
+		}
+
+		entries, err = repo.GetManyForCalendar(ctx, userID, now, 1)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+
+		// Ended bookings are left out
+		entries, err = repo.GetManyForCalendar(ctx, userID_1, sampleTimeUnit[1].EndTime, 10)
+		require.NoError(t, err)
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, later.Entry.ID, entries[0].Entry.ID)
+		}
+	})
+
 	t.Run("GetByUUID - valid booking ID", func(t *testing.T) {
 		t.Cleanup(func() {
 			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
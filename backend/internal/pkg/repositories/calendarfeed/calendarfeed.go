@@ -0,0 +1,23 @@
+package calendarfeed
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type Entry struct {
+	CreatedAt time.Time
+	Token     string // The secret identifying the feed
+	UserID    int64  // The user whose bookings are in the feed
+}
+
+var ErrNotFound = errors.New("no calendar feed found")
+
+type Repository interface {
+	// Set the token of the feed of `userID` to `token`, creating the feed if needed
+	Upsert(ctx context.Context, userID int64, token string) (Entry, error)
+	GetByUser(ctx context.Context, userID int64) (Entry, error)
+	GetByToken(ctx context.Context, token string) (Entry, error)
+	DeleteByUser(ctx context.Context, userID int64) error
+}
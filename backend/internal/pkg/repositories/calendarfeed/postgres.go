@@ -0,0 +1,89 @@
+package calendarfeed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Upsert(ctx context.Context, userID int64, token string) (Entry, error) {
+	inserted, err := dbmodels.Calendarfeeds.Insert(
+		&dbmodels.CalendarfeedSetter{
+			Userid: omit.From(userID),
+			Token:  omit.From(token),
+		},
+		// Replacing the token invalidates the previous feed URL
+		im.OnConflict(dbmodels.ColumnNames.Calendarfeeds.Userid).DoUpdate(
+			im.SetExcluded(dbmodels.ColumnNames.Calendarfeeds.Token),
+			im.SetCol(dbmodels.ColumnNames.Calendarfeeds.Createdat).ToArg(time.Now()),
+		),
+	).One(ctx, p.db)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return entryFromDB(inserted), nil
+}
+
+func (p *PostgresRepository) GetByUser(ctx context.Context, userID int64) (Entry, error) {
+	result, err := dbmodels.FindCalendarfeed(ctx, p.db, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(result), nil
+}
+
+func (p *PostgresRepository) GetByToken(ctx context.Context, token string) (Entry, error) {
+	result, err := dbmodels.Calendarfeeds.Query(
+		dbmodels.SelectWhere.Calendarfeeds.Token.EQ(token),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(result), nil
+}
+
+func (p *PostgresRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	deleted, err := dbmodels.Calendarfeeds.Delete(
+		dbmodels.DeleteWhere.Calendarfeeds.Userid.EQ(userID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func entryFromDB(model *dbmodels.Calendarfeed) Entry {
+	return Entry{
+		CreatedAt: model.Createdat,
+		Token:     model.Token,
+		UserID:    model.Userid,
+	}
+}
@@ -0,0 +1,91 @@
+package calendarfeed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+
+	profile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	authID, _ := authRepo.Create(ctx, profile.Email, models.HashedPassword("some hash"))
+	userID, _ := userRepo.Create(ctx, authID, profile)
+
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	t.Run("create, reset & delete", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		_, err := repo.GetByUser(ctx, userID)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		created, err := repo.Upsert(ctx, userID, "first")
+		require.NoError(t, err)
+		assert.Equal(t, "first", created.Token)
+		assert.Equal(t, userID, created.UserID)
+
+		got, err := repo.GetByToken(ctx, "first")
+		require.NoError(t, err)
+		assert.Equal(t, created, got)
+
+		// Resetting the feed replaces the token
+		reset, err := repo.Upsert(ctx, userID, "second")
+		require.NoError(t, err)
+		assert.Equal(t, "second", reset.Token)
+
+		_, err = repo.GetByToken(ctx, "first")
+		require.ErrorIs(t, err, ErrNotFound)
+		got, err = repo.GetByUser(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, reset, got)
+
+		err = repo.DeleteByUser(ctx, userID)
+		require.NoError(t, err)
+		err = repo.DeleteByUser(ctx, userID)
+		require.ErrorIs(t, err, ErrNotFound)
+		_, err = repo.GetByToken(ctx, "second")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,39 @@
+package calendarimport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	CreatedAt    time.Time
+	NextSyncAt   time.Time                    // When the calendar is polled next
+	LastSyncedAt time.Time                    // When the calendar was last polled, zero if never
+	LastResult   *models.CalendarImportResult // Outcome of the last successful poll, nil if none
+	URL          string
+	LastError    string // Why the last poll failed, empty if it succeeded
+	SpotID       int64  // The internal ID of the spot whose availability is blocked
+	SpotUUID     uuid.UUID
+}
+
+var ErrNotFound = errors.New("no calendar import found")
+
+type Repository interface {
+	// Poll `url` for the spot `spotID` from `next` on, replacing the URL of any existing import
+	Upsert(ctx context.Context, spotID int64, url string, next time.Time) (Entry, error)
+	GetBySpot(ctx context.Context, spotID int64) (Entry, error)
+	DeleteBySpot(ctx context.Context, spotID int64) error
+	// Get at most `limit` imports due to be polled by `now`, the longest due first.
+	//
+	// The returned imports are postponed to `leaseUntil`, so concurrent callers never claim the same import,
+	// and imports interrupted while polling are polled again.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]Entry, error)
+	// Record a poll of the import of `spotID` at `syncedAt`, to be polled again at `next`.
+	//
+	// `result` is the outcome of a successful poll, `syncErr` why an unsuccessful poll failed.
+	RecordSync(ctx context.Context, spotID int64, syncedAt, next time.Time, result *models.CalendarImportResult, syncErr string) error
+}
@@ -0,0 +1,188 @@
+package calendarimport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Upsert(ctx context.Context, spotID int64, url string, next time.Time) (Entry, error) {
+	inserted, err := dbmodels.Calendarimports.Insert(
+		&dbmodels.CalendarimportSetter{
+			Parkingspotid: omit.From(spotID),
+			URL:           omit.From(url),
+			Nextsyncat:    omit.From(next),
+		},
+		im.OnConflict(dbmodels.ColumnNames.Calendarimports.Parkingspotid).DoUpdate(
+			im.SetExcluded(
+				dbmodels.ColumnNames.Calendarimports.URL,
+				dbmodels.ColumnNames.Calendarimports.Nextsyncat,
+			),
+		),
+	).One(ctx, p.db)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	err = inserted.LoadCalendarimportParkingspotidParkingspot(ctx, p.db)
+	if err != nil {
+		return Entry{}, err
+	}
+	return entryFromDB(inserted)
+}
+
+func (p *PostgresRepository) GetBySpot(ctx context.Context, spotID int64) (Entry, error) {
+	result, err := dbmodels.Calendarimports.Query(
+		dbmodels.SelectWhere.Calendarimports.Parkingspotid.EQ(spotID),
+		dbmodels.PreloadCalendarimportParkingspotidParkingspot(),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(result)
+}
+
+func (p *PostgresRepository) DeleteBySpot(ctx context.Context, spotID int64) error {
+	deleted, err := dbmodels.Calendarimports.Delete(
+		dbmodels.DeleteWhere.Calendarimports.Parkingspotid.EQ(spotID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgresRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]Entry, error) {
+	due := psql.Select(
+		sm.Columns(dbmodels.CalendarimportColumns.Parkingspotid),
+		sm.From(dbmodels.Calendarimports.Name()),
+		sm.Where(dbmodels.CalendarimportColumns.Nextsyncat.LTE(psql.Arg(now))),
+		sm.OrderBy(dbmodels.CalendarimportColumns.Nextsyncat),
+		sm.Limit(limit),
+		sm.ForUpdate().SkipLocked(),
+	)
+
+	claimed, err := dbmodels.Calendarimports.Update(
+		um.SetCol(dbmodels.ColumnNames.Calendarimports.Nextsyncat).ToArg(leaseUntil),
+		um.From(due).As("due"),
+		um.Where(dbmodels.CalendarimportColumns.Parkingspotid.EQ(
+			psql.Quote("due", dbmodels.ColumnNames.Calendarimports.Parkingspotid),
+		)),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+	if len(claimed) == 0 {
+		return []Entry{}, nil
+	}
+
+	err = claimed.LoadCalendarimportParkingspotidParkingspot(ctx, p.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(claimed))
+	for _, model := range claimed {
+		entry, err := entryFromDB(model)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry)
+	}
+	// The update does not preserve the order of the subquery, poll the least recently polled first
+	slices.SortFunc(result, func(a, b Entry) int {
+		return a.LastSyncedAt.Compare(b.LastSyncedAt)
+	})
+	return result, nil
+}
+
+func (p *PostgresRepository) RecordSync(
+	ctx context.Context,
+	spotID int64,
+	syncedAt, next time.Time,
+	result *models.CalendarImportResult,
+	syncErr string,
+) error {
+	setter := dbmodels.CalendarimportSetter{
+		Nextsyncat:   omit.From(next),
+		Lastsyncedat: omitnull.From(syncedAt),
+		Lasterror:    omitnull.FromNull(null.FromCond(syncErr, syncErr != "")),
+	}
+	if result != nil {
+		encoded, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("could not encode calendar import result: %w", err)
+		}
+		setter.Lastresult = omitnull.From(string(encoded))
+	}
+
+	updated, err := dbmodels.Calendarimports.Update(
+		setter.UpdateMod(),
+		dbmodels.UpdateWhere.Calendarimports.Parkingspotid.EQ(spotID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func entryFromDB(model *dbmodels.Calendarimport) (Entry, error) {
+	result := Entry{
+		CreatedAt:    model.Createdat,
+		NextSyncAt:   model.Nextsyncat,
+		LastSyncedAt: model.Lastsyncedat.GetOrZero(),
+		URL:          model.URL,
+		LastError:    model.Lasterror.GetOrZero(),
+		SpotID:       model.Parkingspotid,
+	}
+	if model.R.ParkingspotidParkingspot != nil {
+		result.SpotUUID = model.R.ParkingspotidParkingspot.Parkingspotuuid
+	}
+	if encoded, ok := model.Lastresult.Get(); ok {
+		var lastResult models.CalendarImportResult
+		err := json.Unmarshal([]byte(encoded), &lastResult)
+		if err != nil {
+			return Entry{}, fmt.Errorf("could not decode calendar import result: %w", err)
+		}
+		result.LastResult = &lastResult
+	}
+	return result, nil
+}
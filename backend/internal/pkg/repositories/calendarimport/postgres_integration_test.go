@@ -0,0 +1,165 @@
+package calendarimport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+	spotRepo := parkingspot.NewPostgres(db)
+
+	profile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	authID, _ := authRepo.Create(ctx, profile.Email, models.HashedPassword("some hash"))
+	userID, _ := userRepo.Create(ctx, authID, profile)
+
+	spot, _, err := spotRepo.Create(ctx, userID, &models.ParkingSpotCreationInput{
+		Location: models.ParkingSpotLocation{
+			PostalCode:    "L2E6T2",
+			CountryCode:   "CA",
+			City:          "Niagara Falls",
+			StreetAddress: "5 Niagara Parkway",
+			State:         "ON",
+			Latitude:      43.07923,
+			Longitude:     -79.07887,
+		},
+		PricePerHour: 10.5,
+	})
+	require.NoError(t, err)
+
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	t.Run("create, replace & delete", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		_, err := repo.GetBySpot(ctx, spot.InternalID)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		created, err := repo.Upsert(ctx, spot.InternalID, "https://example.com/first.ics", now)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/first.ics", created.URL)
+		assert.Equal(t, spot.InternalID, created.SpotID)
+		assert.Equal(t, spot.ID, created.SpotUUID)
+		assert.True(t, created.LastSyncedAt.IsZero())
+		assert.Nil(t, created.LastResult)
+
+		replaced, err := repo.Upsert(ctx, spot.InternalID, "https://example.com/second.ics", now)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/second.ics", replaced.URL)
+
+		got, err := repo.GetBySpot(ctx, spot.InternalID)
+		require.NoError(t, err)
+		assert.Equal(t, replaced, got)
+
+		err = repo.DeleteBySpot(ctx, spot.InternalID)
+		require.NoError(t, err)
+		err = repo.DeleteBySpot(ctx, spot.InternalID)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("claim & record", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		_, err := repo.Upsert(ctx, spot.InternalID, "https://example.com/cal.ics", now)
+		require.NoError(t, err)
+
+		claimed, err := repo.ClaimDue(ctx, now, now.Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, spot.ID, claimed[0].SpotUUID)
+		assert.WithinDuration(t, now.Add(time.Hour), claimed[0].NextSyncAt, time.Second)
+
+		// Claimed imports are leased
+		claimed, err = repo.ClaimDue(ctx, now, now.Add(time.Hour), 10)
+		require.NoError(t, err)
+		assert.Empty(t, claimed)
+
+		result := models.CalendarImportResult{
+			Removed: []models.TimeUnit{
+				{
+					StartTime: now,
+					EndTime:   now.Add(30 * time.Minute),
+				},
+			},
+			Conflicts: []models.CalendarImportConflict{},
+			Events:    1,
+		}
+		err = repo.RecordSync(ctx, spot.InternalID, now, now.Add(15*time.Minute), &result, "")
+		require.NoError(t, err)
+
+		got, err := repo.GetBySpot(ctx, spot.InternalID)
+		require.NoError(t, err)
+		assert.WithinDuration(t, now, got.LastSyncedAt, time.Second)
+		assert.Empty(t, got.LastError)
+		require.NotNil(t, got.LastResult)
+		assert.Equal(t, 1, got.LastResult.Events)
+		require.Len(t, got.LastResult.Removed, 1)
+
+		// A failed poll keeps the last result
+		err = repo.RecordSync(ctx, spot.InternalID, now, now.Add(15*time.Minute), nil, "not found")
+		require.NoError(t, err)
+		got, err = repo.GetBySpot(ctx, spot.InternalID)
+		require.NoError(t, err)
+		assert.Equal(t, "not found", got.LastError)
+		assert.NotNil(t, got.LastResult)
+
+		claimed, err = repo.ClaimDue(ctx, now.Add(15*time.Minute), now.Add(time.Hour), 10)
+		require.NoError(t, err)
+		assert.Len(t, claimed, 1)
+
+		err = repo.RecordSync(ctx, -1, now, now, nil, "")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -3,13 +3,17 @@ package car
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
 	"github.com/aarondl/opt/omit"
 	"github.com/google/uuid"
 )
 
-var ErrNotFound = errors.New("no car found")
+var (
+	ErrNotFound       = errors.New("no car found")
+	ErrDuplicatePlate = errors.New("license plate of the jurisdiction already registered by the user")
+)
 
 type Entry struct {
 	models.Car
@@ -30,3 +34,10 @@ type Repository interface {
 	DeleteByUUID(ctx context.Context, carID uuid.UUID) error
 	UpdateByUUID(ctx context.Context, carID uuid.UUID, car *models.CarCreationInput) (Entry, error)
 }
+
+// Returns the canonical form of `plate`, as stored in the repository.
+//
+// Plates are case-insensitive, and spaces and dashes are only decoration.
+func NormalizeLicensePlate(plate string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(plate))
+}
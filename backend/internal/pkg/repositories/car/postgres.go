@@ -10,8 +10,11 @@ import (
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
 	"github.com/aarondl/opt/omit"
 	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
 )
@@ -27,22 +30,54 @@ func NewPostgres(db bob.DB) *PostgresRepository {
 }
 
 func (p *PostgresRepository) Create(ctx context.Context, userID int64, car *models.CarCreationInput) (int64, Entry, error) {
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return -1, Entry{}, fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
+
+	err = checkUnknownJurisdiction(ctx, tx, userID, uuid.Nil, car)
+	if err != nil {
+		return -1, Entry{}, err
+	}
+
 	inserted, err := dbmodels.Cars.Insert(&dbmodels.CarSetter{
 		Userid:       omit.From(userID),
 		Licenseplate: omit.From(car.LicensePlate),
+		Countrycode:  omit.From(car.CountryCode),
+		State:        omit.From(car.State),
 		Make:         omit.From(car.Make),
 		Model:        omit.From(car.Model),
 		Color:        omit.From(car.Color),
-	}).One(ctx, p.db)
+		Vehicleclass: omit.From(car.Class),
+		Height:       omit.From(car.Height),
+		Length:       omit.From(car.Length),
+		Width:        omit.From(car.Width),
+	}).One(ctx, tx)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return -1, Entry{}, ErrDuplicatePlate
+		}
+		return -1, Entry{}, fmt.Errorf("could not execute insert: %w", err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return -1, Entry{}, fmt.Errorf("could not commit transaction: %w", err)
 	}
 
 	details := models.CarDetails{
 		LicensePlate: inserted.Licenseplate,
+		CountryCode:  inserted.Countrycode,
+		State:        inserted.State,
 		Make:         inserted.Make,
 		Model:        inserted.Model,
 		Color:        inserted.Color,
+		Class:        inserted.Vehicleclass,
+		Height:       inserted.Height,
+		Length:       inserted.Length,
+		Width:        inserted.Width,
 	}
 
 	insertedCar := models.Car{
@@ -75,28 +110,65 @@ func (p *PostgresRepository) DeleteByUUID(ctx context.Context, carID uuid.UUID)
 }
 
 func (p *PostgresRepository) UpdateByUUID(ctx context.Context, carID uuid.UUID, car *models.CarCreationInput) (Entry, error) {
+	ownerID, err := p.GetOwnerByUUID(ctx, carID)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
+
+	err = checkUnknownJurisdiction(ctx, tx, ownerID, carID, car)
+	if err != nil {
+		return Entry{}, err
+	}
+
 	result, err := dbmodels.Cars.Update(
 		dbmodels.UpdateWhere.Cars.Caruuid.EQ(carID),
 		dbmodels.CarSetter{
 			Licenseplate: omit.From(car.LicensePlate),
+			Countrycode:  omit.From(car.CountryCode),
+			State:        omit.From(car.State),
 			Make:         omit.From(car.Make),
 			Model:        omit.From(car.Model),
 			Color:        omit.From(car.Color),
+			Vehicleclass: omit.From(car.Class),
+			Height:       omit.From(car.Height),
+			Length:       omit.From(car.Length),
+			Width:        omit.From(car.Width),
 		}.UpdateMod(),
 		um.Returning(dbmodels.Cars.Columns()),
-	).One(ctx, p.db)
+	).One(ctx, tx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Entry{}, ErrNotFound
 		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return Entry{}, ErrDuplicatePlate
+		}
 		return Entry{}, fmt.Errorf("could not execute update: %w", err)
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
 	details := models.CarDetails{
 		LicensePlate: result.Licenseplate,
+		CountryCode:  result.Countrycode,
+		State:        result.State,
 		Make:         result.Make,
 		Model:        result.Model,
 		Color:        result.Color,
+		Class:        result.Vehicleclass,
+		Height:       result.Height,
+		Length:       result.Length,
+		Width:        result.Width,
 	}
 
 	return Entry{
@@ -113,9 +185,15 @@ func (p *PostgresRepository) GetByUUID(ctx context.Context, carID uuid.UUID) (En
 	result, err := dbmodels.Cars.Query(
 		sm.Columns(
 			dbmodels.CarColumns.Licenseplate,
+			dbmodels.CarColumns.Countrycode,
+			dbmodels.CarColumns.State,
 			dbmodels.CarColumns.Make,
 			dbmodels.CarColumns.Model,
 			dbmodels.CarColumns.Color,
+			dbmodels.CarColumns.Vehicleclass,
+			dbmodels.CarColumns.Height,
+			dbmodels.CarColumns.Length,
+			dbmodels.CarColumns.Width,
 			dbmodels.CarColumns.Carid,
 			dbmodels.CarColumns.Userid,
 		),
@@ -130,9 +208,15 @@ func (p *PostgresRepository) GetByUUID(ctx context.Context, carID uuid.UUID) (En
 
 	details := models.CarDetails{
 		LicensePlate: result.Licenseplate,
+		CountryCode:  result.Countrycode,
+		State:        result.State,
 		Make:         result.Make,
 		Model:        result.Model,
 		Color:        result.Color,
+		Class:        result.Vehicleclass,
+		Height:       result.Height,
+		Length:       result.Length,
+		Width:        result.Width,
 	}
 
 	car := models.Car{
@@ -194,9 +278,15 @@ func (p *PostgresRepository) GetMany(ctx context.Context, userID int64, limit in
 			Car: models.Car{
 				Details: models.CarDetails{
 					LicensePlate: dbCar.Licenseplate,
+					CountryCode:  dbCar.Countrycode,
+					State:        dbCar.State,
 					Make:         dbCar.Make,
 					Model:        dbCar.Model,
 					Color:        dbCar.Color,
+					Class:        dbCar.Vehicleclass,
+					Height:       dbCar.Height,
+					Length:       dbCar.Length,
+					Width:        dbCar.Width,
 				},
 				ID: dbCar.Caruuid,
 			},
@@ -206,3 +296,37 @@ func (p *PostgresRepository) GetMany(ctx context.Context, userID int64, limit in
 	}
 	return result, nil
 }
+
+// Returns ErrDuplicatePlate if `car` and a car of `userID` other than `carID` have the same plate, and
+// either of them has no jurisdiction.
+//
+// The jurisdiction is optional, and plates without one may have been issued by any jurisdiction. The
+// unique index only covers plates of the same jurisdiction.
+func checkUnknownJurisdiction(ctx context.Context, tx bob.Tx, userID int64, carID uuid.UUID, car *models.CarCreationInput) error {
+	// Cars of the user are registered one at a time, so that concurrent registrations see each other
+	_, err := dbmodels.Users.Query(
+		dbmodels.SelectWhere.Users.Userid.EQ(userID),
+		sm.ForNoKeyUpdate(),
+	).One(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("could not lock user: %w", err)
+	}
+
+	where := []bob.Mod[*dialect.SelectQuery]{
+		dbmodels.SelectWhere.Cars.Userid.EQ(userID),
+		dbmodels.SelectWhere.Cars.Licenseplate.EQ(car.LicensePlate),
+		dbmodels.SelectWhere.Cars.Caruuid.NE(carID),
+	}
+	if car.CountryCode != "" {
+		where = append(where, dbmodels.SelectWhere.Cars.Countrycode.EQ(""))
+	}
+
+	exists, err := dbmodels.Cars.Query(where...).Exists(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("could not check registered plates: %w", err)
+	}
+	if exists {
+		return ErrDuplicatePlate
+	}
+	return nil
+}
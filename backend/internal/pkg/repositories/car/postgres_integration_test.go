@@ -127,6 +127,93 @@ func TestPostgresIntegration(t *testing.T) {
 		}
 	})
 
+	t.Run("duplicate license plate", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		input := models.CarCreationInput{
+			CarDetails: models.CarDetails{
+				LicensePlate: "HTV678",
+				CountryCode:  "CA",
+				State:        "ON",
+				Make:         "Honda",
+				Model:        "Civic",
+				Color:        "Blue",
+			},
+		}
+		_, _, err := repo.Create(ctx, userID, &input)
+		require.NoError(t, err)
+
+		_, _, err = repo.Create(ctx, userID, &input)
+		require.ErrorIs(t, err, ErrDuplicatePlate)
+
+		otherInput := input
+		otherInput.LicensePlate = "ABC123"
+		_, otherEntry, err := repo.Create(ctx, userID, &otherInput)
+		require.NoError(t, err)
+
+		_, err = repo.UpdateByUUID(ctx, otherEntry.ID, &input)
+		require.ErrorIs(t, err, ErrDuplicatePlate)
+
+		// The same plate can be issued by another jurisdiction
+		otherInput = input
+		otherInput.State = "MB"
+		_, _, err = repo.Create(ctx, userID, &otherInput)
+		require.NoError(t, err)
+	})
+
+	t.Run("license plates without a jurisdiction", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		legacyInput := models.CarCreationInput{
+			CarDetails: models.CarDetails{
+				LicensePlate: "HTV678",
+				Make:         "Honda",
+				Model:        "Civic",
+				Color:        "Blue",
+			},
+		}
+		_, legacyEntry, err := repo.Create(ctx, userID, &legacyInput)
+		require.NoError(t, err)
+
+		input := legacyInput
+		input.CountryCode = "CA"
+		input.State = "MB"
+		_, _, err = repo.Create(ctx, userID, &input)
+		require.ErrorIs(t, err, ErrDuplicatePlate)
+
+		otherInput := input
+		otherInput.LicensePlate = "ABC123"
+		_, otherEntry, err := repo.Create(ctx, userID, &otherInput)
+		require.NoError(t, err)
+		_, err = repo.UpdateByUUID(ctx, otherEntry.ID, &input)
+		require.ErrorIs(t, err, ErrDuplicatePlate)
+
+		// Plates without a jurisdiction conflict with any jurisdiction
+		otherInput = legacyInput
+		otherInput.LicensePlate = "ABC123"
+		_, err = repo.UpdateByUUID(ctx, legacyEntry.ID, &otherInput)
+		require.ErrorIs(t, err, ErrDuplicatePlate)
+
+		// The jurisdiction of the car itself can be added
+		updated, err := repo.UpdateByUUID(ctx, legacyEntry.ID, &input)
+		require.NoError(t, err)
+		assert.Equal(t, "MB", updated.Details.State)
+	})
+
 	t.Run("get non-existent", func(t *testing.T) {
 		_, err := repo.GetByUUID(ctx, uuid.Nil)
 		if assert.Error(t, err) {
@@ -0,0 +1,36 @@
+package device
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	Token string // The push token of the device
+	models.Device
+	InternalID int64 // The internal ID of this device
+	UserID     int64 // The user receiving notifications on this device
+}
+
+type CreateInput struct {
+	models.DeviceInput
+	UserID int64
+}
+
+var ErrNotFound = errors.New("no device found")
+
+type Repository interface {
+	// Register a device for `input.UserID`.
+	//
+	// If the token is already registered, the existing device is moved to `input.UserID`.
+	Create(ctx context.Context, input *CreateInput) (Entry, error)
+	GetByUUID(ctx context.Context, deviceID uuid.UUID) (Entry, error)
+	// Get all devices of `userID`, newest first
+	GetMany(ctx context.Context, userID int64) ([]Entry, error)
+	DeleteByUUID(ctx context.Context, deviceID uuid.UUID) error
+	// Delete the device with the push token `token`
+	DeleteByToken(ctx context.Context, token string) error
+}
@@ -0,0 +1,118 @@
+package device
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, input *CreateInput) (Entry, error) {
+	inserted, err := dbmodels.Devices.Insert(
+		&dbmodels.DeviceSetter{
+			Userid:   omit.From(input.UserID),
+			Platform: omit.From(input.Platform),
+			Token:    omit.From(input.Token),
+		},
+		// Tokens identify a device, so registering it again hands it over to the new user
+		im.OnConflict(dbmodels.ColumnNames.Devices.Token).DoUpdate(
+			im.SetExcluded(dbmodels.ColumnNames.Devices.Userid, dbmodels.ColumnNames.Devices.Platform),
+			im.SetCol(dbmodels.ColumnNames.Devices.Createdat).ToArg(time.Now()),
+		),
+	).One(ctx, p.db)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return entryFromDB(inserted), nil
+}
+
+func (p *PostgresRepository) GetByUUID(ctx context.Context, deviceID uuid.UUID) (Entry, error) {
+	result, err := dbmodels.Devices.Query(
+		dbmodels.SelectWhere.Devices.Deviceuuid.EQ(deviceID),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(result), nil
+}
+
+func (p *PostgresRepository) GetMany(ctx context.Context, userID int64) ([]Entry, error) {
+	devices, err := dbmodels.Devices.Query(
+		dbmodels.SelectWhere.Devices.Userid.EQ(userID),
+		sm.OrderBy(dbmodels.DeviceColumns.Deviceid).Desc(),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(devices))
+	for _, model := range devices {
+		result = append(result, entryFromDB(model))
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) DeleteByUUID(ctx context.Context, deviceID uuid.UUID) error {
+	deleted, err := dbmodels.Devices.Delete(
+		dbmodels.DeleteWhere.Devices.Deviceuuid.EQ(deviceID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgresRepository) DeleteByToken(ctx context.Context, token string) error {
+	deleted, err := dbmodels.Devices.Delete(
+		dbmodels.DeleteWhere.Devices.Token.EQ(token),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func entryFromDB(model *dbmodels.Device) Entry {
+	return Entry{
+		Device: models.Device{
+			CreatedAt: model.Createdat,
+			Platform:  model.Platform,
+			ID:        model.Deviceuuid,
+		},
+		Token:      model.Token,
+		InternalID: model.Deviceid,
+		UserID:     model.Userid,
+	}
+}
@@ -0,0 +1,139 @@
+package device
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+
+	profile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	otherProfile := models.UserProfile{
+		FullName: "John Smith",
+		Email:    "j.smith@gmail.com",
+	}
+	userAuth, _ := authRepo.Create(ctx, profile.Email, models.HashedPassword("some hash"))
+	otherAuth, _ := authRepo.Create(ctx, otherProfile.Email, models.HashedPassword("some other hash"))
+	userID, _ := userRepo.Create(ctx, userAuth, profile)
+	otherID, _ := userRepo.Create(ctx, otherAuth, otherProfile)
+
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	t.Run("create, get & delete", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		created, err := repo.Create(ctx, &CreateInput{
+			DeviceInput: models.DeviceInput{
+				Platform: models.DevicePlatformAndroid,
+				Token:    "android-token",
+			},
+			UserID: userID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, userID, created.UserID)
+		assert.Equal(t, "android-token", created.Token)
+		assert.Equal(t, models.DevicePlatformAndroid, created.Platform)
+		assert.NotEqual(t, uuid.Nil, created.ID)
+
+		got, err := repo.GetByUUID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.InternalID, got.InternalID)
+
+		_, err = repo.Create(ctx, &CreateInput{
+			DeviceInput: models.DeviceInput{
+				Platform: models.DevicePlatformIOS,
+				Token:    "ios-token",
+			},
+			UserID: userID,
+		})
+		require.NoError(t, err)
+
+		devices, err := repo.GetMany(ctx, userID)
+		require.NoError(t, err)
+		if assert.Len(t, devices, 2) {
+			assert.Equal(t, "ios-token", devices[0].Token)
+			assert.Equal(t, "android-token", devices[1].Token)
+		}
+
+		err = repo.DeleteByUUID(ctx, created.ID)
+		require.NoError(t, err)
+		_, err = repo.GetByUUID(ctx, created.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+		err = repo.DeleteByUUID(ctx, created.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		err = repo.DeleteByToken(ctx, "ios-token")
+		require.NoError(t, err)
+		err = repo.DeleteByToken(ctx, "ios-token")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("registering a known token moves the device", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+			pool.Reset()
+		})
+
+		input := models.DeviceInput{
+			Platform: models.DevicePlatformIOS,
+			Token:    "shared-token",
+		}
+		first, err := repo.Create(ctx, &CreateInput{DeviceInput: input, UserID: userID})
+		require.NoError(t, err)
+		second, err := repo.Create(ctx, &CreateInput{DeviceInput: input, UserID: otherID})
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, second.ID)
+		assert.Equal(t, otherID, second.UserID)
+
+		devices, err := repo.GetMany(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, devices)
+		devices, err = repo.GetMany(ctx, otherID)
+		require.NoError(t, err)
+		assert.Len(t, devices, 1)
+	})
+}
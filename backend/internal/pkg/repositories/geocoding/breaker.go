@@ -0,0 +1,94 @@
+package geocoding
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrCircuitOpen = errors.New("geocoder is unavailable after repeated failures")
+
+// CircuitBreaker is a Geocoder that stops calling another Geocoder while it keeps failing.
+//
+// Only temporary errors count as failures, errors caused by the request are returned as is. After
+// `threshold` consecutive failures, requests fail with `ErrCircuitOpen` without calling the
+// wrapped Geocoder. Once `cooldown` passed, a single request is let through: the circuit closes again
+// if it succeeds, and stays open for another `cooldown` otherwise.
+type CircuitBreaker struct {
+	openedAt  time.Time
+	next      Geocoder
+	now       func() time.Time
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	mu        sync.Mutex
+	probing   bool // Whether a request is let through to check if the Geocoder recovered
+}
+
+func NewCircuitBreaker(next Geocoder, threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		next:      next,
+		now:       time.Now,
+		threshold: max(threshold, 1),
+		cooldown:  cooldown,
+	}
+}
+
+func (b *CircuitBreaker) Geocode(ctx context.Context, address *Address) ([]Result, error) {
+	return b.do(ctx, geocodeRequest(address))
+}
+
+func (b *CircuitBreaker) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]Result, error) {
+	return b.do(ctx, reverseGeocodeRequest(latitude, longitude))
+}
+
+func (b *CircuitBreaker) Suggest(ctx context.Context, query string) ([]Result, error) {
+	return b.do(ctx, suggestRequest(query))
+}
+
+func (b *CircuitBreaker) Search(ctx context.Context, query string) ([]Result, error) {
+	return b.do(ctx, searchRequest(query))
+}
+
+func (b *CircuitBreaker) do(ctx context.Context, req request) ([]Result, error) {
+	if !b.allow() {
+		return nil, ErrCircuitOpen
+	}
+
+	result, err := req(ctx, b.next)
+	// Invalid requests and requests cancelled by the caller say nothing about the Geocoder
+	b.record(!IsTemporary(err) || ctx.Err() != nil)
+	return result, err
+}
+
+// Returns whether a request can be sent
+func (b *CircuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// Record the outcome of a request
+func (b *CircuitBreaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if success {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,124 @@
+package geocoding
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/rs/zerolog/log"
+)
+
+// How long geocoding results are cached by default
+const DefaultCacheTTL = 30 * 24 * time.Hour
+
+var ErrCacheMiss = errors.New("no cached geocoding result")
+
+// Storage of cached geocoding results
+type CacheStore interface {
+	// Get the results cached for `key` that did not expire by `now`, `ErrCacheMiss` if there are none
+	Get(ctx context.Context, key string, now time.Time) ([]Result, error)
+	// Cache `results` for `key` until `expiresAt`, replacing any results cached for it
+	Put(ctx context.Context, key string, results []Result, expiresAt time.Time) error
+}
+
+// Cache is a Geocoder remembering the results of another Geocoder.
+//
+// Addresses and searches are looked up regardless of case, spacing and punctuation. Only those that
+// resolved to at least one result are cached. The cache is skipped if its store fails.
+//
+// Reverse geocoding and suggestions are not cached since they are rarely repeated.
+type Cache struct {
+	next  Geocoder
+	store CacheStore
+	now   func() time.Time
+	ttl   time.Duration
+}
+
+// Create a Geocoder caching the results of `next` in `store` for `ttl`
+func NewCache(next Geocoder, store CacheStore, ttl time.Duration) *Cache {
+	return &Cache{
+		next:  next,
+		store: store,
+		now:   time.Now,
+		ttl:   ttl,
+	}
+}
+
+func (c *Cache) Geocode(ctx context.Context, address *Address) ([]Result, error) {
+	return c.do(ctx, cacheKey(address), geocodeRequest(address))
+}
+
+func (c *Cache) Search(ctx context.Context, query string) ([]Result, error) {
+	return c.do(ctx, searchCacheKey(query), searchRequest(query))
+}
+
+func (c *Cache) do(ctx context.Context, key string, req request) ([]Result, error) {
+	now := c.now()
+
+	result, err := c.store.Get(ctx, key, now)
+	if err == nil {
+		return result, nil
+	}
+	if !errors.Is(err, ErrCacheMiss) {
+		log.Ctx(ctx).Err(err).Msg("could not read geocoding cache")
+	}
+
+	result, err = req(ctx, c.next)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) > 0 {
+		err = c.store.Put(ctx, key, result, now.Add(c.ttl))
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("could not write geocoding cache")
+		}
+	}
+	return result, nil
+}
+
+func (c *Cache) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]Result, error) {
+	return c.next.ReverseGeocode(ctx, latitude, longitude)
+}
+
+func (c *Cache) Suggest(ctx context.Context, query string) ([]Result, error) {
+	return c.next.Suggest(ctx, query)
+}
+
+// Returns the key identifying `address` in the cache
+func cacheKey(address *Address) string {
+	parts := []string{
+		normalizeKeyPart(address.Street),
+		normalizeKeyPart(address.City),
+		normalizeKeyPart(address.State),
+		normalizePostalCode(address.PostalCode),
+		normalizeKeyPart(address.Country),
+	}
+	return strings.Join(parts, "|")
+}
+
+// Returns the key identifying the search of `query` in the cache, which never matches an address key
+func searchCacheKey(query string) string {
+	return "search|" + normalizeKeyPart(query)
+}
+
+// Lowercase `s`, dropping punctuation and collapsing spaces
+func normalizeKeyPart(s string) string {
+	var sb strings.Builder
+	space := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		// Unit numbers are separated from street numbers with these
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '#' || r == '/':
+			if space && sb.Len() > 0 {
+				sb.WriteByte(' ')
+			}
+			space = false
+			sb.WriteRune(r)
+		default:
+			space = true
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,56 @@
+package geocoding
+
+import (
+	"context"
+	"errors"
+)
+
+// Failover is a Geocoder trying other Geocoders in order until one succeeds.
+type Failover struct {
+	geocoders []Geocoder
+}
+
+// Create a Geocoder using the first of `geocoders` that does not fail.
+//
+// Addresses a Geocoder could not resolve and requests it rejected are not tried with the next one,
+// only temporary failures are.
+func NewFailover(geocoders ...Geocoder) *Failover {
+	return &Failover{
+		geocoders: geocoders,
+	}
+}
+
+func (f *Failover) Geocode(ctx context.Context, address *Address) ([]Result, error) {
+	return f.do(ctx, geocodeRequest(address))
+}
+
+func (f *Failover) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]Result, error) {
+	return f.do(ctx, reverseGeocodeRequest(latitude, longitude))
+}
+
+func (f *Failover) Suggest(ctx context.Context, query string) ([]Result, error) {
+	return f.do(ctx, suggestRequest(query))
+}
+
+func (f *Failover) Search(ctx context.Context, query string) ([]Result, error) {
+	return f.do(ctx, searchRequest(query))
+}
+
+func (f *Failover) do(ctx context.Context, req request) ([]Result, error) {
+	errs := make([]error, 0, len(f.geocoders))
+	for _, geocoder := range f.geocoders {
+		result, err := req(ctx, geocoder)
+		if err == nil {
+			return result, nil
+		}
+		// Invalid requests would be rejected by the other Geocoders too
+		if !IsTemporary(err) {
+			return nil, err
+		}
+		errs = append(errs, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, errors.Join(errs...)
+}
@@ -1,6 +1,10 @@
 package geocoding
 
-import "context"
+import (
+	"context"
+	"errors"
+	"net"
+)
 
 // An address, splitted into components
 type Address struct {
@@ -25,7 +29,59 @@ type Result struct {
 	Accuracy float32
 }
 
+// The most results returned by a request
+const maxResults = 5
+
 type Geocoder interface {
 	// Resolve an address into real location
 	Geocode(ctx context.Context, address *Address) ([]Result, error)
+	// Resolve a location into the addresses at or near it, nearest first
+	ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]Result, error)
+	// Resolve a partially written address into the addresses it might be, most likely first
+	Suggest(ctx context.Context, query string) ([]Result, error)
+	// Resolve a free-text address, postal code or place name into the locations it names, most likely first
+	Search(ctx context.Context, query string) ([]Result, error)
+}
+
+// A request sent to a Geocoder, used by the Geocoders wrapping others
+type request func(ctx context.Context, geocoder Geocoder) ([]Result, error)
+
+func geocodeRequest(address *Address) request {
+	return func(ctx context.Context, geocoder Geocoder) ([]Result, error) {
+		return geocoder.Geocode(ctx, address)
+	}
+}
+
+func reverseGeocodeRequest(latitude, longitude float64) request {
+	return func(ctx context.Context, geocoder Geocoder) ([]Result, error) {
+		return geocoder.ReverseGeocode(ctx, latitude, longitude)
+	}
+}
+
+func suggestRequest(query string) request {
+	return func(ctx context.Context, geocoder Geocoder) ([]Result, error) {
+		return geocoder.Suggest(ctx, query)
+	}
+}
+
+func searchRequest(query string) request {
+	return func(ctx context.Context, geocoder Geocoder) ([]Result, error) {
+		return geocoder.Search(ctx, query)
+	}
+}
+
+// Returns whether `err` is a failure of the provider that might not happen again, such as
+// a server error, a network error or a timeout.
+//
+// Other errors are caused by the request, such as an invalid address, and fail with any provider.
+func IsTemporary(err error) bool {
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) && temporary.Temporary() {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrCircuitOpen)
 }
@@ -0,0 +1,652 @@
+package geocoding
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A Geocoder returning the next of its results on each call
+type fakeGeocoder struct {
+	errs    []error
+	results []Result
+	calls   int
+}
+
+func (f *fakeGeocoder) Geocode(_ context.Context, _ *Address) ([]Result, error) {
+	return f.next()
+}
+
+func (f *fakeGeocoder) ReverseGeocode(_ context.Context, _, _ float64) ([]Result, error) {
+	return f.next()
+}
+
+func (f *fakeGeocoder) Suggest(_ context.Context, _ string) ([]Result, error) {
+	return f.next()
+}
+
+func (f *fakeGeocoder) Search(_ context.Context, _ string) ([]Result, error) {
+	return f.next()
+}
+
+func (f *fakeGeocoder) next() ([]Result, error) {
+	idx := min(f.calls, len(f.errs)-1)
+	f.calls++
+	if f.errs[idx] != nil {
+		return nil, f.errs[idx]
+	}
+	return f.results, nil
+}
+
+type memoryCacheStore struct {
+	entries map[string]memoryCacheEntry
+	mu      sync.Mutex
+}
+
+type memoryCacheEntry struct {
+	expiresAt time.Time
+	results   []Result
+}
+
+func (m *memoryCacheStore) Get(_ context.Context, key string, now time.Time) ([]Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok || !entry.expiresAt.After(now) {
+		return nil, ErrCacheMiss
+	}
+	return entry.results, nil
+}
+
+func (m *memoryCacheStore) Put(_ context.Context, key string, results []Result, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.entries == nil {
+		m.entries = make(map[string]memoryCacheEntry)
+	}
+	m.entries[key] = memoryCacheEntry{
+		expiresAt: expiresAt,
+		results:   results,
+	}
+	return nil
+}
+
+var (
+	sampleAddress = Address{
+		Street:     "66 Chancellors Cir",
+		City:       "Winnipeg",
+		State:      "MB",
+		PostalCode: "R3T2N2",
+		Country:    "CA",
+	}
+	sampleResults = []Result{
+		{
+			Address:   sampleAddress,
+			Latitude:  49.8075,
+			Longitude: -97.1366,
+			Accuracy:  1,
+		},
+	}
+	errServer = GeocodioError{
+		Message:    "503 Service Unavailable",
+		StatusCode: http.StatusServiceUnavailable,
+	}
+	errForbidden = GeocodioError{
+		Message:    "Invalid API key",
+		StatusCode: http.StatusForbidden,
+	}
+	errInvalidAddress = GeocodioError{
+		Message:    "Could not geocode address. Postal code or city required.",
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+)
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("temporary failures are retried", func(t *testing.T) {
+		t.Parallel()
+
+		next := &fakeGeocoder{errs: []error{errServer, context.DeadlineExceeded, nil}, results: sampleResults}
+		geocoder := NewRetry(next, 3, time.Millisecond, time.Second)
+
+		result, err := geocoder.Geocode(ctx, &sampleAddress)
+		require.NoError(t, err)
+		assert.Equal(t, sampleResults, result)
+		assert.Equal(t, 3, next.calls)
+	})
+
+	t.Run("gives up after all attempts", func(t *testing.T) {
+		t.Parallel()
+
+		next := &fakeGeocoder{errs: []error{errServer}}
+		geocoder := NewRetry(next, 3, time.Millisecond, time.Second)
+
+		_, err := geocoder.Geocode(ctx, &sampleAddress)
+		require.ErrorIs(t, err, errServer)
+		assert.Equal(t, 3, next.calls)
+	})
+
+	t.Run("other failures are not retried", func(t *testing.T) {
+		t.Parallel()
+
+		next := &fakeGeocoder{errs: []error{errForbidden}}
+		geocoder := NewRetry(next, 3, time.Millisecond, time.Second)
+
+		_, err := geocoder.Geocode(ctx, &sampleAddress)
+		require.ErrorIs(t, err, errForbidden)
+		assert.Equal(t, 1, next.calls)
+	})
+
+	t.Run("stops when cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		next := &fakeGeocoder{errs: []error{errServer}}
+		geocoder := NewRetry(next, 3, time.Hour, time.Second)
+
+		_, err := geocoder.Geocode(ctx, &sampleAddress)
+		require.ErrorIs(t, err, errServer)
+		assert.Equal(t, 1, next.calls)
+	})
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	next := &fakeGeocoder{errs: []error{errServer, errServer, errServer, nil}, results: sampleResults}
+	breaker := NewCircuitBreaker(next, 2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	for range 2 {
+		_, err := breaker.Geocode(ctx, &sampleAddress)
+		require.ErrorIs(t, err, errServer)
+	}
+
+	// Open after reaching the threshold
+	_, err := breaker.Geocode(ctx, &sampleAddress)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, 2, next.calls)
+
+	// A failed probe keeps it open
+	now = now.Add(time.Minute)
+	_, err = breaker.Geocode(ctx, &sampleAddress)
+	require.ErrorIs(t, err, errServer)
+	_, err = breaker.Geocode(ctx, &sampleAddress)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, 3, next.calls)
+
+	// A successful probe closes it
+	now = now.Add(time.Minute)
+	result, err := breaker.Geocode(ctx, &sampleAddress)
+	require.NoError(t, err)
+	assert.Equal(t, sampleResults, result)
+	_, err = breaker.Geocode(ctx, &sampleAddress)
+	require.NoError(t, err)
+	assert.Equal(t, 5, next.calls)
+
+	// Rejected requests do not open it
+	invalid := &fakeGeocoder{errs: []error{errInvalidAddress}}
+	breaker = NewCircuitBreaker(invalid, 2, time.Minute)
+	for range 3 {
+		_, err = breaker.Geocode(ctx, &sampleAddress)
+		require.ErrorIs(t, err, errInvalidAddress)
+	}
+	assert.Equal(t, 3, invalid.calls)
+}
+
+func TestIsTemporary(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsTemporary(errServer))
+	assert.True(t, IsTemporary(context.DeadlineExceeded))
+	assert.True(t, IsTemporary(ErrCircuitOpen))
+	assert.True(t, IsTemporary(&url.Error{Op: "Get", URL: "https://api.geocod.io", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}))
+	assert.False(t, IsTemporary(errForbidden))
+	assert.False(t, IsTemporary(errInvalidAddress))
+	assert.False(t, IsTemporary(context.Canceled))
+	assert.False(t, IsTemporary(nil))
+}
+
+func TestFailover(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("falls back on failures", func(t *testing.T) {
+		t.Parallel()
+
+		first := &fakeGeocoder{errs: []error{ErrCircuitOpen}}
+		second := &fakeGeocoder{errs: []error{nil}, results: sampleResults}
+		geocoder := NewFailover(first, second)
+
+		result, err := geocoder.Geocode(ctx, &sampleAddress)
+		require.NoError(t, err)
+		assert.Equal(t, sampleResults, result)
+	})
+
+	t.Run("unresolved addresses are not tried again", func(t *testing.T) {
+		t.Parallel()
+
+		first := &fakeGeocoder{errs: []error{nil}, results: []Result{}}
+		second := &fakeGeocoder{errs: []error{nil}, results: sampleResults}
+		geocoder := NewFailover(first, second)
+
+		result, err := geocoder.Geocode(ctx, &sampleAddress)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		assert.Equal(t, 0, second.calls)
+	})
+
+	t.Run("reverse geocoding and suggestions fall back", func(t *testing.T) {
+		t.Parallel()
+
+		first := &fakeGeocoder{errs: []error{errServer}}
+		second := &fakeGeocoder{errs: []error{nil}, results: sampleResults}
+		geocoder := NewFailover(NewRetry(first, 2, time.Millisecond, time.Second), second)
+
+		result, err := geocoder.ReverseGeocode(ctx, 49.8075, -97.1366)
+		require.NoError(t, err)
+		assert.Equal(t, sampleResults, result)
+		result, err = geocoder.Suggest(ctx, "66 chanc")
+		require.NoError(t, err)
+		assert.Equal(t, sampleResults, result)
+		assert.Equal(t, 4, first.calls)
+	})
+
+	t.Run("rejected requests are not tried again", func(t *testing.T) {
+		t.Parallel()
+
+		first := &fakeGeocoder{errs: []error{errInvalidAddress}}
+		second := &fakeGeocoder{errs: []error{nil}, results: sampleResults}
+		geocoder := NewFailover(first, second)
+
+		_, err := geocoder.Geocode(ctx, &sampleAddress)
+		require.ErrorIs(t, err, errInvalidAddress)
+		assert.Equal(t, 0, second.calls)
+	})
+
+	t.Run("all failed", func(t *testing.T) {
+		t.Parallel()
+
+		first := &fakeGeocoder{errs: []error{errServer}}
+		second := &fakeGeocoder{errs: []error{ErrCircuitOpen}}
+		geocoder := NewFailover(first, second)
+
+		_, err := geocoder.Geocode(ctx, &sampleAddress)
+		require.ErrorIs(t, err, errServer)
+		require.ErrorIs(t, err, ErrCircuitOpen)
+	})
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	next := &fakeGeocoder{errs: []error{nil}, results: sampleResults}
+	cache := NewCache(next, &memoryCacheStore{}, time.Hour)
+	cache.now = func() time.Time { return now }
+
+	result, err := cache.Geocode(ctx, &sampleAddress)
+	require.NoError(t, err)
+	assert.Equal(t, sampleResults, result)
+
+	// The same address written differently is cached
+	result, err = cache.Geocode(ctx, &Address{
+		Street:     " 66  chancellors cir. ",
+		City:       "WINNIPEG",
+		State:      "mb",
+		PostalCode: "r3t 2n2",
+		Country:    "ca",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sampleResults, result)
+	assert.Equal(t, 1, next.calls)
+
+	now = now.Add(time.Hour)
+	_, err = cache.Geocode(ctx, &sampleAddress)
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.calls)
+
+	// Searches are cached apart from addresses
+	result, err = cache.Search(ctx, "  Winnipeg ")
+	require.NoError(t, err)
+	assert.Equal(t, sampleResults, result)
+	_, err = cache.Search(ctx, "winnipeg")
+	require.NoError(t, err)
+	assert.Equal(t, 3, next.calls)
+
+	// Failures are not cached
+	failing := &fakeGeocoder{errs: []error{errServer, nil}, results: sampleResults}
+	cache = NewCache(failing, &memoryCacheStore{}, time.Hour)
+	_, err = cache.Geocode(ctx, &sampleAddress)
+	require.ErrorIs(t, err, errServer)
+	_, err = cache.Geocode(ctx, &sampleAddress)
+	require.NoError(t, err)
+}
+
+func TestCacheKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "66 chancellors cir|winnipeg|mb|r3t2n2|ca", cacheKey(&sampleAddress))
+	assert.Equal(t, "12-66 rue saint-jean|québec|qc||ca", cacheKey(&Address{
+		Street:  "12-66, Rue Saint-Jean",
+		City:    "Québec",
+		State:   "QC",
+		Country: "CA",
+	}))
+}
+
+func TestNominatim(t *testing.T) {
+	t.Parallel()
+
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search" || r.Header.Get("User-Agent") != "ParkEasy test" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		query = r.URL.Query()
+		if query.Get("street") == "down" {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{
+			"lat": "49.8075",
+			"lon": "-97.1366",
+			"display_name": "66, Chancellors Circle, Winnipeg, Manitoba, R3T 2N2, Canada",
+			"address": {
+				"house_number": "66",
+				"road": "Chancellors Circle",
+				"city": "Winnipeg",
+				"state": "Manitoba",
+				"ISO3166-2-lvl4": "CA-MB",
+				"postcode": "R3T 2N2",
+				"country_code": "ca"
+			}
+		}, {
+			"lat": "49.8",
+			"lon": "-97.1",
+			"display_name": "Chancellors Circle, Winnipeg",
+			"address": {
+				"road": "Chancellors Circle",
+				"town": "Winnipeg",
+				"ISO3166-2-lvl4": "CA-MB",
+				"country_code": "ca"
+			}
+		}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	geocoder := NewNominatim(server.Client(), baseURL, "ParkEasy test")
+
+	result, err := geocoder.Geocode(context.Background(), &sampleAddress)
+	require.NoError(t, err)
+	assert.Equal(t, "ca", query.Get("countrycodes"))
+	assert.Equal(t, "R3T2N2", query.Get("postalcode"))
+	assert.Empty(t, query.Get("state"))
+
+	require.Len(t, result, 2)
+	assert.Equal(t, Address{
+		Street:     "66 Chancellors Circle",
+		City:       "Winnipeg",
+		State:      "MB",
+		PostalCode: "R3T 2N2",
+		Country:    "CA",
+	}, result[0].Address)
+	assert.InEpsilon(t, 49.8075, result[0].Latitude, 1e-9)
+	assert.InEpsilon(t, -97.1366, result[0].Longitude, 1e-9)
+	assert.InEpsilon(t, float32(1), result[0].Accuracy, 1e-9)
+	assert.Equal(t, "Chancellors Circle", result[1].Address.Street)
+	assert.Equal(t, "Winnipeg", result[1].Address.City)
+	assert.Less(t, result[1].Accuracy, float32(0.8))
+
+	_, err = geocoder.Geocode(context.Background(), &Address{Street: "down"})
+	var nominatimErr NominatimError
+	require.True(t, errors.As(err, &nominatimErr))
+	assert.True(t, IsTemporary(err))
+}
+
+func TestNominatimReverse(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/reverse" || query.Get("format") != "jsonv2" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("lat") != "49.8075" || query.Get("lon") != "-97.1366" {
+			_, _ = w.Write([]byte(`{"error": "Unable to geocode"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"lat": "49.8075",
+			"lon": "-97.1366",
+			"display_name": "66, Chancellors Circle, Winnipeg, Manitoba, R3T 2N2, Canada",
+			"address": {
+				"house_number": "66",
+				"road": "Chancellors Circle",
+				"city": "Winnipeg",
+				"ISO3166-2-lvl4": "CA-MB",
+				"postcode": "R3T 2N2",
+				"country_code": "ca"
+			}
+		}`))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	geocoder := NewNominatim(server.Client(), baseURL, "ParkEasy test")
+
+	result, err := geocoder.ReverseGeocode(context.Background(), 49.8075, -97.1366)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "66 Chancellors Circle", result[0].Address.Street)
+	assert.Equal(t, "MB", result[0].Address.State)
+
+	result, err = geocoder.ReverseGeocode(context.Background(), 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+const sampleDataset = `# A comment
+street,city,state,postal_code,country,latitude,longitude
+,Winnipeg,MB,,CA,49.8954,-97.1385
+,Winnipeg,MB,R3T,CA,49.8,-97.1
+,Winnipeg,MB,R3T 2N2,CA,49.81,-97.13
+66 Chancellors Cir,Winnipeg,MB,R3T2N2,CA,49.8075,-97.1366
+66 Chancellors Cir,Winnipeg,MB,R3T 9Z9,CA,49.9,-97.2
+`
+
+func TestOffline(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	geocoder, err := LoadOffline(strings.NewReader(sampleDataset))
+	require.NoError(t, err)
+
+	t.Run("address", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := geocoder.Geocode(ctx, &Address{
+			Street:     "66 chancellors cir.",
+			City:       "WINNIPEG",
+			PostalCode: "r3t2n2",
+			Country:    "ca",
+		})
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, sampleAddress, result[0].Address)
+		assert.Equal(t, "66 Chancellors Cir, Winnipeg, MB R3T2N2, CA", result[0].FormattedAddress)
+		assert.InEpsilon(t, 49.8075, result[0].Latitude, 1e-9)
+		assert.InEpsilon(t, -97.1366, result[0].Longitude, 1e-9)
+		assert.InEpsilon(t, float32(1), result[0].Accuracy, 1e-6)
+		assert.Equal(t, "R3T 9Z9", result[1].Address.PostalCode)
+	})
+
+	t.Run("postal code centroid", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := geocoder.Geocode(ctx, &Address{
+			Street:     "1 Unknown St",
+			City:       "Winnipeg",
+			PostalCode: "R3T 2N2",
+			Country:    "CA",
+		})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.InEpsilon(t, 49.81, result[0].Latitude, 1e-9)
+		assert.InEpsilon(t, float32(CentroidAccuracy), result[0].Accuracy, 1e-6)
+		assert.Equal(t, "1 Unknown St, Winnipeg, MB R3T 2N2, CA", result[0].FormattedAddress)
+
+		// Falls back to the centre of a shorter postal code
+		result, err = geocoder.Geocode(ctx, &Address{
+			Street:     "1 Unknown St",
+			City:       "Winnipeg",
+			PostalCode: "R3T 1A1",
+			Country:    "CA",
+		})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.InEpsilon(t, 49.8, result[0].Latitude, 1e-9)
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := geocoder.ReverseGeocode(ctx, 49.8076, -97.1367)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, sampleAddress, result[0].Address)
+
+		// Postal code centres are not addresses
+		result, err = geocoder.ReverseGeocode(ctx, 49.8, -97.1)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("suggest", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := geocoder.Suggest(ctx, "66 chanc")
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, sampleAddress, result[0].Address)
+
+		// Words can be given in any order
+		result, err = geocoder.Suggest(ctx, "winnipeg r3t9 chancellors")
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "R3T 9Z9", result[0].Address.PostalCode)
+
+		result, err = geocoder.Suggest(ctx, "67 chanc")
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		t.Parallel()
+
+		// Postal codes
+		result, err := geocoder.Search(ctx, "r3t 2n2")
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.InEpsilon(t, 49.81, result[0].Latitude, 1e-9)
+		result, err = geocoder.Search(ctx, "R3T 5V6")
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.InEpsilon(t, 49.8, result[0].Latitude, 1e-9)
+
+		// Cities
+		result, err = geocoder.Search(ctx, "Winnipeg, MB")
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.InEpsilon(t, 49.8954, result[0].Latitude, 1e-9)
+		assert.InEpsilon(t, float32(CentroidAccuracy), result[0].Accuracy, 1e-6)
+
+		// Addresses
+		result, err = geocoder.Search(ctx, "66 Chancellors Cir, Winnipeg")
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, sampleAddress, result[0].Address)
+
+		result, err = geocoder.Search(ctx, "Brandon")
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := geocoder.Geocode(ctx, &Address{
+			Street:     "1 Unknown St",
+			City:       "Winnipeg",
+			PostalCode: "R3T 2N2",
+			Country:    "US",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func TestLoadOfflineInvalid(t *testing.T) {
+	t.Parallel()
+
+	for name, dataset := range map[string]string{
+		"empty":          "",
+		"missing column": "street,city,state,postal_code,country,latitude\n",
+		"bad latitude":   "street,city,state,postal_code,country,latitude,longitude\nA,B,C,D,E,north,0\n",
+		"bad longitude":  "street,city,state,postal_code,country,latitude,longitude\nA,B,C,D,E,0,200\n",
+		"no location":    "street,city,state,postal_code,country,latitude,longitude\n,,C,,E,0,0\n",
+	} {
+		_, err := LoadOffline(strings.NewReader(dataset))
+		require.ErrorIs(t, err, ErrInvalidDataset, name)
+	}
+}
+
+func TestDevDataset(t *testing.T) {
+	t.Parallel()
+
+	geocoder, err := LoadOffline(bytes.NewReader(DevDataset))
+	require.NoError(t, err)
+
+	// An address used by the load tests
+	result, err := geocoder.Geocode(context.Background(), &Address{
+		Street:     "11 Tidewater Bay",
+		City:       "Winnipeg",
+		State:      "MB",
+		PostalCode: "R3X1X1",
+		Country:    "CA",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, result)
+	assert.InEpsilon(t, float32(1), result[0].Accuracy, 1e-6)
+}
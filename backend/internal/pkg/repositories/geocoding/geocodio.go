@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 var geocodioBaseURL = url.URL{
@@ -27,15 +28,39 @@ func NewGeocodio(client *http.Client, apiKey string) *Geocodio {
 }
 
 func (g *Geocodio) Geocode(ctx context.Context, address *Address) ([]Result, error) {
-	reqURL := geocodioBaseURL.JoinPath("geocode")
 	queryParams := make(url.Values)
-	queryParams.Set("api_key", g.apiKey)
-	queryParams.Set("limit", "5")
 	queryParams.Set("street", address.Street)
 	queryParams.Set("city", address.City)
 	queryParams.Set("state", address.State)
 	queryParams.Set("postal_code", address.PostalCode)
 	queryParams.Set("country", address.Country)
+	return g.get(ctx, "geocode", queryParams)
+}
+
+func (g *Geocodio) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]Result, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("q", strconv.FormatFloat(latitude, 'f', -1, 64)+","+strconv.FormatFloat(longitude, 'f', -1, 64))
+	return g.get(ctx, "reverse", queryParams)
+}
+
+// Suggestions are resolved as a single line address, which geocod.io completes
+func (g *Geocodio) Suggest(ctx context.Context, query string) ([]Result, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("q", query)
+	return g.get(ctx, "geocode", queryParams)
+}
+
+func (g *Geocodio) Search(ctx context.Context, query string) ([]Result, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("q", query)
+	return g.get(ctx, "geocode", queryParams)
+}
+
+// Send a request to the geocod.io `endpoint` and returns its results
+func (g *Geocodio) get(ctx context.Context, endpoint string, queryParams url.Values) ([]Result, error) {
+	reqURL := geocodioBaseURL.JoinPath(endpoint)
+	queryParams.Set("api_key", g.apiKey)
+	queryParams.Set("limit", strconv.Itoa(maxResults))
 	reqURL.RawQuery = queryParams.Encode()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
@@ -49,7 +74,7 @@ func (g *Geocodio) Geocode(ctx context.Context, address *Address) ([]Result, err
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode > 300 {
-		var gerr GeocodioError
+		gerr := GeocodioError{StatusCode: resp.StatusCode}
 		_ = json.NewDecoder(resp.Body).Decode(&gerr)
 		if gerr.Message == "" {
 			gerr.Message = resp.Status
@@ -107,9 +132,15 @@ func (g *Geocodio) Geocode(ctx context.Context, address *Address) ([]Result, err
 }
 
 type GeocodioError struct {
-	Message string `json:"error"`
+	Message    string `json:"error"`
+	StatusCode int    `json:"-"`
 }
 
 func (e GeocodioError) Error() string {
 	return e.Message
 }
+
+// Returns whether the request might succeed if sent again
+func (e GeocodioError) Temporary() bool {
+	return e.StatusCode >= 500
+}
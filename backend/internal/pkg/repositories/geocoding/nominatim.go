@@ -0,0 +1,203 @@
+package geocoding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Nominatim resolves addresses with a Nominatim (OpenStreetMap) server.
+type Nominatim struct {
+	client    *http.Client
+	baseURL   *url.URL
+	userAgent string
+}
+
+// Create a Geocoder using the Nominatim server at `baseURL`.
+//
+// `userAgent` identifies the application to the server, as required by the public servers.
+func NewNominatim(client *http.Client, baseURL *url.URL, userAgent string) *Nominatim {
+	return &Nominatim{
+		client:    client,
+		baseURL:   baseURL,
+		userAgent: userAgent,
+	}
+}
+
+func (n *Nominatim) Geocode(ctx context.Context, address *Address) ([]Result, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("street", address.Street)
+	queryParams.Set("city", address.City)
+	// Nominatim matches states by name, not by code
+	if len(address.State) > 3 {
+		queryParams.Set("state", address.State)
+	}
+	queryParams.Set("postalcode", address.PostalCode)
+	queryParams.Set("countrycodes", strings.ToLower(address.Country))
+	return n.search(ctx, queryParams)
+}
+
+func (n *Nominatim) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]Result, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("lat", strconv.FormatFloat(latitude, 'f', -1, 64))
+	queryParams.Set("lon", strconv.FormatFloat(longitude, 'f', -1, 64))
+
+	var apiResult struct {
+		// Set instead of the place if there are no addresses near the location
+		Error string `json:"error"`
+		nominatimPlace
+	}
+	err := n.get(ctx, "reverse", queryParams, &apiResult)
+	if err != nil {
+		return nil, err
+	}
+	if apiResult.Error != "" {
+		return nil, nil
+	}
+	r, err := apiResult.result()
+	if err != nil {
+		return nil, err
+	}
+	return []Result{r}, nil
+}
+
+func (n *Nominatim) Suggest(ctx context.Context, query string) ([]Result, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("q", query)
+	return n.search(ctx, queryParams)
+}
+
+func (n *Nominatim) Search(ctx context.Context, query string) ([]Result, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("q", query)
+	return n.search(ctx, queryParams)
+}
+
+// Send a search request with `queryParams` and returns its results
+func (n *Nominatim) search(ctx context.Context, queryParams url.Values) ([]Result, error) {
+	queryParams.Set("limit", strconv.Itoa(maxResults))
+	var apiResult []nominatimPlace
+	err := n.get(ctx, "search", queryParams, &apiResult)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Result, 0, len(apiResult))
+	for i := range apiResult {
+		r, err := apiResult[i].result()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// Send a request to the Nominatim `endpoint` and decode its response into `out`
+func (n *Nominatim) get(ctx context.Context, endpoint string, queryParams url.Values, out any) error {
+	reqURL := n.baseURL.JoinPath(endpoint)
+	queryParams.Set("format", "jsonv2")
+	queryParams.Set("addressdetails", "1")
+	reqURL.RawQuery = queryParams.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("User-Agent", n.userAgent)
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return NominatimError{
+			Message:    resp.Status,
+			StatusCode: resp.StatusCode,
+		}
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(out)
+	if err != nil {
+		return fmt.Errorf("could not decode result: %w", err)
+	}
+	return nil
+}
+
+type nominatimPlace struct {
+	Address struct {
+		HouseNumber string `json:"house_number"`
+		Road        string `json:"road"`
+		City        string `json:"city"`
+		Town        string `json:"town"`
+		Village     string `json:"village"`
+		// ISO 3166-2 code of the state, such as CA-MB
+		StateCode   string `json:"ISO3166-2-lvl4"`
+		Postcode    string `json:"postcode"`
+		CountryCode string `json:"country_code"`
+	} `json:"address"`
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+	DisplayName string `json:"display_name"`
+}
+
+func (p *nominatimPlace) result() (Result, error) {
+	lat, err := strconv.ParseFloat(p.Lat, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("invalid latitude %q: %w", p.Lat, err)
+	}
+	lon, err := strconv.ParseFloat(p.Lon, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("invalid longitude %q: %w", p.Lon, err)
+	}
+
+	address := &p.Address
+	street := address.Road
+	// Only places with a house number are precise enough to be a parking spot
+	accuracy := float32(0.5)
+	if address.HouseNumber != "" {
+		street = address.HouseNumber + " " + address.Road
+		accuracy = 1
+	}
+	city := address.City
+	if city == "" {
+		city = address.Town
+	}
+	if city == "" {
+		city = address.Village
+	}
+	_, state, _ := strings.Cut(address.StateCode, "-")
+
+	return Result{
+		Address: Address{
+			Street:     street,
+			City:       city,
+			State:      state,
+			PostalCode: address.Postcode,
+			Country:    strings.ToUpper(address.CountryCode),
+		},
+		FormattedAddress: p.DisplayName,
+		Latitude:         lat,
+		Longitude:        lon,
+		Accuracy:         accuracy,
+	}, nil
+}
+
+type NominatimError struct {
+	Message    string
+	StatusCode int
+}
+
+func (e NominatimError) Error() string {
+	return e.Message
+}
+
+// Returns whether the request might succeed if sent again
+func (e NominatimError) Temporary() bool {
+	return e.StatusCode >= 500 || e.StatusCode == http.StatusTooManyRequests
+}
@@ -0,0 +1,371 @@
+package geocoding
+
+import (
+	"cmp"
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The accuracy of results placed at the centre of a postal code, which are not accurate
+const CentroidAccuracy = 0.5
+
+// A dataset covering the addresses used by the load tests, with synthetic coordinates.
+//
+//go:embed dev_dataset.csv
+var DevDataset []byte
+
+var ErrInvalidDataset = errors.New("invalid geocoding dataset")
+
+// The columns of an offline geocoding dataset
+var datasetColumns = []string{"street", "city", "state", "postal_code", "country", "latitude", "longitude"}
+
+// How far from a location addresses are found by offline reverse geocoding, in meters
+const offlineReverseRadius = 250
+
+// Mean radius of the Earth in meters
+const earthRadius = 6371000
+
+// Offline resolves addresses from a dataset held in memory, without any network access.
+//
+// Addresses listed in the dataset resolve with an accuracy of 1. Other addresses resolve to the
+// centre of the longest matching postal code in the dataset with `CentroidAccuracy`, or to nothing.
+// Reverse geocoding and suggestions only return the addresses listed in the dataset. Searches also
+// return the centres of postal codes and cities.
+type Offline struct {
+	// Results by street, city and country key
+	addresses map[string][]Result
+	// Centres by country and postal code key
+	centroids map[string]Result
+	// All addresses in the order of the dataset
+	entries []offlineEntry
+	// The centres of cities in the order of the dataset
+	cities []offlineEntry
+}
+
+type offlineEntry struct {
+	// The words of the address, as matched by suggestions
+	words  []string
+	result Result
+}
+
+// Load an offline geocoder from the CSV dataset read from `r`.
+//
+// The dataset starts with a header naming the columns: street, city, state, postal_code, country,
+// latitude and longitude, in any order. Lines starting with `#` are ignored. Rows without a street give
+// the centre of their postal code, which also applies to every postal code starting with it. Rows
+// without a street nor a postal code give the centre of their city.
+func LoadOffline(r io.Reader) (*Offline, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: could not read header: %w", ErrInvalidDataset, err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+	columns := make([]int, len(datasetColumns))
+	for i, name := range datasetColumns {
+		column, ok := index[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing column %q", ErrInvalidDataset, name)
+		}
+		columns[i] = column
+	}
+
+	result := &Offline{
+		addresses: make(map[string][]Result),
+		centroids: make(map[string]Result),
+	}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidDataset, err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		entry, err := parseDatasetRecord(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidDataset, line, err)
+		}
+		address := &entry.Address
+		if address.Street == "" {
+			entry.Accuracy = CentroidAccuracy
+			if address.PostalCode == "" {
+				result.cities = append(result.cities, offlineEntry{
+					words:  strings.Fields(normalizeKeyPart(entry.FormattedAddress)),
+					result: entry,
+				})
+			} else {
+				result.centroids[centroidKey(address.Country, address.PostalCode)] = entry
+			}
+			continue
+		}
+		key := offlineAddressKey(address)
+		result.addresses[key] = append(result.addresses[key], entry)
+		// Postal codes are also typed without spaces
+		words := append(strings.Fields(normalizeKeyPart(entry.FormattedAddress)), normalizePostalCode(address.PostalCode))
+		result.entries = append(result.entries, offlineEntry{
+			words:  words,
+			result: entry,
+		})
+	}
+	return result, nil
+}
+
+// Returns the entry in `record`, with `columns` the indices of the dataset columns
+func parseDatasetRecord(record []string, columns []int) (Result, error) {
+	field := func(column int) string { return strings.TrimSpace(record[columns[column]]) }
+	address := Address{
+		Street:     field(0),
+		City:       field(1),
+		State:      field(2),
+		PostalCode: field(3),
+		Country:    field(4),
+	}
+	if address.Street == "" && address.PostalCode == "" && address.City == "" {
+		return Result{}, errors.New("either a street, a postal code or a city is required")
+	}
+	latitude, err := strconv.ParseFloat(field(5), 64)
+	if err != nil || latitude < -90 || latitude > 90 {
+		return Result{}, fmt.Errorf("invalid latitude %q", field(5))
+	}
+	longitude, err := strconv.ParseFloat(field(6), 64)
+	if err != nil || longitude < -180 || longitude > 180 {
+		return Result{}, fmt.Errorf("invalid longitude %q", field(6))
+	}
+	return Result{
+		Address:          address,
+		FormattedAddress: formatAddress(&address),
+		Latitude:         latitude,
+		Longitude:        longitude,
+		Accuracy:         1,
+	}, nil
+}
+
+// Load an offline geocoder from the CSV dataset at `path`, see `LoadOffline` for the format
+func LoadOfflineFile(path string) (*Offline, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadOffline(f)
+}
+
+func (o *Offline) Geocode(_ context.Context, address *Address) ([]Result, error) {
+	if matches := o.addresses[offlineAddressKey(address)]; len(matches) > 0 {
+		result := make([]Result, 0, len(matches))
+		// Prefer the addresses in the requested postal code
+		postalCode := normalizePostalCode(address.PostalCode)
+		for _, match := range matches {
+			if normalizePostalCode(match.Address.PostalCode) == postalCode {
+				result = append(result, match)
+			}
+		}
+		for _, match := range matches {
+			if normalizePostalCode(match.Address.PostalCode) != postalCode {
+				result = append(result, match)
+			}
+		}
+		return result, nil
+	}
+
+	postalCode := normalizePostalCode(address.PostalCode)
+	for end := len(postalCode); end > 0; end-- {
+		centroid, ok := o.centroids[centroidKey(address.Country, postalCode[:end])]
+		if !ok {
+			continue
+		}
+		centroid.Address = Address{
+			Street:     address.Street,
+			City:       centroid.Address.City,
+			State:      centroid.Address.State,
+			PostalCode: address.PostalCode,
+			Country:    centroid.Address.Country,
+		}
+		centroid.FormattedAddress = formatAddress(&centroid.Address)
+		return []Result{centroid}, nil
+	}
+	return nil, nil
+}
+
+func (o *Offline) ReverseGeocode(_ context.Context, latitude, longitude float64) ([]Result, error) {
+	type candidate struct {
+		result   *Result
+		distance float64
+	}
+	var candidates []candidate
+	for i := range o.entries {
+		r := &o.entries[i].result
+		d := distance(latitude, longitude, r.Latitude, r.Longitude)
+		if d <= offlineReverseRadius {
+			candidates = append(candidates, candidate{result: r, distance: d})
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(a.distance, b.distance)
+	})
+
+	result := make([]Result, 0, min(len(candidates), maxResults))
+	for i := range candidates[:min(len(candidates), maxResults)] {
+		result = append(result, *candidates[i].result)
+	}
+	return result, nil
+}
+
+// Suggests the addresses with a word starting with each word of `query`, preferring the addresses
+// whose street starts with `query`
+func (o *Offline) Suggest(_ context.Context, query string) ([]Result, error) {
+	normalized := normalizeKeyPart(query)
+	queryWords := strings.Fields(normalized)
+	if len(queryWords) == 0 {
+		return nil, nil
+	}
+
+	var preferred, others []Result
+	for i := range o.entries {
+		entry := &o.entries[i]
+		if !matchWords(entry.words, queryWords) {
+			continue
+		}
+		if strings.HasPrefix(normalizeKeyPart(entry.result.Address.Street), normalized) {
+			preferred = append(preferred, entry.result)
+			if len(preferred) == maxResults {
+				break
+			}
+		} else if len(others) < maxResults {
+			others = append(others, entry.result)
+		}
+	}
+	preferred = append(preferred, others...)
+	return preferred[:min(len(preferred), maxResults)], nil
+}
+
+// Searches the postal code centres if `query` is a postal code, then the city centres, then the
+// addresses with a word starting with each word of `query`
+func (o *Offline) Search(_ context.Context, query string) ([]Result, error) {
+	postalCode := normalizePostalCode(query)
+	if isPostalCode(postalCode) {
+		for end := len(postalCode); end > 0; end-- {
+			var result []Result
+			for key, centroid := range o.centroids {
+				_, code, _ := strings.Cut(key, "|")
+				if code == postalCode[:end] {
+					result = append(result, centroid)
+				}
+			}
+			if len(result) > 0 {
+				return result[:min(len(result), maxResults)], nil
+			}
+		}
+	}
+
+	queryWords := strings.Fields(normalizeKeyPart(query))
+	if len(queryWords) == 0 {
+		return nil, nil
+	}
+	for _, entries := range [][]offlineEntry{o.cities, o.entries} {
+		var result []Result
+		for i := range entries {
+			if matchWords(entries[i].words, queryWords) {
+				result = append(result, entries[i].result)
+				if len(result) == maxResults {
+					break
+				}
+			}
+		}
+		if len(result) > 0 {
+			return result, nil
+		}
+	}
+	return nil, nil
+}
+
+// Returns whether the normalized `s` looks like a postal code, made of letters and digits with at least a digit
+func isPostalCode(s string) bool {
+	hasDigit := false
+	for _, r := range s {
+		switch {
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			return false
+		}
+	}
+	return hasDigit
+}
+
+// Returns whether each of `queryWords` starts a different word in `words`
+func matchWords(words, queryWords []string) bool {
+	used := make([]bool, len(words))
+	for _, q := range queryWords {
+		found := false
+		for i, w := range words {
+			if !used[i] && strings.HasPrefix(w, q) {
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the great-circle distance in meters between two coordinates
+func distance(lat1, long1, lat2, long2 float64) float64 {
+	phi1 := lat1 * math.Pi / 180
+	phi2 := lat2 * math.Pi / 180
+	deltaPhi := (lat2 - lat1) * math.Pi / 180
+	deltaLambda := (long2 - long1) * math.Pi / 180
+
+	a := math.Sin(deltaPhi/2)*math.Sin(deltaPhi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(deltaLambda/2)*math.Sin(deltaLambda/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+// Returns the key identifying the street of `address`.
+//
+// States and postal codes are left out since they are often abbreviated or omitted.
+func offlineAddressKey(address *Address) string {
+	return normalizeKeyPart(address.Street) + "|" + normalizeKeyPart(address.City) + "|" + normalizeKeyPart(address.Country)
+}
+
+func centroidKey(country, postalCode string) string {
+	return normalizeKeyPart(country) + "|" + normalizePostalCode(postalCode)
+}
+
+// Postal codes are written with or without spaces
+func normalizePostalCode(postalCode string) string {
+	return strings.ReplaceAll(normalizeKeyPart(postalCode), " ", "")
+}
+
+// Returns `address` formatted on a single line, skipping empty components
+func formatAddress(address *Address) string {
+	parts := make([]string, 0, 4)
+	for _, part := range []string{address.Street, address.City, strings.TrimSpace(address.State + " " + address.PostalCode), address.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
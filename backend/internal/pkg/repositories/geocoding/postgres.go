@@ -0,0 +1,100 @@
+package geocoding
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/aarondl/opt/omit"
+	"github.com/rs/zerolog/log"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+)
+
+// Interval between two deletions of expired results
+const cacheCleanupInterval = time.Hour
+
+// PostgresCache stores cached geocoding results in Postgres.
+type PostgresCache struct {
+	db bob.DB
+}
+
+func NewPostgresCache(db bob.DB) *PostgresCache {
+	return &PostgresCache{
+		db: db,
+	}
+}
+
+func (p *PostgresCache) Get(ctx context.Context, key string, now time.Time) ([]Result, error) {
+	cached, err := dbmodels.Geocodecaches.Query(
+		dbmodels.SelectWhere.Geocodecaches.Addresskey.EQ(key),
+		dbmodels.SelectWhere.Geocodecaches.Expiresat.GT(now),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrCacheMiss
+		}
+		return nil, err
+	}
+
+	var result []Result
+	err = json.Unmarshal([]byte(cached.Results), &result)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode cached geocoding results: %w", err)
+	}
+	return result, nil
+}
+
+func (p *PostgresCache) Put(ctx context.Context, key string, results []Result, expiresAt time.Time) error {
+	encoded, err := json.Marshal(results)
+	if err != nil {
+		return fmt.Errorf("could not encode geocoding results: %w", err)
+	}
+
+	_, err = dbmodels.Geocodecaches.Insert(
+		&dbmodels.GeocodecacheSetter{
+			Addresskey: omit.From(key),
+			Results:    omit.From(string(encoded)),
+			Expiresat:  omit.From(expiresAt),
+			Createdat:  omit.From(time.Now()),
+		},
+		im.OnConflict(dbmodels.ColumnNames.Geocodecaches.Addresskey).DoUpdate(
+			im.SetExcluded(
+				dbmodels.ColumnNames.Geocodecaches.Results,
+				dbmodels.ColumnNames.Geocodecaches.Expiresat,
+				dbmodels.ColumnNames.Geocodecaches.Createdat,
+			),
+		),
+	).Exec(ctx, p.db)
+	return err
+}
+
+// Delete results that expired by `now`, returning the number of addresses deleted
+func (p *PostgresCache) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	return dbmodels.Geocodecaches.Delete(
+		dbmodels.DeleteWhere.Geocodecaches.Expiresat.LTE(now),
+	).Exec(ctx, p.db)
+}
+
+// Delete expired results regularly until `ctx` is cancelled
+func (p *PostgresCache) RunCleanup(ctx context.Context) {
+	ticker := time.NewTicker(cacheCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := p.DeleteExpired(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			log.Ctx(ctx).Err(err).Msg("could not delete expired geocoding results")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package geocoding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresCacheIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	store := NewPostgresCache(db)
+	now := time.Now()
+	key := cacheKey(&sampleAddress)
+
+	_, err = store.Get(ctx, key, now)
+	require.ErrorIs(t, err, ErrCacheMiss)
+
+	err = store.Put(ctx, key, sampleResults, now.Add(time.Hour))
+	require.NoError(t, err)
+	result, err := store.Get(ctx, key, now)
+	require.NoError(t, err)
+	assert.Equal(t, sampleResults, result)
+
+	// Putting again replaces the results
+	updated := []Result{sampleResults[0], sampleResults[0]}
+	err = store.Put(ctx, key, updated, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	result, err = store.Get(ctx, key, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, updated, result)
+
+	_, err = store.Get(ctx, key, now.Add(2*time.Hour))
+	require.ErrorIs(t, err, ErrCacheMiss)
+
+	deleted, err := store.DeleteExpired(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
@@ -0,0 +1,71 @@
+package geocoding
+
+import (
+	"context"
+	"time"
+)
+
+// Retry is a Geocoder retrying temporary failures of another Geocoder, waiting longer after each attempt.
+type Retry struct {
+	next      Geocoder
+	attempts  int
+	baseDelay time.Duration
+	timeout   time.Duration
+}
+
+// Create a Geocoder calling `next` at most `attempts` times per request.
+//
+// Each attempt is given `timeout` to complete. The delay before the second attempt is `baseDelay`,
+// and doubles for every following attempt.
+func NewRetry(next Geocoder, attempts int, baseDelay, timeout time.Duration) *Retry {
+	return &Retry{
+		next:      next,
+		attempts:  max(attempts, 1),
+		baseDelay: baseDelay,
+		timeout:   timeout,
+	}
+}
+
+func (r *Retry) Geocode(ctx context.Context, address *Address) ([]Result, error) {
+	return r.do(ctx, geocodeRequest(address))
+}
+
+func (r *Retry) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]Result, error) {
+	return r.do(ctx, reverseGeocodeRequest(latitude, longitude))
+}
+
+func (r *Retry) Suggest(ctx context.Context, query string) ([]Result, error) {
+	return r.do(ctx, suggestRequest(query))
+}
+
+func (r *Retry) Search(ctx context.Context, query string) ([]Result, error) {
+	return r.do(ctx, searchRequest(query))
+}
+
+func (r *Retry) do(ctx context.Context, req request) ([]Result, error) {
+	delay := r.baseDelay
+	for attempt := 1; ; attempt++ {
+		result, err := r.attempt(ctx, req)
+		if err == nil || attempt >= r.attempts || !IsTemporary(err) || ctx.Err() != nil {
+			return result, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+func (r *Retry) attempt(ctx context.Context, req request) ([]Result, error) {
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+	return req(ctx, r.next)
+}
@@ -0,0 +1,41 @@
+package hold
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	models.Hold
+	InternalID int64 // The internal ID of this hold
+	UserID     int64 // The user holding the time slots
+	SpotID     int64 // The internal ID of the held parking spot
+}
+
+type CreateInput struct {
+	ExpiresAt time.Time
+	HeldTimes []models.TimeUnit
+	UserID    int64
+	SpotID    int64 // The internal ID of the parking spot
+}
+
+var (
+	ErrNotFound        = errors.New("no hold found")
+	ErrTimeUnavailable = errors.New("one or more times is not available")
+)
+
+type Repository interface {
+	// Hold the time slots in `input`.
+	//
+	// Other holds of the user on the same spot are released.
+	Create(ctx context.Context, input *CreateInput) (Entry, error)
+	GetByUUID(ctx context.Context, holdID uuid.UUID) (Entry, error)
+	// Release the hold `holdID`
+	DeleteByUUID(ctx context.Context, holdID uuid.UUID) error
+	// Release all holds expired by `now`, returning the number of holds released
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
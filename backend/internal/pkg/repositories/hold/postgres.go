@@ -0,0 +1,306 @@
+package hold
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbtype"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/availability"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/outbox"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, input *CreateInput) (Entry, error) {
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
+
+	// Free up expired holds on the spot and the previous holds of this user on it
+	_, err = Release(
+		ctx,
+		tx,
+		dbmodels.SelectWhere.Holds.Parkingspotid.EQ(input.SpotID),
+		dbmodels.SelectWhere.Holds.Expiresat.LTE(time.Now()),
+	)
+	if err != nil {
+		return Entry{}, err
+	}
+	_, err = Release(
+		ctx,
+		tx,
+		dbmodels.SelectWhere.Holds.Userid.EQ(input.UserID),
+		dbmodels.SelectWhere.Holds.Parkingspotid.EQ(input.SpotID),
+	)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	inserted, err := dbmodels.Holds.Insert(&dbmodels.HoldSetter{
+		Userid:        omit.From(input.UserID),
+		Parkingspotid: omit.From(input.SpotID),
+		Expiresat:     omit.From(input.ExpiresAt),
+	}).One(ctx, tx)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not execute insert: %w", err)
+	}
+
+	held, err := dbmodels.Timeunits.Update(
+		dbmodels.TimeunitSetter{
+			Holdid: omitnull.From(inserted.Holdid),
+		}.UpdateMod(),
+		psql.WhereAnd(
+			dbmodels.UpdateWhere.Timeunits.Bookingid.IsNull(),
+			dbmodels.UpdateWhere.Timeunits.Holdid.IsNull(),
+			dbmodels.UpdateWhere.Timeunits.Parkingspotid.EQ(input.SpotID),
+			um.Where(timeSlotsToSQLExpr(input.HeldTimes)),
+		),
+	).All(ctx, tx)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not update time units: %w", err)
+	}
+	if len(held) != len(input.HeldTimes) {
+		return Entry{}, ErrTimeUnavailable
+	}
+
+	spot, err := dbmodels.FindParkingspot(ctx, tx, input.SpotID)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not get parking spot: %w", err)
+	}
+
+	entry := entryFromDB(inserted, spot.Parkingspotuuid, held)
+	err = notify(ctx, tx, spot, models.AvailabilityEventHeld, entry.HeldTimes, false)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (p *PostgresRepository) GetByUUID(ctx context.Context, holdID uuid.UUID) (Entry, error) {
+	result, err := dbmodels.Holds.Query(
+		dbmodels.SelectWhere.Holds.Holduuid.EQ(holdID),
+		dbmodels.PreloadHoldParkingspotidParkingspot(),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	units, err := result.HoldidTimeunits(
+		sm.OrderBy(psql.F("lower", dbmodels.TimeunitColumns.Timerange)),
+	).All(ctx, p.db)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not get held time units: %w", err)
+	}
+
+	return entryFromDB(result, result.R.ParkingspotidParkingspot.Parkingspotuuid, units), nil
+}
+
+func (p *PostgresRepository) DeleteByUUID(ctx context.Context, holdID uuid.UUID) error {
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
+
+	released, err := Release(ctx, tx, dbmodels.SelectWhere.Holds.Holduuid.EQ(holdID))
+	if err != nil {
+		return err
+	}
+	if released == 0 {
+		return ErrNotFound
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
+
+	// Holds being released by another transaction are left to it
+	released, err := release(ctx, tx, now, sm.ForUpdate().SkipLocked(), dbmodels.SelectWhere.Holds.Expiresat.LTE(now))
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("could not commit transaction: %w", err)
+	}
+	return released, nil
+}
+
+// Delete the holds selected by `where` and publish the time units they release.
+//
+// Callers should select the holds of a single spot, to not wait on unrelated transactions.
+// Returns the number of holds deleted.
+func Release(ctx context.Context, tx bob.Tx, where ...bob.Mod[*dialect.SelectQuery]) (int64, error) {
+	return release(ctx, tx, time.Now(), sm.ForUpdate(), where...)
+}
+
+// Delete the holds selected by `where`, locking them with `lock`.
+//
+// Holds are locked in ID order so that concurrent releases cannot deadlock. Time units of holds
+// expired by `now` are published as released by an expiry.
+func release(ctx context.Context, tx bob.Tx, now time.Time, lock bob.Mod[*dialect.SelectQuery], where ...bob.Mod[*dialect.SelectQuery]) (int64, error) {
+	mods := append(where, sm.OrderBy(dbmodels.HoldColumns.Holdid), lock)
+	holds, err := dbmodels.Holds.Query(mods...).All(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("could not get holds: %w", err)
+	}
+	if len(holds) == 0 {
+		return 0, nil
+	}
+
+	err = holds.LoadHoldParkingspotidParkingspot(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("could not get held parking spots: %w", err)
+	}
+	err = holds.LoadHoldHoldidTimeunits(
+		ctx,
+		tx,
+		dbmodels.SelectWhere.Timeunits.Bookingid.IsNull(),
+		sm.OrderBy(psql.F("lower", dbmodels.TimeunitColumns.Timerange)),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("could not get held time units: %w", err)
+	}
+
+	ids := make([]int64, 0, len(holds))
+	for _, hold := range holds {
+		ids = append(ids, hold.Holdid)
+	}
+	// Time units are released by the foreign key
+	_, err = dbmodels.Holds.Delete(dbmodels.DeleteWhere.Holds.Holdid.In(ids...)).Exec(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("could not delete holds: %w", err)
+	}
+
+	for _, hold := range holds {
+		err = notify(
+			ctx,
+			tx,
+			hold.R.ParkingspotidParkingspot,
+			models.AvailabilityEventReleased,
+			timeUnitsFromDB(hold.R.HoldidTimeunits),
+			!hold.Expiresat.After(now),
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(holds)), nil
+}
+
+// Record and publish an availability event of `eventType` for `times` of `spot`, `expired` if the
+// times were released by an expired hold
+func notify(ctx context.Context, tx bob.Tx, spot *dbmodels.Parkingspot, eventType string, times []models.TimeUnit, expired bool) error {
+	if len(times) == 0 {
+		return nil
+	}
+
+	lat, _ := spot.Latitude.Float64()
+	long, _ := spot.Longitude.Float64()
+	event := models.AvailabilityEvent{
+		Type:      eventType,
+		Times:     times,
+		Longitude: long,
+		Latitude:  lat,
+		SpotID:    spot.Parkingspotuuid,
+		Expired:   expired,
+	}
+	err := outbox.Write(ctx, tx, models.EventAvailabilityChanged, &event)
+	if err != nil {
+		return err
+	}
+	return availability.Notify(ctx, tx, &event)
+}
+
+func timeSlotsToSQLExpr(units []models.TimeUnit) dialect.Expression {
+	var expression dialect.Expression
+	for _, unit := range units {
+		test := dbmodels.TimeunitColumns.Timerange.OP(
+			"&&",
+			psql.Arg(dbtype.Tstzrange{
+				Start: unit.StartTime,
+				End:   unit.EndTime,
+			}),
+		)
+		if expression.Base == nil {
+			expression = test
+		} else {
+			expression = expression.Or(test)
+		}
+	}
+	return expression
+}
+
+func timeUnitsFromDB(model []*dbmodels.Timeunit) []models.TimeUnit {
+	result := make([]models.TimeUnit, 0, len(model))
+	for _, unit := range model {
+		status := "held"
+		if _, ok := unit.Bookingid.Get(); ok {
+			status = "booked"
+		}
+
+		result = append(result, models.TimeUnit{
+			StartTime: unit.Timerange.Start,
+			EndTime:   unit.Timerange.End,
+			Status:    status,
+		})
+	}
+	return result
+}
+
+func entryFromDB(model *dbmodels.Hold, spotID uuid.UUID, units []*dbmodels.Timeunit) Entry {
+	return Entry{
+		Hold: models.Hold{
+			ExpiresAt: model.Expiresat,
+			HeldTimes: timeUnitsFromDB(units),
+			SpotID:    spotID,
+			ID:        model.Holduuid,
+		},
+		InternalID: model.Holdid,
+		UserID:     model.Userid,
+		SpotID:     model.Parkingspotid,
+	}
+}
@@ -0,0 +1,263 @@
+package hold
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/outbox"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+	spotRepo := parkingspot.NewPostgres(db)
+
+	ownerProfile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	driverProfile := models.UserProfile{
+		FullName: "John Smith",
+		Email:    "j.smith@gmail.com",
+	}
+	ownerAuth, _ := authRepo.Create(ctx, ownerProfile.Email, models.HashedPassword("some hash"))
+	driverAuth, _ := authRepo.Create(ctx, driverProfile.Email, models.HashedPassword("some other hash"))
+	ownerID, _ := userRepo.Create(ctx, ownerAuth, ownerProfile)
+	driverID, _ := userRepo.Create(ctx, driverAuth, driverProfile)
+
+	slots := []models.TimeUnit{
+		{
+			StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			StartTime: time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 30, 0, 0, time.UTC),
+		},
+	}
+	spot, _, err := spotRepo.Create(ctx, ownerID, &models.ParkingSpotCreationInput{
+		Location: models.ParkingSpotLocation{
+			PostalCode:    "L2E6T2",
+			CountryCode:   "CA",
+			City:          "Niagara Falls",
+			StreetAddress: "5 Niagara Parkway",
+			State:         "ON",
+			Latitude:      43.07923,
+			Longitude:     -79.07887,
+		},
+		PricePerHour: 10.5,
+		Availability: slots,
+	})
+	require.NoError(t, err)
+
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	availStart := slots[0].StartTime.Add(-time.Hour)
+	availEnd := slots[1].EndTime.Add(time.Hour)
+
+	// Returns the availability events of released time slots recorded in the outbox
+	releasedEvents := func(t *testing.T) []models.AvailabilityEvent {
+		t.Helper()
+
+		events, err := outbox.NewPostgres(db).ClaimDue(ctx, time.Now().Add(time.Minute), time.Now().Add(time.Hour), 100)
+		require.NoError(t, err)
+		var result []models.AvailabilityEvent
+		for _, event := range events {
+			if event.Type != models.EventAvailabilityChanged {
+				continue
+			}
+			var data models.AvailabilityEvent
+			err = json.Unmarshal(event.Data, &data)
+			require.NoError(t, err)
+			if data.Type == models.AvailabilityEventReleased {
+				result = append(result, data)
+			}
+		}
+		return result
+	}
+
+	t.Run("held times are shown as held until released", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		entry, err := repo.Create(ctx, &CreateInput{
+			ExpiresAt: time.Now().Add(time.Minute),
+			HeldTimes: slots[0:1],
+			UserID:    driverID,
+			SpotID:    spot.InternalID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, driverID, entry.UserID)
+		assert.Equal(t, spot.ID, entry.Hold.SpotID)
+		if assert.Len(t, entry.HeldTimes, 1) {
+			assert.Equal(t, "held", entry.HeldTimes[0].Status)
+		}
+
+		got, err := repo.GetByUUID(ctx, entry.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entry.InternalID, got.InternalID)
+
+		avail, err := spotRepo.GetAvailByUUID(ctx, spot.ID, availStart, availEnd)
+		require.NoError(t, err)
+		if assert.Len(t, avail, 2) {
+			assert.Equal(t, "held", avail[0].Status)
+			assert.Equal(t, "available", avail[1].Status)
+		}
+
+		err = repo.DeleteByUUID(ctx, entry.ID)
+		require.NoError(t, err)
+
+		_, err = repo.GetByUUID(ctx, entry.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+		err = repo.DeleteByUUID(ctx, entry.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		avail, err = spotRepo.GetAvailByUUID(ctx, spot.ID, availStart, availEnd)
+		require.NoError(t, err)
+		for _, unit := range avail {
+			assert.Equal(t, "available", unit.Status)
+		}
+
+		// Holds released by their driver did not expire
+		released := releasedEvents(t)
+		if assert.Len(t, released, 1) {
+			assert.False(t, released[0].Expired)
+		}
+	})
+
+	t.Run("held times can not be held by others", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		_, err := repo.Create(ctx, &CreateInput{
+			ExpiresAt: time.Now().Add(time.Minute),
+			HeldTimes: slots,
+			UserID:    driverID,
+			SpotID:    spot.InternalID,
+		})
+		require.NoError(t, err)
+
+		_, err = repo.Create(ctx, &CreateInput{
+			ExpiresAt: time.Now().Add(time.Minute),
+			HeldTimes: slots[1:],
+			UserID:    ownerID,
+			SpotID:    spot.InternalID,
+		})
+		require.ErrorIs(t, err, ErrTimeUnavailable)
+	})
+
+	t.Run("new hold replaces previous hold on the spot", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		first, err := repo.Create(ctx, &CreateInput{
+			ExpiresAt: time.Now().Add(time.Minute),
+			HeldTimes: slots,
+			UserID:    driverID,
+			SpotID:    spot.InternalID,
+		})
+		require.NoError(t, err)
+
+		_, err = repo.Create(ctx, &CreateInput{
+			ExpiresAt: time.Now().Add(time.Minute),
+			HeldTimes: slots[1:],
+			UserID:    driverID,
+			SpotID:    spot.InternalID,
+		})
+		require.NoError(t, err)
+
+		_, err = repo.GetByUUID(ctx, first.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		released := releasedEvents(t)
+		if assert.Len(t, released, 1) {
+			assert.False(t, released[0].Expired)
+		}
+	})
+
+	t.Run("expired holds are released", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		expiresAt := time.Now().Add(time.Minute)
+		entry, err := repo.Create(ctx, &CreateInput{
+			ExpiresAt: expiresAt,
+			HeldTimes: slots,
+			UserID:    driverID,
+			SpotID:    spot.InternalID,
+		})
+		require.NoError(t, err)
+
+		released, err := repo.DeleteExpired(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), released)
+
+		released, err = repo.DeleteExpired(ctx, expiresAt.Add(time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), released)
+
+		_, err = repo.GetByUUID(ctx, entry.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		events := releasedEvents(t)
+		if assert.Len(t, events, 1) {
+			assert.True(t, events[0].Expired)
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package message
+
+import (
+	"context"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	models.Message
+	InternalID int64 // The internal ID of this message
+	SenderID   int64 // The user who sent this message
+}
+
+type CreateInput struct {
+	Body      string
+	BookingID int64 // The internal ID of the booking this message belongs to
+	SenderID  int64
+	Redacted  bool // Whether contact details were removed from Body
+}
+
+type UnreadEntry struct {
+	Count     int64     `db:"unread"`
+	BookingID uuid.UUID `db:"bookinguuid"`
+}
+
+type Cursor struct {
+	_  struct{} `cbor:",toarray"`
+	ID int64    // The internal message ID to use as anchor
+}
+
+type Repository interface {
+	// Create a new message in the thread of a booking
+	Create(ctx context.Context, input *CreateInput) (Entry, error)
+	// Get at most `limit` messages of the booking with the internal ID `bookingID`, newest first
+	GetMany(ctx context.Context, limit int, after omit.Val[Cursor], bookingID int64) ([]Entry, error)
+	// Mark all messages sent to `readerID` in the thread of the booking `bookingID` as read
+	MarkRead(ctx context.Context, bookingID, readerID int64) error
+	// Get the number of unread messages sent to `userID`, for each booking with unread messages
+	GetUnreadCounts(ctx context.Context, userID int64) ([]UnreadEntry, error)
+}
@@ -0,0 +1,129 @@
+package message
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/fm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, input *CreateInput) (Entry, error) {
+	inserted, err := dbmodels.Messages.Insert(&dbmodels.MessageSetter{
+		Bookingid: omit.From(input.BookingID),
+		Senderid:  omit.From(input.SenderID),
+		Body:      omit.From(input.Body),
+		Redacted:  omit.From(input.Redacted),
+	}).One(ctx, p.db)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return entryFromDB(inserted), nil
+}
+
+func (p *PostgresRepository) GetMany(ctx context.Context, limit int, after omit.Val[Cursor], bookingID int64) ([]Entry, error) {
+	smods := []bob.Mod[*dialect.SelectQuery]{
+		dbmodels.SelectWhere.Messages.Bookingid.EQ(bookingID),
+	}
+	if cursor, ok := after.Get(); ok {
+		smods = append(smods, dbmodels.SelectWhere.Messages.Messageid.LT(cursor.ID))
+	}
+	smods = append(
+		smods,
+		sm.OrderBy(dbmodels.MessageColumns.Messageid).Desc(),
+		sm.Limit(limit),
+	)
+
+	messages, err := dbmodels.Messages.Query(smods...).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(messages))
+	for _, model := range messages {
+		result = append(result, entryFromDB(model))
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) MarkRead(ctx context.Context, bookingID, readerID int64) error {
+	_, err := dbmodels.Messages.Update(
+		um.SetCol(dbmodels.ColumnNames.Messages.Readat).ToArg(time.Now()),
+		dbmodels.UpdateWhere.Messages.Bookingid.EQ(bookingID),
+		dbmodels.UpdateWhere.Messages.Senderid.NE(readerID),
+		dbmodels.UpdateWhere.Messages.Readat.IsNull(),
+	).Exec(ctx, p.db)
+	return err
+}
+
+func (p *PostgresRepository) GetUnreadCounts(ctx context.Context, userID int64) ([]UnreadEntry, error) {
+	query := psql.Select(
+		sm.Columns(
+			dbmodels.BookingColumns.Bookinguuid,
+			psql.F("count", psql.Raw("*"))(fm.As("unread")),
+		),
+		sm.From(dbmodels.Messages.Name()),
+		dbmodels.SelectJoins.Messages.InnerJoin.BookingidBooking(ctx),
+		dbmodels.SelectJoins.Bookings.InnerJoin.ParkingspotidParkingspot(ctx),
+		dbmodels.SelectWhere.Messages.Readat.IsNull(),
+		dbmodels.SelectWhere.Messages.Senderid.NE(userID),
+		sm.Where(psql.Or(
+			dbmodels.BookingColumns.Userid.EQ(psql.Arg(userID)),
+			dbmodels.ParkingspotColumns.Userid.EQ(psql.Arg(userID)),
+		)),
+		sm.GroupBy(dbmodels.BookingColumns.Bookinguuid),
+		sm.OrderBy(dbmodels.BookingColumns.Bookinguuid),
+	)
+
+	result, err := bob.All(ctx, p.db, query, scan.StructMapper[UnreadEntry]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []UnreadEntry{}, nil
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+func entryFromDB(model *dbmodels.Message) Entry {
+	var readAt *time.Time
+	if val, ok := model.Readat.Get(); ok {
+		readAt = &val
+	}
+
+	return Entry{
+		Message: models.Message{
+			CreatedAt: model.Createdat,
+			ReadAt:    readAt,
+			Body:      model.Body,
+			Redacted:  model.Redacted,
+			ID:        model.Messageuuid,
+		},
+		InternalID: model.Messageid,
+		SenderID:   model.Senderid,
+	}
+}
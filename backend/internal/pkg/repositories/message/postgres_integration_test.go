@@ -0,0 +1,209 @@
+package message
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/car"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/aarondl/opt/omit"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+	carRepo := car.NewPostgres(db)
+	spotRepo := parkingspot.NewPostgres(db)
+	bookingRepo := booking.NewPostgres(db)
+
+	ownerProfile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	driverProfile := models.UserProfile{
+		FullName: "John Smith",
+		Email:    "j.smith@gmail.com",
+	}
+	ownerAuth, _ := authRepo.Create(ctx, ownerProfile.Email, models.HashedPassword("some hash"))
+	driverAuth, _ := authRepo.Create(ctx, driverProfile.Email, models.HashedPassword("some other hash"))
+	ownerID, _ := userRepo.Create(ctx, ownerAuth, ownerProfile)
+	driverID, _ := userRepo.Create(ctx, driverAuth, driverProfile)
+
+	_, carEntry, err := carRepo.Create(ctx, driverID, &models.CarCreationInput{
+		CarDetails: models.CarDetails{
+			LicensePlate: "HTV 670",
+			Make:         "Honda",
+			Model:        "Civic",
+			Color:        "Blue",
+		},
+	})
+	require.NoError(t, err)
+
+	slots := []models.TimeUnit{
+		{
+			StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			StartTime: time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 30, 0, 0, time.UTC),
+		},
+	}
+	spot, _, err := spotRepo.Create(ctx, ownerID, &models.ParkingSpotCreationInput{
+		Location: models.ParkingSpotLocation{
+			PostalCode:    "L2E6T2",
+			CountryCode:   "CA",
+			City:          "Niagara Falls",
+			StreetAddress: "5 Niagara Parkway",
+			State:         "ON",
+			Latitude:      43.07923,
+			Longitude:     -79.07887,
+		},
+		PricePerHour: 10.5,
+		Availability: slots,
+	})
+	require.NoError(t, err)
+
+	bookings := make([]booking.EntryWithTimes, 0, len(slots))
+	for _, slot := range slots {
+		entry, err := bookingRepo.Create(ctx, &booking.CreateInput{
+			BookedTimes:  []models.TimeUnit{slot},
+			UserID:       driverID,
+			SpotID:       spot.InternalID,
+			CarID:        carEntry.InternalID,
+			PaidAmount:   5.25,
+			PayoutAmount: 5.25,
+		})
+		require.NoError(t, err)
+		bookings = append(bookings, entry)
+	}
+
+	// Snapshot after bookings are inserted
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	t.Run("messages are paginated newest first", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		first, err := repo.Create(ctx, &CreateInput{
+			Body:      "Which side of the driveway?",
+			BookingID: bookings[0].Entry.InternalID,
+			SenderID:  driverID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, driverID, first.SenderID)
+		assert.Nil(t, first.ReadAt)
+
+		second, err := repo.Create(ctx, &CreateInput{
+			Body:      "The left side",
+			BookingID: bookings[0].Entry.InternalID,
+			SenderID:  ownerID,
+		})
+		require.NoError(t, err)
+
+		// Messages of other bookings are not included
+		_, err = repo.Create(ctx, &CreateInput{
+			Body:      "Hello",
+			BookingID: bookings[1].Entry.InternalID,
+			SenderID:  driverID,
+		})
+		require.NoError(t, err)
+
+		messages, err := repo.GetMany(ctx, 1, omit.Val[Cursor]{}, bookings[0].Entry.InternalID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{second}, messages)
+
+		messages, err = repo.GetMany(ctx, 5, omit.From(Cursor{ID: messages[0].InternalID}), bookings[0].Entry.InternalID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{first}, messages)
+	})
+
+	t.Run("unread counts", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		for idx := range bookings {
+			_, err := repo.Create(ctx, &CreateInput{
+				Body:      "Hello",
+				BookingID: bookings[idx].Entry.InternalID,
+				SenderID:  driverID,
+			})
+			require.NoError(t, err)
+		}
+		_, err := repo.Create(ctx, &CreateInput{
+			Body:      "Hi",
+			BookingID: bookings[0].Entry.InternalID,
+			SenderID:  ownerID,
+		})
+		require.NoError(t, err)
+
+		unread, err := repo.GetUnreadCounts(ctx, ownerID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []UnreadEntry{
+			{Count: 1, BookingID: bookings[0].Entry.ID},
+			{Count: 1, BookingID: bookings[1].Entry.ID},
+		}, unread)
+
+		err = repo.MarkRead(ctx, bookings[0].Entry.InternalID, ownerID)
+		require.NoError(t, err)
+
+		unread, err = repo.GetUnreadCounts(ctx, ownerID)
+		require.NoError(t, err)
+		assert.Equal(t, []UnreadEntry{{Count: 1, BookingID: bookings[1].Entry.ID}}, unread)
+
+		// The owner's own message is still unread by the driver
+		unread, err = repo.GetUnreadCounts(ctx, driverID)
+		require.NoError(t, err)
+		assert.Equal(t, []UnreadEntry{{Count: 1, BookingID: bookings[0].Entry.ID}}, unread)
+
+		messages, err := repo.GetMany(ctx, 5, omit.Val[Cursor]{}, bookings[0].Entry.InternalID)
+		require.NoError(t, err)
+		if assert.Len(t, messages, 2) {
+			assert.Nil(t, messages[0].ReadAt)
+			assert.NotNil(t, messages[1].ReadAt)
+		}
+	})
+}
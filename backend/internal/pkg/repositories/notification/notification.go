@@ -0,0 +1,47 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	models.Notification
+	InternalID int64 // The internal ID of this notification
+	UserID     int64 // The recipient of this notification
+}
+
+type CreateInput struct {
+	models.NotificationInput
+	UserID int64 // The recipient of the notification
+}
+
+type Cursor struct {
+	_  struct{} `cbor:",toarray"`
+	ID int64    // The internal notification ID to use as anchor
+}
+
+var (
+	ErrNotFound       = errors.New("no notification found")
+	ErrDuplicateEvent = errors.New("a notification of this type was already sent to the user for this event")
+)
+
+type Repository interface {
+	// Record a new notification in the inbox of a user
+	//
+	// Returns ErrDuplicateEvent if the user already has a notification of the same type for the same event.
+	Create(ctx context.Context, input *CreateInput) (Entry, error)
+	// Get at most `limit` notifications of `userID`, newest first
+	GetMany(ctx context.Context, limit int, after omit.Val[Cursor], userID int64) ([]Entry, error)
+	// Mark the notification `notificationID` of `userID` as read at `now`, if it was not read before
+	//
+	// Returns ErrNotFound if `userID` has no such notification.
+	MarkRead(ctx context.Context, userID int64, notificationID uuid.UUID, now time.Time) (Entry, error)
+	// Mark all unread notifications of `userID` as read at `now`, returning the number of marked notifications
+	MarkAllRead(ctx context.Context, userID int64, now time.Time) (int64, error)
+}
@@ -0,0 +1,133 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, input *CreateInput) (Entry, error) {
+	setter := dbmodels.NotificationSetter{
+		Userid: omit.From(input.UserID),
+		Type:   omit.From(input.Type),
+		Title:  omit.From(input.Title),
+		Body:   omit.From(input.Body),
+	}
+	if input.SubjectID != uuid.Nil {
+		setter.Subjectuuid = omitnull.From(input.SubjectID)
+	}
+	if input.EventID != uuid.Nil {
+		setter.Eventuuid = omitnull.From(input.EventID)
+	}
+
+	inserted, err := dbmodels.Notifications.Insert(&setter).One(ctx, p.db)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			err = ErrDuplicateEvent
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(inserted), nil
+}
+
+func (p *PostgresRepository) GetMany(ctx context.Context, limit int, after omit.Val[Cursor], userID int64) ([]Entry, error) {
+	smods := []bob.Mod[*dialect.SelectQuery]{
+		dbmodels.SelectWhere.Notifications.Userid.EQ(userID),
+	}
+	if cursor, ok := after.Get(); ok {
+		smods = append(smods, dbmodels.SelectWhere.Notifications.Notificationid.LT(cursor.ID))
+	}
+	smods = append(
+		smods,
+		sm.OrderBy(dbmodels.NotificationColumns.Notificationid).Desc(),
+		sm.Limit(limit),
+	)
+
+	notifications, err := dbmodels.Notifications.Query(smods...).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(notifications))
+	for _, model := range notifications {
+		result = append(result, entryFromDB(model))
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) MarkRead(ctx context.Context, userID int64, notificationID uuid.UUID, now time.Time) (Entry, error) {
+	// Keep the time of the first read
+	updated, err := dbmodels.Notifications.Update(
+		um.SetCol(dbmodels.ColumnNames.Notifications.Readat).To(
+			psql.F("COALESCE", dbmodels.NotificationColumns.Readat, psql.Arg(now))(),
+		),
+		dbmodels.UpdateWhere.Notifications.Notificationuuid.EQ(notificationID),
+		dbmodels.UpdateWhere.Notifications.Userid.EQ(userID),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(updated), nil
+}
+
+func (p *PostgresRepository) MarkAllRead(ctx context.Context, userID int64, now time.Time) (int64, error) {
+	return dbmodels.Notifications.Update(
+		um.SetCol(dbmodels.ColumnNames.Notifications.Readat).ToArg(now),
+		dbmodels.UpdateWhere.Notifications.Userid.EQ(userID),
+		dbmodels.UpdateWhere.Notifications.Readat.IsNull(),
+	).Exec(ctx, p.db)
+}
+
+func entryFromDB(model *dbmodels.Notification) Entry {
+	var readAt *time.Time
+	if val, ok := model.Readat.Get(); ok {
+		readAt = &val
+	}
+
+	return Entry{
+		Notification: models.Notification{
+			CreatedAt: model.Createdat,
+			ReadAt:    readAt,
+			Type:      model.Type,
+			Title:     model.Title,
+			Body:      model.Body,
+			SubjectID: model.Subjectuuid.GetOrZero(),
+			ID:        model.Notificationuuid,
+		},
+		InternalID: model.Notificationid,
+		UserID:     model.Userid,
+	}
+}
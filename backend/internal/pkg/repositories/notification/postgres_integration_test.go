@@ -0,0 +1,202 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+
+	profile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	profile_1 := models.UserProfile{
+		FullName: "John Smith",
+		Email:    "j.smith@gmail.com",
+	}
+	authUUID, _ := authRepo.Create(ctx, profile.Email, models.HashedPassword("some hash"))
+	authUUID_1, _ := authRepo.Create(ctx, profile_1.Email, models.HashedPassword("some other hash"))
+	userID, _ := userRepo.Create(ctx, authUUID, profile)
+	userID_1, _ := userRepo.Create(ctx, authUUID_1, profile_1)
+
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	t.Run("notifications are paginated newest first", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		first, err := repo.Create(ctx, &CreateInput{
+			NotificationInput: models.NotificationInput{
+				Type:      models.NotificationSpotAvailable,
+				Title:     "Parking available",
+				Body:      "1 time slot is now available at 5 Niagara Parkway, Niagara Falls.",
+				SubjectID: uuid.New(),
+			},
+			UserID: userID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, userID, first.UserID)
+		assert.Nil(t, first.ReadAt)
+
+		second, err := repo.Create(ctx, &CreateInput{
+			NotificationInput: models.NotificationInput{
+				Type:  models.NotificationSpotAvailable,
+				Title: "Parking available",
+				Body:  "2 time slots were released at 5 Niagara Parkway, Niagara Falls.",
+			},
+			UserID: userID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uuid.Nil, second.SubjectID)
+
+		// Notifications of other users are not included
+		_, err = repo.Create(ctx, &CreateInput{
+			NotificationInput: models.NotificationInput{
+				Type:  models.NotificationSpotAvailable,
+				Title: "Parking available",
+				Body:  "Hello",
+			},
+			UserID: userID_1,
+		})
+		require.NoError(t, err)
+
+		notifications, err := repo.GetMany(ctx, 1, omit.Val[Cursor]{}, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{second}, notifications)
+
+		notifications, err = repo.GetMany(ctx, 5, omit.From(Cursor{ID: notifications[0].InternalID}), userID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{first}, notifications)
+	})
+	t.Run("mark read", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		input := models.NotificationInput{
+			Type:  models.NotificationBookingCreated,
+			Title: "New booking",
+			Body:  "2 time slots booked at 5 Niagara Parkway, Niagara Falls.",
+		}
+		first, err := repo.Create(ctx, &CreateInput{NotificationInput: input, UserID: userID})
+		require.NoError(t, err)
+		_, err = repo.Create(ctx, &CreateInput{NotificationInput: input, UserID: userID})
+		require.NoError(t, err)
+		other, err := repo.Create(ctx, &CreateInput{NotificationInput: input, UserID: userID_1})
+		require.NoError(t, err)
+
+		readAt := time.Now().Truncate(time.Microsecond)
+		read, err := repo.MarkRead(ctx, userID, first.ID, readAt)
+		require.NoError(t, err)
+		if assert.NotNil(t, read.ReadAt) {
+			assert.True(t, readAt.Equal(*read.ReadAt))
+		}
+
+		// The first read time is kept
+		read, err = repo.MarkRead(ctx, userID, first.ID, readAt.Add(time.Hour))
+		require.NoError(t, err)
+		if assert.NotNil(t, read.ReadAt) {
+			assert.True(t, readAt.Equal(*read.ReadAt))
+		}
+
+		// Notifications of other users can not be marked
+		_, err = repo.MarkRead(ctx, userID, other.ID, readAt)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		marked, err := repo.MarkAllRead(ctx, userID, readAt)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), marked)
+
+		notifications, err := repo.GetMany(ctx, 5, omit.Val[Cursor]{}, userID)
+		require.NoError(t, err)
+		for _, entry := range notifications {
+			assert.NotNil(t, entry.ReadAt)
+		}
+		notifications, err = repo.GetMany(ctx, 5, omit.Val[Cursor]{}, userID_1)
+		require.NoError(t, err)
+		if assert.Len(t, notifications, 1) {
+			assert.Nil(t, notifications[0].ReadAt)
+		}
+	})
+
+	t.Run("one notification per event, recipient and type", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		input := models.NotificationInput{
+			Type:    models.NotificationBookingCreated,
+			Title:   "New booking",
+			Body:    "2 time slots booked at 5 Niagara Parkway, Niagara Falls.",
+			EventID: uuid.New(),
+		}
+		_, err := repo.Create(ctx, &CreateInput{NotificationInput: input, UserID: userID})
+		require.NoError(t, err)
+		_, err = repo.Create(ctx, &CreateInput{NotificationInput: input, UserID: userID})
+		require.ErrorIs(t, err, ErrDuplicateEvent)
+
+		// Other recipients and types of the same event are still sent
+		_, err = repo.Create(ctx, &CreateInput{NotificationInput: input, UserID: userID_1})
+		require.NoError(t, err)
+		confirmed := input
+		confirmed.Type = models.NotificationBookingConfirmed
+		_, err = repo.Create(ctx, &CreateInput{NotificationInput: confirmed, UserID: userID})
+		require.NoError(t, err)
+
+		notifications, err := repo.GetMany(ctx, 5, omit.Val[Cursor]{}, userID)
+		require.NoError(t, err)
+		assert.Len(t, notifications, 2)
+	})
+}
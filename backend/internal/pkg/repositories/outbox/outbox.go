@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+)
+
+type Entry struct {
+	DeliveredTo []string // Names of the subscribers that handled this event
+	models.DomainEvent
+	InternalID int64 // The internal ID of this event
+	Attempts   int32 // The number of relay attempts so far
+}
+
+var ErrNotFound = errors.New("no event found")
+
+type Repository interface {
+	// Get at most `limit` unpublished events due by `now`, oldest first.
+	//
+	// The returned events are postponed to `leaseUntil`, so concurrent callers never claim the same event,
+	// and events interrupted before being published are relayed again.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]Entry, error)
+	// Record that `subscriber` handled the event `eventID`.
+	//
+	// Recording the same subscriber again has no effect.
+	MarkDelivered(ctx context.Context, eventID int64, subscriber string) error
+	// Mark the event `eventID` as published at `now`
+	MarkPublished(ctx context.Context, eventID int64, now time.Time) error
+	// Count a failed relay attempt of the event `eventID`, to be attempted again at `next`
+	Reschedule(ctx context.Context, eventID int64, next time.Time) error
+	// Delete events published before `before`, returning the number of events deleted
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,151 @@
+package outbox
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+// Record an event of `eventType` about `data` in the outbox.
+//
+// `exec` should be the transaction making the change described by the event, so the event is
+// recorded if and only if the change is committed.
+func Write(ctx context.Context, exec bob.Executor, eventType string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("could not encode %v event: %w", eventType, err)
+	}
+
+	_, err = dbmodels.Outboxes.Insert(&dbmodels.OutboxSetter{
+		Eventtype: omit.From(eventType),
+		Payload:   omit.From(string(payload)),
+	}).Exec(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("could not record %v event: %w", eventType, err)
+	}
+	return nil
+}
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]Entry, error) {
+	due := psql.Select(
+		sm.Columns(dbmodels.OutboxColumns.Eventid),
+		sm.From(dbmodels.Outboxes.Name()),
+		sm.Where(dbmodels.OutboxColumns.Publishedat.IsNull()),
+		sm.Where(dbmodels.OutboxColumns.Nextattemptat.LTE(psql.Arg(now))),
+		sm.OrderBy(dbmodels.OutboxColumns.Eventid),
+		sm.Limit(limit),
+		sm.ForUpdate().SkipLocked(),
+	)
+
+	claimed, err := dbmodels.Outboxes.Update(
+		um.SetCol(dbmodels.ColumnNames.Outboxes.Nextattemptat).ToArg(leaseUntil),
+		um.From(due).As("due"),
+		um.Where(dbmodels.OutboxColumns.Eventid.EQ(psql.Quote("due", dbmodels.ColumnNames.Outboxes.Eventid))),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(claimed))
+	for _, model := range claimed {
+		result = append(result, entryFromDB(model))
+	}
+	// The update does not preserve the order of the subquery
+	slices.SortFunc(result, func(a, b Entry) int {
+		return cmp.Compare(a.InternalID, b.InternalID)
+	})
+	return result, nil
+}
+
+func (p *PostgresRepository) MarkDelivered(ctx context.Context, eventID int64, subscriber string) error {
+	_, err := dbmodels.Outboxes.Update(
+		um.SetCol(dbmodels.ColumnNames.Outboxes.Deliveredto).To(
+			psql.F("array_append", dbmodels.OutboxColumns.Deliveredto, psql.Arg(subscriber))(),
+		),
+		dbmodels.UpdateWhere.Outboxes.Eventid.EQ(eventID),
+		um.Where(psql.Not(psql.Arg(subscriber).EQ(psql.F("ANY", dbmodels.OutboxColumns.Deliveredto)()))),
+	).Exec(ctx, p.db)
+	return err
+}
+
+func (p *PostgresRepository) MarkPublished(ctx context.Context, eventID int64, now time.Time) error {
+	updated, err := dbmodels.Outboxes.Update(
+		dbmodels.OutboxSetter{
+			Publishedat: omitnull.From(now),
+		}.UpdateMod(),
+		dbmodels.UpdateWhere.Outboxes.Eventid.EQ(eventID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgresRepository) Reschedule(ctx context.Context, eventID int64, next time.Time) error {
+	updated, err := dbmodels.Outboxes.Update(
+		dbmodels.OutboxSetter{
+			Nextattemptat: omit.From(next),
+		}.UpdateMod(),
+		um.SetCol(dbmodels.ColumnNames.Outboxes.Attempts).To(
+			psql.Raw(dbmodels.ColumnNames.Outboxes.Attempts+" + 1"),
+		),
+		dbmodels.UpdateWhere.Outboxes.Eventid.EQ(eventID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgresRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return dbmodels.Outboxes.Delete(
+		dbmodels.DeleteWhere.Outboxes.Publishedat.LT(before),
+	).Exec(ctx, p.db)
+}
+
+func entryFromDB(model *dbmodels.Outbox) Entry {
+	return Entry{
+		DomainEvent: models.DomainEvent{
+			OccurredAt: model.Createdat,
+			Type:       model.Eventtype,
+			Data:       json.RawMessage(model.Payload),
+			ID:         model.Eventuuid,
+		},
+		DeliveredTo: model.Deliveredto,
+		InternalID:  model.Eventid,
+		Attempts:    model.Attempts,
+	}
+}
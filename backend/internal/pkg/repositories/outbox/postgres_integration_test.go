@@ -0,0 +1,125 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	t.Run("events are only written on commit", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		err = Write(ctx, tx, models.EventBookingCreated, map[string]int{"rolled": 1})
+		require.NoError(t, err)
+		require.NoError(t, tx.Rollback())
+
+		tx, err = db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		err = Write(ctx, tx, models.EventBookingCreated, map[string]int{"committed": 1})
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+
+		now := time.Now().Add(time.Second)
+		claimed, err := repo.ClaimDue(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, models.EventBookingCreated, claimed[0].Type)
+		assert.JSONEq(t, `{"committed":1}`, string(claimed[0].Data))
+		assert.NotZero(t, claimed[0].ID)
+		assert.Zero(t, claimed[0].Attempts)
+	})
+
+	t.Run("claim, reschedule and publish", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		for range 3 {
+			err := Write(ctx, db, models.EventAvailabilityChanged, struct{}{})
+			require.NoError(t, err)
+		}
+
+		now := time.Now().Add(time.Second)
+		claimed, err := repo.ClaimDue(ctx, now, now.Add(time.Minute), 2)
+		require.NoError(t, err)
+		require.Len(t, claimed, 2)
+		assert.Less(t, claimed[0].InternalID, claimed[1].InternalID)
+
+		// Claimed events are leased
+		rest, err := repo.ClaimDue(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, rest, 1)
+		assert.Greater(t, rest[0].InternalID, claimed[1].InternalID)
+
+		err = repo.MarkPublished(ctx, claimed[0].InternalID, now)
+		require.NoError(t, err)
+		err = repo.Reschedule(ctx, claimed[1].InternalID, now.Add(time.Second))
+		require.NoError(t, err)
+		err = repo.Reschedule(ctx, -1, now)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.Empty(t, claimed[1].DeliveredTo)
+		for range 2 {
+			err = repo.MarkDelivered(ctx, claimed[1].InternalID, "notifications")
+			require.NoError(t, err)
+		}
+
+		// Only the rescheduled event is due again
+		later := now.Add(2 * time.Second)
+		claimed2, err := repo.ClaimDue(ctx, later, later.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, claimed2, 1)
+		assert.Equal(t, claimed[1].ID, claimed2[0].ID)
+		assert.Equal(t, int32(1), claimed2[0].Attempts)
+		assert.Equal(t, []string{"notifications"}, claimed2[0].DeliveredTo)
+
+		deleted, err := repo.DeletePublishedBefore(ctx, later)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+	})
+}
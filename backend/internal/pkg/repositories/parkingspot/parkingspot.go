@@ -11,6 +11,7 @@ import (
 )
 
 type Entry struct {
+	AccessInstructions string // Private to the owner and drivers who booked the spot, not set on ParkingSpot
 	models.ParkingSpot
 	InternalID int64 // The internal ID of this spot
 	OwnerID    int64 // The user id owning this spot
@@ -32,10 +33,29 @@ type FilterAvailability struct {
 	End   time.Time
 }
 
+// A vehicle the spots must allow, sizes are in centimetres and 0 when unknown
+type FilterVehicle struct {
+	Height int32
+	Length int32
+	Width  int32
+	Truck  bool
+}
+
+// Order of the results of `GetMany`
+type SortKey int
+
+const (
+	SortDefault SortKey = iota // Closest first when filtering by location, unspecified otherwise
+	SortRating                 // Highest average rating first, unrated spots last
+)
+
 type Filter struct {
-	Availability omit.Val[FilterAvailability]
-	Location     omit.Val[FilterLocation]
-	UserID       omit.Val[int64]
+	Availability  omit.Val[FilterAvailability]
+	Location      omit.Val[FilterLocation]
+	UserID        omit.Val[int64]
+	Sort          SortKey
+	Vehicle       omit.Val[FilterVehicle]
+	Accessibility models.ParkingSpotAccessibility // The accessibility the spots must at least have
 }
 
 type Cursor struct {
@@ -50,7 +70,7 @@ var (
 	ErrNoConstraint         = errors.New("no constraint provided for get many")
 	ErrInvalidCoordinate    = errors.New("invalid coordinates")
 	ErrInvalidPrice         = errors.New("price not valid")
-	ErrDeleteBookedTimeUnit = errors.New("booked time unit cannot be deleted")
+	ErrDeleteBookedTimeUnit = errors.New("booked or held time unit cannot be deleted")
 )
 
 type Repository interface {
@@ -5,11 +5,14 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbtype"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/availability"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/outbox"
 	"github.com/aarondl/opt/omit"
 	"github.com/google/uuid"
 	"github.com/govalues/decimal"
@@ -31,6 +34,9 @@ type PostgresRepository struct {
 	db bob.DB
 }
 
+// Distance in meters under which spots are at the same location
+const locationRadius = 3
+
 func NewPostgres(db bob.DB) *PostgresRepository {
 	return &PostgresRepository{
 		db: db,
@@ -39,7 +45,9 @@ func NewPostgres(db bob.DB) *PostgresRepository {
 
 type getManyResult struct {
 	dbmodels.Parkingspot
-	DistanceToOrigin float64 `db:"distance_to_origin"`
+	AverageRating    sql.NullFloat64 `db:"average_rating"` // Only selected when sorting by rating
+	DistanceToOrigin float64         `db:"distance_to_origin"`
+	LocationUUID     uuid.UUID       `db:"location_uuid"`
 }
 
 func (p *PostgresRepository) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (Entry, []models.TimeUnit, error) {
@@ -53,13 +61,16 @@ func (p *PostgresRepository) Create(ctx context.Context, userID int64, spot *mod
 	if err != nil {
 		return Entry{}, nil, err
 	}
+	location, err := findOrCreateLocation(ctx, tx, userID, spot.Location.Latitude, spot.Location.Longitude)
+	if err != nil {
+		return Entry{}, nil, err
+	}
+	// Spaces are placed at their location so they are found together
+	spotSetter.Parkinglocationid = omit.From(location.Parkinglocationid)
+	spotSetter.Latitude = omit.From(location.Latitude)
+	spotSetter.Longitude = omit.From(location.Longitude)
 	inserted, err := dbmodels.Parkingspots.Insert(&spotSetter).One(ctx, tx)
 	if err != nil {
-		// Handle duplicate error
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ExclusionViolation {
-			err = ErrDuplicatedAddress
-		}
 		return Entry{}, nil, err
 	}
 
@@ -71,18 +82,72 @@ func (p *PostgresRepository) Create(ctx context.Context, userID int64, spot *mod
 		return Entry{}, nil, err
 	}
 
+	entry, err := entryFromDB(inserted, location.Parkinglocationuuid)
+	if err != nil {
+		return Entry{}, nil, fmt.Errorf("could not adapt dbmodels.Parkingspot: %w", err)
+	}
+	availableTimes := timeUnitsFromDB(inserted.R.ParkingspotidTimeunits)
+
+	err = notifyAvailability(ctx, tx, &entry, models.AvailabilityEventAdded, availableTimes)
+	if err != nil {
+		return Entry{}, nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return Entry{}, nil, fmt.Errorf("could not commit transaction: %w", err)
 	}
 
-	entry, err := entryFromDB(inserted)
-	if err != nil {
-		return Entry{}, nil, fmt.Errorf("could not adapt dbmodels.Parkingspot: %w", err)
+	return entry, availableTimes, nil
+}
+
+// Returns the location of `userID` at the given coordinates, creating it if there is none.
+//
+// Returns ErrDuplicatedAddress if the coordinates are at the location of another user.
+func findOrCreateLocation(ctx context.Context, tx bob.Tx, userID int64, latitude, longitude float64) (*dbmodels.Parkinglocation, error) {
+	box := psql.F("earth_box", psql.F("ll_to_earth", psql.Arg(latitude), psql.Arg(longitude)), psql.Arg(locationRadius))
+	locationBox := psql.F(
+		"earth_box",
+		psql.F("ll_to_earth", dbmodels.ParkinglocationColumns.Latitude, dbmodels.ParkinglocationColumns.Longitude),
+		psql.Arg(locationRadius),
+	)
+	existing, err := dbmodels.Parkinglocations.Query(
+		sm.Where(locationBox().OP("&&", box())),
+		sm.Limit(1),
+		sm.ForUpdate(),
+	).One(ctx, tx)
+	if err == nil {
+		if existing.Userid != userID {
+			return nil, ErrDuplicatedAddress
+		}
+		return existing, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("could not query locations: %w", err)
 	}
-	availability := timeUnitsFromDB(inserted.R.ParkingspotidTimeunits)
 
-	return entry, availability, nil
+	lat, err := decimal.NewFromFloat64(latitude)
+	if err != nil {
+		return nil, ErrInvalidCoordinate
+	}
+	lon, err := decimal.NewFromFloat64(longitude)
+	if err != nil {
+		return nil, ErrInvalidCoordinate
+	}
+	inserted, err := dbmodels.Parkinglocations.Insert(&dbmodels.ParkinglocationSetter{
+		Userid:    omit.From(userID),
+		Latitude:  omit.From(lat),
+		Longitude: omit.From(lon),
+	}).One(ctx, tx)
+	if err != nil {
+		// Created concurrently by another user
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ExclusionViolation {
+			err = ErrDuplicatedAddress
+		}
+		return nil, err
+	}
+	return inserted, nil
 }
 
 func (p *PostgresRepository) UpdateSpotByUUID(ctx context.Context, spotID uuid.UUID, updateSpot *models.ParkingSpotUpdateInput) (Entry, error) {
@@ -103,8 +168,12 @@ func (p *PostgresRepository) UpdateSpotByUUID(ctx context.Context, spotID uuid.U
 		}
 		return Entry{}, fmt.Errorf("could not execute update: %w", err)
 	}
+	err = updated.LoadParkingspotParkinglocationidParkinglocation(ctx, p.db)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not load location: %w", err)
+	}
 
-	entry, err := entryFromDB(updated)
+	entry, err := entryFromDB(updated, updated.R.ParkinglocationidParkinglocation.Parkinglocationuuid)
 	if err != nil {
 		return Entry{}, fmt.Errorf("could not adapt dbmodels.Parkingspot: %w", err)
 	}
@@ -144,6 +213,15 @@ func (p *PostgresRepository) UpdateAvailByUUID(ctx context.Context, spotID uuid.
 		}
 	}
 
+	err = notifyAvailability(ctx, tx, &entry, models.AvailabilityEventRemoved, updateTimes.RemoveAvailability)
+	if err != nil {
+		return err
+	}
+	err = notifyAvailability(ctx, tx, &entry, models.AvailabilityEventAdded, updateTimes.AddAvailability)
+	if err != nil {
+		return err
+	}
+
 	// Commit since audit passed
 	err = tx.Commit()
 	if err != nil {
@@ -153,6 +231,26 @@ func (p *PostgresRepository) UpdateAvailByUUID(ctx context.Context, spotID uuid.
 	return nil
 }
 
+// Record and publish an availability event of `eventType` for `times` of the spot `entry`
+func notifyAvailability(ctx context.Context, tx bob.Tx, entry *Entry, eventType string, times []models.TimeUnit) error {
+	if len(times) == 0 {
+		return nil
+	}
+
+	event := models.AvailabilityEvent{
+		Type:      eventType,
+		Times:     times,
+		Longitude: entry.Location.Longitude,
+		Latitude:  entry.Location.Latitude,
+		SpotID:    entry.ID,
+	}
+	err := outbox.Write(ctx, tx, models.EventAvailabilityChanged, &event)
+	if err != nil {
+		return err
+	}
+	return availability.Notify(ctx, tx, &event)
+}
+
 func removeAvailability(ctx context.Context, tx bob.Tx, spotID int64, remove []models.TimeUnit) error {
 	if len(remove) == 0 {
 		return nil
@@ -165,6 +263,7 @@ func removeAvailability(ctx context.Context, tx bob.Tx, spotID int64, remove []m
 		whereMods,
 		dbmodels.DeleteWhere.Timeunits.Parkingspotid.EQ(spotID),
 		dbmodels.DeleteWhere.Timeunits.Bookingid.IsNull(),
+		dbmodels.DeleteWhere.Timeunits.Holdid.IsNull(),
 		dm.Where(timeslots),
 	)
 
@@ -175,7 +274,7 @@ func removeAvailability(ctx context.Context, tx bob.Tx, spotID int64, remove []m
 	if err != nil {
 		return err
 	}
-	// Audit failure must mean update availability input is attempting to removed a booked time unit
+	// Audit failure must mean update availability input is attempting to removed a booked or held time unit
 	if deleted != int64(len(remove)) {
 		return ErrDeleteBookedTimeUnit
 	}
@@ -204,6 +303,7 @@ func timeSlotsToSQLExpr(units []models.TimeUnit) dialect.Expression {
 func (p *PostgresRepository) GetByUUID(ctx context.Context, spotID uuid.UUID) (Entry, error) {
 	spotResult, err := dbmodels.Parkingspots.Query(
 		dbmodels.SelectWhere.Parkingspots.Parkingspotuuid.EQ(spotID),
+		dbmodels.PreloadParkingspotParkinglocationidParkinglocation(),
 	).One(ctx, p.db)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -212,7 +312,7 @@ func (p *PostgresRepository) GetByUUID(ctx context.Context, spotID uuid.UUID) (E
 		return Entry{}, err
 	}
 
-	entry, err := entryFromDB(spotResult)
+	entry, err := entryFromDB(spotResult, spotResult.R.ParkinglocationidParkinglocation.Parkinglocationuuid)
 	if err != nil {
 		return Entry{}, fmt.Errorf("could not adapt dbmodels.Parkingspot: %w", err)
 	}
@@ -223,6 +323,7 @@ func (p *PostgresRepository) GetAvailByUUID(ctx context.Context, spotID uuid.UUI
 	result, err := dbmodels.Timeunits.Query(
 		sm.Columns(dbmodels.TimeunitColumns.Timerange),
 		sm.Columns(dbmodels.TimeunitColumns.Bookingid),
+		sm.Columns(dbmodels.TimeunitColumns.Holdid),
 		psql.WhereAnd(
 			dbmodels.SelectWhere.Parkingspots.Parkingspotuuid.EQ(spotID),
 			sm.Where(dbmodels.TimeunitColumns.Timerange.OP("&&", psql.Arg(dbtype.Tstzrange{
@@ -279,13 +380,31 @@ func (p *PostgresRepository) GetMany(ctx context.Context, limit int, filter *Fil
 		Str("component", "parkingspot.Postgres").
 		Logger()
 
-	smods := []bob.Mod[*dialect.SelectQuery]{sm.Columns(dbmodels.Parkingspots.Columns())}
+	smods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Columns(dbmodels.Parkingspots.Columns()),
+		sm.Columns(dbmodels.ParkinglocationColumns.Parkinglocationuuid.As("location_uuid")),
+		dbmodels.SelectJoins.Parkingspots.InnerJoin.ParkinglocationidParkinglocation(ctx),
+	}
 	var whereMods []mods.Where[*dialect.SelectQuery]
 
 	if userID, ok := filter.UserID.Get(); ok {
 		whereMods = append(whereMods, dbmodels.SelectWhere.Parkingspots.Userid.EQ(userID))
 	}
 
+	switch filter.Sort {
+	case SortRating:
+		// Ties are broken by the following orderings, if any
+		averageRating := psql.Cast(dbmodels.ParkingspotColumns.Ratingtotal, "float8").
+			OP("/", psql.F("nullif", dbmodels.ParkingspotColumns.Ratingcount, psql.Arg(0))())
+		smods = append(
+			smods,
+			sm.Columns(psql.Group(averageRating).As("average_rating")),
+			sm.OrderBy("average_rating").Desc().NullsLast(),
+			sm.OrderBy(dbmodels.ParkingspotColumns.Ratingcount).Desc(),
+		)
+	case SortDefault:
+	}
+
 	if locFilter, ok := filter.Location.Get(); ok {
 		centre := psql.F("ll_to_earth", psql.Arg(locFilter.Latitude), psql.Arg(locFilter.Longitude))
 		spotPosition := psql.F("ll_to_earth", dbmodels.ParkingspotColumns.Latitude, dbmodels.ParkingspotColumns.Longitude)
@@ -306,6 +425,11 @@ func (p *PostgresRepository) GetMany(ctx context.Context, limit int, filter *Fil
 		)
 	}
 
+	if vehicle, ok := filter.Vehicle.Get(); ok {
+		whereMods = append(whereMods, vehicleFilter(vehicle)...)
+	}
+	whereMods = append(whereMods, accessibilityFilter(filter.Accessibility)...)
+
 	if availFilter, ok := filter.Availability.Get(); ok {
 		whereMods = append(whereMods, sm.Where(
 			dbmodels.TimeunitColumns.Timerange.OP(
@@ -362,8 +486,51 @@ func (p *PostgresRepository) GetMany(ctx context.Context, limit int, filter *Fil
 	return result, nil
 }
 
+// Returns the conditions for spots to allow `vehicle`
+func vehicleFilter(vehicle FilterVehicle) []mods.Where[*dialect.SelectQuery] {
+	var result []mods.Where[*dialect.SelectQuery]
+	// Sizes of 0 are no limit
+	if vehicle.Height > 0 {
+		result = append(result, sm.Where(psql.Or(
+			dbmodels.ParkingspotColumns.Maxheight.EQ(psql.Arg(0)),
+			dbmodels.ParkingspotColumns.Maxheight.GTE(psql.Arg(vehicle.Height)),
+		)))
+	}
+	if vehicle.Length > 0 {
+		result = append(result, sm.Where(psql.Or(
+			dbmodels.ParkingspotColumns.Maxlength.EQ(psql.Arg(0)),
+			dbmodels.ParkingspotColumns.Maxlength.GTE(psql.Arg(vehicle.Length)),
+		)))
+	}
+	if vehicle.Width > 0 {
+		result = append(result, sm.Where(psql.Or(
+			dbmodels.ParkingspotColumns.Maxwidth.EQ(psql.Arg(0)),
+			dbmodels.ParkingspotColumns.Maxwidth.GTE(psql.Arg(vehicle.Width)),
+		)))
+	}
+	if vehicle.Truck {
+		result = append(result, dbmodels.SelectWhere.Parkingspots.Notrucks.EQ(false))
+	}
+	return result
+}
+
+// Returns the conditions for spots to have at least `accessibility`
+func accessibilityFilter(accessibility models.ParkingSpotAccessibility) []mods.Where[*dialect.SelectQuery] {
+	var result []mods.Where[*dialect.SelectQuery]
+	if accessibility.Accessible {
+		result = append(result, dbmodels.SelectWhere.Parkingspots.Isaccessible.EQ(true))
+	}
+	if accessibility.StepFree {
+		result = append(result, dbmodels.SelectWhere.Parkingspots.Isstepfree.EQ(true))
+	}
+	if accessibility.WellLit {
+		result = append(result, dbmodels.SelectWhere.Parkingspots.Iswelllit.EQ(true))
+	}
+	return result
+}
+
 func (r *getManyResult) ToEntry() (GetManyEntry, error) {
-	entry, err := entryFromDB(&r.Parkingspot)
+	entry, err := entryFromDB(&r.Parkingspot, r.LocationUUID)
 	if err != nil {
 		return GetManyEntry{}, err
 	}
@@ -374,7 +541,7 @@ func (r *getManyResult) ToEntry() (GetManyEntry, error) {
 	}, nil
 }
 
-func entryFromDB(model *dbmodels.Parkingspot) (Entry, error) {
+func entryFromDB(model *dbmodels.Parkingspot, locationID uuid.UUID) (Entry, error) {
 	lat, ok := model.Latitude.Float64()
 	if !ok {
 		return Entry{}, fmt.Errorf("could not convert %v to float64", model.Latitude)
@@ -404,20 +571,49 @@ func entryFromDB(model *dbmodels.Parkingspot) (Entry, error) {
 				PlugIn:          model.Hasplugin,
 				ChargingStation: model.Haschargingstation,
 			},
+			Restrictions: models.ParkingSpotRestrictions{
+				MaxHeight: model.Maxheight,
+				MaxLength: model.Maxlength,
+				MaxWidth:  model.Maxwidth,
+				NoTrucks:  model.Notrucks,
+			},
+			Accessibility: models.ParkingSpotAccessibility{
+				Accessible: model.Isaccessible,
+				StepFree:   model.Isstepfree,
+				WellLit:    model.Iswelllit,
+			},
+			Label:        model.Label,
+			Description:  model.Description,
 			PricePerHour: price,
+			Rating:       averageRating(model.Ratingtotal, model.Ratingcount),
+			RatingCount:  model.Ratingcount,
 			ID:           model.Parkingspotuuid,
+			LocationID:   locationID,
 		},
-		InternalID: model.Parkingspotid,
-		OwnerID:    model.Userid,
+		AccessInstructions: model.Accessinstructions,
+		InternalID:         model.Parkingspotid,
+		OwnerID:            model.Userid,
 	}, nil
 }
 
+// Returns the average of `count` ratings adding up to `total`, rounded to one decimal place.
+//
+// Returns 0 if there are no ratings.
+func averageRating(total, count int32) float64 {
+	if count == 0 {
+		return 0
+	}
+	return math.Round(float64(total)/float64(count)*10) / 10
+}
+
 func timeUnitsFromDB(model []*dbmodels.Timeunit) []models.TimeUnit {
 	result := make([]models.TimeUnit, 0, len(model))
 	for _, unit := range model {
 		var status string
 		if _, ok := unit.Bookingid.Get(); ok {
 			status = "booked"
+		} else if _, ok := unit.Holdid.Get(); ok {
+			status = "held"
 		} else {
 			status = "available"
 		}
@@ -467,6 +663,16 @@ func settersFromCreateInput(userID int64, input *models.ParkingSpotCreationInput
 		Hasplugin:          omit.From(input.Features.PlugIn),
 		Haschargingstation: omit.From(input.Features.ChargingStation),
 		Priceperhour:       omit.From(price),
+		Label:              omit.From(input.Label),
+		Description:        omit.From(input.Description),
+		Accessinstructions: omit.From(input.AccessInstructions),
+		Maxheight:          omit.From(input.Restrictions.MaxHeight),
+		Maxlength:          omit.From(input.Restrictions.MaxLength),
+		Maxwidth:           omit.From(input.Restrictions.MaxWidth),
+		Notrucks:           omit.From(input.Restrictions.NoTrucks),
+		Isaccessible:       omit.From(input.Accessibility.Accessible),
+		Isstepfree:         omit.From(input.Accessibility.StepFree),
+		Iswelllit:          omit.From(input.Accessibility.WellLit),
 	}, timeunits, nil
 }
 
@@ -481,6 +687,16 @@ func spotSetterFromUpdateInput(input *models.ParkingSpotUpdateInput) (dbmodels.P
 		Hasplugin:          omit.From(input.Features.PlugIn),
 		Haschargingstation: omit.From(input.Features.ChargingStation),
 		Priceperhour:       omit.From(price),
+		Label:              omit.From(input.Label),
+		Description:        omit.From(input.Description),
+		Accessinstructions: omit.From(input.AccessInstructions),
+		Maxheight:          omit.From(input.Restrictions.MaxHeight),
+		Maxlength:          omit.From(input.Restrictions.MaxLength),
+		Maxwidth:           omit.From(input.Restrictions.MaxWidth),
+		Notrucks:           omit.From(input.Restrictions.NoTrucks),
+		Isaccessible:       omit.From(input.Accessibility.Accessible),
+		Isstepfree:         omit.From(input.Accessibility.StepFree),
+		Iswelllit:          omit.From(input.Accessibility.WellLit),
 	}, nil
 }
 
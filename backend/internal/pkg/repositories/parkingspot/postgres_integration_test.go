@@ -267,12 +267,14 @@ func TestPostgresIntegration(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEqual(t, 0, createEntry.InternalID)
 		assert.NotEqual(t, uuid.Nil, createEntry.ID)
+		assert.NotEqual(t, uuid.Nil, createEntry.LocationID)
 		expectedSpot := Entry{
 			ParkingSpot: models.ParkingSpot{
 				Location:     sampleLocation,
 				Features:     sampleFeatures,
 				PricePerHour: samplePricePerHour,
 				ID:           createEntry.ID,
+				LocationID:   createEntry.LocationID,
 			},
 			InternalID: createEntry.InternalID,
 			OwnerID:    userID,
@@ -305,7 +307,7 @@ func TestPostgresIntegration(t *testing.T) {
 		}
 	})
 
-	t.Run("duplicate address creation should fail", func(t *testing.T) {
+	t.Run("duplicate address creation", func(t *testing.T) {
 		t.Cleanup(func() {
 			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
 			require.NoError(t, err, "could not restore db")
@@ -316,12 +318,29 @@ func TestPostgresIntegration(t *testing.T) {
 		})
 
 		// Create the first parkingspot
-		_, _, err := repo.Create(ctx, userID, &creationInput)
+		first, _, err := repo.Create(ctx, userID, &creationInput)
 		require.NoError(t, err)
 
-		// Attempt to create another parkingspot with same address
-		_, _, err = repo.Create(ctx, userID, &creationInput)
-		if assert.Error(t, err, "Creating a parkingspot with duplicate address should fail") {
+		// Another parkingspot at the same address is another space of the same location
+		secondInput := creationInput
+		secondInput.Label = "Second space"
+		secondInput.Availability = nil
+		second, _, err := repo.Create(ctx, userID, &secondInput)
+		require.NoError(t, err)
+		assert.NotEqual(t, first.ID, second.ID)
+		assert.Equal(t, first.LocationID, second.LocationID)
+		assert.Equal(t, "Second space", second.Label)
+
+		// Attempt to create a parkingspot at the same address for another user
+		otherAuthUUID, err := authRepo.Create(ctx, "other@example.com", models.HashedPassword(testPasswordHash))
+		require.NoError(t, err)
+		otherUserID, err := userRepo.Create(ctx, otherAuthUUID, models.UserProfile{
+			FullName: "Other User",
+			Email:    "other@example.com",
+		})
+		require.NoError(t, err)
+		_, _, err = repo.Create(ctx, otherUserID, &creationInput)
+		if assert.Error(t, err, "Creating a parkingspot at the address of another user should fail") {
 			assert.ErrorIs(t, err, ErrDuplicatedAddress)
 		}
 	})
@@ -465,6 +484,7 @@ func TestPostgresIntegration(t *testing.T) {
 					Features:     sampleUpdateFeatures,
 					PricePerHour: sampleUpdatePricePerHour,
 					ID:           updateEntry.ID,
+					LocationID:   createEntry.LocationID,
 				},
 				InternalID: updateEntry.InternalID,
 				OwnerID:    userID,
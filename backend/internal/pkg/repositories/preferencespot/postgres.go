@@ -9,6 +9,7 @@ import (
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
 	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/rs/zerolog/log"
@@ -31,7 +32,8 @@ func NewPostgres(db bob.DB) *PostgresRepository {
 
 type getManyResult struct {
 	dbmodels.Parkingspot
-	Preferencespotid int64 `db:"preferencespotid"`
+	Preferencespotid int64     `db:"preferencespotid"`
+	LocationUUID     uuid.UUID `db:"location_uuid"`
 }
 
 func (p *PostgresRepository) Create(ctx context.Context, userID, spotID int64) error {
@@ -76,8 +78,10 @@ func (p *PostgresRepository) GetMany(ctx context.Context, userID int64, limit in
 	smods := []bob.Mod[*dialect.SelectQuery]{
 		sm.Columns(dbmodels.Parkingspots.Columns()),
 		sm.Columns(dbmodels.PreferencespotColumns.Preferencespotid),
+		sm.Columns(dbmodels.ParkinglocationColumns.Parkinglocationuuid.As("location_uuid")),
 		sm.From(dbmodels.Preferencespots.Name()),
 		dbmodels.SelectJoins.Preferencespots.InnerJoin.ParkingspotidParkingspot(ctx),
+		dbmodels.SelectJoins.Parkingspots.InnerJoin.ParkinglocationidParkinglocation(ctx),
 		sm.Limit(limit),
 		where,
 	}
@@ -158,8 +162,10 @@ func entryFromDB(model *getManyResult) (Entry, error) {
 				PlugIn:          model.Hasplugin,
 				ChargingStation: model.Haschargingstation,
 			},
+			Label:        model.Label,
 			PricePerHour: price,
 			ID:           model.Parkingspotuuid,
+			LocationID:   model.LocationUUID,
 		},
 		InternalID: model.Preferencespotid,
 	}, nil
@@ -0,0 +1,173 @@
+package pricing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/govalues/decimal"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) GetBySpotID(ctx context.Context, spotID int64) (Entry, error) {
+	return getBySpotID(ctx, p.db, spotID)
+}
+
+func (p *PostgresRepository) UpdateBySpotID(ctx context.Context, spotID int64, pricing *Entry) (Entry, error) {
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
+
+	minimumCharge, err := decimal.NewFromFloat64(pricing.MinimumCharge)
+	if err != nil {
+		return Entry{}, ErrInvalidPrice
+	}
+	dailyMaximum, err := decimal.NewFromFloat64(pricing.DailyMaximum)
+	if err != nil {
+		return Entry{}, ErrInvalidPrice
+	}
+
+	_, err = dbmodels.Spotpricings.Insert(
+		&dbmodels.SpotpricingSetter{
+			Parkingspotid: omit.From(spotID),
+			Minimumcharge: omit.From(minimumCharge),
+			Dailymaximum:  omit.From(dailyMaximum),
+		},
+		im.OnConflict(dbmodels.SpotpricingColumns.Parkingspotid).DoUpdate(
+			im.SetExcluded(
+				dbmodels.ColumnNames.Spotpricings.Minimumcharge,
+				dbmodels.ColumnNames.Spotpricings.Dailymaximum,
+			),
+		),
+	).Exec(ctx, tx)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return Entry{}, ErrNotFound
+		}
+		return Entry{}, fmt.Errorf("could not update spot pricing: %w", err)
+	}
+
+	_, err = dbmodels.Pricingrules.Delete(
+		dbmodels.DeleteWhere.Pricingrules.Parkingspotid.EQ(spotID),
+	).Exec(ctx, tx)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not delete pricing rules: %w", err)
+	}
+
+	if len(pricing.Rules) > 0 {
+		ruleSetters, err := ruleSettersFromEntry(spotID, pricing.Rules)
+		if err != nil {
+			return Entry{}, err
+		}
+		_, err = dbmodels.Pricingrules.Insert(bob.ToMods(ruleSetters...)).Exec(ctx, tx)
+		if err != nil {
+			return Entry{}, fmt.Errorf("could not insert pricing rules: %w", err)
+		}
+	}
+
+	result, err := getBySpotID(ctx, tx, spotID)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+func getBySpotID(ctx context.Context, exec bob.Executor, spotID int64) (Entry, error) {
+	var result Entry
+
+	spotPricing, err := dbmodels.FindSpotpricing(ctx, exec, spotID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return Entry{}, fmt.Errorf("could not get spot pricing: %w", err)
+	}
+	if spotPricing != nil {
+		result.MinimumCharge, _ = spotPricing.Minimumcharge.Float64()
+		result.DailyMaximum, _ = spotPricing.Dailymaximum.Float64()
+	}
+
+	rules, err := dbmodels.Pricingrules.Query(
+		dbmodels.SelectWhere.Pricingrules.Parkingspotid.EQ(spotID),
+		sm.OrderBy(dbmodels.PricingruleColumns.Position),
+	).All(ctx, exec)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not get pricing rules: %w", err)
+	}
+
+	result.Rules = make([]Rule, 0, len(rules))
+	for _, rule := range rules {
+		result.Rules = append(result.Rules, ruleFromDB(rule))
+	}
+
+	return result, nil
+}
+
+func ruleFromDB(model *dbmodels.Pricingrule) Rule {
+	price, _ := model.Priceperhour.Float64()
+	result := Rule{
+		Weekdays:     model.Weekdays,
+		StartMinute:  model.Startminute,
+		EndMinute:    model.Endminute,
+		PricePerHour: price,
+	}
+	if date, ok := model.Ruledate.Get(); ok {
+		year, month, day := date.Date()
+		result.Date = omit.From(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	}
+	return result
+}
+
+func ruleSettersFromEntry(spotID int64, rules []Rule) ([]*dbmodels.PricingruleSetter, error) {
+	result := make([]*dbmodels.PricingruleSetter, 0, len(rules))
+	for idx := range rules {
+		rule := &rules[idx]
+		price, err := decimal.NewFromFloat64(rule.PricePerHour)
+		if err != nil {
+			return nil, ErrInvalidPrice
+		}
+
+		var date omitnull.Val[time.Time]
+		if d, ok := rule.Date.Get(); ok {
+			date = omitnull.From(d)
+		} else {
+			date.Null()
+		}
+
+		result = append(result, &dbmodels.PricingruleSetter{
+			Parkingspotid: omit.From(spotID),
+			Position:      omit.From(int32(idx)),
+			Ruledate:      date,
+			Weekdays:      omit.From(rule.Weekdays),
+			Startminute:   omit.From(rule.StartMinute),
+			Endminute:     omit.From(rule.EndMinute),
+			Priceperhour:  omit.From(price),
+		})
+	}
+	return result, nil
+}
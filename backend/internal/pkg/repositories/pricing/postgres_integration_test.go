@@ -0,0 +1,138 @@
+package pricing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/timeofday"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+	spotRepo := parkingspot.NewPostgres(db)
+
+	profile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+
+	authUUID, _ := authRepo.Create(ctx, profile.Email, models.HashedPassword("some hash"))
+	userID, _ := userRepo.Create(ctx, authUUID, profile)
+
+	spot, _, err := spotRepo.Create(ctx, userID, &models.ParkingSpotCreationInput{
+		Location: models.ParkingSpotLocation{
+			PostalCode:    "L2E6T2",
+			CountryCode:   "CA",
+			City:          "Niagara Falls",
+			StreetAddress: "5 Niagara Parkway",
+			State:         "ON",
+			Latitude:      43.07923,
+			Longitude:     -79.07887,
+		},
+		PricePerHour: 10.5,
+		Availability: []models.TimeUnit{
+			{
+				StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+				EndTime:   time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Snapshot after parking spots are inserted
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	t.Run("get pricing when none is set", func(t *testing.T) {
+		result, err := repo.GetBySpotID(ctx, spot.InternalID)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(Entry{Rules: []Rule{}}, result))
+	})
+
+	t.Run("update and get pricing", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		pricing := Entry{
+			Rules: []Rule{
+				{
+					Date:         omit.From(time.Date(2024, time.December, 25, 0, 0, 0, 0, time.UTC)),
+					Weekdays:     timeofday.AllWeekdays,
+					StartMinute:  0,
+					EndMinute:    timeofday.MinutesPerDay,
+					PricePerHour: 2.5,
+				},
+				{
+					Weekdays:     1<<time.Monday | 1<<time.Tuesday,
+					StartMinute:  9 * 60,
+					EndMinute:    17 * 60,
+					PricePerHour: 15,
+				},
+			},
+			MinimumCharge: 5,
+			DailyMaximum:  60,
+		}
+
+		result, err := repo.UpdateBySpotID(ctx, spot.InternalID, &pricing)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(pricing, result))
+
+		result, err = repo.GetBySpotID(ctx, spot.InternalID)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(pricing, result))
+
+		// Updates replace all existing rules
+		pricing = Entry{
+			Rules: []Rule{
+				pricing.Rules[1],
+			},
+		}
+		result, err = repo.UpdateBySpotID(ctx, spot.InternalID, &pricing)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(pricing, result))
+	})
+
+	t.Run("update pricing of non-existent spot", func(t *testing.T) {
+		_, err := repo.UpdateBySpotID(ctx, -1, &Entry{})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,52 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+)
+
+type Rule struct {
+	Date         omit.Val[time.Time] // The date this rule applies to, at midnight UTC
+	Weekdays     int16               // Bitmask of the days this rule applies to, indexed by time.Weekday
+	StartMinute  int32               // Start of the time band in minutes since midnight
+	EndMinute    int32               // End of the time band (exclusive) in minutes since midnight
+	PricePerHour float64
+}
+
+type Entry struct {
+	Rules         []Rule
+	MinimumCharge float64
+	DailyMaximum  float64 // Zero if there are no daily maximum
+}
+
+var (
+	ErrInvalidPrice = errors.New("price not valid")
+	ErrNotFound     = errors.New("no parking spot found")
+)
+
+type Repository interface {
+	// Get the pricing for the spot with internal `spotID`.
+	//
+	// Returns an empty entry if no pricing has been set.
+	GetBySpotID(ctx context.Context, spotID int64) (Entry, error)
+	// Replace the pricing for the spot with internal `spotID`.
+	UpdateBySpotID(ctx context.Context, spotID int64, pricing *Entry) (Entry, error)
+}
+
+// Returns whether `rule` applies to a slot starting at local time `t`.
+func (r *Rule) Matches(t time.Time) bool {
+	if date, ok := r.Date.Get(); ok {
+		year, month, day := t.Date()
+		if date.Year() != year || date.Month() != month || date.Day() != day {
+			return false
+		}
+	}
+	if r.Weekdays&(1<<t.Weekday()) == 0 {
+		return false
+	}
+	minute := int32(t.Hour()*60 + t.Minute())
+	return r.StartMinute <= minute && minute < r.EndMinute
+}
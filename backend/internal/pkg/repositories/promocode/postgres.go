@@ -0,0 +1,223 @@
+package promocode
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+type getResult struct {
+	dbmodels.Promocode
+	Email           null.Val[string]    `db:"email" `
+	Parkingspotuuid null.Val[uuid.UUID] `db:"parkingspotuuid" `
+	Uses            int64               `db:"uses" `
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, input *CreateInput) (Entry, error) {
+	discountValue, err := decimal.NewFromFloat64(input.DiscountValue)
+	if err != nil {
+		return Entry{}, ErrInvalidAmount
+	}
+	minimumSpend, err := decimal.NewFromFloat64(input.MinimumSpend)
+	if err != nil {
+		return Entry{}, ErrInvalidAmount
+	}
+
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
+
+	setter := dbmodels.PromocodeSetter{
+		Code:           omit.From(input.Code),
+		Discounttype:   omit.From(input.DiscountType),
+		Discountvalue:  omit.From(discountValue),
+		Minimumspend:   omit.From(minimumSpend),
+		Maxuses:        omit.From(input.MaxUses),
+		Maxusesperuser: omit.From(input.MaxUsesPerUser),
+	}
+	if input.ExpiresAt != nil {
+		setter.Expiresat = omitnull.From(*input.ExpiresAt)
+	}
+	if input.SpotID != 0 {
+		setter.Parkingspotid = omitnull.From(input.SpotID)
+	}
+	if input.OwnerEmail != "" {
+		owner, err := dbmodels.Users.Query(
+			sm.Columns(dbmodels.UserColumns.Userid),
+			dbmodels.SelectWhere.Users.Email.EQ(input.OwnerEmail),
+		).One(ctx, tx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = ErrOwnerNotFound
+			}
+			return Entry{}, err
+		}
+		setter.Ownerid = omitnull.From(owner.Userid)
+	}
+
+	inserted, err := dbmodels.Promocodes.Insert(&setter).One(ctx, tx)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return Entry{}, ErrDuplicateCode
+		}
+		return Entry{}, fmt.Errorf("could not execute insert: %w", err)
+	}
+
+	result, err := getOne(ctx, tx, dbmodels.SelectWhere.Promocodes.Promocodeid.EQ(inserted.Promocodeid))
+	if err != nil {
+		return Entry{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not commit transaction: %w", err)
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) GetByUUID(ctx context.Context, id uuid.UUID) (Entry, error) {
+	return getOne(ctx, p.db, dbmodels.SelectWhere.Promocodes.Promocodeuuid.EQ(id))
+}
+
+func (p *PostgresRepository) GetByCode(ctx context.Context, code string) (Entry, error) {
+	return getOne(ctx, p.db, dbmodels.SelectWhere.Promocodes.Code.EQ(code))
+}
+
+func (p *PostgresRepository) GetMany(ctx context.Context, limit int, after omit.Val[Cursor]) ([]Entry, error) {
+	smods := selectMods(ctx)
+	if cursor, ok := after.Get(); ok {
+		smods = append(smods, dbmodels.SelectWhere.Promocodes.Promocodeid.GT(cursor.ID))
+	}
+	smods = append(
+		smods,
+		sm.OrderBy(dbmodels.PromocodeColumns.Promocodeid),
+		sm.Limit(limit),
+	)
+
+	entryCursor, err := bob.Cursor(ctx, p.db, psql.Select(smods...), scan.StructMapper[getResult]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+	defer entryCursor.Close()
+
+	result := make([]Entry, 0, 8)
+	for entryCursor.Next() {
+		get, err := entryCursor.Get()
+		if err != nil { // if there's an error, just return what we already have
+			break
+		}
+		result = append(result, entryFromDB(&get))
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) ExpireByUUID(ctx context.Context, id uuid.UUID) (Entry, error) {
+	now := time.Now()
+	_, err := dbmodels.Promocodes.Update(
+		dbmodels.PromocodeSetter{
+			Expiresat: omitnull.From(now),
+		}.UpdateMod(),
+		dbmodels.UpdateWhere.Promocodes.Promocodeuuid.EQ(id),
+		psql.WhereOr(
+			dbmodels.UpdateWhere.Promocodes.Expiresat.IsNull(),
+			dbmodels.UpdateWhere.Promocodes.Expiresat.GT(now),
+		),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not execute update: %w", err)
+	}
+
+	return p.GetByUUID(ctx, id)
+}
+
+// Select promo codes with their restrictions and use counts
+func selectMods(ctx context.Context) []bob.Mod[*dialect.SelectQuery] {
+	uses := psql.Select(
+		sm.Columns(psql.F("count", psql.Raw("*"))),
+		sm.From(dbmodels.Bookings.Name()),
+		sm.Where(dbmodels.BookingColumns.Promocodeid.EQ(dbmodels.PromocodeColumns.Promocodeid)),
+	)
+
+	return []bob.Mod[*dialect.SelectQuery]{
+		sm.Columns(dbmodels.Promocodes.Columns()),
+		sm.Columns(dbmodels.ParkingspotColumns.Parkingspotuuid),
+		sm.Columns(dbmodels.UserColumns.Email),
+		sm.Columns(psql.Group(uses).As("uses")),
+		sm.From(dbmodels.Promocodes.Name()),
+		dbmodels.SelectJoins.Promocodes.LeftJoin.ParkingspotidParkingspot(ctx),
+		dbmodels.SelectJoins.Promocodes.LeftJoin.OwneridUser(ctx),
+	}
+}
+
+func getOne(ctx context.Context, exec bob.Executor, where bob.Mod[*dialect.SelectQuery]) (Entry, error) {
+	smods := append(selectMods(ctx), where)
+	result, err := bob.One(ctx, exec, psql.Select(smods...), scan.StructMapper[getResult]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+	return entryFromDB(&result), nil
+}
+
+func entryFromDB(model *getResult) Entry {
+	discountValue, _ := model.Discountvalue.Float64()
+	minimumSpend, _ := model.Minimumspend.Float64()
+
+	result := Entry{
+		PromoCode: models.PromoCode{
+			CreatedAt: model.Createdat,
+			PromoCodeCreationInput: models.PromoCodeCreationInput{
+				ExpiresAt:      model.Expiresat.Ptr(),
+				Code:           model.Code,
+				DiscountType:   model.Discounttype,
+				OwnerEmail:     model.Email.GetOrZero(),
+				DiscountValue:  discountValue,
+				MinimumSpend:   minimumSpend,
+				MaxUses:        model.Maxuses,
+				MaxUsesPerUser: model.Maxusesperuser,
+				ParkingSpotID:  model.Parkingspotuuid.GetOrZero(),
+			},
+			Uses: model.Uses,
+			ID:   model.Promocodeuuid,
+		},
+		InternalID: model.Promocodeid,
+		SpotID:     model.Parkingspotid.GetOrZero(),
+		OwnerID:    model.Ownerid.GetOrZero(),
+	}
+	return result
+}
@@ -0,0 +1,224 @@
+package promocode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+	spotRepo := parkingspot.NewPostgres(db)
+
+	profile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+
+	authUUID, _ := authRepo.Create(ctx, profile.Email, models.HashedPassword("some hash"))
+	userID, _ := userRepo.Create(ctx, authUUID, profile)
+
+	spot, _, err := spotRepo.Create(ctx, userID, &models.ParkingSpotCreationInput{
+		Location: models.ParkingSpotLocation{
+			PostalCode:    "L2E6T2",
+			CountryCode:   "CA",
+			City:          "Niagara Falls",
+			StreetAddress: "5 Niagara Parkway",
+			State:         "ON",
+			Latitude:      43.07923,
+			Longitude:     -79.07887,
+		},
+		PricePerHour: 10.5,
+	})
+	require.NoError(t, err)
+
+	// Snapshot after parking spots are inserted
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	ignoreGenerated := cmpopts.IgnoreFields(models.PromoCode{}, "CreatedAt", "ID")
+
+	t.Run("create and get promo code", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		expiresAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+		input := CreateInput{
+			PromoCodeCreationInput: models.PromoCodeCreationInput{
+				ExpiresAt:      &expiresAt,
+				Code:           "SPRING10",
+				DiscountType:   models.DiscountPercentage,
+				OwnerEmail:     profile.Email,
+				DiscountValue:  10,
+				MinimumSpend:   20,
+				MaxUses:        100,
+				MaxUsesPerUser: 1,
+				ParkingSpotID:  spot.ID,
+			},
+			SpotID: spot.InternalID,
+		}
+		expected := Entry{
+			PromoCode: models.PromoCode{
+				PromoCodeCreationInput: input.PromoCodeCreationInput,
+			},
+			SpotID:  spot.InternalID,
+			OwnerID: userID,
+		}
+
+		created, err := repo.Create(ctx, &input)
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, created.ID)
+		assert.Empty(t, cmp.Diff(expected, created, ignoreGenerated, cmpopts.IgnoreFields(Entry{}, "InternalID")))
+
+		result, err := repo.GetByUUID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(created, result))
+
+		result, err = repo.GetByCode(ctx, input.Code)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(created, result))
+
+		_, err = repo.Create(ctx, &input)
+		assert.ErrorIs(t, err, ErrDuplicateCode)
+	})
+
+	t.Run("create with unknown owner", func(t *testing.T) {
+		_, err := repo.Create(ctx, &CreateInput{
+			PromoCodeCreationInput: models.PromoCodeCreationInput{
+				Code:          "NOBODY",
+				DiscountType:  models.DiscountFixed,
+				DiscountValue: 5,
+				OwnerEmail:    "nobody@example.com",
+			},
+		})
+		assert.ErrorIs(t, err, ErrOwnerNotFound)
+	})
+
+	t.Run("get unknown promo code", func(t *testing.T) {
+		_, err := repo.GetByUUID(ctx, uuid.Nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = repo.GetByCode(ctx, "UNKNOWN")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("get many with cursor", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		codes := []string{"FIRST", "SECOND", "THIRD"}
+		for _, code := range codes {
+			_, err := repo.Create(ctx, &CreateInput{
+				PromoCodeCreationInput: models.PromoCodeCreationInput{
+					Code:          code,
+					DiscountType:  models.DiscountFixed,
+					DiscountValue: 5,
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		result, err := repo.GetMany(ctx, 2, omit.Val[Cursor]{})
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, codes[0], result[0].Code)
+		assert.Equal(t, codes[1], result[1].Code)
+
+		result, err = repo.GetMany(ctx, 2, omit.From(Cursor{ID: result[1].InternalID}))
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, codes[2], result[0].Code)
+	})
+
+	t.Run("expire promo code", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		pastExpiry := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		expired, err := repo.Create(ctx, &CreateInput{
+			PromoCodeCreationInput: models.PromoCodeCreationInput{
+				ExpiresAt:     &pastExpiry,
+				Code:          "EXPIRED",
+				DiscountType:  models.DiscountFixed,
+				DiscountValue: 5,
+			},
+		})
+		require.NoError(t, err)
+		active, err := repo.Create(ctx, &CreateInput{
+			PromoCodeCreationInput: models.PromoCodeCreationInput{
+				Code:          "ACTIVE",
+				DiscountType:  models.DiscountFixed,
+				DiscountValue: 5,
+			},
+		})
+		require.NoError(t, err)
+
+		before := time.Now()
+		result, err := repo.ExpireByUUID(ctx, active.ID)
+		require.NoError(t, err)
+		require.NotNil(t, result.ExpiresAt)
+		assert.False(t, result.ExpiresAt.Before(before.Add(-time.Second)))
+
+		// Already expired codes keep their expiry
+		result, err = repo.ExpireByUUID(ctx, expired.ID)
+		require.NoError(t, err)
+		require.NotNil(t, result.ExpiresAt)
+		assert.True(t, result.ExpiresAt.Equal(pastExpiry))
+
+		_, err = repo.ExpireByUUID(ctx, uuid.Nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,55 @@
+package promocode
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	models.PromoCode
+	InternalID int64 // The internal ID of this promo code
+	SpotID     int64 // The internal ID of the spot this code is restricted to, 0 if unrestricted
+	OwnerID    int64 // The internal ID of the spot owner this code is restricted to, 0 if unrestricted
+}
+
+type CreateInput struct {
+	models.PromoCodeCreationInput
+	SpotID int64 // The internal ID of the spot to restrict the code to, 0 if unrestricted
+}
+
+type Cursor struct {
+	_  struct{} `cbor:",toarray"`
+	ID int64    // The internal promo code ID to use as anchor
+}
+
+var (
+	ErrDuplicateCode = errors.New("promo code already exists")
+	ErrNotFound      = errors.New("no promo code found")
+	ErrOwnerNotFound = errors.New("no user found with the owner email")
+	ErrInvalidAmount = errors.New("discount or minimum spend not valid")
+)
+
+type Repository interface {
+	// Create a new promo code.
+	//
+	// Codes are stored as given, callers are expected to normalize them with `NormalizeCode`.
+	Create(ctx context.Context, input *CreateInput) (Entry, error)
+	GetByUUID(ctx context.Context, id uuid.UUID) (Entry, error)
+	GetByCode(ctx context.Context, code string) (Entry, error)
+	// Get at most `limit` promo codes after `after`, in creation order
+	GetMany(ctx context.Context, limit int, after omit.Val[Cursor]) ([]Entry, error)
+	// Expire the promo code with `id` immediately, if it has not already expired
+	ExpireByUUID(ctx context.Context, id uuid.UUID) (Entry, error)
+}
+
+// Returns the canonical form of `code`, as stored in the repository.
+//
+// Codes are case-insensitive.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,175 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+var (
+	apnsBaseURL = url.URL{
+		Scheme: "https",
+		Host:   "api.push.apple.com",
+		Path:   "/3/device",
+	}
+	apnsSandboxBaseURL = url.URL{
+		Scheme: "https",
+		Host:   "api.sandbox.push.apple.com",
+		Path:   "/3/device",
+	}
+)
+
+// APNs rejects provider tokens older than an hour, and refreshing more often
+// than every 20 minutes is throttled.
+const apnsTokenLifetime = 50 * time.Minute
+
+type APNsConfig struct {
+	KeyID   string // ID of the signing key
+	TeamID  string // ID of the developer team owning the key
+	Topic   string // Bundle ID of the app
+	Key     []byte // PEM encoded signing key (.p8) from the Apple developer account
+	Sandbox bool   // Whether to use the development environment
+}
+
+// APNs pushes messages through the Apple Push Notification service, using token-based authentication.
+//
+// The service requires HTTP/2, which is negotiated by the default transport.
+type APNs struct {
+	issuedAt time.Time
+	client   *http.Client
+	key      *ecdsa.PrivateKey
+	keyID    string
+	teamID   string
+	topic    string
+	token    string
+	baseURL  url.URL
+	mu       sync.Mutex
+}
+
+func NewAPNs(client *http.Client, config *APNsConfig) (*APNs, error) {
+	key, err := parsePrivateKey(config.Key)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: APNs key is not an ECDSA key", errInvalidKey)
+	}
+
+	baseURL := apnsBaseURL
+	if config.Sandbox {
+		baseURL = apnsSandboxBaseURL
+	}
+
+	return &APNs{
+		client:  client,
+		key:     ecKey,
+		baseURL: baseURL,
+		keyID:   config.KeyID,
+		teamID:  config.TeamID,
+		topic:   config.Topic,
+	}, nil
+}
+
+func (a *APNs) Push(ctx context.Context, token string, message *Message) error {
+	providerToken, err := a.getProviderToken()
+	if err != nil {
+		return err
+	}
+
+	// Custom data sits next to the reserved "aps" key
+	payload := make(map[string]any, len(message.Data)+1)
+	for k, v := range message.Data {
+		payload[k] = v
+	}
+	payload["aps"] = map[string]any{
+		"alert": map[string]string{
+			"title": message.Title,
+			"body":  message.Body,
+		},
+		"sound": "default",
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	reqURL := a.baseURL.JoinPath(token)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("Apns-Topic", a.topic)
+	req.Header.Set("Apns-Push-Type", "alert")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var apiError struct {
+		Reason string `json:"reason"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&apiError)
+
+	switch apiError.Reason {
+	case "BadDeviceToken", "Unregistered", "DeviceTokenNotForTopic":
+		return ErrInvalidToken
+	case "ExpiredProviderToken", "InvalidProviderToken":
+		a.mu.Lock()
+		a.token = ""
+		a.mu.Unlock()
+	}
+	if resp.StatusCode == http.StatusGone {
+		return ErrInvalidToken
+	}
+
+	reason := apiError.Reason
+	if reason == "" {
+		reason = resp.Status
+	}
+	return Error{
+		Provider:   "APNs",
+		Reason:     reason,
+		StatusCode: resp.StatusCode,
+	}
+}
+
+// Get the cached provider token, signing a new one if it is too old
+func (a *APNs) getProviderToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	if a.token != "" && now.Sub(a.issuedAt) < apnsTokenLifetime {
+		return a.token, nil
+	}
+
+	token, err := signES256(
+		a.key,
+		map[string]string{"alg": "ES256", "kid": a.keyID},
+		map[string]any{
+			"iss": a.teamID,
+			"iat": now.Unix(),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	a.token = token
+	a.issuedAt = now
+	return token, nil
+}
@@ -0,0 +1,143 @@
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAPNs(t *testing.T, handler http.HandlerFunc) (*APNs, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	apns, err := NewAPNs(srv.Client(), &APNsConfig{
+		Key:    pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+		KeyID:  "ABC123DEFG",
+		TeamID: "DEF123GHIJ",
+		Topic:  "ca.parkeasy.app",
+	})
+	require.NoError(t, err)
+	baseURL, err := url.Parse(srv.URL + "/3/device")
+	require.NoError(t, err)
+	apns.baseURL = *baseURL
+	return apns, key
+}
+
+func TestAPNsPush(t *testing.T) {
+	t.Parallel()
+
+	message := &Message{
+		Data:  map[string]string{"type": "booking_reminder"},
+		Title: "Upcoming booking",
+		Body:  "Your booking starts at 09:30.",
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		var key *ecdsa.PrivateKey
+		apns, key := newTestAPNs(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/3/device/some-device", r.URL.Path)
+			assert.Equal(t, "ca.parkeasy.app", r.Header.Get("Apns-Topic"))
+			assert.Equal(t, "alert", r.Header.Get("Apns-Push-Type"))
+
+			// The provider token must be signed by the APNs key
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "bearer ")
+			assert.True(t, ok)
+			parts := strings.Split(token, ".")
+			if assert.Len(t, parts, 3) {
+				sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+				assert.NoError(t, err)
+				if assert.Len(t, sig, 64) {
+					digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+					r := new(big.Int).SetBytes(sig[:32])
+					s := new(big.Int).SetBytes(sig[32:])
+					assert.True(t, ecdsa.Verify(&key.PublicKey, digest[:], r, s))
+				}
+			}
+
+			var body struct {
+				Aps struct {
+					Alert struct {
+						Title string `json:"title"`
+						Body  string `json:"body"`
+					} `json:"alert"`
+				} `json:"aps"`
+				Type string `json:"type"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, message.Title, body.Aps.Alert.Title)
+			assert.Equal(t, message.Body, body.Aps.Alert.Body)
+			assert.Equal(t, "booking_reminder", body.Type)
+		})
+
+		require.NoError(t, apns.Push(context.Background(), "some-device", message))
+	})
+
+	t.Run("unregistered device", func(t *testing.T) {
+		t.Parallel()
+
+		apns, _ := newTestAPNs(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"reason":"Unregistered","timestamp":1729519200000}`))
+		})
+
+		err := apns.Push(context.Background(), "some-device", message)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("bad device token", func(t *testing.T) {
+		t.Parallel()
+
+		apns, _ := newTestAPNs(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		})
+
+		err := apns.Push(context.Background(), "some-device", message)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		t.Parallel()
+
+		apns, _ := newTestAPNs(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"reason":"TooManyRequests"}`))
+		})
+
+		err := apns.Push(context.Background(), "some-device", message)
+		var pushErr Error
+		require.ErrorAs(t, err, &pushErr)
+		assert.Equal(t, "TooManyRequests", pushErr.Reason)
+	})
+}
+
+func TestNewAPNsWrongKeyType(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewAPNs(http.DefaultClient, &APNsConfig{Key: []byte("not a key")})
+	require.ErrorIs(t, err, errInvalidKey)
+}
@@ -0,0 +1,221 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+var fcmBaseURL = url.URL{
+	Scheme: "https",
+	Host:   "fcm.googleapis.com",
+	Path:   "/v1",
+}
+
+const fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+
+// Access tokens are refreshed this long before they expire
+const fcmTokenLeeway = time.Minute
+
+// FCM pushes messages through the Firebase Cloud Messaging HTTP v1 API.
+type FCM struct {
+	expiresAt   time.Time
+	client      *http.Client
+	key         *rsa.PrivateKey
+	email       string
+	tokenURI    string
+	projectID   string
+	accessToken string
+	baseURL     url.URL
+	mu          sync.Mutex
+}
+
+// Create a new FCM client from the JSON key of a Google service account.
+func NewFCM(client *http.Client, credentials []byte) (*FCM, error) {
+	var account struct {
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+		TokenURI    string `json:"token_uri"`
+		ProjectID   string `json:"project_id"`
+	}
+	err := json.Unmarshal(credentials, &account)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode service account: %w", err)
+	}
+	if account.ClientEmail == "" || account.TokenURI == "" || account.ProjectID == "" {
+		return nil, errors.New("incomplete service account")
+	}
+
+	key, err := parsePrivateKey([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: service account key is not an RSA key", errInvalidKey)
+	}
+
+	return &FCM{
+		client:    client,
+		key:       rsaKey,
+		baseURL:   fcmBaseURL,
+		email:     account.ClientEmail,
+		tokenURI:  account.TokenURI,
+		projectID: account.ProjectID,
+	}, nil
+}
+
+func (f *FCM) Push(ctx context.Context, token string, message *Message) error {
+	accessToken, err := f.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	type fcmNotification struct {
+		Title string `json:"title"`
+		Body  string `json:"body"`
+	}
+	type fcmMessage struct {
+		Data         map[string]string `json:"data,omitempty"`
+		Notification fcmNotification   `json:"notification"`
+		Token        string            `json:"token"`
+	}
+	body, err := json.Marshal(struct {
+		Message fcmMessage `json:"message"`
+	}{
+		Message: fcmMessage{
+			Data: message.Data,
+			Notification: fcmNotification{
+				Title: message.Title,
+				Body:  message.Body,
+			},
+			Token: token,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	reqURL := f.baseURL.JoinPath("projects", f.projectID, "messages:send")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var apiError struct {
+		Error struct {
+			Message string `json:"message"`
+			Status  string `json:"status"`
+			Details []struct {
+				ErrorCode string `json:"errorCode"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&apiError)
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		// The cached access token might have been revoked
+		f.mu.Lock()
+		f.accessToken = ""
+		f.mu.Unlock()
+	}
+
+	for _, detail := range apiError.Error.Details {
+		if detail.ErrorCode == "UNREGISTERED" {
+			return ErrInvalidToken
+		}
+	}
+	if apiError.Error.Status == "NOT_FOUND" {
+		return ErrInvalidToken
+	}
+
+	reason := apiError.Error.Message
+	if reason == "" {
+		reason = resp.Status
+	}
+	return Error{
+		Provider:   "FCM",
+		Reason:     reason,
+		StatusCode: resp.StatusCode,
+	}
+}
+
+// Get a cached OAuth access token, exchanging a new one if it expired
+func (f *FCM) getAccessToken(ctx context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	if f.accessToken != "" && now.Before(f.expiresAt) {
+		return f.accessToken, nil
+	}
+
+	assertion, err := signRS256(
+		f.key,
+		map[string]string{"alg": "RS256", "typ": "JWT"},
+		map[string]any{
+			"iss":   f.email,
+			"scope": fcmScope,
+			"aud":   f.tokenURI,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	form := make(url.Values)
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("could not create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not send token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", Error{
+			Provider:   "FCM",
+			Reason:     "could not get access token: " + resp.Status,
+			StatusCode: resp.StatusCode,
+		}
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return "", fmt.Errorf("could not decode access token: %w", err)
+	}
+
+	f.accessToken = result.AccessToken
+	f.expiresAt = now.Add(time.Duration(result.ExpiresIn)*time.Second - fcmTokenLeeway)
+	return f.accessToken, nil
+}
@@ -0,0 +1,159 @@
+package push
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFCM(t *testing.T, handler http.HandlerFunc) (*FCM, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	credentials, err := json.Marshal(map[string]string{
+		"client_email": "push@parkeasy.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":    srv.URL + "/token",
+		"project_id":   "parkeasy",
+	})
+	require.NoError(t, err)
+
+	fcm, err := NewFCM(srv.Client(), credentials)
+	require.NoError(t, err)
+	baseURL, err := url.Parse(srv.URL + "/v1")
+	require.NoError(t, err)
+	fcm.baseURL = *baseURL
+	return fcm, key
+}
+
+func TestFCMPush(t *testing.T) {
+	t.Parallel()
+
+	message := &Message{
+		Data:  map[string]string{"type": "booking_confirmed"},
+		Title: "Booking confirmed",
+		Body:  "Your booking is confirmed.",
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			key        *rsa.PrivateKey
+			tokenCalls atomic.Int32
+		)
+		fcm, key := newTestFCM(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/token":
+				tokenCalls.Add(1)
+				assert.NoError(t, r.ParseForm())
+				assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+
+				// The assertion must be signed by the service account key
+				parts := strings.Split(r.PostForm.Get("assertion"), ".")
+				if assert.Len(t, parts, 3) {
+					sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+					assert.NoError(t, err)
+					digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+					assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], sig))
+				}
+
+				_, _ = w.Write([]byte(`{"access_token":"some-access-token","expires_in":3600}`))
+			case "/v1/projects/parkeasy/messages:send":
+				assert.Equal(t, "Bearer some-access-token", r.Header.Get("Authorization"))
+
+				var body struct {
+					Message struct {
+						Data         map[string]string `json:"data"`
+						Notification struct {
+							Title string `json:"title"`
+							Body  string `json:"body"`
+						} `json:"notification"`
+						Token string `json:"token"`
+					} `json:"message"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "some-device", body.Message.Token)
+				assert.Equal(t, message.Title, body.Message.Notification.Title)
+				assert.Equal(t, message.Body, body.Message.Notification.Body)
+				assert.Equal(t, message.Data, body.Message.Data)
+
+				_, _ = w.Write([]byte(`{"name":"projects/parkeasy/messages/1"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		require.NoError(t, fcm.Push(context.Background(), "some-device", message))
+		require.NoError(t, fcm.Push(context.Background(), "some-device", message))
+		// Access tokens are cached
+		assert.Equal(t, int32(1), tokenCalls.Load())
+	})
+
+	t.Run("unregistered device", func(t *testing.T) {
+		t.Parallel()
+
+		fcm, _ := newTestFCM(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/token" {
+				_, _ = w.Write([]byte(`{"access_token":"some-access-token","expires_in":3600}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND","details":[{"errorCode":"UNREGISTERED"}]}}`))
+		})
+
+		err := fcm.Push(context.Background(), "some-device", message)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		t.Parallel()
+
+		fcm, _ := newTestFCM(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/token" {
+				_, _ = w.Write([]byte(`{"access_token":"some-access-token","expires_in":3600}`))
+				return
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error":{"code":503,"message":"The service is currently unavailable.","status":"UNAVAILABLE"}}`))
+		})
+
+		err := fcm.Push(context.Background(), "some-device", message)
+		var pushErr Error
+		require.ErrorAs(t, err, &pushErr)
+		assert.Equal(t, http.StatusServiceUnavailable, pushErr.StatusCode)
+		assert.NotErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestNewFCMInvalidCredentials(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFCM(http.DefaultClient, []byte(`{"client_email":"push@parkeasy.iam.gserviceaccount.com"}`))
+	require.Error(t, err)
+
+	_, err = NewFCM(http.DefaultClient, []byte(`{"client_email":"a","token_uri":"b","project_id":"c","private_key":"not a key"}`))
+	require.ErrorIs(t, err, errInvalidKey)
+}
@@ -0,0 +1,74 @@
+package push
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// Both providers authenticate with JWTs, but only need a couple of signing algorithms,
+// which are simple enough to implement here.
+
+var errInvalidKey = errors.New("invalid private key")
+
+// Parse a PEM encoded PKCS #8 private key
+func parsePrivateKey(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errInvalidKey
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidKey, err)
+	}
+	return key, nil
+}
+
+// Create a JWT signed with RS256
+func signRS256(key *rsa.PrivateKey, header, claims any) (string, error) {
+	return signJWT(header, claims, func(digest []byte) ([]byte, error) {
+		return rsa.SignPKCS1v15(nil, key, crypto.SHA256, digest)
+	})
+}
+
+// Create a JWT signed with ES256
+func signES256(key *ecdsa.PrivateKey, header, claims any) (string, error) {
+	return signJWT(header, claims, func(digest []byte) ([]byte, error) {
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			return nil, err
+		}
+		// JWS uses the fixed-size concatenation of r and s instead of ASN.1
+		sig := make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+		return sig, nil
+	})
+}
+
+func signJWT(header, claims any, sign func(digest []byte) ([]byte, error)) (string, error) {
+	rawHeader, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	rawClaims, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(rawHeader) + "." + base64.RawURLEncoding.EncodeToString(rawClaims)
+	digest := sha256.Sum256([]byte(payload))
+	sig, err := sign(digest[:])
+	if err != nil {
+		return "", fmt.Errorf("could not sign token: %w", err)
+	}
+	return payload + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
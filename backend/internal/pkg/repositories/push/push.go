@@ -0,0 +1,35 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// A notification pushed to a device
+type Message struct {
+	Data  map[string]string // Custom payload delivered to the app along with the alert
+	Title string
+	Body  string
+}
+
+var ErrInvalidToken = errors.New("device token is no longer valid")
+
+// Pusher delivers messages to devices through a push provider.
+type Pusher interface {
+	// Push `message` to the device identified by `token`.
+	//
+	// Returns ErrInvalidToken if the provider does not recognize the device anymore.
+	Push(ctx context.Context, token string, message *Message) error
+}
+
+// Error returned by a push provider
+type Error struct {
+	Provider   string
+	Reason     string
+	StatusCode int
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%v push failed with status %v: %v", e.Provider, e.StatusCode, e.Reason)
+}
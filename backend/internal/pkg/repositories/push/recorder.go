@@ -0,0 +1,59 @@
+package push
+
+import (
+	"context"
+	"sync"
+)
+
+// A message kept by Recorder
+type Recorded struct {
+	Message Message
+	Token   string
+}
+
+// Recorder is a Pusher keeping messages in memory instead of delivering them.
+//
+// Used for tests and local development.
+type Recorder struct {
+	invalid map[string]struct{}
+	pushes  []Recorded
+	mu      sync.Mutex
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		invalid: make(map[string]struct{}),
+	}
+}
+
+func (r *Recorder) Push(_ context.Context, token string, message *Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.invalid[token]; ok {
+		return ErrInvalidToken
+	}
+	r.pushes = append(r.pushes, Recorded{
+		Message: *message,
+		Token:   token,
+	})
+	return nil
+}
+
+// Get all messages pushed so far
+func (r *Recorder) Pushes() []Recorded {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make([]Recorded, len(r.pushes))
+	copy(result, r.pushes)
+	return result
+}
+
+// Reject further pushes to `token` with ErrInvalidToken
+func (r *Recorder) Invalidate(token string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.invalid[token] = struct{}{}
+}
@@ -0,0 +1,135 @@
+package quote
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/rs/zerolog/log"
+	"github.com/stephenafamo/bob"
+)
+
+// Interval between two deletions of expired quotes
+const cleanupInterval = time.Hour
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, entry *Entry) error {
+	bookedTimes, err := json.Marshal(entry.BookedTimes)
+	if err != nil {
+		return fmt.Errorf("could not encode quoted time slots: %w", err)
+	}
+	total, err := decimal.NewFromFloat64(entry.Total)
+	if err != nil {
+		return fmt.Errorf("invalid quoted total: %w", err)
+	}
+	discount, err := decimal.NewFromFloat64(entry.Discount)
+	if err != nil {
+		return fmt.Errorf("invalid quoted discount: %w", err)
+	}
+	payout, err := decimal.NewFromFloat64(entry.Payout)
+	if err != nil {
+		return fmt.Errorf("invalid quoted payout: %w", err)
+	}
+
+	setter := dbmodels.QuoteSetter{
+		Quoteuuid:     omit.From(entry.ID),
+		Userid:        omit.From(entry.UserID),
+		Parkingspotid: omit.From(entry.SpotID),
+		Promocode:     omit.From(entry.PromoCode),
+		Bookedtimes:   omit.From(string(bookedTimes)),
+		Total:         omit.From(total),
+		Discount:      omit.From(discount),
+		Payout:        omit.From(payout),
+		Expiresat:     omit.From(entry.ExpiresAt),
+	}
+	if entry.PromoCodeID != 0 {
+		setter.Promocodeid = omitnull.From(entry.PromoCodeID)
+	}
+	_, err = dbmodels.Quotes.Insert(&setter).Exec(ctx, p.db)
+	return err
+}
+
+// Delete quotes that expired by `now`, returning the number of quotes deleted
+func (p *PostgresRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	return dbmodels.Quotes.Delete(
+		dbmodels.DeleteWhere.Quotes.Expiresat.LTE(now),
+	).Exec(ctx, p.db)
+}
+
+// Delete expired quotes regularly until `ctx` is cancelled
+func (p *PostgresRepository) RunCleanup(ctx context.Context) {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := p.DeleteExpired(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			log.Ctx(ctx).Err(err).Msg("could not delete expired quotes")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Delete and return the quote with `quoteID` issued to `userID` for `spotID`, if it has not expired
+// by `now`.
+//
+// Quotes are consumed in the transaction of the booking paying them, so each quote pays for at most
+// one booking. Returns ErrNotFound if there is no such quote.
+func Consume(ctx context.Context, tx bob.Tx, quoteID uuid.UUID, userID, spotID int64, now time.Time) (Entry, error) {
+	consumed, err := dbmodels.Quotes.Delete(
+		dbmodels.DeleteWhere.Quotes.Quoteuuid.EQ(quoteID),
+		dbmodels.DeleteWhere.Quotes.Userid.EQ(userID),
+		dbmodels.DeleteWhere.Quotes.Parkingspotid.EQ(spotID),
+		dbmodels.DeleteWhere.Quotes.Expiresat.GT(now),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	var bookedTimes []models.TimeUnit
+	err = json.Unmarshal([]byte(consumed.Bookedtimes), &bookedTimes)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not decode quoted time slots: %w", err)
+	}
+	total, _ := consumed.Total.Float64()
+	discount, _ := consumed.Discount.Float64()
+	payout, _ := consumed.Payout.Float64()
+	return Entry{
+		PromoCode:   consumed.Promocode,
+		ExpiresAt:   consumed.Expiresat,
+		BookedTimes: bookedTimes,
+		Total:       total,
+		Discount:    discount,
+		Payout:      payout,
+		UserID:      consumed.Userid,
+		SpotID:      consumed.Parkingspotid,
+		PromoCodeID: consumed.Promocodeid.GetOrZero(),
+		ID:          consumed.Quoteuuid,
+	}, nil
+}
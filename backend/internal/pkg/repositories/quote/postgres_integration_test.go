@@ -0,0 +1,86 @@
+package quote
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	now := time.Now().Truncate(time.Microsecond)
+	entry := Entry{
+		PromoCode:   "SAVE5",
+		ExpiresAt:   now.Add(time.Minute),
+		BookedTimes: sampleTimes,
+		Total:       95,
+		Discount:    5,
+		Payout:      85,
+		UserID:      1,
+		SpotID:      2,
+		ID:          uuid.New(),
+	}
+	err = repo.Create(ctx, &entry)
+	require.NoError(t, err)
+
+	consume := func(userID int64, at time.Time) (Entry, error) {
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer func() { _ = tx.Commit() }()
+
+		return Consume(ctx, tx, entry.ID, userID, entry.SpotID, at)
+	}
+
+	// Quotes can only be consumed by the user they were issued to before they expire
+	_, err = consume(entry.UserID+1, now)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = consume(entry.UserID, entry.ExpiresAt)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	consumed, err := consume(entry.UserID, now)
+	require.NoError(t, err)
+	assert.Equal(t, entry.ID, consumed.ID)
+	assert.Equal(t, entry.PromoCode, consumed.PromoCode)
+	assert.True(t, entry.ExpiresAt.Equal(consumed.ExpiresAt))
+	assert.True(t, consumed.MatchesTimes(entry.BookedTimes))
+	assert.InDelta(t, entry.Total, consumed.Total, 0)
+	assert.InDelta(t, entry.Discount, consumed.Discount, 0)
+	assert.InDelta(t, entry.Payout, consumed.Payout, 0)
+	assert.Zero(t, consumed.PromoCodeID)
+
+	// Each quote is consumed once
+	_, err = consume(entry.UserID, now)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	expired := entry
+	expired.ID = uuid.New()
+	err = repo.Create(ctx, &expired)
+	require.NoError(t, err)
+	deleted, err := repo.DeleteExpired(ctx, expired.ExpiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
@@ -0,0 +1,49 @@
+package quote
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	PromoCode   string // The normalized promo code applied, empty if none
+	ExpiresAt   time.Time
+	BookedTimes []models.TimeUnit
+	Total       float64
+	Discount    float64
+	Payout      float64 // The amount to be paid out to the spot owner
+	UserID      int64   // The user this quote was issued to
+	SpotID      int64   // Internal ID of the quoted spot
+	PromoCodeID int64   // Internal ID of the promo code applied, 0 if none
+	ID          uuid.UUID
+}
+
+// Returns whether the quote is for the same time slots as `times`, regardless of order
+func (e *Entry) MatchesTimes(times []models.TimeUnit) bool {
+	if len(e.BookedTimes) != len(times) {
+		return false
+	}
+
+	compare := func(x, y models.TimeUnit) int {
+		return x.StartTime.Compare(y.StartTime)
+	}
+	quoted := slices.Clone(e.BookedTimes)
+	times = slices.Clone(times)
+	slices.SortFunc(quoted, compare)
+	slices.SortFunc(times, compare)
+	return slices.EqualFunc(quoted, times, func(x, y models.TimeUnit) bool {
+		return x.StartTime.Equal(y.StartTime) && x.EndTime.Equal(y.EndTime)
+	})
+}
+
+var ErrNotFound = errors.New("no quote found")
+
+type Repository interface {
+	// Store the quote `entry`.
+	Create(ctx context.Context, entry *Entry) error
+}
@@ -0,0 +1,34 @@
+package quote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+var sampleTimes = []models.TimeUnit{
+	{
+		StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+		EndTime:   time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+	},
+	{
+		StartTime: time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2024, time.October, 21, 15, 30, 0, 0, time.UTC),
+	},
+}
+
+func TestMatchesTimes(t *testing.T) {
+	t.Parallel()
+
+	entry := Entry{BookedTimes: sampleTimes}
+
+	assert.True(t, entry.MatchesTimes(sampleTimes))
+	assert.True(t, entry.MatchesTimes([]models.TimeUnit{sampleTimes[1], sampleTimes[0]}))
+	assert.False(t, entry.MatchesTimes(sampleTimes[:1]))
+	assert.False(t, entry.MatchesTimes([]models.TimeUnit{sampleTimes[0], sampleTimes[0]}))
+
+	// Original order is left untouched
+	assert.Equal(t, sampleTimes[0], entry.BookedTimes[0])
+}
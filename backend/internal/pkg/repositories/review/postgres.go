@@ -0,0 +1,167 @@
+package review
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+type getResult struct {
+	dbmodels.Review
+	Bookinguuid uuid.UUID `db:"bookinguuid" `
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, input *CreateInput) (Entry, error) {
+	if input.Rating < 1 || input.Rating > 5 {
+		return Entry{}, ErrInvalidRating
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	inserted, err := dbmodels.Reviews.Insert(&dbmodels.ReviewSetter{
+		Bookingid:  omit.From(input.BookingID),
+		Reviewerid: omit.From(input.ReviewerID),
+		Subject:    omit.From(input.Subject),
+		Rating:     omit.From(int16(input.Rating)),
+		Comment:    omit.From(input.Comment),
+	}).One(ctx, tx)
+	if err != nil {
+		// Handle duplicate review
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			err = ErrDuplicateReview
+		}
+		return Entry{}, err
+	}
+
+	if input.Subject == models.ReviewSubjectSpot {
+		_, err = dbmodels.Parkingspots.Update(
+			um.SetCol(dbmodels.ColumnNames.Parkingspots.Ratingcount).
+				To(dbmodels.ParkingspotColumns.Ratingcount.OP("+", psql.Arg(1))),
+			um.SetCol(dbmodels.ColumnNames.Parkingspots.Ratingtotal).
+				To(dbmodels.ParkingspotColumns.Ratingtotal.OP("+", psql.Arg(input.Rating))),
+			um.From(dbmodels.Bookings.Name()),
+			dbmodels.UpdateWhere.Bookings.Bookingid.EQ(input.BookingID),
+			um.Where(dbmodels.BookingColumns.Parkingspotid.EQ(dbmodels.ParkingspotColumns.Parkingspotid)),
+		).Exec(ctx, tx)
+		if err != nil {
+			return Entry{}, fmt.Errorf("could not update spot rating: %w", err)
+		}
+	}
+
+	booking, err := dbmodels.FindBooking(ctx, tx, input.BookingID, dbmodels.ColumnNames.Bookings.Bookinguuid)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not get booking: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return entryFromDB(&getResult{
+		Review:      *inserted,
+		Bookinguuid: booking.Bookinguuid,
+	}), nil
+}
+
+func (p *PostgresRepository) GetManyForBooking(ctx context.Context, bookingID int64) ([]Entry, error) {
+	smods := selectMods(ctx)
+	smods = append(
+		smods,
+		dbmodels.SelectWhere.Reviews.Bookingid.EQ(bookingID),
+		sm.OrderBy(dbmodels.ReviewColumns.Reviewid),
+	)
+	return p.getMany(ctx, smods)
+}
+
+func (p *PostgresRepository) GetManyForSpot(ctx context.Context, limit int, after omit.Val[Cursor], spotID int64) ([]Entry, error) {
+	smods := selectMods(ctx)
+	if cursor, ok := after.Get(); ok {
+		smods = append(smods, dbmodels.SelectWhere.Reviews.Reviewid.LT(cursor.ID))
+	}
+	smods = append(
+		smods,
+		dbmodels.SelectWhere.Bookings.Parkingspotid.EQ(spotID),
+		dbmodels.SelectWhere.Reviews.Subject.EQ(models.ReviewSubjectSpot),
+		sm.OrderBy(dbmodels.ReviewColumns.Reviewid).Desc(),
+		sm.Limit(limit),
+	)
+	return p.getMany(ctx, smods)
+}
+
+func (p *PostgresRepository) getMany(ctx context.Context, smods []bob.Mod[*dialect.SelectQuery]) ([]Entry, error) {
+	entryCursor, err := bob.Cursor(ctx, p.db, psql.Select(smods...), scan.StructMapper[getResult]())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+	defer entryCursor.Close()
+
+	result := make([]Entry, 0, 8)
+	for entryCursor.Next() {
+		get, err := entryCursor.Get()
+		if err != nil { // if there's an error, just return what we already have
+			break
+		}
+		result = append(result, entryFromDB(&get))
+	}
+	return result, nil
+}
+
+// Select reviews with the UUID of their booking
+func selectMods(ctx context.Context) []bob.Mod[*dialect.SelectQuery] {
+	return []bob.Mod[*dialect.SelectQuery]{
+		sm.Columns(dbmodels.Reviews.Columns()),
+		sm.Columns(dbmodels.BookingColumns.Bookinguuid),
+		sm.From(dbmodels.Reviews.Name()),
+		dbmodels.SelectJoins.Reviews.InnerJoin.BookingidBooking(ctx),
+	}
+}
+
+func entryFromDB(model *getResult) Entry {
+	return Entry{
+		Review: models.Review{
+			Subject:   model.Subject,
+			CreatedAt: model.Createdat,
+			ReviewCreationInput: models.ReviewCreationInput{
+				Comment: model.Comment,
+				Rating:  int32(model.Rating),
+			},
+			ID:        model.Reviewuuid,
+			BookingID: model.Bookinguuid,
+		},
+		InternalID: model.Reviewid,
+		ReviewerID: model.Reviewerid,
+	}
+}
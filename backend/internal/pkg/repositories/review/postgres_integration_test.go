@@ -0,0 +1,279 @@
+package review
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/car"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/aarondl/opt/omit"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+	carRepo := car.NewPostgres(db)
+	spotRepo := parkingspot.NewPostgres(db)
+	bookingRepo := booking.NewPostgres(db)
+
+	ownerProfile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	driverProfile := models.UserProfile{
+		FullName: "John Smith",
+		Email:    "j.smith@gmail.com",
+	}
+	ownerAuth, _ := authRepo.Create(ctx, ownerProfile.Email, models.HashedPassword("some hash"))
+	driverAuth, _ := authRepo.Create(ctx, driverProfile.Email, models.HashedPassword("some other hash"))
+	ownerID, _ := userRepo.Create(ctx, ownerAuth, ownerProfile)
+	driverID, _ := userRepo.Create(ctx, driverAuth, driverProfile)
+
+	_, carEntry, err := carRepo.Create(ctx, driverID, &models.CarCreationInput{
+		CarDetails: models.CarDetails{
+			LicensePlate: "HTV 670",
+			Make:         "Honda",
+			Model:        "Civic",
+			Color:        "Blue",
+		},
+	})
+	require.NoError(t, err)
+
+	slots := []models.TimeUnit{
+		{
+			StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			StartTime: time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 30, 0, 0, time.UTC),
+		},
+	}
+	spot, _, err := spotRepo.Create(ctx, ownerID, &models.ParkingSpotCreationInput{
+		Location: models.ParkingSpotLocation{
+			PostalCode:    "L2E6T2",
+			CountryCode:   "CA",
+			City:          "Niagara Falls",
+			StreetAddress: "5 Niagara Parkway",
+			State:         "ON",
+			Latitude:      43.07923,
+			Longitude:     -79.07887,
+		},
+		PricePerHour: 10.5,
+		Availability: slots,
+	})
+	require.NoError(t, err)
+
+	bookings := make([]booking.EntryWithTimes, 0, len(slots))
+	for _, slot := range slots {
+		entry, err := bookingRepo.Create(ctx, &booking.CreateInput{
+			BookedTimes:  []models.TimeUnit{slot},
+			UserID:       driverID,
+			SpotID:       spot.InternalID,
+			CarID:        carEntry.InternalID,
+			PaidAmount:   5.25,
+			PayoutAmount: 5.25,
+		})
+		require.NoError(t, err)
+		bookings = append(bookings, entry)
+	}
+
+	// Snapshot after bookings are inserted
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	t.Run("create reviews and aggregate spot ratings", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		spotReview, err := repo.Create(ctx, &CreateInput{
+			ReviewCreationInput: models.ReviewCreationInput{Comment: "Great", Rating: 5},
+			Subject:             models.ReviewSubjectSpot,
+			BookingID:           bookings[0].Entry.InternalID,
+			ReviewerID:          driverID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, bookings[0].Entry.ID, spotReview.BookingID)
+		assert.Equal(t, models.ReviewSubjectSpot, spotReview.Subject)
+
+		driverReview, err := repo.Create(ctx, &CreateInput{
+			ReviewCreationInput: models.ReviewCreationInput{Rating: 4},
+			Subject:             models.ReviewSubjectDriver,
+			BookingID:           bookings[0].Entry.InternalID,
+			ReviewerID:          ownerID,
+		})
+		require.NoError(t, err)
+
+		_, err = repo.Create(ctx, &CreateInput{
+			ReviewCreationInput: models.ReviewCreationInput{Rating: 2},
+			Subject:             models.ReviewSubjectSpot,
+			BookingID:           bookings[1].Entry.InternalID,
+			ReviewerID:          driverID,
+		})
+		require.NoError(t, err)
+
+		// Driver reviews do not count toward the spot rating
+		spotEntry, err := spotRepo.GetByUUID(ctx, spot.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), spotEntry.RatingCount)
+		assert.InDelta(t, 3.5, spotEntry.Rating, 0)
+
+		reviews, err := repo.GetManyForBooking(ctx, bookings[0].Entry.InternalID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{spotReview, driverReview}, reviews)
+	})
+
+	t.Run("bookings can only be reviewed once per subject", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		input := CreateInput{
+			ReviewCreationInput: models.ReviewCreationInput{Rating: 5},
+			Subject:             models.ReviewSubjectSpot,
+			BookingID:           bookings[0].Entry.InternalID,
+			ReviewerID:          driverID,
+		}
+		_, err := repo.Create(ctx, &input)
+		require.NoError(t, err)
+
+		_, err = repo.Create(ctx, &input)
+		require.ErrorIs(t, err, ErrDuplicateReview)
+
+		// The failed review must not count toward the rating
+		spotEntry, err := spotRepo.GetByUUID(ctx, spot.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), spotEntry.RatingCount)
+	})
+
+	t.Run("spot reviews are paginated newest first", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		created := make([]Entry, 0, len(bookings))
+		for idx := range bookings {
+			entry, err := repo.Create(ctx, &CreateInput{
+				ReviewCreationInput: models.ReviewCreationInput{Rating: 3},
+				Subject:             models.ReviewSubjectSpot,
+				BookingID:           bookings[idx].Entry.InternalID,
+				ReviewerID:          driverID,
+			})
+			require.NoError(t, err)
+			created = append(created, entry)
+		}
+
+		reviews, err := repo.GetManyForSpot(ctx, 1, omit.Val[Cursor]{}, spot.InternalID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{created[1]}, reviews)
+
+		reviews, err = repo.GetManyForSpot(ctx, 1, omit.From(Cursor{ID: reviews[0].InternalID}), spot.InternalID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{created[0]}, reviews)
+	})
+
+	t.Run("search sorted by rating", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		// A closer, unrated spot
+		closer, _, err := spotRepo.Create(ctx, ownerID, &models.ParkingSpotCreationInput{
+			Location: models.ParkingSpotLocation{
+				PostalCode:    "L2E6T2",
+				CountryCode:   "CA",
+				City:          "Niagara Falls",
+				StreetAddress: "7 Niagara Parkway",
+				State:         "ON",
+				Latitude:      43.07920,
+				Longitude:     -79.07880,
+			},
+			PricePerHour: 10.5,
+			Availability: []models.TimeUnit{
+				{
+					StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+					EndTime:   time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = repo.Create(ctx, &CreateInput{
+			ReviewCreationInput: models.ReviewCreationInput{Rating: 4},
+			Subject:             models.ReviewSubjectSpot,
+			BookingID:           bookings[0].Entry.InternalID,
+			ReviewerID:          driverID,
+		})
+		require.NoError(t, err)
+
+		filter := parkingspot.Filter{
+			Location: omit.From(parkingspot.FilterLocation{
+				Latitude:  43.07920,
+				Longitude: -79.07880,
+				Radius:    1000,
+			}),
+			Availability: omit.From(parkingspot.FilterAvailability{
+				Start: slots[0].StartTime,
+				End:   slots[1].EndTime,
+			}),
+			Sort: parkingspot.SortRating,
+		}
+		result, err := spotRepo.GetMany(ctx, 10, &filter)
+		require.NoError(t, err)
+		if assert.Len(t, result, 2) {
+			assert.Equal(t, spot.ID, result[0].ID)
+			assert.Equal(t, closer.ID, result[1].ID)
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package review
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+)
+
+type Entry struct {
+	models.Review
+	InternalID int64 // The internal ID of this review
+	ReviewerID int64 // The user who left this review
+}
+
+type CreateInput struct {
+	Subject string // One of models.ReviewSubjectSpot or models.ReviewSubjectDriver
+	models.ReviewCreationInput
+	BookingID  int64 // The internal ID of the reviewed booking
+	ReviewerID int64
+}
+
+type Cursor struct {
+	_  struct{} `cbor:",toarray"`
+	ID int64    // The internal review ID to use as anchor
+}
+
+var (
+	ErrDuplicateReview = errors.New("booking already reviewed")
+	ErrInvalidRating   = errors.New("rating not valid")
+)
+
+type Repository interface {
+	// Create a new review.
+	//
+	// Reviews of spots are also added to the aggregated rating of the booked spot.
+	Create(ctx context.Context, input *CreateInput) (Entry, error)
+	// Get all reviews of the booking with the internal ID `bookingID`
+	GetManyForBooking(ctx context.Context, bookingID int64) ([]Entry, error)
+	// Get at most `limit` reviews of the spot with the internal ID `spotID`, newest first
+	GetManyForSpot(ctx context.Context, limit int, after omit.Val[Cursor], spotID int64) ([]Entry, error)
+}
@@ -0,0 +1,220 @@
+package savedsearch
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/mods"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, input *CreateInput) (Entry, error) {
+	lon, err := decimal.NewFromFloat64(input.Longitude)
+	if err != nil {
+		return Entry{}, err
+	}
+	lat, err := decimal.NewFromFloat64(input.Latitude)
+	if err != nil {
+		return Entry{}, err
+	}
+	maxPrice, err := decimal.NewFromFloat64(input.MaxPricePerHour)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	inserted, err := dbmodels.Savedsearches.Insert(&dbmodels.SavedsearchSetter{
+		Userid:             omit.From(input.UserID),
+		Name:               omit.From(input.Name),
+		Longitude:          omit.From(lon),
+		Latitude:           omit.From(lat),
+		Distance:           omit.From(input.Distance),
+		Weekdays:           omit.From(input.Weekdays),
+		Startminute:        omit.From(input.StartMinute),
+		Endminute:          omit.From(input.EndMinute),
+		Maxpriceperhour:    omit.From(maxPrice),
+		Hasshelter:         omit.From(input.Features.Shelter),
+		Hasplugin:          omit.From(input.Features.PlugIn),
+		Haschargingstation: omit.From(input.Features.ChargingStation),
+	}).One(ctx, p.db)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return entryFromDB(inserted), nil
+}
+
+func (p *PostgresRepository) GetByUUID(ctx context.Context, searchID uuid.UUID) (Entry, error) {
+	result, err := dbmodels.Savedsearches.Query(
+		dbmodels.SelectWhere.Savedsearches.Savedsearchuuid.EQ(searchID),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(result), nil
+}
+
+func (p *PostgresRepository) GetMany(ctx context.Context, userID int64) ([]Entry, error) {
+	searches, err := dbmodels.Savedsearches.Query(
+		dbmodels.SelectWhere.Savedsearches.Userid.EQ(userID),
+		sm.OrderBy(dbmodels.SavedsearchColumns.Savedsearchid).Desc(),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+
+	return entriesFromDB(searches), nil
+}
+
+func (p *PostgresRepository) DeleteByUUID(ctx context.Context, searchID uuid.UUID) error {
+	deleted, err := dbmodels.Savedsearches.Delete(
+		dbmodels.DeleteWhere.Savedsearches.Savedsearchuuid.EQ(searchID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgresRepository) GetCandidates(ctx context.Context, target *Target, notifiedBefore time.Time) ([]Entry, error) {
+	price, err := decimal.NewFromFloat64(target.PricePerHour)
+	if err != nil {
+		return nil, err
+	}
+
+	distance := psql.F(
+		"earth_distance",
+		psql.F("ll_to_earth", dbmodels.SavedsearchColumns.Latitude, dbmodels.SavedsearchColumns.Longitude),
+		psql.F("ll_to_earth", psql.Arg(target.Latitude), psql.Arg(target.Longitude)),
+	)()
+
+	where := []mods.Where[*dialect.SelectQuery]{
+		dbmodels.SelectWhere.Savedsearches.Userid.NE(target.OwnerID),
+		psql.WhereOr(
+			dbmodels.SelectWhere.Savedsearches.Lastnotifiedat.IsNull(),
+			dbmodels.SelectWhere.Savedsearches.Lastnotifiedat.LT(notifiedBefore),
+		),
+		psql.WhereOr(
+			dbmodels.SelectWhere.Savedsearches.Maxpriceperhour.EQ(decimal.Zero),
+			dbmodels.SelectWhere.Savedsearches.Maxpriceperhour.GTE(price),
+		),
+		sm.Where(distance.LTE(dbmodels.SavedsearchColumns.Distance)),
+	}
+	// Searches requiring a feature the spot lacks never match
+	if !target.Features.Shelter {
+		where = append(where, dbmodels.SelectWhere.Savedsearches.Hasshelter.EQ(false))
+	}
+	if !target.Features.PlugIn {
+		where = append(where, dbmodels.SelectWhere.Savedsearches.Hasplugin.EQ(false))
+	}
+	if !target.Features.ChargingStation {
+		where = append(where, dbmodels.SelectWhere.Savedsearches.Haschargingstation.EQ(false))
+	}
+
+	searches, err := dbmodels.Savedsearches.Query(
+		psql.WhereAnd(where...),
+		sm.OrderBy(dbmodels.SavedsearchColumns.Savedsearchid),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+
+	return entriesFromDB(searches), nil
+}
+
+func (p *PostgresRepository) Claim(ctx context.Context, searchIDs []int64, notifiedBefore, now time.Time) ([]int64, error) {
+	if len(searchIDs) == 0 {
+		return []int64{}, nil
+	}
+
+	claimed, err := dbmodels.Savedsearches.Update(
+		um.SetCol(dbmodels.ColumnNames.Savedsearches.Lastnotifiedat).ToArg(now),
+		psql.WhereAnd(
+			dbmodels.UpdateWhere.Savedsearches.Savedsearchid.In(searchIDs...),
+			psql.WhereOr(
+				dbmodels.UpdateWhere.Savedsearches.Lastnotifiedat.IsNull(),
+				dbmodels.UpdateWhere.Savedsearches.Lastnotifiedat.LT(notifiedBefore),
+			),
+		),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []int64{}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]int64, 0, len(claimed))
+	for _, model := range claimed {
+		result = append(result, model.Savedsearchid)
+	}
+	return result, nil
+}
+
+func entriesFromDB(searches dbmodels.SavedsearchSlice) []Entry {
+	result := make([]Entry, 0, len(searches))
+	for _, model := range searches {
+		result = append(result, entryFromDB(model))
+	}
+	return result
+}
+
+func entryFromDB(model *dbmodels.Savedsearch) Entry {
+	lon, _ := model.Longitude.Float64()
+	lat, _ := model.Latitude.Float64()
+	maxPrice, _ := model.Maxpriceperhour.Float64()
+	return Entry{
+		CreatedAt: model.Createdat,
+		Name:      model.Name,
+		Criteria: Criteria{
+			Features: models.ParkingSpotFeatures{
+				Shelter:         model.Hasshelter,
+				PlugIn:          model.Hasplugin,
+				ChargingStation: model.Haschargingstation,
+			},
+			Longitude:       lon,
+			Latitude:        lat,
+			MaxPricePerHour: maxPrice,
+			Distance:        model.Distance,
+			StartMinute:     model.Startminute,
+			EndMinute:       model.Endminute,
+			Weekdays:        model.Weekdays,
+		},
+		ID:         model.Savedsearchuuid,
+		InternalID: model.Savedsearchid,
+		UserID:     model.Userid,
+	}
+}
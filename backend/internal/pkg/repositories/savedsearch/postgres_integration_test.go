@@ -0,0 +1,171 @@
+package savedsearch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+
+	ownerProfile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	driverProfile := models.UserProfile{
+		FullName: "John Smith",
+		Email:    "j.smith@gmail.com",
+	}
+	ownerAuth, _ := authRepo.Create(ctx, ownerProfile.Email, models.HashedPassword("some hash"))
+	driverAuth, _ := authRepo.Create(ctx, driverProfile.Email, models.HashedPassword("some other hash"))
+	ownerID, _ := userRepo.Create(ctx, ownerAuth, ownerProfile)
+	driverID, _ := userRepo.Create(ctx, driverAuth, driverProfile)
+
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	criteria := Criteria{
+		Features:        models.ParkingSpotFeatures{Shelter: true},
+		Longitude:       -79.07887,
+		Latitude:        43.07823,
+		MaxPricePerHour: 12,
+		Distance:        500,
+		StartMinute:     8 * 60,
+		EndMinute:       17 * 60,
+		Weekdays:        0b0111110,
+	}
+	target := Target{
+		Features:     models.ParkingSpotFeatures{Shelter: true, PlugIn: true},
+		Longitude:    -79.07887,
+		Latitude:     43.07923,
+		PricePerHour: 10.5,
+		OwnerID:      ownerID,
+	}
+
+	t.Run("basic add, get & delete", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		first, err := repo.Create(ctx, &CreateInput{Name: "Office", Criteria: criteria, UserID: driverID})
+		require.NoError(t, err)
+		assert.Equal(t, "Office", first.Name)
+		assert.Equal(t, criteria, first.Criteria)
+		second, err := repo.Create(ctx, &CreateInput{Name: "Office", Criteria: criteria, UserID: driverID})
+		require.NoError(t, err)
+
+		got, err := repo.GetByUUID(ctx, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, first, got)
+
+		searches, err := repo.GetMany(ctx, driverID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{second, first}, searches)
+
+		err = repo.DeleteByUUID(ctx, first.ID)
+		require.NoError(t, err)
+		err = repo.DeleteByUUID(ctx, first.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+		_, err = repo.GetByUUID(ctx, first.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("get candidates and claim", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		matching, err := repo.Create(ctx, &CreateInput{Name: "Office", Criteria: criteria, UserID: driverID})
+		require.NoError(t, err)
+
+		noLimit := criteria
+		noLimit.MaxPricePerHour = 0
+		noLimitSearch, err := repo.Create(ctx, &CreateInput{Name: "Any price", Criteria: noLimit, UserID: driverID})
+		require.NoError(t, err)
+
+		tooCheap := criteria
+		tooCheap.MaxPricePerHour = 5
+		_, err = repo.Create(ctx, &CreateInput{Name: "Cheap", Criteria: tooCheap, UserID: driverID})
+		require.NoError(t, err)
+
+		charging := criteria
+		charging.Features.ChargingStation = true
+		_, err = repo.Create(ctx, &CreateInput{Name: "Charging", Criteria: charging, UserID: driverID})
+		require.NoError(t, err)
+
+		farAway := criteria
+		farAway.Longitude = -79.3832
+		farAway.Latitude = 43.6532
+		_, err = repo.Create(ctx, &CreateInput{Name: "Toronto", Criteria: farAway, UserID: driverID})
+		require.NoError(t, err)
+
+		// The owner is never notified about their own spot
+		_, err = repo.Create(ctx, &CreateInput{Name: "Mine", Criteria: criteria, UserID: ownerID})
+		require.NoError(t, err)
+
+		now := time.Now()
+		candidates, err := repo.GetCandidates(ctx, &target, now.Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{matching, noLimitSearch}, candidates)
+
+		claimed, err := repo.Claim(ctx, []int64{matching.InternalID}, now.Add(-time.Hour), now)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{matching.InternalID}, claimed)
+
+		// Searches are not claimed again during the cooldown
+		claimed, err = repo.Claim(ctx, []int64{matching.InternalID, noLimitSearch.InternalID}, now.Add(-time.Hour), now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{noLimitSearch.InternalID}, claimed)
+
+		candidates, err = repo.GetCandidates(ctx, &target, now.Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, candidates)
+
+		// But are afterwards
+		later := now.Add(2 * time.Hour)
+		candidates, err = repo.GetCandidates(ctx, &target, later.Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Len(t, candidates, 2)
+	})
+}
@@ -0,0 +1,74 @@
+package savedsearch
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/google/uuid"
+)
+
+// Conditions a parking spot and its time slots have to meet to match a saved search
+type Criteria struct {
+	Features        models.ParkingSpotFeatures // Features required from the spot
+	Longitude       float64
+	Latitude        float64
+	MaxPricePerHour float64 // Zero if there are no price limit
+	Distance        int32   // Radius of the searched area in meters
+	StartMinute     int32   // Start of the time band in minutes since midnight
+	EndMinute       int32   // End of the time band (exclusive) in minutes since midnight
+	Weekdays        int16   // Bitmask of the matching days, indexed by time.Weekday
+}
+
+// Returns whether `t` falls within the days and time band of the criteria.
+func (c *Criteria) MatchesTime(t time.Time) bool {
+	if c.Weekdays&(1<<t.Weekday()) == 0 {
+		return false
+	}
+	minute := int32(t.Hour()*60 + t.Minute())
+	return c.StartMinute <= minute && minute < c.EndMinute
+}
+
+type Entry struct {
+	CreatedAt time.Time
+	Name      string
+	Criteria
+	ID         uuid.UUID
+	InternalID int64 // The internal ID of this search
+	UserID     int64 // The user who saved this search
+}
+
+type CreateInput struct {
+	Name string
+	Criteria
+	UserID int64
+}
+
+// A parking spot with new availability
+type Target struct {
+	Features     models.ParkingSpotFeatures
+	Longitude    float64
+	Latitude     float64
+	PricePerHour float64
+	OwnerID      int64 // Searches of the spot owner never match
+}
+
+var ErrNotFound = errors.New("no saved search found")
+
+type Repository interface {
+	// Create a new saved search
+	Create(ctx context.Context, input *CreateInput) (Entry, error)
+	GetByUUID(ctx context.Context, searchID uuid.UUID) (Entry, error)
+	// Get all saved searches of `userID`, newest first
+	GetMany(ctx context.Context, userID int64) ([]Entry, error)
+	DeleteByUUID(ctx context.Context, searchID uuid.UUID) error
+	// Get the searches whose location, price and features match `target` that were not notified since `notifiedBefore`.
+	//
+	// Time conditions are not evaluated.
+	GetCandidates(ctx context.Context, target *Target, notifiedBefore time.Time) ([]Entry, error)
+	// Mark the searches with internal IDs `searchIDs` as notified at `now`, unless they were notified since `notifiedBefore`.
+	//
+	// Returns the internal IDs of the marked searches, so concurrent callers never claim the same search.
+	Claim(ctx context.Context, searchIDs []int64, notifiedBefore, now time.Time) ([]int64, error)
+}
@@ -0,0 +1,195 @@
+package spotphoto
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, entry *Entry, limit int) (Entry, error) {
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
+
+	// Lock the spot so concurrent uploads cannot go over the limit
+	_, err = dbmodels.Parkingspots.Query(
+		dbmodels.SelectWhere.Parkingspots.Parkingspotid.EQ(entry.SpotID),
+		sm.ForUpdate(),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	photos, err := getBySpot(ctx, tx, entry.SpotID)
+	if err != nil {
+		return Entry{}, err
+	}
+	if len(photos) >= limit {
+		return Entry{}, ErrLimitReached
+	}
+	position := 0
+	if len(photos) > 0 {
+		position = photos[len(photos)-1].Position + 1
+	}
+
+	inserted, err := dbmodels.Parkingspotphotos.Insert(&dbmodels.ParkingspotphotoSetter{
+		Parkingspotid: omit.From(entry.SpotID),
+		Position:      omit.From(int32(position)),
+		Contenttype:   omit.From(entry.ContentType),
+		Imagekey:      omit.From(entry.ImageKey),
+		Thumbnailkey:  omit.From(entry.ThumbnailKey),
+		Width:         omit.From(int32(entry.Width)),
+		Height:        omit.From(int32(entry.Height)),
+	}).One(ctx, tx)
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not insert photo: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Entry{}, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return entryFromDB(inserted), nil
+}
+
+func (p *PostgresRepository) GetBySpot(ctx context.Context, spotID int64) ([]Entry, error) {
+	return getBySpot(ctx, p.db, spotID)
+}
+
+func (p *PostgresRepository) GetByUUID(ctx context.Context, spotID int64, photoID uuid.UUID) (Entry, error) {
+	result, err := dbmodels.Parkingspotphotos.Query(
+		psql.WhereAnd(
+			dbmodels.SelectWhere.Parkingspotphotos.Parkingspotid.EQ(spotID),
+			dbmodels.SelectWhere.Parkingspotphotos.Parkingspotphotouuid.EQ(photoID),
+		),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(result), nil
+}
+
+func (p *PostgresRepository) Delete(ctx context.Context, spotID int64, photoID uuid.UUID) (Entry, error) {
+	deleted, err := dbmodels.Parkingspotphotos.Delete(
+		psql.WhereAnd(
+			dbmodels.DeleteWhere.Parkingspotphotos.Parkingspotid.EQ(spotID),
+			dbmodels.DeleteWhere.Parkingspotphotos.Parkingspotphotouuid.EQ(photoID),
+		),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(deleted), nil
+}
+
+func (p *PostgresRepository) Reorder(ctx context.Context, spotID int64, order []uuid.UUID) ([]Entry, error) {
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not start a transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // Default to rollback if commit is not done
+
+	photos, err := dbmodels.Parkingspotphotos.Query(
+		dbmodels.SelectWhere.Parkingspotphotos.Parkingspotid.EQ(spotID),
+		sm.ForUpdate(),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get photos: %w", err)
+	}
+
+	if len(photos) != len(order) {
+		return nil, ErrOrderMismatch
+	}
+	for _, photo := range photos {
+		position := slices.Index(order, photo.Parkingspotphotouuid)
+		if position < 0 {
+			return nil, ErrOrderMismatch
+		}
+		if int(photo.Position) == position {
+			continue
+		}
+		err = photo.Update(ctx, tx, &dbmodels.ParkingspotphotoSetter{
+			Position: omit.From(int32(position)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not update photo position: %w", err)
+		}
+	}
+
+	result, err := getBySpot(ctx, tx, spotID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+func getBySpot(ctx context.Context, exec bob.Executor, spotID int64) ([]Entry, error) {
+	photos, err := dbmodels.Parkingspotphotos.Query(
+		dbmodels.SelectWhere.Parkingspotphotos.Parkingspotid.EQ(spotID),
+		sm.OrderBy(dbmodels.ParkingspotphotoColumns.Position),
+		sm.OrderBy(dbmodels.ParkingspotphotoColumns.Parkingspotphotoid),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("could not get photos: %w", err)
+	}
+
+	result := make([]Entry, 0, len(photos))
+	for _, photo := range photos {
+		result = append(result, entryFromDB(photo))
+	}
+	return result, nil
+}
+
+func entryFromDB(model *dbmodels.Parkingspotphoto) Entry {
+	return Entry{
+		CreatedAt:    model.Createdat,
+		ContentType:  model.Contenttype,
+		ImageKey:     model.Imagekey,
+		ThumbnailKey: model.Thumbnailkey,
+		InternalID:   model.Parkingspotphotoid,
+		SpotID:       model.Parkingspotid,
+		Width:        int(model.Width),
+		Height:       int(model.Height),
+		Position:     int(model.Position),
+		ID:           model.Parkingspotphotouuid,
+	}
+}
@@ -0,0 +1,44 @@
+package spotphoto
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	CreatedAt    time.Time
+	ContentType  string
+	ImageKey     string // Blob store key of the full size image
+	ThumbnailKey string // Blob store key of the thumbnail
+	InternalID   int64
+	SpotID       int64
+	Width        int
+	Height       int
+	Position     int
+	ID           uuid.UUID
+}
+
+var (
+	ErrNotFound      = errors.New("no photo found")
+	ErrLimitReached  = errors.New("the spot has too many photos")
+	ErrOrderMismatch = errors.New("the order does not list every photo of the spot exactly once")
+)
+
+type Repository interface {
+	// Add a photo after the other photos of its spot, unless the spot already has `limit` photos.
+	//
+	// Returns ErrLimitReached if the spot has too many photos.
+	Create(ctx context.Context, entry *Entry, limit int) (Entry, error)
+	// Get the photos of `spotID` in display order.
+	GetBySpot(ctx context.Context, spotID int64) ([]Entry, error)
+	GetByUUID(ctx context.Context, spotID int64, photoID uuid.UUID) (Entry, error)
+	// Delete the photo `photoID` of `spotID`, returning it.
+	Delete(ctx context.Context, spotID int64, photoID uuid.UUID) (Entry, error)
+	// Display the photos of `spotID` in the order of `order`.
+	//
+	// Returns ErrOrderMismatch unless `order` lists every photo of the spot exactly once.
+	Reorder(ctx context.Context, spotID int64, order []uuid.UUID) ([]Entry, error)
+}
@@ -0,0 +1,311 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/dbmodels"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+)
+
+// Event types that can be subscribed to, the position is the bit of the type in the events mask
+var eventTypes = [...]string{
+	models.WebhookEventBookingCreated,
+	models.WebhookEventAvailabilityChanged,
+}
+
+type PostgresRepository struct {
+	db bob.DB
+}
+
+func NewPostgres(db bob.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (p *PostgresRepository) Create(ctx context.Context, input *CreateInput) (Entry, error) {
+	inserted, err := dbmodels.Webhooks.Insert(&dbmodels.WebhookSetter{
+		Userid: omit.From(input.UserID),
+		URL:    omit.From(input.URL),
+		Secret: omit.From(input.Secret),
+		Events: omit.From(eventsToMask(input.Events)),
+	}).One(ctx, p.db)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return entryFromDB(inserted), nil
+}
+
+func (p *PostgresRepository) GetByUUID(ctx context.Context, webhookID uuid.UUID) (Entry, error) {
+	result, err := dbmodels.Webhooks.Query(
+		dbmodels.SelectWhere.Webhooks.Webhookuuid.EQ(webhookID),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Entry{}, err
+	}
+
+	return entryFromDB(result), nil
+}
+
+func (p *PostgresRepository) GetMany(ctx context.Context, userID int64) ([]Entry, error) {
+	webhooks, err := dbmodels.Webhooks.Query(
+		dbmodels.SelectWhere.Webhooks.Userid.EQ(userID),
+		sm.OrderBy(dbmodels.WebhookColumns.Webhookid).Desc(),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(webhooks))
+	for _, model := range webhooks {
+		result = append(result, entryFromDB(model))
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) DeleteByUUID(ctx context.Context, webhookID uuid.UUID) error {
+	deleted, err := dbmodels.Webhooks.Delete(
+		dbmodels.DeleteWhere.Webhooks.Webhookuuid.EQ(webhookID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgresRepository) Enqueue(ctx context.Context, userID int64, event *EventInput) (int64, error) {
+	mask := eventsToMask([]string{event.Type})
+	if mask == 0 {
+		return 0, nil
+	}
+
+	subscribed := psql.Select(
+		sm.Columns(
+			dbmodels.WebhookColumns.Webhookid,
+			psql.Arg(event.ID),
+			psql.Arg(event.Type),
+			psql.Arg(event.Payload),
+		),
+		sm.From(dbmodels.Webhooks.Name()),
+		sm.Where(dbmodels.WebhookColumns.Userid.EQ(psql.Arg(userID))),
+		sm.Where(psql.Group(dbmodels.WebhookColumns.Events.OP("&", psql.Arg(mask))).NE(psql.Arg(0))),
+	)
+
+	result, err := psql.Insert(
+		im.Into(
+			dbmodels.Webhookdeliveries.Name(),
+			dbmodels.ColumnNames.Webhookdeliveries.Webhookid,
+			dbmodels.ColumnNames.Webhookdeliveries.Eventid,
+			dbmodels.ColumnNames.Webhookdeliveries.Eventtype,
+			dbmodels.ColumnNames.Webhookdeliveries.Payload,
+		),
+		im.Query(subscribed),
+		im.OnConflict(
+			dbmodels.ColumnNames.Webhookdeliveries.Webhookid,
+			dbmodels.ColumnNames.Webhookdeliveries.Eventid,
+		).DoNothing(),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (p *PostgresRepository) EnqueueFor(ctx context.Context, webhookID int64, event *EventInput) (DeliveryEntry, error) {
+	inserted, err := dbmodels.Webhookdeliveries.Insert(&dbmodels.WebhookdeliverySetter{
+		Webhookid: omit.From(webhookID),
+		Eventid:   omit.From(event.ID),
+		Eventtype: omit.From(event.Type),
+		Payload:   omit.From(event.Payload),
+	}).One(ctx, p.db)
+	if err != nil {
+		return DeliveryEntry{}, err
+	}
+
+	err = inserted.LoadWebhookdeliveryWebhookidWebhook(ctx, p.db)
+	if err != nil {
+		return DeliveryEntry{}, err
+	}
+	return deliveryFromDB(inserted), nil
+}
+
+func (p *PostgresRepository) GetDeliveries(ctx context.Context, webhookID int64, limit int, after omit.Val[DeliveryCursor]) ([]DeliveryEntry, error) {
+	where := dbmodels.SelectWhere.Webhookdeliveries.Webhookid.EQ(webhookID)
+	if cursor, ok := after.Get(); ok {
+		where = psql.WhereAnd(where, dbmodels.SelectWhere.Webhookdeliveries.Deliveryid.LT(cursor.ID))
+	}
+
+	deliveries, err := dbmodels.Webhookdeliveries.Query(
+		where,
+		dbmodels.PreloadWebhookdeliveryWebhookidWebhook(),
+		sm.OrderBy(dbmodels.WebhookdeliveryColumns.Deliveryid).Desc(),
+		sm.Limit(limit),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []DeliveryEntry{}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]DeliveryEntry, 0, len(deliveries))
+	for _, model := range deliveries {
+		result = append(result, deliveryFromDB(model))
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]DeliveryEntry, error) {
+	due := psql.Select(
+		sm.Columns(dbmodels.WebhookdeliveryColumns.Deliveryid),
+		sm.From(dbmodels.Webhookdeliveries.Name()),
+		sm.Where(dbmodels.WebhookdeliveryColumns.Status.EQ(psql.Arg(models.WebhookDeliveryPending))),
+		sm.Where(dbmodels.WebhookdeliveryColumns.Nextattemptat.LTE(psql.Arg(now))),
+		sm.OrderBy(dbmodels.WebhookdeliveryColumns.Nextattemptat),
+		sm.Limit(limit),
+		sm.ForUpdate().SkipLocked(),
+	)
+
+	claimed, err := dbmodels.Webhookdeliveries.Update(
+		um.SetCol(dbmodels.ColumnNames.Webhookdeliveries.Nextattemptat).ToArg(leaseUntil),
+		um.From(due).As("due"),
+		um.Where(dbmodels.WebhookdeliveryColumns.Deliveryid.EQ(psql.Quote("due", dbmodels.ColumnNames.Webhookdeliveries.Deliveryid))),
+	).All(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []DeliveryEntry{}, nil
+		}
+		return nil, err
+	}
+	if len(claimed) == 0 {
+		return []DeliveryEntry{}, nil
+	}
+
+	err = claimed.LoadWebhookdeliveryWebhookidWebhook(ctx, p.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]DeliveryEntry, 0, len(claimed))
+	for _, model := range claimed {
+		result = append(result, deliveryFromDB(model))
+	}
+	return result, nil
+}
+
+func (p *PostgresRepository) RecordAttempt(ctx context.Context, deliveryID int64, result *AttemptResult) error {
+	setter := dbmodels.WebhookdeliverySetter{
+		Status:         omit.From(result.Status),
+		Lastattemptat:  omitnull.From(result.AttemptedAt),
+		Laststatuscode: omitnull.FromNull(null.FromCond(result.StatusCode, result.StatusCode != 0)),
+		Lasterror:      omitnull.FromNull(null.FromCond(result.Error, result.Error != "")),
+	}
+	if result.Status == models.WebhookDeliveryPending {
+		setter.Nextattemptat = omit.From(result.NextAttemptAt)
+	}
+
+	updated, err := dbmodels.Webhookdeliveries.Update(
+		setter.UpdateMod(),
+		um.SetCol(dbmodels.ColumnNames.Webhookdeliveries.Attempts).To(
+			psql.Raw(dbmodels.ColumnNames.Webhookdeliveries.Attempts+" + 1"),
+		),
+		dbmodels.UpdateWhere.Webhookdeliveries.Deliveryid.EQ(deliveryID),
+	).Exec(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func eventsToMask(events []string) int16 {
+	var mask int16
+	for _, event := range events {
+		for i, known := range eventTypes {
+			if event == known {
+				mask |= 1 << i
+			}
+		}
+	}
+	return mask
+}
+
+func eventsFromMask(mask int16) []string {
+	result := make([]string, 0, len(eventTypes))
+	for i, event := range eventTypes {
+		if mask&(1<<i) != 0 {
+			result = append(result, event)
+		}
+	}
+	return result
+}
+
+func entryFromDB(model *dbmodels.Webhook) Entry {
+	return Entry{
+		Secret: model.Secret,
+		Webhook: models.Webhook{
+			CreatedAt: model.Createdat,
+			WebhookInput: models.WebhookInput{
+				URL:    model.URL,
+				Events: eventsFromMask(model.Events),
+			},
+			ID: model.Webhookuuid,
+		},
+		InternalID: model.Webhookid,
+		UserID:     model.Userid,
+	}
+}
+
+func deliveryFromDB(model *dbmodels.Webhookdelivery) DeliveryEntry {
+	result := DeliveryEntry{
+		Payload: model.Payload,
+		WebhookDelivery: models.WebhookDelivery{
+			CreatedAt:      model.Createdat,
+			EventType:      model.Eventtype,
+			Status:         model.Status,
+			LastError:      model.Lasterror.GetOrZero(),
+			Attempts:       model.Attempts,
+			LastStatusCode: model.Laststatuscode.GetOrZero(),
+			EventID:        model.Eventid,
+			ID:             model.Deliveryuuid,
+		},
+		InternalID: model.Deliveryid,
+		WebhookID:  model.Webhookid,
+	}
+	if lastAttempt, ok := model.Lastattemptat.Get(); ok {
+		result.LastAttemptAt = &lastAttempt
+	}
+	if model.Status == models.WebhookDeliveryPending {
+		nextAttempt := model.Nextattemptat
+		result.NextAttemptAt = &nextAttempt
+	}
+	if webhook := model.R.WebhookidWebhook; webhook != nil {
+		result.URL = webhook.URL
+		result.Secret = webhook.Secret
+	}
+	return result
+}
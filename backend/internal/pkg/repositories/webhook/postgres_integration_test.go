@@ -0,0 +1,227 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/auth"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/user"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/testutils"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresIntegration(t *testing.T) {
+	t.Parallel()
+
+	testutils.Integration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	container, connString := testutils.CreatePostgresContainer(ctx, t)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+	testutils.RunMigrations(t, connString)
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err, "could not connect to db")
+	t.Cleanup(func() { pool.Close() })
+	db := bob.NewDB(stdlib.OpenDBFromPool(pool))
+
+	repo := NewPostgres(db)
+	userRepo := user.NewPostgres(db)
+	authRepo := auth.NewPostgres(db)
+
+	profile := models.UserProfile{
+		FullName: "John Wick",
+		Email:    "j.wick@gmail.com",
+	}
+	authID, _ := authRepo.Create(ctx, profile.Email, models.HashedPassword("some hash"))
+	userID, _ := userRepo.Create(ctx, authID, profile)
+
+	pool.Reset()
+	snapshotErr := container.Snapshot(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+	require.NoError(t, snapshotErr, "could not snapshot db")
+
+	bookingHook := CreateInput{
+		Secret: "whsec_booking",
+		WebhookInput: models.WebhookInput{
+			URL:    "https://example.com/bookings",
+			Events: []string{models.WebhookEventBookingCreated},
+		},
+		UserID: userID,
+	}
+	spotHook := CreateInput{
+		Secret: "whsec_spot",
+		WebhookInput: models.WebhookInput{
+			URL:    "https://example.com/spots",
+			Events: []string{models.WebhookEventAvailabilityChanged},
+		},
+		UserID: userID,
+	}
+
+	t.Run("basic add, get & delete", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		created, err := repo.Create(ctx, &bookingHook)
+		require.NoError(t, err)
+		assert.Equal(t, bookingHook.Secret, created.Secret)
+		assert.Equal(t, bookingHook.WebhookInput, created.WebhookInput)
+		assert.Equal(t, userID, created.UserID)
+
+		other, err := repo.Create(ctx, &spotHook)
+		require.NoError(t, err)
+
+		got, err := repo.GetByUUID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created, got)
+
+		webhooks, err := repo.GetMany(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{other, created}, webhooks)
+
+		err = repo.DeleteByUUID(ctx, created.ID)
+		require.NoError(t, err)
+		err = repo.DeleteByUUID(ctx, created.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+		_, err = repo.GetByUUID(ctx, created.ID)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("enqueue to subscribed webhooks once", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		bookingEntry, err := repo.Create(ctx, &bookingHook)
+		require.NoError(t, err)
+		spotEntry, err := repo.Create(ctx, &spotHook)
+		require.NoError(t, err)
+
+		event := EventInput{
+			Type:    models.WebhookEventBookingCreated,
+			Payload: `{"type":"booking.created"}`,
+			ID:      uuid.New(),
+		}
+		queued, err := repo.Enqueue(ctx, userID, &event)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), queued)
+
+		// Publishing the same event again is a no-op
+		queued, err = repo.Enqueue(ctx, userID, &event)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), queued)
+
+		deliveries, err := repo.GetDeliveries(ctx, bookingEntry.InternalID, 10, omit.Val[DeliveryCursor]{})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, event.ID, deliveries[0].EventID)
+		assert.Equal(t, event.Payload, deliveries[0].Payload)
+		assert.Equal(t, models.WebhookDeliveryPending, deliveries[0].Status)
+		assert.Equal(t, bookingEntry.URL, deliveries[0].URL)
+
+		deliveries, err = repo.GetDeliveries(ctx, spotEntry.InternalID, 10, omit.Val[DeliveryCursor]{})
+		require.NoError(t, err)
+		assert.Empty(t, deliveries)
+
+		// Test events are delivered regardless of subscriptions
+		testDelivery, err := repo.EnqueueFor(ctx, spotEntry.InternalID, &EventInput{
+			Type:    models.WebhookEventTest,
+			Payload: `{"type":"webhook.test"}`,
+			ID:      uuid.New(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, spotEntry.Secret, testDelivery.Secret)
+
+		deliveries, err = repo.GetDeliveries(ctx, spotEntry.InternalID, 10, omit.Val[DeliveryCursor]{})
+		require.NoError(t, err)
+		assert.Equal(t, []DeliveryEntry{testDelivery}, deliveries)
+	})
+
+	t.Run("claim and record attempts", func(t *testing.T) {
+		t.Cleanup(func() {
+			err := container.Restore(ctx, postgres.WithSnapshotName(testutils.PostgresSnapshotName))
+			require.NoError(t, err, "could not restore db")
+
+			// clear all idle connections
+			// required since Restore() deletes the current DB
+			pool.Reset()
+		})
+
+		entry, err := repo.Create(ctx, &bookingHook)
+		require.NoError(t, err)
+		first, err := repo.EnqueueFor(ctx, entry.InternalID, &EventInput{Type: models.WebhookEventTest, Payload: "{}", ID: uuid.New()})
+		require.NoError(t, err)
+		second, err := repo.EnqueueFor(ctx, entry.InternalID, &EventInput{Type: models.WebhookEventTest, Payload: "{}", ID: uuid.New()})
+		require.NoError(t, err)
+
+		now := time.Now().Add(time.Second)
+		claimed, err := repo.ClaimDue(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 2)
+		assert.Equal(t, entry.URL, claimed[0].URL)
+		assert.Equal(t, entry.Secret, claimed[0].Secret)
+
+		// Claimed deliveries are leased
+		claimed, err = repo.ClaimDue(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		assert.Empty(t, claimed)
+
+		err = repo.RecordAttempt(ctx, first.InternalID, &AttemptResult{
+			AttemptedAt: now,
+			Status:      models.WebhookDeliverySucceeded,
+			StatusCode:  200,
+		})
+		require.NoError(t, err)
+		err = repo.RecordAttempt(ctx, second.InternalID, &AttemptResult{
+			AttemptedAt:   now,
+			NextAttemptAt: now.Add(time.Second),
+			Status:        models.WebhookDeliveryPending,
+			Error:         "connection refused",
+		})
+		require.NoError(t, err)
+
+		deliveries, err := repo.GetDeliveries(ctx, entry.InternalID, 1, omit.Val[DeliveryCursor]{})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, second.ID, deliveries[0].ID)
+		assert.Equal(t, int32(1), deliveries[0].Attempts)
+		assert.Equal(t, "connection refused", deliveries[0].LastError)
+		assert.Zero(t, deliveries[0].LastStatusCode)
+
+		deliveries, err = repo.GetDeliveries(ctx, entry.InternalID, 1, omit.From(DeliveryCursor{ID: deliveries[0].InternalID}))
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, first.ID, deliveries[0].ID)
+		assert.Equal(t, models.WebhookDeliverySucceeded, deliveries[0].Status)
+		assert.Equal(t, int32(200), deliveries[0].LastStatusCode)
+		assert.Nil(t, deliveries[0].NextAttemptAt)
+
+		// Only the retried delivery is due again
+		later := now.Add(2 * time.Second)
+		claimed, err = repo.ClaimDue(ctx, later, later.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, second.ID, claimed[0].ID)
+	})
+}
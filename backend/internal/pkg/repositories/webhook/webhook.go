@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	Secret string // Key used to sign payloads
+	models.Webhook
+	InternalID int64 // The internal ID of this webhook
+	UserID     int64 // The user owning this webhook
+}
+
+type CreateInput struct {
+	Secret string
+	models.WebhookInput
+	UserID int64
+}
+
+type DeliveryEntry struct {
+	Payload string // The encoded event
+	URL     string // The endpoint of the webhook
+	Secret  string // The signing key of the webhook
+	models.WebhookDelivery
+	InternalID int64 // The internal ID of this delivery
+	WebhookID  int64 // The internal ID of the webhook
+}
+
+// An event queued for delivery
+type EventInput struct {
+	Type    string
+	Payload string // The encoded event
+	ID      uuid.UUID
+}
+
+// Outcome of a delivery attempt
+type AttemptResult struct {
+	AttemptedAt   time.Time
+	NextAttemptAt time.Time // Only used if the delivery is still pending
+	Status        string
+	Error         string
+	StatusCode    int32 // 0 if the endpoint did not respond
+}
+
+type DeliveryCursor struct {
+	_  struct{} `cbor:",toarray"`
+	ID int64    // The internal delivery ID to use as anchor
+}
+
+var ErrNotFound = errors.New("no webhook found")
+
+type Repository interface {
+	Create(ctx context.Context, input *CreateInput) (Entry, error)
+	GetByUUID(ctx context.Context, webhookID uuid.UUID) (Entry, error)
+	// Get all webhooks of `userID`, newest first
+	GetMany(ctx context.Context, userID int64) ([]Entry, error)
+	// Delete a webhook along with its deliveries
+	DeleteByUUID(ctx context.Context, webhookID uuid.UUID) error
+	// Queue `event` for delivery to every webhook of `userID` subscribed to its type.
+	//
+	// Events already queued for a webhook are skipped. Returns the number of deliveries queued.
+	Enqueue(ctx context.Context, userID int64, event *EventInput) (int64, error)
+	// Queue `event` for delivery to the webhook `webhookID` regardless of its subscriptions.
+	EnqueueFor(ctx context.Context, webhookID int64, event *EventInput) (DeliveryEntry, error)
+	// Get at most `limit` deliveries of `webhookID`, newest first
+	GetDeliveries(ctx context.Context, webhookID int64, limit int, after omit.Val[DeliveryCursor]) ([]DeliveryEntry, error)
+	// Get at most `limit` pending deliveries due by `now`.
+	//
+	// The returned deliveries are postponed to `leaseUntil`, so concurrent callers never claim the same delivery,
+	// and deliveries interrupted before their outcome is recorded are attempted again.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]DeliveryEntry, error)
+	// Record the outcome of an attempt of the delivery `deliveryID`
+	RecordAttempt(ctx context.Context, deliveryID int64, result *AttemptResult) error
+}
@@ -0,0 +1,159 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `AlertRoute`
+type AlertServicer interface {
+	// Create an alert for `userID` on availability of the spot `spotID`.
+	CreateForSpot(ctx context.Context, userID int64, spotID uuid.UUID) (models.AvailabilityAlert, error)
+	// Create an alert for `userID` on availability of spots within `area`.
+	CreateForArea(ctx context.Context, userID int64, area *models.AvailabilityAlertArea) (models.AvailabilityAlert, error)
+	// Get all alerts of `userID`.
+	GetMany(ctx context.Context, userID int64) ([]models.AvailabilityAlert, error)
+	// Delete the alert `alertID` if `userID` owns the resource.
+	DeleteByUUID(ctx context.Context, userID int64, alertID uuid.UUID) error
+}
+
+// AlertRoute represents availability alert API routes
+type AlertRoute struct {
+	service       AlertServicer
+	sessionGetter SessionDataGetter
+}
+
+type alertOutput struct {
+	Body models.AvailabilityAlert
+}
+
+type alertListOutput struct {
+	Body []models.AvailabilityAlert `nullable:"false"`
+}
+
+var AlertTag = huma.Tag{
+	Name:        "Alert",
+	Description: "Operations for getting notified when parking becomes available.",
+}
+
+// Returns a new `AlertRoute`
+func NewAlertRoute(
+	service AlertServicer,
+	sessionGetter SessionDataGetter,
+) *AlertRoute {
+	return &AlertRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+func (r *AlertRoute) RegisterAlertTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &AlertTag)
+}
+
+// Registers availability alert routes
+func (r *AlertRoute) RegisterAlertRoutes(api huma.API) {
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "create-spot-alert",
+		Method:        http.MethodPost,
+		Path:          "/spots/{id}/alerts",
+		Summary:       "Get notified when a parking spot has new availability",
+		Description:   "A notification is sent when the spot owner adds time slots or held time slots are released, at most once an hour.",
+		Tags:          []string{AlertTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*alertOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.CreateForSpot(ctx, userID, input.ID)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			switch {
+			case errors.Is(err, models.ErrParkingSpotNotFound):
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			case errors.Is(err, models.ErrAlertDuplicate):
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+		return &alertOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "create-area-alert",
+		Method:        http.MethodPost,
+		Path:          "/user/alerts",
+		Summary:       "Get notified when parking spots in an area have new availability",
+		Description:   "A notification is sent when time slots of a spot within the area are added or released, at most once an hour.",
+		Tags:          []string{AlertTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.AvailabilityAlertArea
+	},
+	) (*alertOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.CreateForArea(ctx, userID, &input.Body)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &alertOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-alerts",
+		Method:      http.MethodGet,
+		Path:        "/user/alerts",
+		Summary:     "Get availability alerts of the current user",
+		Tags:        []string{AlertTag.Name},
+	}), func(ctx context.Context, _ *struct{}) (*alertListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.GetMany(ctx, userID)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &alertListOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "delete-alert",
+		Method:      http.MethodDelete,
+		Path:        "/alerts/{id}",
+		Summary:     "Delete the specified availability alert",
+		Tags:        []string{AlertTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.DeleteByUUID(ctx, userID, input.ID)
+		if err != nil {
+			if errors.Is(err, models.ErrAlertNotFound) {
+				detail := &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				return nil, NewHumaError(ctx, http.StatusNotFound, err, detail)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return nil, nil
+	})
+}
@@ -0,0 +1,290 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAlertService struct {
+	mock.Mock
+}
+
+// CreateForSpot implements AlertServicer.
+func (m *mockAlertService) CreateForSpot(ctx context.Context, userID int64, spotID uuid.UUID) (models.AvailabilityAlert, error) {
+	args := m.Called(ctx, userID, spotID)
+	return args.Get(0).(models.AvailabilityAlert), args.Error(1)
+}
+
+// CreateForArea implements AlertServicer.
+func (m *mockAlertService) CreateForArea(ctx context.Context, userID int64, area *models.AvailabilityAlertArea) (models.AvailabilityAlert, error) {
+	args := m.Called(ctx, userID, area)
+	return args.Get(0).(models.AvailabilityAlert), args.Error(1)
+}
+
+// GetMany implements AlertServicer.
+func (m *mockAlertService) GetMany(ctx context.Context, userID int64) ([]models.AvailabilityAlert, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.AvailabilityAlert), args.Error(1)
+}
+
+// DeleteByUUID implements AlertServicer.
+func (m *mockAlertService) DeleteByUUID(ctx context.Context, userID int64, alertID uuid.UUID) error {
+	args := m.Called(ctx, userID, alertID)
+	return args.Error(0)
+}
+
+func TestCreateSpotAlert(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testSpotID := uuid.New()
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAlertService)
+		route := NewAlertRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		expected := models.AvailabilityAlert{
+			SpotID: testSpotID,
+			ID:     uuid.New(),
+		}
+		srv.On("CreateForSpot", mock.Anything, testUserID, testSpotID).
+			Return(expected, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+testSpotID.String()+"/alerts")
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.AvailabilityAlert
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected.SpotID, result.SpotID)
+		assert.Equal(t, expected.ID, result.ID)
+		assert.Nil(t, result.Area)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAlertService)
+		route := NewAlertRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("CreateForSpot", mock.Anything, testUserID, testSpotID).
+			Return(models.AvailabilityAlert{}, models.ErrParkingSpotNotFound).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+testSpotID.String()+"/alerts")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(testSpotID),
+		}
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("duplicate alert", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAlertService)
+		route := NewAlertRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("CreateForSpot", mock.Anything, testUserID, testSpotID).
+			Return(models.AvailabilityAlert{}, models.ErrAlertDuplicate).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+testSpotID.String()+"/alerts")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeDuplicate.TypeURI(), errModel.Type)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestCreateAreaAlert(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAlertService)
+		route := NewAlertRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		area := models.AvailabilityAlertArea{
+			Longitude: -79.07887,
+			Latitude:  43.07923,
+			Distance:  500,
+		}
+		expected := models.AvailabilityAlert{
+			Area: &area,
+			ID:   uuid.New(),
+		}
+		srv.On("CreateForArea", mock.Anything, testUserID, &area).
+			Return(expected, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/user/alerts", area)
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.AvailabilityAlert
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected.Area, result.Area)
+		assert.Equal(t, uuid.Nil, result.SpotID)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("invalid area", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAlertService)
+		route := NewAlertRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.PostCtx(ctx, "/user/alerts", models.AvailabilityAlertArea{
+			Longitude: -200,
+			Latitude:  43.07923,
+			Distance:  500,
+		})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertNotCalled(t, "CreateForArea", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("too many alerts", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAlertService)
+		route := NewAlertRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("CreateForArea", mock.Anything, testUserID, mock.Anything).
+			Return(models.AvailabilityAlert{}, models.ErrTooManyAlerts).
+			Once()
+
+		resp := api.PostCtx(ctx, "/user/alerts", models.AvailabilityAlertArea{Distance: 250})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeAlertInvalid.TypeURI(), errModel.Type)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestListAlerts(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	srv := new(mockAlertService)
+	route := NewAlertRoute(srv, fakeSessionDataGetter{})
+	_, api := humatest.New(t)
+	huma.AutoRegister(api, route)
+
+	srv.On("GetMany", mock.Anything, testUserID).
+		Return([]models.AvailabilityAlert{}, nil).
+		Once()
+
+	resp := api.GetCtx(ctx, "/user/alerts")
+	assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+	assert.JSONEq(t, "[]", resp.Body.String())
+
+	srv.AssertExpectations(t)
+}
+
+func TestDeleteAlert(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testAlertID := uuid.New()
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAlertService)
+		route := NewAlertRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("DeleteByUUID", mock.Anything, testUserID, testAlertID).
+			Return(nil).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/alerts/"+testAlertID.String())
+		assert.Equal(t, http.StatusNoContent, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("alert not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAlertService)
+		route := NewAlertRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("DeleteByUUID", mock.Anything, testUserID, testAlertID).
+			Return(models.ErrAlertNotFound).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/alerts/"+testAlertID.String())
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,150 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Interval between keep-alive comments sent on idle event streams
+const eventStreamKeepAlive = 30 * time.Second
+
+// Service provider for `AvailabilityRoute`
+type AvailabilityServicer interface {
+	// Subscribe to availability events of the spot `spotID` until `ctx` is cancelled.
+	SubscribeSpot(ctx context.Context, spotID uuid.UUID) (<-chan models.AvailabilityEvent, error)
+	// Subscribe to availability events of spots within `filter` until `ctx` is cancelled.
+	SubscribeArea(ctx context.Context, filter *models.AvailabilityAreaFilter) (<-chan models.AvailabilityEvent, error)
+}
+
+// AvailabilityRoute represents real-time availability API routes
+type AvailabilityRoute struct {
+	service AvailabilityServicer
+}
+
+// Returns a new `AvailabilityRoute`
+func NewAvailabilityRoute(service AvailabilityServicer) *AvailabilityRoute {
+	return &AvailabilityRoute{
+		service: service,
+	}
+}
+
+// Registers availability routes
+func (r *AvailabilityRoute) RegisterAvailabilityRoutes(api huma.API) {
+	responses := availabilityEventResponses(api)
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "stream-parking-spot-availability",
+		Method:      http.MethodGet,
+		Path:        "/spots/{id}/availability/events",
+		Summary:     "Stream changes to the availability of a parking spot",
+		Description: "Sends an event whenever time slots of the parking spot are added, removed or booked.",
+		Tags:        []string{ParkingSpotTag.Name},
+		Responses:   responses,
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*huma.StreamResponse, error) {
+		events, err := r.service.SubscribeSpot(ctx, input.ID)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			if errors.Is(err, models.ErrParkingSpotNotFound) {
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+		return streamAvailabilityEvents(events), nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "stream-area-availability",
+		Method:      http.MethodGet,
+		Path:        "/spots/availability/events",
+		Summary:     "Stream changes to the availability of parking spots around a location",
+		Description: "Sends an event whenever time slots of a parking spot within the area are added, removed or booked.",
+		Tags:        []string{ParkingSpotTag.Name},
+		Responses:   responses,
+		Errors:      []int{http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *models.AvailabilityAreaFilter) (*huma.StreamResponse, error) {
+		events, err := r.service.SubscribeArea(ctx, input)
+		if err != nil {
+			var detail error
+			if errors.Is(err, models.ErrInvalidAreaDistance) {
+				detail = &huma.ErrorDetail{
+					Location: "query.distance",
+					Value:    input.Distance,
+				}
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+		}
+		return streamAvailabilityEvents(events), nil
+	})
+}
+
+// Documents the Server-Sent Events responses of availability streams
+func availabilityEventResponses(api huma.API) map[string]*huma.Response {
+	schema := api.OpenAPI().Components.Schemas.Schema(reflect.TypeOf(models.AvailabilityEvent{}), true, "AvailabilityEvent")
+	return map[string]*huma.Response{
+		"200": {
+			Description: "Server-Sent Events stream. The event name is the availability event type.",
+			Content: map[string]*huma.MediaType{
+				"text/event-stream": {Schema: schema},
+			},
+		},
+	}
+}
+
+// Writes `events` as Server-Sent Events until the channel is closed or the client goes away
+func streamAvailabilityEvents(events <-chan models.AvailabilityEvent) *huma.StreamResponse {
+	return &huma.StreamResponse{
+		Body: func(ctx huma.Context) {
+			ctx.SetHeader("Content-Type", "text/event-stream")
+			ctx.SetHeader("Cache-Control", "no-store")
+			writer := ctx.BodyWriter()
+			flusher, _ := writer.(http.Flusher)
+
+			keepAlive := time.NewTicker(eventStreamKeepAlive)
+			defer keepAlive.Stop()
+
+			for {
+				var message []byte
+				select {
+				case event, ok := <-events:
+					if !ok {
+						return
+					}
+					data, err := json.Marshal(&event)
+					if err != nil {
+						continue
+					}
+					message = append([]byte("event: "+event.Type+"\ndata: "), data...)
+					message = append(message, "\n\n"...)
+				case <-keepAlive.C:
+					message = []byte(": keep-alive\n\n")
+				}
+
+				_, err := writer.Write(message)
+				if err != nil {
+					return
+				}
+				if flusher != nil {
+					flusher.Flush()
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,146 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAvailabilityService struct {
+	mock.Mock
+}
+
+// SubscribeSpot implements AvailabilityServicer.
+func (m *mockAvailabilityService) SubscribeSpot(ctx context.Context, spotID uuid.UUID) (<-chan models.AvailabilityEvent, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(<-chan models.AvailabilityEvent), args.Error(1)
+}
+
+// SubscribeArea implements AvailabilityServicer.
+func (m *mockAvailabilityService) SubscribeArea(ctx context.Context, filter *models.AvailabilityAreaFilter) (<-chan models.AvailabilityEvent, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(<-chan models.AvailabilityEvent), args.Error(1)
+}
+
+// Returns a closed channel containing `events`
+func closedEvents(events ...models.AvailabilityEvent) <-chan models.AvailabilityEvent {
+	result := make(chan models.AvailabilityEvent, len(events))
+	for _, event := range events {
+		result <- event
+	}
+	close(result)
+	return result
+}
+
+func TestAvailabilityEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testSpotID := uuid.New()
+	start := time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC)
+	testEvent := models.AvailabilityEvent{
+		Type: models.AvailabilityEventBooked,
+		Times: []models.TimeUnit{
+			{StartTime: start, EndTime: start.Add(30 * time.Minute)},
+		},
+		Longitude: -79.07887,
+		Latitude:  43.07923,
+		SpotID:    testSpotID,
+	}
+
+	t.Run("spot events are streamed", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAvailabilityService)
+		route := NewAvailabilityRoute(srv)
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("SubscribeSpot", mock.Anything, testSpotID).
+			Return(closedEvents(testEvent), nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots/"+testSpotID.String()+"/availability/events")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Result().Header.Get("Content-Type"))
+
+		body := resp.Body.String()
+		require.True(t, strings.HasPrefix(body, "event: booked\ndata: "), body)
+		var result models.AvailabilityEvent
+		err := json.Unmarshal([]byte(strings.TrimPrefix(body, "event: booked\ndata: ")), &result)
+		require.NoError(t, err)
+		assert.Equal(t, testEvent, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAvailabilityService)
+		route := NewAvailabilityRoute(srv)
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("SubscribeSpot", mock.Anything, testSpotID).
+			Return((<-chan models.AvailabilityEvent)(nil), models.ErrParkingSpotNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots/"+testSpotID.String()+"/availability/events")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("area events are streamed", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAvailabilityService)
+		route := NewAvailabilityRoute(srv)
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("SubscribeArea", mock.Anything, &models.AvailabilityAreaFilter{
+			Longitude: -79.07887,
+			Latitude:  43.07923,
+			Distance:  250,
+		}).
+			Return(closedEvents(testEvent), nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots/availability/events?latitude=43.07923&longitude=-79.07887")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+		assert.Contains(t, resp.Body.String(), "event: booked\n")
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("area distance is limited", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockAvailabilityService)
+		route := NewAvailabilityRoute(srv)
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.GetCtx(ctx, "/spots/availability/events?latitude=43.07923&longitude=-79.07887&distance=10001")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertNotCalled(t, "SubscribeArea")
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
 	"github.com/danielgtaylor/huma/v2"
@@ -33,6 +34,10 @@ type BookingServicer interface {
 	GetByUUID(ctx context.Context, userID int64, bookingID uuid.UUID) (models.BookingWithDetailsAndTimes, error)
 	// Get booked times with `bookingID if `userID` has enough permission to view the resource.
 	GetBookedTimesByUUID(ctx context.Context, userID int64, bookingID uuid.UUID) ([]models.TimeUnit, error)
+	// Get the price of booking the spot `spotID` between `startTime` and `endTime`.
+	GetQuote(ctx context.Context, spotID uuid.UUID, startTime, endTime time.Time) (models.PriceQuote, error)
+	// Create a quote for booking the spot `spotID` by `userID`, usable for creating a booking at the quoted price.
+	CreateQuote(ctx context.Context, userID int64, spotID uuid.UUID, input *models.BookingQuoteInput) (models.BookingQuote, error)
 }
 
 // BookingRoute represents booking-related API routes
@@ -58,6 +63,14 @@ type bookedTimes struct {
 	Body []models.TimeUnit
 }
 
+type priceQuoteOutput struct {
+	Body models.PriceQuote
+}
+
+type bookingQuoteOutput struct {
+	Body models.BookingQuote
+}
+
 var BookingTag = huma.Tag{
 	Name:        "Booking",
 	Description: "Operations for handling bookings.",
@@ -110,7 +123,7 @@ func (r *BookingRoute) RegisterBookingRoutes(api huma.API) {
 					Location: "body.car_id",
 					Value:    input.Body.CarID,
 				}
-			case errors.Is(err, models.ErrCarNotOwned):
+			case errors.Is(err, models.ErrCarNotOwned), errors.Is(err, models.ErrCarDoesNotFit):
 				detail = &huma.ErrorDetail{
 					Location: "body.car_id",
 					Value:    input.Body.CarID,
@@ -120,6 +133,23 @@ func (r *BookingRoute) RegisterBookingRoutes(api huma.API) {
 					Location: "body.booked_times",
 					Value:    input.Body.BookedTimes,
 				}
+			case errors.Is(err, models.ErrQuoteInvalid):
+				detail = &huma.ErrorDetail{
+					Location: "body.quote_id",
+					Value:    input.Body.QuoteID,
+				}
+			case isPromoCodeError(err):
+				detail = &huma.ErrorDetail{
+					Location: "body.promo_code",
+					Value:    input.Body.PromoCode,
+				}
+			case errors.Is(err, models.ErrHoldNotFound),
+				errors.Is(err, models.ErrHoldExpired),
+				errors.Is(err, models.ErrHoldSpotMismatch):
+				detail = &huma.ErrorDetail{
+					Location: "body.hold_id",
+					Value:    input.Body.HoldID,
+				}
 			}
 			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
 		}
@@ -335,4 +365,94 @@ func (r *BookingRoute) RegisterBookingRoutes(api huma.API) {
 		}
 		return &result, nil
 	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "get-spot-quote",
+		Method:      http.MethodGet,
+		Path:        "/spots/{id}/quote",
+		Summary:     "Get the price of booking a parking spot",
+		Description: "Each 30 minute slot within the time range is priced by the spot pricing rules.",
+		Tags:        []string{BookingTag.Name},
+		Errors:      []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		models.PriceQuoteFilter
+		ID uuid.UUID `path:"id"`
+	},
+	) (*priceQuoteOutput, error) {
+		result, err := r.service.GetQuote(ctx, input.ID, input.StartTime, input.EndTime)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			switch {
+			case errors.Is(err, models.ErrParkingSpotNotFound):
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			case errors.Is(err, models.ErrInvalidQuoteTimeRange):
+				detail = &huma.ErrorDetail{
+					Location: "query.end_time",
+					Value:    input.EndTime,
+				}
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+		return &priceQuoteOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "create-spot-quote",
+		Method:        http.MethodPost,
+		Path:          "/spots/{id}/quotes",
+		Summary:       "Create a price quote for booking a parking spot",
+		Description:   "The returned quote ID can be passed to create-booking to book the same time slots at the quoted price until the quote expires.",
+		Tags:          []string{BookingTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.BookingQuoteInput
+		ID   uuid.UUID `path:"id"`
+	},
+	) (*bookingQuoteOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.CreateQuote(ctx, userID, input.ID, &input.Body)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			switch {
+			case errors.Is(err, models.ErrParkingSpotNotFound):
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			case errors.Is(err, models.ErrEmptyBookingTimes),
+				errors.Is(err, models.ErrInvalidTimeUnit),
+				errors.Is(err, models.ErrTooManyQuotedTimes):
+				detail = &huma.ErrorDetail{
+					Location: "body.booked_times",
+					Value:    input.Body.BookedTimes,
+				}
+			case isPromoCodeError(err):
+				detail = &huma.ErrorDetail{
+					Location: "body.promo_code",
+					Value:    input.Body.PromoCode,
+				}
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+		return &bookingQuoteOutput{Body: result}, nil
+	})
+}
+
+// Returns whether `err` is caused by the promo code of a booking or quote
+func isPromoCodeError(err error) bool {
+	return errors.Is(err, models.ErrPromoCodeInvalid) ||
+		errors.Is(err, models.ErrPromoCodeNotApplicable) ||
+		errors.Is(err, models.ErrPromoCodeMinimumSpend) ||
+		errors.Is(err, models.ErrPromoCodeExhausted) ||
+		errors.Is(err, models.ErrPromoCodeQuoteMismatch)
 }
@@ -56,6 +56,18 @@ func (m *mockBookingService) GetBookedTimesByUUID(ctx context.Context, userID in
 	return args.Get(0).([]models.TimeUnit), args.Error(1)
 }
 
+// GetQuote implements BookingServicer.
+func (m *mockBookingService) GetQuote(ctx context.Context, spotID uuid.UUID, startTime, endTime time.Time) (models.PriceQuote, error) {
+	args := m.Called(ctx, spotID, startTime, endTime)
+	return args.Get(0).(models.PriceQuote), args.Error(1)
+}
+
+// CreateQuote implements BookingServicer.
+func (m *mockBookingService) CreateQuote(ctx context.Context, userID int64, spotID uuid.UUID, input *models.BookingQuoteInput) (models.BookingQuote, error) {
+	args := m.Called(ctx, userID, spotID, input)
+	return args.Get(0).(models.BookingQuote), args.Error(1)
+}
+
 var sampleBookTimes = []models.TimeUnit{
 	{
 		StartTime: time.Date(2024, time.October, 26, 10, 0, 0, 0, time.UTC),  // 10:00 AM
@@ -338,6 +350,37 @@ func TestCreateBooking(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 
+	t.Run("invalid quote", func(t *testing.T) {
+		t.Parallel()
+
+		quotedInput := bookingInput
+		quotedInput.QuoteID = uuid.New()
+
+		mockService := new(mockBookingService)
+		mockService.On("Create", mock.Anything, userID, spotUUID, &quotedInput).
+			Return(int64(0), models.BookingWithTimes{}, models.ErrQuoteInvalid).Once()
+
+		route := NewBookingRoute(mockService, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.PostCtx(ctx, fmt.Sprintf("/spots/%v/bookings", spotUUID), quotedInput)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		require.NoError(t, json.NewDecoder(resp.Result().Body).Decode(&errModel))
+
+		testDetail := huma.ErrorDetail{
+			Location: "body.quote_id",
+			Value:    jsonAnyify(quotedInput.QuoteID),
+		}
+
+		assert.Equal(t, models.CodeBookingInvalid.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		mockService.AssertExpectations(t)
+	})
+
 	t.Run("unexpected error returns 500", func(t *testing.T) {
 		mockService := new(mockBookingService)
 		mockService.On("Create", mock.Anything, userID, spotUUID, &bookingInput).
@@ -847,3 +890,185 @@ func TestGetBookedTimeSlotsOfABooking(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestGetSpotQuote(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), userID)
+
+	startTime := time.Date(2024, time.October, 26, 10, 0, 0, 0, time.UTC)
+	endTime := time.Date(2024, time.October, 26, 11, 0, 0, 0, time.UTC)
+	quoteURL := "/spots/" + spotUUID.String() + "/quote?" + url.Values{
+		"start_time": []string{startTime.Format(time.RFC3339)},
+		"end_time":   []string{endTime.Format(time.RFC3339)},
+	}.Encode()
+
+	t.Run("successfully get a quote", func(t *testing.T) {
+		t.Parallel()
+
+		expectedQuote := models.PriceQuote{
+			Items: []models.PriceQuoteItem{
+				{StartTime: sampleBookTimes[0].StartTime, EndTime: sampleBookTimes[0].EndTime, PricePerHour: 10, Amount: 5},
+				{StartTime: sampleBookTimes[1].StartTime, EndTime: sampleBookTimes[1].EndTime, PricePerHour: 10, Amount: 5},
+			},
+			Adjustments: []models.PriceQuoteAdjustment{},
+			Total:       10,
+		}
+
+		mockService := new(mockBookingService)
+		mockService.On("GetQuote", mock.Anything, spotUUID, startTime, endTime).
+			Return(expectedQuote, nil).Once()
+
+		route := NewBookingRoute(mockService, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.GetCtx(ctx, quoteURL)
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var quote models.PriceQuote
+		err := json.NewDecoder(resp.Result().Body).Decode(&quote)
+		require.NoError(t, err)
+
+		assert.Empty(t, cmp.Diff(expectedQuote, quote))
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockService := new(mockBookingService)
+		mockService.On("GetQuote", mock.Anything, spotUUID, startTime, endTime).
+			Return(models.PriceQuote{}, models.ErrParkingSpotNotFound).Once()
+
+		route := NewBookingRoute(mockService, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.GetCtx(ctx, quoteURL)
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		require.NoError(t, json.NewDecoder(resp.Result().Body).Decode(&errModel))
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(spotUUID),
+		}
+
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		t.Parallel()
+
+		mockService := new(mockBookingService)
+		mockService.On("GetQuote", mock.Anything, spotUUID, startTime, endTime).
+			Return(models.PriceQuote{}, models.ErrInvalidQuoteTimeRange).Once()
+
+		route := NewBookingRoute(mockService, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.GetCtx(ctx, quoteURL)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		require.NoError(t, json.NewDecoder(resp.Result().Body).Decode(&errModel))
+
+		assert.Equal(t, models.CodeBookingInvalid.TypeURI(), errModel.Type)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestCreateSpotQuote(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), userID)
+
+	input := models.BookingQuoteInput{BookedTimes: sampleBookTimes}
+
+	t.Run("successfully create a quote", func(t *testing.T) {
+		t.Parallel()
+
+		expectedQuote := models.BookingQuote{
+			ExpiresAt: time.Date(2024, time.October, 26, 9, 15, 0, 0, time.UTC),
+			PriceQuote: models.PriceQuote{
+				Items:       []models.PriceQuoteItem{},
+				Adjustments: []models.PriceQuoteAdjustment{},
+				Taxes:       []models.PriceQuoteTax{{Name: "HST", Rate: 0.13, Amount: 1.37}},
+				Subtotal:    10,
+				ServiceFee:  0.5,
+				Total:       11.87,
+			},
+			ID: uuid.New(),
+		}
+
+		mockService := new(mockBookingService)
+		mockService.On("CreateQuote", mock.Anything, userID, spotUUID, &input).
+			Return(expectedQuote, nil).Once()
+
+		route := NewBookingRoute(mockService, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.PostCtx(ctx, "/spots/"+spotUUID.String()+"/quotes", input)
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var quote models.BookingQuote
+		err := json.NewDecoder(resp.Result().Body).Decode(&quote)
+		require.NoError(t, err)
+
+		assert.Empty(t, cmp.Diff(expectedQuote, quote))
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid time slots", func(t *testing.T) {
+		t.Parallel()
+
+		mockService := new(mockBookingService)
+		mockService.On("CreateQuote", mock.Anything, userID, spotUUID, &input).
+			Return(models.BookingQuote{}, models.ErrTooManyQuotedTimes).Once()
+
+		route := NewBookingRoute(mockService, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.PostCtx(ctx, "/spots/"+spotUUID.String()+"/quotes", input)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		require.NoError(t, json.NewDecoder(resp.Result().Body).Decode(&errModel))
+
+		testDetail := huma.ErrorDetail{
+			Location: "body.booked_times",
+			Value:    jsonAnyify(input.BookedTimes),
+		}
+
+		assert.Equal(t, models.CodeBookingInvalid.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockService := new(mockBookingService)
+		mockService.On("CreateQuote", mock.Anything, userID, spotUUID, &input).
+			Return(models.BookingQuote{}, models.ErrParkingSpotNotFound).Once()
+
+		route := NewBookingRoute(mockService, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.PostCtx(ctx, "/spots/"+spotUUID.String()+"/quotes", input)
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,325 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/ical"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/calendar"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `CalendarRoute`
+type CalendarServicer interface {
+	// Get the booking `bookingID` as an iCalendar file if `userID` has enough permission to view the resource.
+	GetBooking(ctx context.Context, userID int64, bookingID uuid.UUID) ([]byte, error)
+	// Get the calendar feed of `userID`.
+	GetFeed(ctx context.Context, userID int64) (models.CalendarFeed, error)
+	// Create a calendar feed for `userID`, replacing the URL of any existing feed.
+	ResetFeed(ctx context.Context, userID int64) (models.CalendarFeed, error)
+	// Delete the calendar feed of `userID`.
+	DeleteFeed(ctx context.Context, userID int64) error
+	// Get the calendar feed identified by `token` as an iCalendar file, with the bookings not ended by `now`.
+	GetFeedCalendar(ctx context.Context, token string, now time.Time) ([]byte, error)
+	// Remove the unbooked slots of `spotID` overlapping the busy times of the iCalendar file `data`
+	// after `now`, if `userID` owns the spot.
+	ImportCalendar(ctx context.Context, userID int64, spotID uuid.UUID, data []byte, now time.Time) (models.CalendarImportResult, error)
+	// Poll a calendar to block the availability of `spotID`, if `userID` owns the spot. The calendar is imported immediately.
+	SetImport(ctx context.Context, userID int64, spotID uuid.UUID, input *models.CalendarImportInput, now time.Time) (models.CalendarImport, error)
+	// Get the calendar polled to block the availability of `spotID`, if `userID` owns the spot.
+	GetImport(ctx context.Context, userID int64, spotID uuid.UUID) (models.CalendarImport, error)
+	// Stop polling the calendar of `spotID`, if `userID` owns the spot.
+	DeleteImport(ctx context.Context, userID int64, spotID uuid.UUID) error
+}
+
+// CalendarRoute represents calendar export API routes
+type CalendarRoute struct {
+	service       CalendarServicer
+	sessionGetter SessionDataGetter
+}
+
+type calendarFeedOutput struct {
+	Body models.CalendarFeed
+}
+
+type calendarImportOutput struct {
+	Body models.CalendarImport
+}
+
+type calendarImportResultOutput struct {
+	Body models.CalendarImportResult
+}
+
+type calendarFileOutput struct {
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+	Body               []byte
+}
+
+var CalendarTag = huma.Tag{
+	Name:        "Calendar",
+	Description: "Operations for adding bookings to calendar applications, and blocking availability with calendars.",
+}
+
+// Returns the responses of operations returning an iCalendar file
+func calendarFileResponses() map[string]*huma.Response {
+	return map[string]*huma.Response{
+		"200": {
+			Description: "An iCalendar (RFC 5545) file",
+			Content: map[string]*huma.MediaType{
+				"text/calendar": {
+					Schema: &huma.Schema{Type: huma.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// Returns the error of a calendar import operation on `spotID`
+func calendarImportError(ctx context.Context, err error, spotID uuid.UUID) error {
+	switch {
+	case errors.Is(err, models.ErrParkingSpotNotFound), errors.Is(err, models.ErrCalendarImportNotFound):
+		detail := &huma.ErrorDetail{
+			Location: "path.id",
+			Value:    spotID,
+		}
+		return NewHumaError(ctx, http.StatusNotFound, err, detail)
+	case errors.Is(err, models.ErrCalendarUnreadable), errors.Is(err, models.ErrCalendarFetch):
+		// The cause helps hosts fix their calendar
+		detail := &huma.ErrorDetail{
+			Message:  err.Error(),
+			Location: "body",
+		}
+		return NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+	}
+	return NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+}
+
+// Returns a new `CalendarRoute`
+func NewCalendarRoute(
+	service CalendarServicer,
+	sessionGetter SessionDataGetter,
+) *CalendarRoute {
+	return &CalendarRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+func (r *CalendarRoute) RegisterCalendarTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &CalendarTag)
+}
+
+// Registers calendar routes
+func (r *CalendarRoute) RegisterCalendarRoutes(api huma.API) {
+	apiPrefix := getAPIPrefix(api.OpenAPI())
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "export-booking-calendar",
+		Method:      http.MethodGet,
+		Path:        "/bookings/{id}/calendar",
+		Summary:     "Download a booking as an iCalendar file",
+		Description: "Each contiguous range of booked time slots is a separate event.",
+		Tags:        []string{CalendarTag.Name},
+		Responses:   calendarFileResponses(),
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*calendarFileOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.GetBooking(ctx, userID, input.ID)
+		if err != nil {
+			if errors.Is(err, models.ErrBookingNotFound) {
+				detail := &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				return nil, NewHumaError(ctx, http.StatusNotFound, err, detail)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &calendarFileOutput{
+			ContentType:        ical.ContentType,
+			ContentDisposition: `attachment; filename="booking-` + input.ID.String() + `.ics"`,
+			Body:               result,
+		}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "get-calendar-feed",
+		Method:      http.MethodGet,
+		Path:        "/user/calendar-feed",
+		Summary:     "Get the calendar feed URL of the current user",
+		Tags:        []string{CalendarTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, _ *struct{}) (*calendarFeedOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.GetFeed(ctx, userID)
+		if err != nil {
+			if errors.Is(err, models.ErrCalendarFeedNotFound) {
+				return nil, NewHumaError(ctx, http.StatusNotFound, err)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		result.URL = apiPrefix.JoinPath("/calendars", result.Token).String()
+		return &calendarFeedOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "reset-calendar-feed",
+		Method:        http.MethodPost,
+		Path:          "/user/calendar-feed",
+		Summary:       "Create a new calendar feed URL for the current user",
+		Description:   "The feed has the upcoming bookings and leasings of the current user. Any previous feed URL stops working.",
+		Tags:          []string{CalendarTag.Name},
+		DefaultStatus: http.StatusCreated,
+	}), func(ctx context.Context, _ *struct{}) (*calendarFeedOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.ResetFeed(ctx, userID)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		result.URL = apiPrefix.JoinPath("/calendars", result.Token).String()
+		return &calendarFeedOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "delete-calendar-feed",
+		Method:      http.MethodDelete,
+		Path:        "/user/calendar-feed",
+		Summary:     "Delete the calendar feed of the current user",
+		Description: "The feed URL stops working.",
+		Tags:        []string{CalendarTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, _ *struct{}) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.DeleteFeed(ctx, userID)
+		if err != nil {
+			if errors.Is(err, models.ErrCalendarFeedNotFound) {
+				return nil, NewHumaError(ctx, http.StatusNotFound, err)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return nil, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "export-calendar-feed",
+		Method:      http.MethodGet,
+		Path:        "/calendars/{token}",
+		Summary:     "Get the bookings of a calendar feed as an iCalendar file",
+		Description: "Calendar applications can subscribe to this URL. The feed has the bookings and leasings of the feed owner that have not ended yet.",
+		Tags:        []string{CalendarTag.Name},
+		Responses:   calendarFileResponses(),
+		Errors:      []int{http.StatusNotFound},
+	}, func(ctx context.Context, input *struct {
+		Token string `path:"token" doc:"The secret token of the feed"`
+	},
+	) (*calendarFileOutput, error) {
+		result, err := r.service.GetFeedCalendar(ctx, input.Token, time.Now())
+		if err != nil {
+			if errors.Is(err, models.ErrCalendarFeedNotFound) {
+				return nil, NewHumaError(ctx, http.StatusNotFound, err)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &calendarFileOutput{
+			ContentType: ical.ContentType,
+			Body:        result,
+		}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:  "import-spot-calendar-file",
+		Method:       http.MethodPost,
+		Path:         "/spots/{id}/calendar-import/file",
+		Summary:      "Block the availability of a parking spot with an iCalendar file",
+		Description:  "Unbooked slots overlapping busy events of the calendar in the next 90 days are removed. Booked and held slots are never removed, they are reported as conflicts.",
+		Tags:         []string{CalendarTag.Name},
+		MaxBodyBytes: calendar.MaxImportSize,
+		Errors:       []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		RawBody []byte    `contentType:"text/calendar"`
+		ID      uuid.UUID `path:"id"`
+	},
+	) (*calendarImportResultOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.ImportCalendar(ctx, userID, input.ID, input.RawBody, time.Now())
+		if err != nil {
+			return nil, calendarImportError(ctx, err, input.ID)
+		}
+		return &calendarImportResultOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "set-spot-calendar-import",
+		Method:      http.MethodPut,
+		Path:        "/spots/{id}/calendar-import",
+		Summary:     "Block the availability of a parking spot with a calendar URL",
+		Description: "The calendar is imported immediately, then polled regularly. Unbooked slots overlapping busy events of the calendar in the next 90 days are removed. Booked and held slots are never removed, they are reported as conflicts. Any calendar previously polled for the spot is replaced.",
+		Tags:        []string{CalendarTag.Name},
+		Errors:      []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.CalendarImportInput
+		ID   uuid.UUID `path:"id"`
+	},
+	) (*calendarImportOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.SetImport(ctx, userID, input.ID, &input.Body, time.Now())
+		if err != nil {
+			if errors.Is(err, models.ErrInvalidCalendarURL) {
+				detail := &huma.ErrorDetail{
+					Location: "body.url",
+					Value:    input.Body.URL,
+				}
+				return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+			}
+			return nil, calendarImportError(ctx, err, input.ID)
+		}
+		return &calendarImportOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "get-spot-calendar-import",
+		Method:      http.MethodGet,
+		Path:        "/spots/{id}/calendar-import",
+		Summary:     "Get the calendar polled to block the availability of a parking spot",
+		Tags:        []string{CalendarTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*calendarImportOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.GetImport(ctx, userID, input.ID)
+		if err != nil {
+			return nil, calendarImportError(ctx, err, input.ID)
+		}
+		return &calendarImportOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "delete-spot-calendar-import",
+		Method:      http.MethodDelete,
+		Path:        "/spots/{id}/calendar-import",
+		Summary:     "Stop polling the calendar of a parking spot",
+		Description: "Slots already removed are not restored.",
+		Tags:        []string{CalendarTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.DeleteImport(ctx, userID, input.ID)
+		if err != nil {
+			return nil, calendarImportError(ctx, err, input.ID)
+		}
+		return nil, nil
+	})
+}
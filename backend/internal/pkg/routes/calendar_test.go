@@ -0,0 +1,365 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/ical"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCalendarService struct {
+	mock.Mock
+}
+
+// GetBooking implements CalendarServicer.
+func (m *mockCalendarService) GetBooking(ctx context.Context, userID int64, bookingID uuid.UUID) ([]byte, error) {
+	args := m.Called(ctx, userID, bookingID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+// GetFeed implements CalendarServicer.
+func (m *mockCalendarService) GetFeed(ctx context.Context, userID int64) (models.CalendarFeed, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(models.CalendarFeed), args.Error(1)
+}
+
+// ResetFeed implements CalendarServicer.
+func (m *mockCalendarService) ResetFeed(ctx context.Context, userID int64) (models.CalendarFeed, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(models.CalendarFeed), args.Error(1)
+}
+
+// DeleteFeed implements CalendarServicer.
+func (m *mockCalendarService) DeleteFeed(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// GetFeedCalendar implements CalendarServicer.
+func (m *mockCalendarService) GetFeedCalendar(ctx context.Context, token string, now time.Time) ([]byte, error) {
+	args := m.Called(ctx, token, now)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+// ImportCalendar implements CalendarServicer.
+func (m *mockCalendarService) ImportCalendar(ctx context.Context, userID int64, spotID uuid.UUID, data []byte, now time.Time) (models.CalendarImportResult, error) {
+	args := m.Called(ctx, userID, spotID, data, now)
+	return args.Get(0).(models.CalendarImportResult), args.Error(1)
+}
+
+// SetImport implements CalendarServicer.
+func (m *mockCalendarService) SetImport(ctx context.Context, userID int64, spotID uuid.UUID, input *models.CalendarImportInput, now time.Time) (models.CalendarImport, error) {
+	args := m.Called(ctx, userID, spotID, input, now)
+	return args.Get(0).(models.CalendarImport), args.Error(1)
+}
+
+// GetImport implements CalendarServicer.
+func (m *mockCalendarService) GetImport(ctx context.Context, userID int64, spotID uuid.UUID) (models.CalendarImport, error) {
+	args := m.Called(ctx, userID, spotID)
+	return args.Get(0).(models.CalendarImport), args.Error(1)
+}
+
+// DeleteImport implements CalendarServicer.
+func (m *mockCalendarService) DeleteImport(ctx context.Context, userID int64, spotID uuid.UUID) error {
+	args := m.Called(ctx, userID, spotID)
+	return args.Error(0)
+}
+
+const testCalendar = "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"
+
+func TestExportBookingCalendar(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	bookingID := uuid.New()
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetBooking", mock.Anything, testUserID, bookingID).
+			Return([]byte(testCalendar), nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/bookings/"+bookingID.String()+"/calendar")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+		assert.Equal(t, ical.ContentType, resp.Result().Header.Get("Content-Type"))
+		assert.Contains(t, resp.Result().Header.Get("Content-Disposition"), "booking-"+bookingID.String()+".ics")
+		assert.Equal(t, testCalendar, resp.Body.String())
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("booking not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetBooking", mock.Anything, testUserID, bookingID).
+			Return([]byte(nil), models.ErrBookingNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/bookings/"+bookingID.String()+"/calendar")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(bookingID),
+		}
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestCalendarFeed(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	t.Run("reset returns the feed URL", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("ResetFeed", mock.Anything, testUserID).
+			Return(models.CalendarFeed{Token: "secret"}, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/user/calendar-feed")
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.CalendarFeed
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", result.Token)
+		assert.Contains(t, result.URL, "/calendars/secret")
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("no feed", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetFeed", mock.Anything, testUserID).
+			Return(models.CalendarFeed{}, models.ErrCalendarFeedNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/user/calendar-feed")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("export without session", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetFeedCalendar", mock.Anything, "secret", mock.Anything).
+			Return([]byte(testCalendar), nil).
+			Once()
+		srv.On("GetFeedCalendar", mock.Anything, "unknown", mock.Anything).
+			Return([]byte(nil), models.ErrCalendarFeedNotFound).
+			Once()
+
+		resp := api.Get("/calendars/secret")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+		assert.Equal(t, ical.ContentType, resp.Result().Header.Get("Content-Type"))
+		assert.Equal(t, testCalendar, resp.Body.String())
+
+		resp = api.Get("/calendars/unknown")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestCalendarImport(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	spotID := uuid.New()
+
+	t.Run("upload a file", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		slot := models.TimeUnit{
+			StartTime: time.Date(2024, time.November, 4, 15, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.November, 4, 15, 30, 0, 0, time.UTC),
+		}
+		srv.On("ImportCalendar", mock.Anything, testUserID, spotID, []byte(testCalendar), mock.Anything).
+			Return(models.CalendarImportResult{
+				Removed:   []models.TimeUnit{slot},
+				Conflicts: []models.CalendarImportConflict{},
+				Events:    1,
+			}, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+spotID.String()+"/calendar-import/file", "Content-Type: text/calendar", strings.NewReader(testCalendar))
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result models.CalendarImportResult
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Events)
+		require.Len(t, result.Removed, 1)
+		assert.True(t, slot.StartTime.Equal(result.Removed[0].StartTime))
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("unreadable calendar", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("ImportCalendar", mock.Anything, testUserID, spotID, mock.Anything, mock.Anything).
+			Return(models.CalendarImportResult{}, fmt.Errorf("%w: %w", models.ErrCalendarUnreadable, ical.ErrInvalidCalendar)).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+spotID.String()+"/calendar-import/file", "Content-Type: text/calendar", strings.NewReader("nonsense"))
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeCalendarInvalid.TypeURI(), errModel.Type)
+		require.Len(t, errModel.Errors, 1)
+		assert.Contains(t, errModel.Errors[0].Message, ical.ErrInvalidCalendar.Error())
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("set a URL", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		input := models.CalendarImportInput{URL: "webcal://example.com/cal.ics"}
+		srv.On("SetImport", mock.Anything, testUserID, spotID, &input, mock.Anything).
+			Return(models.CalendarImport{CalendarImportInput: input}, nil).
+			Once()
+
+		resp := api.PutCtx(ctx, "/spots/"+spotID.String()+"/calendar-import", input)
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result models.CalendarImport
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, input.URL, result.URL)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("invalid URL", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		input := models.CalendarImportInput{URL: "ftp://example.com/cal.ics"}
+		srv.On("SetImport", mock.Anything, testUserID, spotID, &input, mock.Anything).
+			Return(models.CalendarImport{}, models.ErrInvalidCalendarURL).
+			Once()
+
+		resp := api.PutCtx(ctx, "/spots/"+spotID.String()+"/calendar-import", input)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "body.url",
+			Value:    input.URL,
+		}
+		assert.Equal(t, models.CodeCalendarInvalid.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("no import", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockCalendarService)
+		route := NewCalendarRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetImport", mock.Anything, testUserID, spotID).
+			Return(models.CalendarImport{}, models.ErrCalendarImportNotFound).
+			Once()
+		srv.On("DeleteImport", mock.Anything, testUserID, spotID).
+			Return(models.ErrCalendarImportNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots/"+spotID.String()+"/calendar-import")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		resp = api.DeleteCtx(ctx, "/spots/"+spotID.String()+"/calendar-import")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+}
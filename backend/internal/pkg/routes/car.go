@@ -217,11 +217,16 @@ func (r *CarRoute) RegisterCarRoutes(api huma.API) {
 // Returns nil if there are no description for the error
 func describeCarInputError(err error, input *models.CarCreationInput) error {
 	switch {
-	case errors.Is(err, models.ErrInvalidLicensePlate):
+	case errors.Is(err, models.ErrInvalidLicensePlate), errors.Is(err, models.ErrCarDuplicate):
 		return &huma.ErrorDetail{
 			Location: "body.license_plate",
 			Value:    input.LicensePlate,
 		}
+	case errors.Is(err, models.ErrPlateStateInvalid):
+		return &huma.ErrorDetail{
+			Location: "body.state",
+			Value:    input.State,
+		}
 	case errors.Is(err, models.ErrInvalidMake):
 		return &huma.ErrorDetail{
 			Location: "body.make",
@@ -0,0 +1,120 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `DeviceRoute`
+type DeviceServicer interface {
+	// Register a device of `userID` to receive push notifications.
+	Register(ctx context.Context, userID int64, input *models.DeviceInput) (models.Device, error)
+	// Get all devices of `userID`.
+	GetMany(ctx context.Context, userID int64) ([]models.Device, error)
+	// Delete the device `deviceID` if `userID` owns the resource.
+	DeleteByUUID(ctx context.Context, userID int64, deviceID uuid.UUID) error
+}
+
+// DeviceRoute represents push device API routes
+type DeviceRoute struct {
+	service       DeviceServicer
+	sessionGetter SessionDataGetter
+}
+
+type deviceOutput struct {
+	Body models.Device
+}
+
+type deviceListOutput struct {
+	Body []models.Device `nullable:"false"`
+}
+
+var DeviceTag = huma.Tag{
+	Name:        "Device",
+	Description: "Operations for receiving notifications on mobile devices.",
+}
+
+// Returns a new `DeviceRoute`
+func NewDeviceRoute(
+	service DeviceServicer,
+	sessionGetter SessionDataGetter,
+) *DeviceRoute {
+	return &DeviceRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+func (r *DeviceRoute) RegisterDeviceTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &DeviceTag)
+}
+
+// Registers push device routes
+func (r *DeviceRoute) RegisterDeviceRoutes(api huma.API) {
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "register-device",
+		Method:        http.MethodPost,
+		Path:          "/user/devices",
+		Summary:       "Receive push notifications on a device",
+		Description:   "Notifications sent to the current user are pushed to the device. Registering a token already in use moves the device to the current user.",
+		Tags:          []string{DeviceTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.DeviceInput
+	},
+	) (*deviceOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.Register(ctx, userID, &input.Body)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &deviceOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-devices",
+		Method:      http.MethodGet,
+		Path:        "/user/devices",
+		Summary:     "Get devices of the current user",
+		Tags:        []string{DeviceTag.Name},
+	}), func(ctx context.Context, _ *struct{}) (*deviceListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.GetMany(ctx, userID)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &deviceListOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "delete-device",
+		Method:      http.MethodDelete,
+		Path:        "/devices/{id}",
+		Summary:     "Stop receiving push notifications on the specified device",
+		Tags:        []string{DeviceTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.DeleteByUUID(ctx, userID, input.ID)
+		if err != nil {
+			if errors.Is(err, models.ErrDeviceNotFound) {
+				detail := &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				return nil, NewHumaError(ctx, http.StatusNotFound, err, detail)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return nil, nil
+	})
+}
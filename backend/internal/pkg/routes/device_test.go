@@ -0,0 +1,202 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDeviceService struct {
+	mock.Mock
+}
+
+// Register implements DeviceServicer.
+func (m *mockDeviceService) Register(ctx context.Context, userID int64, input *models.DeviceInput) (models.Device, error) {
+	args := m.Called(ctx, userID, input)
+	return args.Get(0).(models.Device), args.Error(1)
+}
+
+// GetMany implements DeviceServicer.
+func (m *mockDeviceService) GetMany(ctx context.Context, userID int64) ([]models.Device, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.Device), args.Error(1)
+}
+
+// DeleteByUUID implements DeviceServicer.
+func (m *mockDeviceService) DeleteByUUID(ctx context.Context, userID int64, deviceID uuid.UUID) error {
+	args := m.Called(ctx, userID, deviceID)
+	return args.Error(0)
+}
+
+func TestRegisterDevice(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	input := models.DeviceInput{
+		Platform: models.DevicePlatformIOS,
+		Token:    "some-token",
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockDeviceService)
+		route := NewDeviceRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		expected := models.Device{
+			Platform: models.DevicePlatformIOS,
+			ID:       uuid.New(),
+		}
+		srv.On("Register", mock.Anything, testUserID, &input).
+			Return(expected, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/user/devices", input)
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.Device
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected.ID, result.ID)
+		assert.Equal(t, expected.Platform, result.Platform)
+		assert.NotContains(t, resp.Body.String(), input.Token)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("unknown platform", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockDeviceService)
+		route := NewDeviceRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.PostCtx(ctx, "/user/devices", models.DeviceInput{
+			Platform: "windows",
+			Token:    "some-token",
+		})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertNotCalled(t, "Register", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("too many devices", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockDeviceService)
+		route := NewDeviceRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Register", mock.Anything, testUserID, &input).
+			Return(models.Device{}, models.ErrTooManyDevices).
+			Once()
+
+		resp := api.PostCtx(ctx, "/user/devices", input)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeDeviceInvalid.TypeURI(), errModel.Type)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestListDevices(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	srv := new(mockDeviceService)
+	route := NewDeviceRoute(srv, fakeSessionDataGetter{})
+	_, api := humatest.New(t)
+	huma.AutoRegister(api, route)
+
+	srv.On("GetMany", mock.Anything, testUserID).
+		Return([]models.Device{}, nil).
+		Once()
+
+	resp := api.GetCtx(ctx, "/user/devices")
+	assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+	assert.JSONEq(t, "[]", resp.Body.String())
+
+	srv.AssertExpectations(t)
+}
+
+func TestDeleteDevice(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testDeviceID := uuid.New()
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockDeviceService)
+		route := NewDeviceRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("DeleteByUUID", mock.Anything, testUserID, testDeviceID).
+			Return(nil).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/devices/"+testDeviceID.String())
+		assert.Equal(t, http.StatusNoContent, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("device not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockDeviceService)
+		route := NewDeviceRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("DeleteByUUID", mock.Anything, testUserID, testDeviceID).
+			Return(models.ErrDeviceNotFound).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/devices/"+testDeviceID.String())
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(testDeviceID),
+		}
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
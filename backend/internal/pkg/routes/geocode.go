@@ -0,0 +1,116 @@
+package routes
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/ratelimit"
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// Service provider for `GeocodeRoute`
+type GeocodeServicer interface {
+	// Get the addresses at or near a location, nearest first.
+	ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]models.GeocodeResult, error)
+	// Get the addresses a partially written address might be, most likely first.
+	Suggest(ctx context.Context, query string) ([]models.GeocodeResult, error)
+}
+
+// GeocodeRoute represents geocoding API routes
+type GeocodeRoute struct {
+	service       GeocodeServicer
+	sessionGetter SessionDataGetter
+	limiter       *ratelimit.Limiter[int64]
+}
+
+type geocodeListOutput struct {
+	Body []models.GeocodeResult `nullable:"false"`
+}
+
+var GeocodeTag = huma.Tag{
+	Name:        "Geocoding",
+	Description: "Operations for finding addresses.",
+}
+
+// Returns a new `GeocodeRoute`, limiting the requests of each user with `limiter`
+func NewGeocodeRoute(
+	service GeocodeServicer,
+	sessionGetter SessionDataGetter,
+	limiter *ratelimit.Limiter[int64],
+) *GeocodeRoute {
+	return &GeocodeRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+		limiter:       limiter,
+	}
+}
+
+func (r *GeocodeRoute) RegisterGeocodeTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &GeocodeTag)
+}
+
+// Registers geocoding routes
+func (r *GeocodeRoute) RegisterGeocodeRoutes(api huma.API) {
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "reverse-geocode",
+		Method:      http.MethodGet,
+		Path:        "/geocode/reverse",
+		Summary:     "Get the addresses at a location",
+		Description: "Returns the addresses at or near the location, nearest first. This can be used to fill the address of a parking spot from a pin dropped on a map.",
+		Tags:        []string{GeocodeTag.Name},
+		Errors:      []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+	}), func(ctx context.Context, input *struct {
+		Latitude  float64 `query:"latitude" required:"true" minimum:"-90" maximum:"90" doc:"The latitude of the location"`
+		Longitude float64 `query:"longitude" required:"true" minimum:"-180" maximum:"180" doc:"The longitude of the location"`
+	},
+	) (*geocodeListOutput, error) {
+		err := r.checkRate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result, err := r.service.ReverseGeocode(ctx, input.Latitude, input.Longitude)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusServiceUnavailable, err)
+		}
+		return &geocodeListOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "suggest-addresses",
+		Method:      http.MethodGet,
+		Path:        "/geocode/suggest",
+		Summary:     "Get the addresses matching a partially written address",
+		Description: "Returns the addresses the query might refer to, most likely first. This can be used to complete addresses as they are typed.",
+		Tags:        []string{GeocodeTag.Name},
+		Errors:      []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+	}), func(ctx context.Context, input *struct {
+		Query string `query:"q" required:"true" minLength:"3" maxLength:"200" doc:"The address written so far"`
+	},
+	) (*geocodeListOutput, error) {
+		err := r.checkRate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result, err := r.service.Suggest(ctx, input.Query)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusServiceUnavailable, err)
+		}
+		return &geocodeListOutput{Body: result}, nil
+	})
+}
+
+// Returns an error if the current user sent too many geocoding requests
+func (r *GeocodeRoute) checkRate(ctx context.Context) error {
+	userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+	wait := r.limiter.Allow(userID)
+	if wait == 0 {
+		return nil
+	}
+	return huma.ErrorWithHeaders(
+		NewHumaError(ctx, http.StatusTooManyRequests, models.ErrRateLimited),
+		http.Header{"Retry-After": []string{strconv.Itoa(int(math.Ceil(wait.Seconds())))}},
+	)
+}
@@ -0,0 +1,175 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/ratelimit"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockGeocodeService struct {
+	mock.Mock
+}
+
+// ReverseGeocode implements GeocodeServicer.
+func (m *mockGeocodeService) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]models.GeocodeResult, error) {
+	args := m.Called(ctx, latitude, longitude)
+	return args.Get(0).([]models.GeocodeResult), args.Error(1)
+}
+
+// Suggest implements GeocodeServicer.
+func (m *mockGeocodeService) Suggest(ctx context.Context, query string) ([]models.GeocodeResult, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]models.GeocodeResult), args.Error(1)
+}
+
+var sampleGeocodeResult = models.GeocodeResult{
+	FormattedAddress: "66 Chancellors Cir, Winnipeg, MB R3T 2N2",
+	ParkingSpotLocation: models.ParkingSpotLocation{
+		PostalCode:    "R3T 2N2",
+		CountryCode:   "CA",
+		City:          "Winnipeg",
+		State:         "MB",
+		StreetAddress: "66 Chancellors Cir",
+		Longitude:     -97.1366,
+		Latitude:      49.8075,
+	},
+	Accuracy: 1,
+}
+
+func TestReverseGeocode(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockGeocodeService)
+		route := NewGeocodeRoute(srv, fakeSessionDataGetter{}, ratelimit.New[int64](1, 10))
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("ReverseGeocode", mock.Anything, 49.8075, -97.1366).
+			Return([]models.GeocodeResult{sampleGeocodeResult}, nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/geocode/reverse?latitude=49.8075&longitude=-97.1366")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result []models.GeocodeResult
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, []models.GeocodeResult{sampleGeocodeResult}, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("invalid location", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockGeocodeService)
+		route := NewGeocodeRoute(srv, fakeSessionDataGetter{}, ratelimit.New[int64](1, 10))
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.GetCtx(ctx, "/geocode/reverse?latitude=91&longitude=0")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertNotCalled(t, "ReverseGeocode", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("geocoding unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockGeocodeService)
+		route := NewGeocodeRoute(srv, fakeSessionDataGetter{}, ratelimit.New[int64](1, 10))
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("ReverseGeocode", mock.Anything, 0.0, 0.0).
+			Return([]models.GeocodeResult(nil), models.ErrGeocodingUnavailable).
+			Once()
+
+		resp := api.GetCtx(ctx, "/geocode/reverse?latitude=0&longitude=0")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeGeocodingUnavailable.TypeURI(), errModel.Type)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestSuggestAddresses(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockGeocodeService)
+		route := NewGeocodeRoute(srv, fakeSessionDataGetter{}, ratelimit.New[int64](1, 10))
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Suggest", mock.Anything, "66 chanc").
+			Return([]models.GeocodeResult{sampleGeocodeResult}, nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/geocode/suggest?q=66%20chanc")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result []models.GeocodeResult
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, []models.GeocodeResult{sampleGeocodeResult}, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockGeocodeService)
+		route := NewGeocodeRoute(srv, fakeSessionDataGetter{}, ratelimit.New[int64](0.5, 2))
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Suggest", mock.Anything, "66 chanc").
+			Return([]models.GeocodeResult{}, nil).
+			Twice()
+
+		for range 2 {
+			resp := api.GetCtx(ctx, "/geocode/suggest?q=66%20chanc")
+			assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+		}
+		resp := api.GetCtx(ctx, "/geocode/suggest?q=66%20chanc")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Result().StatusCode)
+		assert.Equal(t, "2", resp.Result().Header.Get("Retry-After"))
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeRateLimited.TypeURI(), errModel.Type)
+
+		srv.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,112 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `HoldRoute`
+type HoldServicer interface {
+	// Hold time slots of the spot `spotID` for `userID` while they check out.
+	//
+	// Any previous hold of the user on the same spot is released.
+	CreateHold(ctx context.Context, userID int64, spotID uuid.UUID, input *models.HoldCreationInput) (models.Hold, error)
+	// Release the hold `holdID` placed by `userID`.
+	ReleaseHold(ctx context.Context, userID int64, holdID uuid.UUID) error
+}
+
+// HoldRoute represents checkout hold API routes
+type HoldRoute struct {
+	service       HoldServicer
+	sessionGetter SessionDataGetter
+}
+
+type holdOutput struct {
+	Body models.Hold
+}
+
+// Returns a new `HoldRoute`
+func NewHoldRoute(
+	service HoldServicer,
+	sessionGetter SessionDataGetter,
+) *HoldRoute {
+	return &HoldRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+// Registers hold routes
+func (r *HoldRoute) RegisterHoldRoutes(api huma.API) {
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "create-hold",
+		Method:        http.MethodPost,
+		Path:          "/spots/{id}/holds",
+		Summary:       "Hold time slots of a parking spot during checkout",
+		Description:   "Held time slots can not be booked by other users until the hold expires or is released. Pass the hold ID to create-booking to convert it into a booking. Creating a new hold releases any previous hold of the user on the same spot.",
+		Tags:          []string{BookingTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.HoldCreationInput
+		ID   uuid.UUID `path:"id"`
+	},
+	) (*holdOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.CreateHold(ctx, userID, input.ID, &input.Body)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			switch {
+			case errors.Is(err, models.ErrParkingSpotNotFound):
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			case errors.Is(err, models.ErrDuplicateBooking),
+				errors.Is(err, models.ErrEmptyHoldTimes),
+				errors.Is(err, models.ErrInvalidTimeUnit),
+				errors.Is(err, models.ErrTooManyQuotedTimes):
+				detail = &huma.ErrorDetail{
+					Location: "body.held_times",
+					Value:    input.Body.HeldTimes,
+				}
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+		return &holdOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "delete-hold",
+		Method:      http.MethodDelete,
+		Path:        "/holds/{id}",
+		Summary:     "Release a hold",
+		Tags:        []string{BookingTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.ReleaseHold(ctx, userID, input.ID)
+		if err != nil {
+			if errors.Is(err, models.ErrHoldNotFound) {
+				detail := &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				return nil, NewHumaError(ctx, http.StatusNotFound, err, detail)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return nil, nil
+	})
+}
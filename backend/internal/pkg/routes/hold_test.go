@@ -0,0 +1,203 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockHoldService struct {
+	mock.Mock
+}
+
+// CreateHold implements HoldServicer.
+func (m *mockHoldService) CreateHold(ctx context.Context, userID int64, spotID uuid.UUID, input *models.HoldCreationInput) (models.Hold, error) {
+	args := m.Called(ctx, userID, spotID, input)
+	return args.Get(0).(models.Hold), args.Error(1)
+}
+
+// ReleaseHold implements HoldServicer.
+func (m *mockHoldService) ReleaseHold(ctx context.Context, userID int64, holdID uuid.UUID) error {
+	args := m.Called(ctx, userID, holdID)
+	return args.Error(0)
+}
+
+func TestCreateHold(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testSpotID := uuid.New()
+	testInput := models.HoldCreationInput{
+		HeldTimes: []models.TimeUnit{
+			{
+				StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+				EndTime:   time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockHoldService)
+		route := NewHoldRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		expected := models.Hold{
+			ExpiresAt: time.Date(2024, time.October, 20, 14, 30, 0, 0, time.UTC),
+			HeldTimes: []models.TimeUnit{
+				{
+					StartTime: testInput.HeldTimes[0].StartTime,
+					EndTime:   testInput.HeldTimes[0].EndTime,
+					Status:    "held",
+				},
+			},
+			SpotID: testSpotID,
+			ID:     uuid.New(),
+		}
+		srv.On("CreateHold", mock.Anything, testUserID, testSpotID, &testInput).
+			Return(expected, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+testSpotID.String()+"/holds", testInput)
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.Hold
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockHoldService)
+		route := NewHoldRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("CreateHold", mock.Anything, testUserID, testSpotID, &testInput).
+			Return(models.Hold{}, models.ErrParkingSpotNotFound).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+testSpotID.String()+"/holds", testInput)
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(testSpotID),
+		}
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("times not available", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockHoldService)
+		route := NewHoldRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("CreateHold", mock.Anything, testUserID, testSpotID, &testInput).
+			Return(models.Hold{}, models.ErrDuplicateBooking).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+testSpotID.String()+"/holds", testInput)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "body.held_times",
+			Value:    jsonAnyify(testInput.HeldTimes),
+		}
+		assert.Equal(t, models.CodeDuplicate.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestReleaseHold(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testHoldID := uuid.New()
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockHoldService)
+		route := NewHoldRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("ReleaseHold", mock.Anything, testUserID, testHoldID).
+			Return(nil).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/holds/"+testHoldID.String())
+		assert.Equal(t, http.StatusNoContent, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("hold not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockHoldService)
+		route := NewHoldRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("ReleaseHold", mock.Anything, testUserID, testHoldID).
+			Return(models.ErrHoldNotFound).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/holds/"+testHoldID.String())
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(testHoldID),
+		}
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,197 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `MessageRoute`
+type MessageServicer interface {
+	// Send a message as `userID` in the thread of the booking `bookingID`.
+	Send(ctx context.Context, userID int64, bookingID uuid.UUID, input *models.MessageCreationInput) (models.Message, error)
+	// Get at most `count` messages of the booking `bookingID` if `userID` has enough permission to view the resource.
+	//
+	// If there are more entries following the result, a non-empty cursor will be returned
+	// which can be passed to the next invocation to get the next entries.
+	GetMany(ctx context.Context, userID int64, bookingID uuid.UUID, count int, after models.Cursor) ([]models.Message, models.Cursor, error)
+	// Mark all messages sent to `userID` in the thread of the booking `bookingID` as read.
+	MarkRead(ctx context.Context, userID int64, bookingID uuid.UUID) error
+	// Get the number of unread messages sent to `userID`.
+	GetUnread(ctx context.Context, userID int64) (models.UnreadMessages, error)
+}
+
+// MessageRoute represents booking message API routes
+type MessageRoute struct {
+	service       MessageServicer
+	sessionGetter SessionDataGetter
+}
+
+type messageOutput struct {
+	Body models.Message
+}
+
+type messageListOutput struct {
+	Link []string         `header:"Link" doc:"Contains details on getting the next page of resources" example:"<https://example.com/bookings/0/messages?after=gQL>; rel=\"next\""`
+	Body []models.Message `nullable:"false"`
+}
+
+type unreadMessagesOutput struct {
+	Body models.UnreadMessages
+}
+
+var MessageTag = huma.Tag{
+	Name:        "Message",
+	Description: "Operations for messaging between drivers and hosts.",
+}
+
+// Returns a new `MessageRoute`
+func NewMessageRoute(
+	service MessageServicer,
+	sessionGetter SessionDataGetter,
+) *MessageRoute {
+	return &MessageRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+func (r *MessageRoute) RegisterMessageTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &MessageTag)
+}
+
+// Registers message routes
+func (r *MessageRoute) RegisterMessageRoutes(api huma.API) {
+	apiPrefix := getAPIPrefix(api.OpenAPI())
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "send-booking-message",
+		Method:        http.MethodPost,
+		Path:          "/bookings/{id}/messages",
+		Summary:       "Send a message to the other party of a booking",
+		Description:   "Phone numbers and email addresses are removed from messages sent before the booking is confirmed.",
+		Tags:          []string{MessageTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.MessageCreationInput
+		ID   uuid.UUID `path:"id"`
+	},
+	) (*messageOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.Send(ctx, userID, input.ID, &input.Body)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			switch {
+			case errors.Is(err, models.ErrBookingNotFound):
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			case errors.Is(err, models.ErrInvalidMessage):
+				detail = &huma.ErrorDetail{
+					Location: "body.body",
+					Value:    input.Body.Body,
+				}
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+		return &messageOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-booking-messages",
+		Method:      http.MethodGet,
+		Path:        "/bookings/{id}/messages",
+		Summary:     "Get the messages of a booking",
+		Description: "Messages are ordered from newest to oldest.",
+		Tags:        []string{MessageTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		After models.Cursor `query:"after" doc:"Token used for requesting the next page of resources"`
+		Count int           `query:"count" minimum:"1" default:"50" doc:"The maximum number of messages that appear per page."`
+		ID    uuid.UUID     `path:"id"`
+	},
+	) (*messageListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		messages, nextCursor, err := r.service.GetMany(ctx, userID, input.ID, input.Count, input.After)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			if errors.Is(err, models.ErrBookingNotFound) {
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+
+		result := messageListOutput{Body: messages}
+		if nextCursor != "" {
+			nextURL := apiPrefix.JoinPath(fmt.Sprintf("/bookings/%v/messages", input.ID))
+			nextURL.RawQuery = url.Values{
+				"count": []string{strconv.Itoa(input.Count)},
+				"after": []string{string(nextCursor)},
+			}.Encode()
+			result.Link = append(result.Link, "<"+nextURL.String()+`>; rel="next"`)
+		}
+		return &result, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "read-booking-messages",
+		Method:      http.MethodPost,
+		Path:        "/bookings/{id}/messages/read",
+		Summary:     "Mark all messages received in a booking as read",
+		Tags:        []string{MessageTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.MarkRead(ctx, userID, input.ID)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			if errors.Is(err, models.ErrBookingNotFound) {
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+		return nil, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "get-unread-messages",
+		Method:      http.MethodGet,
+		Path:        "/user/messages/unread",
+		Summary:     "Get the number of unread messages received by the current user",
+		Tags:        []string{MessageTag.Name},
+	}), func(ctx context.Context, _ *struct{}) (*unreadMessagesOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.GetUnread(ctx, userID)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &unreadMessagesOutput{Body: result}, nil
+	})
+}
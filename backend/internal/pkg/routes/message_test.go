@@ -0,0 +1,242 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/peterhellberg/link"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMessageService struct {
+	mock.Mock
+}
+
+// Send implements MessageServicer.
+func (m *mockMessageService) Send(ctx context.Context, userID int64, bookingID uuid.UUID, input *models.MessageCreationInput) (models.Message, error) {
+	args := m.Called(ctx, userID, bookingID, input)
+	return args.Get(0).(models.Message), args.Error(1)
+}
+
+// GetMany implements MessageServicer.
+func (m *mockMessageService) GetMany(ctx context.Context, userID int64, bookingID uuid.UUID, count int, after models.Cursor) ([]models.Message, models.Cursor, error) {
+	args := m.Called(ctx, userID, bookingID, count, after)
+	return args.Get(0).([]models.Message), args.Get(1).(models.Cursor), args.Error(2)
+}
+
+// MarkRead implements MessageServicer.
+func (m *mockMessageService) MarkRead(ctx context.Context, userID int64, bookingID uuid.UUID) error {
+	args := m.Called(ctx, userID, bookingID)
+	return args.Error(0)
+}
+
+// GetUnread implements MessageServicer.
+func (m *mockMessageService) GetUnread(ctx context.Context, userID int64) (models.UnreadMessages, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(models.UnreadMessages), args.Error(1)
+}
+
+func TestSendMessage(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testBookingID := uuid.New()
+	testInput := models.MessageCreationInput{Body: "Which side of the driveway?"}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockMessageService)
+		route := NewMessageRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		expected := models.Message{
+			Sender: models.MessageSenderDriver,
+			Body:   testInput.Body,
+			ID:     uuid.New(),
+		}
+		srv.On("Send", mock.Anything, testUserID, testBookingID, &testInput).
+			Return(expected, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/bookings/"+testBookingID.String()+"/messages", testInput)
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.Message
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("booking not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockMessageService)
+		route := NewMessageRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Send", mock.Anything, testUserID, testBookingID, &testInput).
+			Return(models.Message{}, models.ErrBookingNotFound).
+			Once()
+
+		resp := api.PostCtx(ctx, "/bookings/"+testBookingID.String()+"/messages", testInput)
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(testBookingID),
+		}
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("empty message", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockMessageService)
+		route := NewMessageRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.PostCtx(ctx, "/bookings/"+testBookingID.String()+"/messages", models.MessageCreationInput{})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestListMessages(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testBookingID := uuid.New()
+
+	t.Run("paginates", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockMessageService)
+		route := NewMessageRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		messages := []models.Message{{Sender: models.MessageSenderHost, Body: "Left side", ID: uuid.New()}}
+		srv.On("GetMany", mock.Anything, testUserID, testBookingID, 1, models.Cursor("")).
+			Return(messages, models.Cursor("next"), nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/bookings/"+testBookingID.String()+"/messages?count=1")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result []models.Message
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, messages, result)
+
+		links := link.ParseResponse(resp.Result())
+		if assert.NotEmpty(t, links["next"]) {
+			assert.Contains(t, links["next"].URI, "after=next")
+		}
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("booking not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockMessageService)
+		route := NewMessageRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetMany", mock.Anything, testUserID, testBookingID, 50, models.Cursor("")).
+			Return([]models.Message(nil), models.Cursor(""), models.ErrBookingNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/bookings/"+testBookingID.String()+"/messages")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestUnreadMessages(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testBookingID := uuid.New()
+
+	t.Run("mark as read", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockMessageService)
+		route := NewMessageRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("MarkRead", mock.Anything, testUserID, testBookingID).
+			Return(nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/bookings/"+testBookingID.String()+"/messages/read")
+		assert.Equal(t, http.StatusNoContent, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("get counts", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockMessageService)
+		route := NewMessageRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		expected := models.UnreadMessages{
+			Bookings: []models.UnreadMessageCount{{BookingID: testBookingID, Count: 2}},
+			Total:    2,
+		}
+		srv.On("GetUnread", mock.Anything, testUserID).
+			Return(expected, nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/user/messages/unread")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result models.UnreadMessages
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		srv.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,137 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `NotificationRoute`
+type NotificationServicer interface {
+	// Get at most `count` notifications of `userID`, newest first.
+	//
+	// If there are more entries following the result, a non-empty cursor will be returned
+	// which can be passed to the next invocation to get the next entries.
+	GetMany(ctx context.Context, userID int64, count int, after models.Cursor) ([]models.Notification, models.Cursor, error)
+	// Mark the notification `notificationID` as read if `userID` is its recipient.
+	MarkRead(ctx context.Context, userID int64, notificationID uuid.UUID) (models.Notification, error)
+	// Mark all notifications of `userID` as read.
+	MarkAllRead(ctx context.Context, userID int64) error
+}
+
+// NotificationRoute represents notification inbox API routes
+type NotificationRoute struct {
+	service       NotificationServicer
+	sessionGetter SessionDataGetter
+}
+
+type notificationOutput struct {
+	Body models.Notification
+}
+
+type notificationListOutput struct {
+	Link []string              `header:"Link" doc:"Contains details on getting the next page of resources" example:"<https://example.com/user/notifications?after=gQL>; rel=\"next\""`
+	Body []models.Notification `nullable:"false"`
+}
+
+var NotificationTag = huma.Tag{
+	Name:        "Notification",
+	Description: "Operations for the in-app notification inbox.",
+}
+
+// Returns a new `NotificationRoute`
+func NewNotificationRoute(
+	service NotificationServicer,
+	sessionGetter SessionDataGetter,
+) *NotificationRoute {
+	return &NotificationRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+func (r *NotificationRoute) RegisterNotificationTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &NotificationTag)
+}
+
+// Registers notification routes
+func (r *NotificationRoute) RegisterNotificationRoutes(api huma.API) {
+	apiPrefix := getAPIPrefix(api.OpenAPI())
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-notifications",
+		Method:      http.MethodGet,
+		Path:        "/user/notifications",
+		Summary:     "Get notifications of the current user",
+		Description: "Notifications are ordered from newest to oldest.",
+		Tags:        []string{NotificationTag.Name},
+	}), func(ctx context.Context, input *struct {
+		After models.Cursor `query:"after" doc:"Token used for requesting the next page of resources"`
+		Count int           `query:"count" minimum:"1" default:"50" doc:"The maximum number of notifications that appear per page."`
+	},
+	) (*notificationListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		notifications, nextCursor, err := r.service.GetMany(ctx, userID, input.Count, input.After)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+
+		result := notificationListOutput{Body: notifications}
+		if nextCursor != "" {
+			nextURL := apiPrefix.JoinPath("/user/notifications")
+			nextURL.RawQuery = url.Values{
+				"count": []string{strconv.Itoa(input.Count)},
+				"after": []string{string(nextCursor)},
+			}.Encode()
+			result.Link = append(result.Link, "<"+nextURL.String()+`>; rel="next"`)
+		}
+		return &result, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "mark-notification-read",
+		Method:      http.MethodPost,
+		Path:        "/notifications/{id}/read",
+		Summary:     "Mark the specified notification as read",
+		Tags:        []string{NotificationTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*notificationOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.MarkRead(ctx, userID, input.ID)
+		if err != nil {
+			if errors.Is(err, models.ErrNotificationNotFound) {
+				detail := &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				return nil, NewHumaError(ctx, http.StatusNotFound, err, detail)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &notificationOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "mark-all-notifications-read",
+		Method:      http.MethodPost,
+		Path:        "/user/notifications/read",
+		Summary:     "Mark all notifications of the current user as read",
+		Tags:        []string{NotificationTag.Name},
+	}), func(ctx context.Context, _ *struct{}) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.MarkAllRead(ctx, userID)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return nil, nil
+	})
+}
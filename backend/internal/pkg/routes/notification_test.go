@@ -0,0 +1,199 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/peterhellberg/link"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockNotificationService struct {
+	mock.Mock
+}
+
+// GetMany implements NotificationServicer.
+func (m *mockNotificationService) GetMany(ctx context.Context, userID int64, count int, after models.Cursor) ([]models.Notification, models.Cursor, error) {
+	args := m.Called(ctx, userID, count, after)
+	return args.Get(0).([]models.Notification), args.Get(1).(models.Cursor), args.Error(2)
+}
+
+// MarkRead implements NotificationServicer.
+func (m *mockNotificationService) MarkRead(ctx context.Context, userID int64, notificationID uuid.UUID) (models.Notification, error) {
+	args := m.Called(ctx, userID, notificationID)
+	return args.Get(0).(models.Notification), args.Error(1)
+}
+
+// MarkAllRead implements NotificationServicer.
+func (m *mockNotificationService) MarkAllRead(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func TestListNotifications(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	notifications := []models.Notification{
+		{
+			CreatedAt: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+			Type:      models.NotificationSpotAvailable,
+			Title:     "Parking available",
+			Body:      "1 time slot is now available at 5 Niagara Parkway, Niagara Falls.",
+			SubjectID: uuid.New(),
+			ID:        uuid.New(),
+		},
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockNotificationService)
+		route := NewNotificationRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetMany", mock.Anything, testUserID, 1, models.Cursor("")).
+			Return(notifications, models.Cursor("next"), nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/user/notifications?count=1")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result []models.Notification
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, notifications, result)
+
+		links := link.ParseResponse(resp.Result())
+		if assert.NotEmpty(t, links["next"]) {
+			assert.Contains(t, links["next"].URI, "after=next")
+		}
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("no more notifications", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockNotificationService)
+		route := NewNotificationRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetMany", mock.Anything, testUserID, 50, models.Cursor("")).
+			Return([]models.Notification{}, models.Cursor(""), nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/user/notifications")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+		assert.Empty(t, resp.Result().Header.Get("Link"))
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestMarkNotificationRead(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testNotificationID := uuid.New()
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockNotificationService)
+		route := NewNotificationRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		readAt := time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC)
+		expected := models.Notification{
+			ReadAt: &readAt,
+			Type:   models.NotificationBookingCreated,
+			ID:     testNotificationID,
+		}
+		srv.On("MarkRead", mock.Anything, testUserID, testNotificationID).
+			Return(expected, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/notifications/"+testNotificationID.String()+"/read")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result models.Notification
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("notification not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockNotificationService)
+		route := NewNotificationRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("MarkRead", mock.Anything, testUserID, testNotificationID).
+			Return(models.Notification{}, models.ErrNotificationNotFound).
+			Once()
+
+		resp := api.PostCtx(ctx, "/notifications/"+testNotificationID.String()+"/read")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(testNotificationID),
+		}
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestMarkAllNotificationsRead(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	srv := new(mockNotificationService)
+	route := NewNotificationRoute(srv, fakeSessionDataGetter{})
+	_, api := humatest.New(t)
+	huma.AutoRegister(api, route)
+
+	srv.On("MarkAllRead", mock.Anything, testUserID).
+		Return(nil).
+		Once()
+
+	resp := api.PostCtx(ctx, "/user/notifications/read")
+	assert.Equal(t, http.StatusNoContent, resp.Result().StatusCode)
+
+	srv.AssertExpectations(t)
+}
@@ -21,8 +21,12 @@ type ParkingSpotServicer interface {
 	Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (int64, models.ParkingSpotWithAvailability, error)
 	// Get the parking spot with `spotID`.
 	GetByUUID(ctx context.Context, userID int64, spotID uuid.UUID) (models.ParkingSpot, error)
+	// Set the coordinates of `filter` to the location of its address, if it has one.
+	ResolveSearchCentre(ctx context.Context, filter *models.ParkingSpotFilter) error
 	// Get many parking spots.
 	GetMany(ctx context.Context, userID int64, count int, filter models.ParkingSpotFilter) (spots []models.ParkingSpotWithDistance, err error)
+	// Get the locations with spaces matching `filter`, searching up to `count` spaces.
+	GetManyLocations(ctx context.Context, userID int64, count int, filter models.ParkingSpotFilter) ([]models.ParkingLocationWithSpaces, error)
 	// Get a particular user's(seller's) parking spots.
 	GetManyForUser(ctx context.Context, userID int64, count int) (spots []models.ParkingSpot, err error)
 	// Get the availability from start time to end time for a parking spot.
@@ -31,6 +35,10 @@ type ParkingSpotServicer interface {
 	UpdateSpotByUUID(ctx context.Context, userID int64, spotID uuid.UUID, input *models.ParkingSpotUpdateInput) (models.ParkingSpot, error)
 	// Update the parking spot availability with `spotID` if `userID` owns the resource.
 	UpdateAvailByUUID(ctx context.Context, userID int64, spotID uuid.UUID, input *models.ParkingSpotAvailUpdateInput) error
+	// Get the pricing of the parking spot with `spotID`.
+	GetPricingByUUID(ctx context.Context, spotID uuid.UUID) (models.ParkingSpotPricing, error)
+	// Replace the pricing of the parking spot with `spotID` if `userID` owns the resource.
+	UpdatePricingByUUID(ctx context.Context, userID int64, spotID uuid.UUID, input *models.ParkingSpotPricing) (models.ParkingSpotPricing, error)
 
 	// Creates a new preference attached to `userID`.
 	//
@@ -54,7 +62,31 @@ type parkingSpotListOutput struct {
 }
 
 type parkingSpotWithDistance struct {
-	Body []models.ParkingSpotWithDistance `nullable:"false"`
+	Body      []models.ParkingSpotWithDistance `nullable:"false"`
+	Latitude  float64                          `header:"Search-Latitude" doc:"Latitude of the centre point of the search"`
+	Longitude float64                          `header:"Search-Longitude" doc:"Longitude of the centre point of the search"`
+}
+
+type parkingLocationListOutput struct {
+	Body      []models.ParkingLocationWithSpaces `nullable:"false"`
+	Latitude  float64                            `header:"Search-Latitude" doc:"Latitude of the centre point of the search"`
+	Longitude float64                            `header:"Search-Longitude" doc:"Longitude of the centre point of the search"`
+}
+
+type parkingSpotSearchInput struct {
+	models.ParkingSpotFilter
+}
+
+// Resolve implements huma.Resolver.
+func (i *parkingSpotSearchInput) Resolve(ctx huma.Context) []error {
+	hasCoordinates := ctx.Query("latitude") != "" && ctx.Query("longitude") != ""
+	if i.Address == "" && !hasCoordinates {
+		return []error{&huma.ErrorDetail{
+			Message:  "either an address or both latitude and longitude are required",
+			Location: "query.address",
+		}}
+	}
+	return nil
 }
 
 type parkingSpotAvailabilityListOutput struct {
@@ -65,6 +97,10 @@ type parkingSpotOutput struct {
 	Body models.ParkingSpot
 }
 
+type parkingSpotPricingOutput struct {
+	Body models.ParkingSpotPricing
+}
+
 type parkingSpotCreationOutput struct {
 	Body models.ParkingSpotWithAvailability
 }
@@ -211,8 +247,22 @@ func (r *ParkingSpotRoute) RegisterParkingSpotPreferenceRoutes(api huma.API) {
 	})
 }
 
+// Returns the error of a search with `input`
+func searchError(ctx context.Context, err error, input *parkingSpotSearchInput) error {
+	var detail error
+	if errors.Is(err, models.ErrCarNotFound) {
+		detail = &huma.ErrorDetail{
+			Location: "query.car_id",
+			Value:    input.CarID,
+		}
+	}
+	return NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+}
+
 // Registers `/spots` routes
 func (r *ParkingSpotRoute) RegisterParkingSpotRoutes(api huma.API) {
+	apiPrefix := getAPIPrefix(api.OpenAPI())
+
 	huma.Register(api, *withUserID(&huma.Operation{
 		OperationID:   "create-parking-spot",
 		Method:        http.MethodPost,
@@ -311,6 +361,7 @@ func (r *ParkingSpotRoute) RegisterParkingSpotRoutes(api huma.API) {
 			}
 			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
 		}
+		setPhotoURLs(apiPrefix, result.ID, result.Photos)
 		return &parkingSpotOutput{Body: result}, nil
 	})
 
@@ -342,21 +393,114 @@ func (r *ParkingSpotRoute) RegisterParkingSpotRoutes(api huma.API) {
 		return &result, nil
 	})
 
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "get-parking-spot-pricing",
+		Method:      http.MethodGet,
+		Path:        "/spots/{id}/pricing",
+		Summary:     "Get the pricing rules of the spot",
+		Tags:        []string{ParkingSpotTag.Name},
+		Errors:      []int{http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*parkingSpotPricingOutput, error) {
+		result, err := r.service.GetPricingByUUID(ctx, input.ID)
+		if err != nil {
+			var detail error
+			if errors.Is(err, models.ErrParkingSpotNotFound) {
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+		}
+		return &parkingSpotPricingOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "update-parking-spot-pricing",
+		Method:      http.MethodPut,
+		Path:        "/spots/{id}/pricing",
+		Summary:     "Replaces the pricing rules of the specified parking spot",
+		Tags:        []string{ParkingSpotTag.Name},
+		Errors:      []int{http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.ParkingSpotPricing
+		ID   uuid.UUID `path:"id"`
+	},
+	) (*parkingSpotPricingOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.UpdatePricingByUUID(ctx, userID, input.ID, &input.Body)
+		if err != nil {
+			detail := describeParkingSpotPricingInputError(err, &input.Body)
+			if errors.Is(err, models.ErrParkingSpotNotFound) {
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+		}
+		return &parkingSpotPricingOutput{Body: result}, nil
+	})
+
 	huma.Register(api, *withUserID(&huma.Operation{
 		OperationID: "get-spots",
 		Method:      http.MethodGet,
 		Path:        "/spots",
 		Summary:     "Get listings around a location",
+		Description: "The centre point is either given by its coordinates or by an address, a postal code or a city. The coordinates of the centre point are returned in the `Search-Latitude` and `Search-Longitude` headers.",
 		Tags:        []string{ParkingSpotTag.Name},
-	}), func(ctx context.Context, input *models.ParkingSpotFilter) (*parkingSpotWithDistance, error) {
+		Errors:      []int{http.StatusUnprocessableEntity, http.StatusServiceUnavailable},
+	}), func(ctx context.Context, input *parkingSpotSearchInput) (*parkingSpotWithDistance, error) {
 		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
 
-		spots, err := r.service.GetMany(ctx, userID, 50, *input)
+		err := r.resolveSearchCentre(ctx, input)
 		if err != nil {
-			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+			return nil, err
+		}
+
+		spots, err := r.service.GetMany(ctx, userID, 50, input.ParkingSpotFilter)
+		if err != nil {
+			return nil, searchError(ctx, err, input)
+		}
+
+		result := parkingSpotWithDistance{
+			Body:      spots,
+			Latitude:  input.Latitude,
+			Longitude: input.Longitude,
+		}
+
+		return &result, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "get-locations",
+		Method:      http.MethodGet,
+		Path:        "/locations",
+		Summary:     "Get locations with available spaces around a location",
+		Description: "Searches like `get-spots`, grouping the spaces found by location. The coordinates of the centre point are returned in the `Search-Latitude` and `Search-Longitude` headers.",
+		Tags:        []string{ParkingSpotTag.Name},
+		Errors:      []int{http.StatusUnprocessableEntity, http.StatusServiceUnavailable},
+	}), func(ctx context.Context, input *parkingSpotSearchInput) (*parkingLocationListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+
+		err := r.resolveSearchCentre(ctx, input)
+		if err != nil {
+			return nil, err
 		}
 
-		result := parkingSpotWithDistance{Body: spots}
+		locations, err := r.service.GetManyLocations(ctx, userID, 50, input.ParkingSpotFilter)
+		if err != nil {
+			return nil, searchError(ctx, err, input)
+		}
+
+		result := parkingLocationListOutput{
+			Body:      locations,
+			Latitude:  input.Latitude,
+			Longitude: input.Longitude,
+		}
 
 		return &result, nil
 	})
@@ -382,6 +526,22 @@ func (r *ParkingSpotRoute) RegisterParkingSpotRoutes(api huma.API) {
 	})
 }
 
+// Set the coordinates of the search to the location of its address, if it has one
+func (r *ParkingSpotRoute) resolveSearchCentre(ctx context.Context, input *parkingSpotSearchInput) error {
+	err := r.service.ResolveSearchCentre(ctx, &input.ParkingSpotFilter)
+	if err != nil {
+		if errors.Is(err, models.ErrGeocodingUnavailable) {
+			return NewHumaError(ctx, http.StatusServiceUnavailable, err)
+		}
+		detail := &huma.ErrorDetail{
+			Location: "query.address",
+			Value:    input.Address,
+		}
+		return NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+	}
+	return nil
+}
+
 // Returns a huma.ErrorDetail describing the error in input
 //
 // Returns nil if there are no description for the error
@@ -446,3 +606,31 @@ func describeParkingSpotAvailUpdateInputError(err error, availability *models.Pa
 		return nil
 	}
 }
+
+// Returns a huma.ErrorDetail describing the error in input for pricing update
+//
+// Returns nil if there are no description for the error
+func describeParkingSpotPricingInputError(err error, pricing *models.ParkingSpotPricing) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidMinimumCharge):
+		return &huma.ErrorDetail{
+			Location: "body.minimum_charge",
+			Value:    pricing.MinimumCharge,
+		}
+	case errors.Is(err, models.ErrInvalidDailyMaximum):
+		return &huma.ErrorDetail{
+			Location: "body.daily_maximum",
+			Value:    pricing.DailyMaximum,
+		}
+	case errors.Is(err, models.ErrInvalidPricingRule),
+		errors.Is(err, models.ErrInvalidPricingDate),
+		errors.Is(err, models.ErrInvalidPricingTime),
+		errors.Is(err, models.ErrTooManyPricingRules):
+		return &huma.ErrorDetail{
+			Location: "body.rules",
+			Value:    pricing.Rules,
+		}
+	default:
+		return nil
+	}
+}
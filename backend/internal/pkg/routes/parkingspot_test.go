@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
@@ -41,12 +42,24 @@ func (m *mockParkingSpotService) GetAvailByUUID(ctx context.Context, spotID uuid
 	return args.Get(0).([]models.TimeUnit), args.Error(1)
 }
 
+// ResolveSearchCentre implements ParkingSpotServicer.
+func (m *mockParkingSpotService) ResolveSearchCentre(ctx context.Context, filter *models.ParkingSpotFilter) error {
+	args := m.Called(ctx, filter)
+	return args.Error(0)
+}
+
 // GetMany implements ParkingSpotServicer.
 func (m *mockParkingSpotService) GetMany(ctx context.Context, userID int64, count int, filter models.ParkingSpotFilter) (spots []models.ParkingSpotWithDistance, err error) {
 	args := m.Called(ctx, userID, count, filter)
 	return args.Get(0).([]models.ParkingSpotWithDistance), args.Error(1)
 }
 
+// GetManyLocations implements ParkingSpotServicer.
+func (m *mockParkingSpotService) GetManyLocations(ctx context.Context, userID int64, count int, filter models.ParkingSpotFilter) ([]models.ParkingLocationWithSpaces, error) {
+	args := m.Called(ctx, userID, count, filter)
+	return args.Get(0).([]models.ParkingLocationWithSpaces), args.Error(1)
+}
+
 // GetManyForUser implements ParkingSpotServicer.
 func (m *mockParkingSpotService) GetManyForUser(ctx context.Context, userID int64, count int) (spots []models.ParkingSpot, err error) {
 	args := m.Called(ctx, userID, count)
@@ -65,6 +78,18 @@ func (m *mockParkingSpotService) UpdateAvailByUUID(ctx context.Context, userID i
 	return args.Error(0)
 }
 
+// GetPricingByUUID implements ParkingSpotServicer.
+func (m *mockParkingSpotService) GetPricingByUUID(ctx context.Context, spotID uuid.UUID) (models.ParkingSpotPricing, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(models.ParkingSpotPricing), args.Error(1)
+}
+
+// UpdatePricingByUUID implements ParkingSpotServicer.
+func (m *mockParkingSpotService) UpdatePricingByUUID(ctx context.Context, userID int64, spotID uuid.UUID, input *models.ParkingSpotPricing) (models.ParkingSpotPricing, error) {
+	args := m.Called(ctx, userID, spotID, input)
+	return args.Get(0).(models.ParkingSpotPricing), args.Error(1)
+}
+
 // CreatePreference implements ParkingSpotServicer.
 func (m *mockParkingSpotService) CreatePreference(ctx context.Context, userID int64, spotID uuid.UUID) error {
 	args := m.Called(ctx, userID, spotID)
@@ -140,6 +165,7 @@ var sampleFilter = models.ParkingSpotFilter{
 	Longitude: sampleLongitudeFloat,
 	Latitude:  sampleLatitudeFloat,
 	Distance:  int32(sampleDistanceToLocation),
+	Sort:      models.SpotSortDistance,
 	ParkingSpotAvailabilityFilter: models.ParkingSpotAvailabilityFilter{
 		AvailabilityStart: sampleAvailability[0].StartTime,
 		AvailabilityEnd:   sampleAvailability[1].EndTime,
@@ -917,6 +943,9 @@ func TestGetParkingSpotAroundLocation(t *testing.T) {
 		_, api := humatest.New(t)
 		huma.AutoRegister(api, route)
 
+		srv.On("ResolveSearchCentre", mock.Anything, &sampleFilter).
+			Return(nil).
+			Once()
 		srv.On("GetMany", mock.Anything, testOwnerID, 50, sampleFilter).
 			Return(testOutput, nil).
 			Once()
@@ -939,6 +968,78 @@ func TestGetParkingSpotAroundLocation(t *testing.T) {
 		srv.AssertExpectations(t)
 	})
 
+	t.Run("by address", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockParkingSpotService)
+		route := NewParkingSpotRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		filter := sampleFilter
+		filter.Address = "R3T 2N2"
+		filter.Latitude = 0
+		filter.Longitude = 0
+		srv.On("ResolveSearchCentre", mock.Anything, &filter).
+			Run(func(args mock.Arguments) {
+				resolved := args.Get(1).(*models.ParkingSpotFilter)
+				resolved.Latitude = sampleLatitudeFloat
+				resolved.Longitude = sampleLongitudeFloat
+			}).
+			Return(nil).
+			Once()
+		resolved := sampleFilter
+		resolved.Address = filter.Address
+		srv.On("GetMany", mock.Anything, testOwnerID, 50, resolved).
+			Return(testOutput, nil).
+			Once()
+
+		reqURL := fmt.Sprintf("/spots?address=R3T%%202N2&distance=%d&availability_start=%s&availability_end=%s",
+			int32(sampleDistanceToLocation),
+			sampleAvailability[0].StartTime.Format(time.RFC3339),
+			sampleAvailability[1].EndTime.Format(time.RFC3339))
+
+		resp := api.GetCtx(ctx, reqURL)
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+		assert.Equal(t, strconv.FormatFloat(sampleLatitudeFloat, 'f', -1, 64), resp.Result().Header.Get("Search-Latitude"))
+		assert.Equal(t, strconv.FormatFloat(sampleLongitudeFloat, 'f', -1, 64), resp.Result().Header.Get("Search-Longitude"))
+
+		var spot []models.ParkingSpotWithDistance
+		err := json.NewDecoder(resp.Result().Body).Decode(&spot)
+		require.NoError(t, err)
+
+		assert.Equal(t, testOutput, spot)
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("address not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockParkingSpotService)
+		route := NewParkingSpotRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("ResolveSearchCentre", mock.Anything, mock.Anything).
+			Return(models.ErrSearchLocationNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots?address=nowhere")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &huma.ErrorDetail{
+			Location: "query.address",
+			Value:    "nowhere",
+		})
+
+		srv.AssertExpectations(t)
+		srv.AssertNotCalled(t, "GetMany", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("no coordinate", func(t *testing.T) {
 		t.Parallel()
 
@@ -959,6 +1060,81 @@ func TestGetParkingSpotAroundLocation(t *testing.T) {
 	})
 }
 
+func TestGetLocationsAroundLocation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testOwnerID)
+
+	testOutput := []models.ParkingLocationWithSpaces{
+		{
+			Location: sampleOutput.Location,
+			Spaces: []models.ParkingSpotWithDistance{
+				{
+					ParkingSpot:        sampleOutput,
+					DistanceToLocation: sampleDistanceToLocation,
+				},
+			},
+			DistanceToLocation: sampleDistanceToLocation,
+			AvailableSpaces:    1,
+			ID:                 uuid.New(),
+		},
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockParkingSpotService)
+		route := NewParkingSpotRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("ResolveSearchCentre", mock.Anything, &sampleFilter).
+			Return(nil).
+			Once()
+		srv.On("GetManyLocations", mock.Anything, testOwnerID, 50, sampleFilter).
+			Return(testOutput, nil).
+			Once()
+
+		reqURL := fmt.Sprintf("/locations?latitude=%f&longitude=%f&distance=%d&availability_start=%s&availability_end=%s",
+			sampleLatitudeFloat,
+			sampleLongitudeFloat,
+			int32(sampleDistanceToLocation),
+			sampleAvailability[0].StartTime.Format(time.RFC3339),
+			sampleAvailability[1].EndTime.Format(time.RFC3339))
+
+		resp := api.GetCtx(ctx, reqURL)
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var locations []models.ParkingLocationWithSpaces
+		err := json.NewDecoder(resp.Result().Body).Decode(&locations)
+		require.NoError(t, err)
+
+		assert.Equal(t, testOutput, locations)
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("address not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockParkingSpotService)
+		route := NewParkingSpotRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("ResolveSearchCentre", mock.Anything, mock.Anything).
+			Return(models.ErrSearchLocationNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/locations?address=nowhere")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+		srv.AssertNotCalled(t, "GetManyLocations", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestGetMySpots(t *testing.T) {
 	t.Parallel()
 
@@ -1291,3 +1467,143 @@ func TestDeletePreference(t *testing.T) {
 		srv.AssertExpectations(t)
 	})
 }
+
+func TestParkingSpotPricing(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testOwnerID)
+
+	testPricing := models.ParkingSpotPricing{
+		Rules: []models.PricingRule{
+			{
+				StartTime:    "09:00",
+				EndTime:      "17:00",
+				Weekdays:     []string{"monday", "tuesday"},
+				PricePerHour: 15,
+			},
+		},
+		MinimumCharge: 5,
+		DailyMaximum:  50,
+	}
+
+	t.Run("get pricing", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockParkingSpotService)
+		route := NewParkingSpotRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetPricingByUUID", mock.Anything, testSpotUUID).
+			Return(testPricing, nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots/"+testSpotUUID.String()+"/pricing")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result models.ParkingSpotPricing
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, testPricing, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("update pricing", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockParkingSpotService)
+		route := NewParkingSpotRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("UpdatePricingByUUID", mock.Anything, testOwnerID, testSpotUUID, &testPricing).
+			Return(testPricing, nil).
+			Once()
+
+		resp := api.PutCtx(ctx, "/spots/"+testSpotUUID.String()+"/pricing", testPricing)
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result models.ParkingSpotPricing
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, testPricing, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockParkingSpotService)
+		route := NewParkingSpotRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("UpdatePricingByUUID", mock.Anything, testOwnerID, testSpotUUID, &testPricing).
+			Return(models.ParkingSpotPricing{}, models.ErrInvalidPricingTime).
+			Once()
+
+		resp := api.PutCtx(ctx, "/spots/"+testSpotUUID.String()+"/pricing", testPricing)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeSpotInvalid.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &huma.ErrorDetail{
+			Location: "body.rules",
+			Value:    jsonAnyify(testPricing.Rules),
+		})
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("invalid weekday is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockParkingSpotService)
+		route := NewParkingSpotRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		input := models.ParkingSpotPricing{
+			Rules: []models.PricingRule{
+				{Weekdays: []string{"someday"}, PricePerHour: 1},
+			},
+		}
+		resp := api.PutCtx(ctx, "/spots/"+testSpotUUID.String()+"/pricing", input)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertNotCalled(t, "UpdatePricingByUUID")
+	})
+
+	t.Run("not found handling", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockParkingSpotService)
+		route := NewParkingSpotRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("UpdatePricingByUUID", mock.Anything, testOwnerID, testSpotUUID, &testPricing).
+			Return(models.ParkingSpotPricing{}, models.ErrParkingSpotNotFound).
+			Once()
+
+		resp := api.PutCtx(ctx, "/spots/"+testSpotUUID.String()+"/pricing", testPricing)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(testSpotUUID),
+		})
+
+		srv.AssertExpectations(t)
+	})
+}
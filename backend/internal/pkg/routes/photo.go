@@ -0,0 +1,248 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/photo"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `PhotoRoute`
+type PhotoServicer interface {
+	// Add the image `data` after the other photos of `spotID`, if `userID` owns the spot.
+	Create(ctx context.Context, userID int64, spotID uuid.UUID, data []byte) (models.ParkingSpotPhoto, error)
+	// Get the photos of `spotID` in display order.
+	GetMany(ctx context.Context, spotID uuid.UUID) ([]models.ParkingSpotPhoto, error)
+	// Get the full size image of the photo `photoID` of `spotID`, and its content type.
+	GetImage(ctx context.Context, spotID, photoID uuid.UUID) ([]byte, string, error)
+	// Get the thumbnail of the photo `photoID` of `spotID`, and its content type.
+	GetThumbnail(ctx context.Context, spotID, photoID uuid.UUID) ([]byte, string, error)
+	// Show the photos of `spotID` in the order of `input`, if `userID` owns the spot.
+	Reorder(ctx context.Context, userID int64, spotID uuid.UUID, input *models.ParkingSpotPhotoOrderInput) ([]models.ParkingSpotPhoto, error)
+	// Delete the photo `photoID` of `spotID`, if `userID` owns the spot.
+	Delete(ctx context.Context, userID int64, spotID, photoID uuid.UUID) error
+}
+
+// PhotoRoute represents parking spot photo API routes
+type PhotoRoute struct {
+	service       PhotoServicer
+	sessionGetter SessionDataGetter
+}
+
+type photoOutput struct {
+	Body models.ParkingSpotPhoto
+}
+
+type photoListOutput struct {
+	Body []models.ParkingSpotPhoto
+}
+
+type photoImageOutput struct {
+	ContentType  string `header:"Content-Type"`
+	CacheControl string `header:"Cache-Control"`
+	Body         []byte
+}
+
+// Photos never change once uploaded, only their deletion takes a while to be seen
+const photoCacheControl = "public, max-age=86400"
+
+// Returns the responses of operations returning an image
+func photoImageResponses() map[string]*huma.Response {
+	imageContent := &huma.MediaType{
+		Schema: &huma.Schema{Type: huma.TypeString, Format: "binary"},
+	}
+	return map[string]*huma.Response{
+		"200": {
+			Description: "A JPEG or PNG image",
+			Content: map[string]*huma.MediaType{
+				"image/jpeg": imageContent,
+				"image/png":  imageContent,
+			},
+		},
+	}
+}
+
+// Returns the error of a photo operation on `spotID`
+func photoError(ctx context.Context, err error, spotID uuid.UUID) error {
+	switch {
+	case errors.Is(err, models.ErrParkingSpotNotFound):
+		detail := &huma.ErrorDetail{
+			Location: "path.id",
+			Value:    spotID,
+		}
+		return NewHumaError(ctx, http.StatusNotFound, err, detail)
+	case errors.Is(err, models.ErrPhotoNotFound):
+		return NewHumaError(ctx, http.StatusNotFound, err, &huma.ErrorDetail{Location: "path.photo_id"})
+	case errors.Is(err, models.ErrPhotoTooLarge):
+		return NewHumaError(ctx, http.StatusRequestEntityTooLarge, err, &huma.ErrorDetail{Location: "body"})
+	case errors.Is(err, models.ErrPhotoUnsupported), errors.Is(err, models.ErrPhotoUnreadable):
+		return NewHumaError(ctx, http.StatusUnsupportedMediaType, err, &huma.ErrorDetail{Location: "body"})
+	case errors.Is(err, models.ErrInvalidPhotoOrder):
+		return NewHumaError(ctx, http.StatusUnprocessableEntity, err, &huma.ErrorDetail{Location: "body.photos"})
+	}
+	return NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+}
+
+// Set the image URLs of `photos`, photos of `spotID`
+func setPhotoURLs(apiPrefix *url.URL, spotID uuid.UUID, photos []models.ParkingSpotPhoto) {
+	for i := range photos {
+		photoURL := apiPrefix.JoinPath("/spots", spotID.String(), "photos", photos[i].ID.String())
+		photos[i].URL = photoURL.JoinPath("image").String()
+		photos[i].ThumbnailURL = photoURL.JoinPath("thumbnail").String()
+	}
+}
+
+// Returns a new `PhotoRoute`
+func NewPhotoRoute(
+	service PhotoServicer,
+	sessionGetter SessionDataGetter,
+) *PhotoRoute {
+	return &PhotoRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+// Registers parking spot photo routes
+func (r *PhotoRoute) RegisterPhotoRoutes(api huma.API) {
+	apiPrefix := getAPIPrefix(api.OpenAPI())
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "create-parking-spot-photo",
+		Method:        http.MethodPost,
+		Path:          "/spots/{id}/photos",
+		Summary:       "Add a photo to a parking spot",
+		Description:   "The photo is shown after the other photos of the spot. The type of the image is detected from its content, and its metadata (including location) is removed.",
+		Tags:          []string{ParkingSpotTag.Name},
+		DefaultStatus: http.StatusCreated,
+		MaxBodyBytes:  photo.MaxUploadSize,
+		Errors:        []int{http.StatusNotFound, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		RawBody []byte    `contentType:"image/*"`
+		ID      uuid.UUID `path:"id"`
+	},
+	) (*photoOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.Create(ctx, userID, input.ID, input.RawBody)
+		if err != nil {
+			return nil, photoError(ctx, err, input.ID)
+		}
+		photos := []models.ParkingSpotPhoto{result}
+		setPhotoURLs(apiPrefix, input.ID, photos)
+		return &photoOutput{Body: photos[0]}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-parking-spot-photos",
+		Method:      http.MethodGet,
+		Path:        "/spots/{id}/photos",
+		Summary:     "Get the photos of a parking spot in display order",
+		Tags:        []string{ParkingSpotTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*photoListOutput, error) {
+		result, err := r.service.GetMany(ctx, input.ID)
+		if err != nil {
+			return nil, photoError(ctx, err, input.ID)
+		}
+		setPhotoURLs(apiPrefix, input.ID, result)
+		return &photoListOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "reorder-parking-spot-photos",
+		Method:      http.MethodPut,
+		Path:        "/spots/{id}/photos/order",
+		Summary:     "Change the order of the photos of a parking spot",
+		Tags:        []string{ParkingSpotTag.Name},
+		Errors:      []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.ParkingSpotPhotoOrderInput
+		ID   uuid.UUID `path:"id"`
+	},
+	) (*photoListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.Reorder(ctx, userID, input.ID, &input.Body)
+		if err != nil {
+			return nil, photoError(ctx, err, input.ID)
+		}
+		setPhotoURLs(apiPrefix, input.ID, result)
+		return &photoListOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "delete-parking-spot-photo",
+		Method:      http.MethodDelete,
+		Path:        "/spots/{id}/photos/{photo_id}",
+		Summary:     "Delete a photo of a parking spot",
+		Tags:        []string{ParkingSpotTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID      uuid.UUID `path:"id"`
+		PhotoID uuid.UUID `path:"photo_id"`
+	},
+	) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.Delete(ctx, userID, input.ID, input.PhotoID)
+		if err != nil {
+			return nil, photoError(ctx, err, input.ID)
+		}
+		return nil, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-parking-spot-photo-image",
+		Method:      http.MethodGet,
+		Path:        "/spots/{id}/photos/{photo_id}/image",
+		Summary:     "Get the full size image of a parking spot photo",
+		Tags:        []string{ParkingSpotTag.Name},
+		Responses:   photoImageResponses(),
+		Errors:      []int{http.StatusNotFound},
+	}, func(ctx context.Context, input *struct {
+		ID      uuid.UUID `path:"id"`
+		PhotoID uuid.UUID `path:"photo_id"`
+	},
+	) (*photoImageOutput, error) {
+		data, contentType, err := r.service.GetImage(ctx, input.ID, input.PhotoID)
+		if err != nil {
+			return nil, photoError(ctx, err, input.ID)
+		}
+		return &photoImageOutput{
+			ContentType:  contentType,
+			CacheControl: photoCacheControl,
+			Body:         data,
+		}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-parking-spot-photo-thumbnail",
+		Method:      http.MethodGet,
+		Path:        "/spots/{id}/photos/{photo_id}/thumbnail",
+		Summary:     "Get a smaller version of a parking spot photo",
+		Description: "The thumbnail fits in a 320 by 320 pixels square.",
+		Tags:        []string{ParkingSpotTag.Name},
+		Responses:   photoImageResponses(),
+		Errors:      []int{http.StatusNotFound},
+	}, func(ctx context.Context, input *struct {
+		ID      uuid.UUID `path:"id"`
+		PhotoID uuid.UUID `path:"photo_id"`
+	},
+	) (*photoImageOutput, error) {
+		data, contentType, err := r.service.GetThumbnail(ctx, input.ID, input.PhotoID)
+		if err != nil {
+			return nil, photoError(ctx, err, input.ID)
+		}
+		return &photoImageOutput{
+			ContentType:  contentType,
+			CacheControl: photoCacheControl,
+			Body:         data,
+		}, nil
+	})
+}
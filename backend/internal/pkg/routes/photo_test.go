@@ -0,0 +1,332 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPhotoService struct {
+	mock.Mock
+}
+
+// Create implements PhotoServicer.
+func (m *mockPhotoService) Create(ctx context.Context, userID int64, spotID uuid.UUID, data []byte) (models.ParkingSpotPhoto, error) {
+	args := m.Called(ctx, userID, spotID, data)
+	return args.Get(0).(models.ParkingSpotPhoto), args.Error(1)
+}
+
+// GetMany implements PhotoServicer.
+func (m *mockPhotoService) GetMany(ctx context.Context, spotID uuid.UUID) ([]models.ParkingSpotPhoto, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).([]models.ParkingSpotPhoto), args.Error(1)
+}
+
+// GetImage implements PhotoServicer.
+func (m *mockPhotoService) GetImage(ctx context.Context, spotID, photoID uuid.UUID) ([]byte, string, error) {
+	args := m.Called(ctx, spotID, photoID)
+	return args.Get(0).([]byte), args.String(1), args.Error(2)
+}
+
+// GetThumbnail implements PhotoServicer.
+func (m *mockPhotoService) GetThumbnail(ctx context.Context, spotID, photoID uuid.UUID) ([]byte, string, error) {
+	args := m.Called(ctx, spotID, photoID)
+	return args.Get(0).([]byte), args.String(1), args.Error(2)
+}
+
+// Reorder implements PhotoServicer.
+func (m *mockPhotoService) Reorder(ctx context.Context, userID int64, spotID uuid.UUID, input *models.ParkingSpotPhotoOrderInput) ([]models.ParkingSpotPhoto, error) {
+	args := m.Called(ctx, userID, spotID, input)
+	return args.Get(0).([]models.ParkingSpotPhoto), args.Error(1)
+}
+
+// Delete implements PhotoServicer.
+func (m *mockPhotoService) Delete(ctx context.Context, userID int64, spotID, photoID uuid.UUID) error {
+	args := m.Called(ctx, userID, spotID, photoID)
+	return args.Error(0)
+}
+
+func TestCreatePhoto(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	spotID := uuid.New()
+	image := []byte("\x89PNG\r\n\x1a\n")
+
+	t.Run("create okay", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		api.OpenAPI().Servers = append(api.OpenAPI().Servers, &huma.Server{
+			URL: "http://localhost/api",
+		})
+		huma.AutoRegister(api, route)
+
+		photoID := uuid.New()
+		srv.On("Create", mock.Anything, testUserID, spotID, image).
+			Return(models.ParkingSpotPhoto{ID: photoID, Width: 640, Height: 480}, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+spotID.String()+"/photos", "Content-Type: image/png", bytes.NewReader(image))
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.ParkingSpotPhoto
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, models.ParkingSpotPhoto{
+			URL:          "http://localhost/api/spots/" + spotID.String() + "/photos/" + photoID.String() + "/image",
+			ThumbnailURL: "http://localhost/api/spots/" + spotID.String() + "/photos/" + photoID.String() + "/thumbnail",
+			Width:        640,
+			Height:       480,
+			ID:           photoID,
+		}, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("unsupported image", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Create", mock.Anything, testUserID, spotID, mock.Anything).
+			Return(models.ParkingSpotPhoto{}, models.ErrPhotoUnsupported).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+spotID.String()+"/photos", "Content-Type: image/gif", strings.NewReader("GIF89a"))
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodePhotoInvalid.TypeURI(), errModel.Type)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("too many photos", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Create", mock.Anything, testUserID, spotID, image).
+			Return(models.ParkingSpotPhoto{}, models.ErrTooManyPhotos).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+spotID.String()+"/photos", "Content-Type: image/png", bytes.NewReader(image))
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Create", mock.Anything, testUserID, spotID, image).
+			Return(models.ParkingSpotPhoto{}, models.ErrParkingSpotNotFound).
+			Once()
+
+		resp := api.PostCtx(ctx, "/spots/"+spotID.String()+"/photos", "Content-Type: image/png", bytes.NewReader(image))
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(spotID),
+		}
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestGetPhotoImage(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	spotID := uuid.New()
+	photoID := uuid.New()
+
+	t.Run("image okay", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetImage", mock.Anything, spotID, photoID).
+			Return([]byte("jpeg data"), "image/jpeg", nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots/"+spotID.String()+"/photos/"+photoID.String()+"/image")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+		assert.Equal(t, "image/jpeg", resp.Result().Header.Get("Content-Type"))
+		assert.Equal(t, photoCacheControl, resp.Result().Header.Get("Cache-Control"))
+		assert.Equal(t, "jpeg data", resp.Body.String())
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("thumbnail not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetThumbnail", mock.Anything, spotID, photoID).
+			Return([]byte(nil), "", models.ErrPhotoNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots/"+spotID.String()+"/photos/"+photoID.String()+"/thumbnail")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestReorderPhotos(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	spotID := uuid.New()
+	order := []uuid.UUID{uuid.New(), uuid.New()}
+
+	t.Run("reorder okay", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Reorder", mock.Anything, testUserID, spotID, &models.ParkingSpotPhotoOrderInput{Photos: order}).
+			Return([]models.ParkingSpotPhoto{{ID: order[0]}, {ID: order[1]}}, nil).
+			Once()
+
+		resp := api.PutCtx(ctx, "/spots/"+spotID.String()+"/photos/order", models.ParkingSpotPhotoOrderInput{Photos: order})
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result []models.ParkingSpotPhoto
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, order[0], result[0].ID)
+		assert.True(t, strings.HasSuffix(result[1].ThumbnailURL, "/spots/"+spotID.String()+"/photos/"+order[1].String()+"/thumbnail"))
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("invalid order", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Reorder", mock.Anything, testUserID, spotID, mock.Anything).
+			Return([]models.ParkingSpotPhoto(nil), models.ErrInvalidPhotoOrder).
+			Once()
+
+		resp := api.PutCtx(ctx, "/spots/"+spotID.String()+"/photos/order", models.ParkingSpotPhotoOrderInput{Photos: order[:1]})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodePhotoInvalid.TypeURI(), errModel.Type)
+		assert.Equal(t, models.ErrInvalidPhotoOrder.Error(), errModel.Detail)
+		assert.Contains(t, errModel.Errors, &huma.ErrorDetail{Location: "body.photos"})
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestDeletePhoto(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	spotID := uuid.New()
+	photoID := uuid.New()
+
+	t.Run("delete okay", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Delete", mock.Anything, testUserID, spotID, photoID).Return(nil).Once()
+
+		resp := api.DeleteCtx(ctx, "/spots/"+spotID.String()+"/photos/"+photoID.String())
+		assert.Equal(t, http.StatusNoContent, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("photo not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPhotoService)
+		route := NewPhotoRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Delete", mock.Anything, testUserID, spotID, photoID).Return(models.ErrPhotoNotFound).Once()
+
+		resp := api.DeleteCtx(ctx, "/spots/"+spotID.String()+"/photos/"+photoID.String())
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+}
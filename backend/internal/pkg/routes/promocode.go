@@ -0,0 +1,220 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `PromoCodeRoute`
+type PromoCodeServicer interface {
+	// Create a new promo code as the administrator `userID`.
+	Create(ctx context.Context, userID int64, input *models.PromoCodeCreationInput) (models.PromoCode, error)
+	// Get at most `count` promo codes as the administrator `userID`.
+	//
+	// If there are more entries following the result, a non-empty cursor will be returned
+	// which can be passed to the next invocation to get the next entries.
+	GetMany(ctx context.Context, userID int64, count int, after models.Cursor) ([]models.PromoCode, models.Cursor, error)
+	// Get the promo code with `id` as the administrator `userID`.
+	GetByUUID(ctx context.Context, userID int64, id uuid.UUID) (models.PromoCode, error)
+	// Expire the promo code with `id` immediately as the administrator `userID`.
+	ExpireByUUID(ctx context.Context, userID int64, id uuid.UUID) (models.PromoCode, error)
+}
+
+// PromoCodeRoute represents promo code administration API routes
+type PromoCodeRoute struct {
+	service       PromoCodeServicer
+	sessionGetter SessionDataGetter
+}
+
+type promoCodeOutput struct {
+	Body models.PromoCode
+}
+
+type promoCodeListOutput struct {
+	Link []string           `header:"Link" doc:"Contains details on getting the next page of resources" example:"<https://example.com/admin/promocodes?after=gQL>; rel=\"next\""`
+	Body []models.PromoCode `nullable:"false"`
+}
+
+var PromoCodeTag = huma.Tag{
+	Name:        "Promo code",
+	Description: "Operations for managing promo codes. Requires administrator access.",
+}
+
+// Returns a new `PromoCodeRoute`
+func NewPromoCodeRoute(
+	service PromoCodeServicer,
+	sessionGetter SessionDataGetter,
+) *PromoCodeRoute {
+	return &PromoCodeRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+func (r *PromoCodeRoute) RegisterPromoCodeTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &PromoCodeTag)
+}
+
+// Registers `/admin/promocodes` routes
+func (r *PromoCodeRoute) RegisterPromoCodeRoutes(api huma.API) {
+	apiPrefix := getAPIPrefix(api.OpenAPI())
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "create-promo-code",
+		Method:        http.MethodPost,
+		Path:          "/admin/promocodes",
+		Summary:       "Create a new promo code",
+		Tags:          []string{PromoCodeTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusForbidden, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.PromoCodeCreationInput
+	},
+	) (*promoCodeOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.Create(ctx, userID, &input.Body)
+		if err != nil {
+			if errors.Is(err, models.ErrNotAdministrator) {
+				return nil, NewHumaError(ctx, http.StatusForbidden, err)
+			}
+			detail := describePromoCodeInputError(err, &input.Body)
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+		}
+		return &promoCodeOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-promo-codes",
+		Method:      http.MethodGet,
+		Path:        "/admin/promocodes",
+		Summary:     "Get all promo codes",
+		Tags:        []string{PromoCodeTag.Name},
+		Errors:      []int{http.StatusForbidden},
+	}), func(ctx context.Context, input *struct {
+		After models.Cursor `query:"after" doc:"Token used for requesting the next page of resources"`
+		Count int           `query:"count" minimum:"1" default:"50" doc:"The maximum number of promo codes that appear per page."`
+	},
+	) (*promoCodeListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		promoCodes, nextCursor, err := r.service.GetMany(ctx, userID, input.Count, input.After)
+		if err != nil {
+			if errors.Is(err, models.ErrNotAdministrator) {
+				return nil, NewHumaError(ctx, http.StatusForbidden, err)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+
+		result := promoCodeListOutput{Body: promoCodes}
+		if nextCursor != "" {
+			nextURL := apiPrefix.JoinPath("/admin/promocodes")
+			nextURL.RawQuery = url.Values{
+				"count": []string{strconv.Itoa(input.Count)},
+				"after": []string{string(nextCursor)},
+			}.Encode()
+			result.Link = append(result.Link, "<"+nextURL.String()+`>; rel="next"`)
+		}
+		return &result, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "get-promo-code",
+		Method:      http.MethodGet,
+		Path:        "/admin/promocodes/{id}",
+		Summary:     "Get information about a promo code",
+		Tags:        []string{PromoCodeTag.Name},
+		Errors:      []int{http.StatusForbidden, http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*promoCodeOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.GetByUUID(ctx, userID, input.ID)
+		if err != nil {
+			return nil, promoCodeLookupError(ctx, err, input.ID)
+		}
+		return &promoCodeOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "expire-promo-code",
+		Method:      http.MethodPost,
+		Path:        "/admin/promocodes/{id}/expire",
+		Summary:     "Expire a promo code immediately",
+		Description: "Bookings already made with the promo code are not affected.",
+		Tags:        []string{PromoCodeTag.Name},
+		Errors:      []int{http.StatusForbidden, http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*promoCodeOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.ExpireByUUID(ctx, userID, input.ID)
+		if err != nil {
+			return nil, promoCodeLookupError(ctx, err, input.ID)
+		}
+		return &promoCodeOutput{Body: result}, nil
+	})
+}
+
+// Returns the huma error for `err` produced while looking up the promo code `id`
+func promoCodeLookupError(ctx context.Context, err error, id uuid.UUID) error {
+	switch {
+	case errors.Is(err, models.ErrNotAdministrator):
+		return NewHumaError(ctx, http.StatusForbidden, err)
+	case errors.Is(err, models.ErrPromoCodeNotFound):
+		detail := &huma.ErrorDetail{
+			Location: "path.id",
+			Value:    id,
+		}
+		return NewHumaError(ctx, http.StatusNotFound, err, detail)
+	default:
+		return NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+	}
+}
+
+// Returns a huma.ErrorDetail describing the error in input
+//
+// Returns nil if there are no description for the error
+func describePromoCodeInputError(err error, input *models.PromoCodeCreationInput) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidPromoCode), errors.Is(err, models.ErrPromoCodeExists):
+		return &huma.ErrorDetail{
+			Location: "body.code",
+			Value:    input.Code,
+		}
+	case errors.Is(err, models.ErrInvalidDiscount):
+		return &huma.ErrorDetail{
+			Location: "body.discount_value",
+			Value:    input.DiscountValue,
+		}
+	case errors.Is(err, models.ErrInvalidMinimumSpend):
+		return &huma.ErrorDetail{
+			Location: "body.minimum_spend",
+			Value:    input.MinimumSpend,
+		}
+	case errors.Is(err, models.ErrInvalidPromoCodeLimit):
+		return &huma.ErrorDetail{
+			Location: "body.max_uses",
+			Value:    input.MaxUses,
+		}
+	case errors.Is(err, models.ErrPromoCodeOwnerNotFound):
+		return &huma.ErrorDetail{
+			Location: "body.owner_email",
+			Value:    input.OwnerEmail,
+		}
+	case errors.Is(err, models.ErrParkingSpotNotFound):
+		return &huma.ErrorDetail{
+			Location: "body.parkingspot_id",
+			Value:    input.ParkingSpotID,
+		}
+	default:
+		return nil
+	}
+}
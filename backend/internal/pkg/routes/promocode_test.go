@@ -0,0 +1,176 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPromoCodeService struct {
+	mock.Mock
+}
+
+// Create implements PromoCodeServicer.
+func (m *mockPromoCodeService) Create(ctx context.Context, userID int64, input *models.PromoCodeCreationInput) (models.PromoCode, error) {
+	args := m.Called(ctx, userID, input)
+	return args.Get(0).(models.PromoCode), args.Error(1)
+}
+
+// GetMany implements PromoCodeServicer.
+func (m *mockPromoCodeService) GetMany(ctx context.Context, userID int64, count int, after models.Cursor) ([]models.PromoCode, models.Cursor, error) {
+	args := m.Called(ctx, userID, count, after)
+	return args.Get(0).([]models.PromoCode), args.Get(1).(models.Cursor), args.Error(2)
+}
+
+// GetByUUID implements PromoCodeServicer.
+func (m *mockPromoCodeService) GetByUUID(ctx context.Context, userID int64, id uuid.UUID) (models.PromoCode, error) {
+	args := m.Called(ctx, userID, id)
+	return args.Get(0).(models.PromoCode), args.Error(1)
+}
+
+// ExpireByUUID implements PromoCodeServicer.
+func (m *mockPromoCodeService) ExpireByUUID(ctx context.Context, userID int64, id uuid.UUID) (models.PromoCode, error) {
+	args := m.Called(ctx, userID, id)
+	return args.Get(0).(models.PromoCode), args.Error(1)
+}
+
+func TestCreatePromoCode(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testInput := models.PromoCodeCreationInput{
+		Code:          "SAVE10",
+		DiscountType:  models.DiscountPercentage,
+		DiscountValue: 10,
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPromoCodeService)
+		route := NewPromoCodeRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		promoUUID := uuid.New()
+		srv.On("Create", mock.Anything, testUserID, &testInput).
+			Return(models.PromoCode{PromoCodeCreationInput: testInput, ID: promoUUID}, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/admin/promocodes", testInput)
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var promoCode models.PromoCode
+		err := json.NewDecoder(resp.Result().Body).Decode(&promoCode)
+		require.NoError(t, err)
+
+		assert.Equal(t, testInput, promoCode.PromoCodeCreationInput)
+		assert.Equal(t, promoUUID, promoCode.ID)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("not an administrator", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPromoCodeService)
+		route := NewPromoCodeRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Create", mock.Anything, testUserID, &testInput).
+			Return(models.PromoCode{}, models.ErrNotAdministrator).
+			Once()
+
+		resp := api.PostCtx(ctx, "/admin/promocodes", testInput)
+		assert.Equal(t, http.StatusForbidden, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeForbidden.TypeURI(), errModel.Type)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("discount errors", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPromoCodeService)
+		route := NewPromoCodeRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Create", mock.Anything, testUserID, &testInput).
+			Return(models.PromoCode{}, models.ErrInvalidDiscount).
+			Once()
+
+		resp := api.PostCtx(ctx, "/admin/promocodes", testInput)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "body.discount_value",
+			Value:    jsonAnyify(testInput.DiscountValue),
+		}
+		assert.Equal(t, models.CodePromoCodeInvalid.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestGetPromoCode(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockPromoCodeService)
+		route := NewPromoCodeRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		promoUUID := uuid.New()
+		srv.On("GetByUUID", mock.Anything, testUserID, promoUUID).
+			Return(models.PromoCode{}, models.ErrPromoCodeNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/admin/promocodes/"+promoUUID.String())
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(promoUUID),
+		}
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
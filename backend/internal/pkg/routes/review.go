@@ -0,0 +1,187 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `ReviewRoute`
+type ReviewServicer interface {
+	// Review the booking `bookingID` as `userID`.
+	//
+	// The booker reviews the spot and the spot owner reviews the driver.
+	CreateReview(ctx context.Context, userID int64, bookingID uuid.UUID, input *models.ReviewCreationInput) (models.Review, error)
+	// Get the reviews of the booking `bookingID` if `userID` has enough permission to view the resource.
+	GetReviews(ctx context.Context, userID int64, bookingID uuid.UUID) ([]models.Review, error)
+	// Get at most `count` reviews of the spot `spotID`.
+	//
+	// If there are more entries following the result, a non-empty cursor will be returned
+	// which can be passed to the next invocation to get the next entries.
+	GetSpotReviews(ctx context.Context, spotID uuid.UUID, count int, after models.Cursor) ([]models.Review, models.Cursor, error)
+}
+
+// ReviewRoute represents review-related API routes
+type ReviewRoute struct {
+	service       ReviewServicer
+	sessionGetter SessionDataGetter
+}
+
+type reviewOutput struct {
+	Body models.Review
+}
+
+type reviewListOutput struct {
+	Link []string        `header:"Link" doc:"Contains details on getting the next page of resources" example:"<https://example.com/spots/0/reviews?after=gQL>; rel=\"next\""`
+	Body []models.Review `nullable:"false"`
+}
+
+var ReviewTag = huma.Tag{
+	Name:        "Review",
+	Description: "Operations for reviewing parking spots and drivers.",
+}
+
+// Returns a new `ReviewRoute`
+func NewReviewRoute(
+	service ReviewServicer,
+	sessionGetter SessionDataGetter,
+) *ReviewRoute {
+	return &ReviewRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+func (r *ReviewRoute) RegisterReviewTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &ReviewTag)
+}
+
+// Registers review routes
+func (r *ReviewRoute) RegisterReviewRoutes(api huma.API) {
+	apiPrefix := getAPIPrefix(api.OpenAPI())
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "create-booking-review",
+		Method:        http.MethodPost,
+		Path:          "/bookings/{id}/reviews",
+		Summary:       "Review a completed booking",
+		Description:   "The booker reviews the parking spot and the spot owner reviews the driver. Each side can review a booking once, after all booked times have ended.",
+		Tags:          []string{ReviewTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.ReviewCreationInput
+		ID   uuid.UUID `path:"id"`
+	},
+	) (*reviewOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.CreateReview(ctx, userID, input.ID, &input.Body)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			switch {
+			case errors.Is(err, models.ErrBookingNotFound):
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			case errors.Is(err, models.ErrReviewDuplicate), errors.Is(err, models.ErrBookingNotCompleted):
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+			case errors.Is(err, models.ErrInvalidRating):
+				detail = &huma.ErrorDetail{
+					Location: "body.rating",
+					Value:    input.Body.Rating,
+				}
+			case errors.Is(err, models.ErrInvalidReviewComment):
+				detail = &huma.ErrorDetail{
+					Location: "body.comment",
+					Value:    input.Body.Comment,
+				}
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+		return &reviewOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-booking-reviews",
+		Method:      http.MethodGet,
+		Path:        "/bookings/{id}/reviews",
+		Summary:     "Get the reviews of a booking",
+		Tags:        []string{ReviewTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*reviewListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		reviews, err := r.service.GetReviews(ctx, userID, input.ID)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			if errors.Is(err, models.ErrBookingNotFound) {
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+		return &reviewListOutput{Body: reviews}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-spot-reviews",
+		Method:      http.MethodGet,
+		Path:        "/spots/{id}/reviews",
+		Summary:     "Get the reviews left by drivers for a parking spot",
+		Description: "Reviews are ordered from newest to oldest.",
+		Tags:        []string{ReviewTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		After models.Cursor `query:"after" doc:"Token used for requesting the next page of resources"`
+		Count int           `query:"count" minimum:"1" default:"50" doc:"The maximum number of reviews that appear per page."`
+		ID    uuid.UUID     `path:"id"`
+	},
+	) (*reviewListOutput, error) {
+		reviews, nextCursor, err := r.service.GetSpotReviews(ctx, input.ID, input.Count, input.After)
+		if err != nil {
+			var detail error
+			status := http.StatusUnprocessableEntity
+
+			if errors.Is(err, models.ErrParkingSpotNotFound) {
+				detail = &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				status = http.StatusNotFound
+			}
+			return nil, NewHumaError(ctx, status, err, detail)
+		}
+
+		result := reviewListOutput{Body: reviews}
+		if nextCursor != "" {
+			nextURL := apiPrefix.JoinPath(fmt.Sprintf("/spots/%v/reviews", input.ID))
+			nextURL.RawQuery = url.Values{
+				"count": []string{strconv.Itoa(input.Count)},
+				"after": []string{string(nextCursor)},
+			}.Encode()
+			result.Link = append(result.Link, "<"+nextURL.String()+`>; rel="next"`)
+		}
+		return &result, nil
+	})
+}
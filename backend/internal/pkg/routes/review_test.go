@@ -0,0 +1,207 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/peterhellberg/link"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockReviewService struct {
+	mock.Mock
+}
+
+// CreateReview implements ReviewServicer.
+func (m *mockReviewService) CreateReview(ctx context.Context, userID int64, bookingID uuid.UUID, input *models.ReviewCreationInput) (models.Review, error) {
+	args := m.Called(ctx, userID, bookingID, input)
+	return args.Get(0).(models.Review), args.Error(1)
+}
+
+// GetReviews implements ReviewServicer.
+func (m *mockReviewService) GetReviews(ctx context.Context, userID int64, bookingID uuid.UUID) ([]models.Review, error) {
+	args := m.Called(ctx, userID, bookingID)
+	return args.Get(0).([]models.Review), args.Error(1)
+}
+
+// GetSpotReviews implements ReviewServicer.
+func (m *mockReviewService) GetSpotReviews(ctx context.Context, spotID uuid.UUID, count int, after models.Cursor) ([]models.Review, models.Cursor, error) {
+	args := m.Called(ctx, spotID, count, after)
+	return args.Get(0).([]models.Review), args.Get(1).(models.Cursor), args.Error(2)
+}
+
+func TestCreateReview(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testBookingID := uuid.New()
+	testInput := models.ReviewCreationInput{
+		Comment: "Easy to find",
+		Rating:  4,
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockReviewService)
+		route := NewReviewRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		expected := models.Review{
+			Subject:             models.ReviewSubjectSpot,
+			ReviewCreationInput: testInput,
+			ID:                  uuid.New(),
+			BookingID:           testBookingID,
+		}
+		srv.On("CreateReview", mock.Anything, testUserID, testBookingID, &testInput).
+			Return(expected, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/bookings/"+testBookingID.String()+"/reviews", testInput)
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.Review
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("booking not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockReviewService)
+		route := NewReviewRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("CreateReview", mock.Anything, testUserID, testBookingID, &testInput).
+			Return(models.Review{}, models.ErrBookingNotFound).
+			Once()
+
+		resp := api.PostCtx(ctx, "/bookings/"+testBookingID.String()+"/reviews", testInput)
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(testBookingID),
+		}
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("booking not completed", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockReviewService)
+		route := NewReviewRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("CreateReview", mock.Anything, testUserID, testBookingID, &testInput).
+			Return(models.Review{}, models.ErrBookingNotCompleted).
+			Once()
+
+		resp := api.PostCtx(ctx, "/bookings/"+testBookingID.String()+"/reviews", testInput)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+		assert.Equal(t, models.CodeReviewInvalid.TypeURI(), errModel.Type)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("rating out of range", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockReviewService)
+		route := NewReviewRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.PostCtx(ctx, "/bookings/"+testBookingID.String()+"/reviews", models.ReviewCreationInput{Rating: 6})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertNotCalled(t, "CreateReview", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetSpotReviews(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testSpotID := uuid.New()
+
+	t.Run("paginates", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockReviewService)
+		route := NewReviewRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		reviews := []models.Review{{ID: uuid.New()}}
+		srv.On("GetSpotReviews", mock.Anything, testSpotID, 1, models.Cursor("")).
+			Return(reviews, models.Cursor("next"), nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots/"+testSpotID.String()+"/reviews?count=1")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result []models.Review
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, reviews, result)
+
+		links := link.ParseResponse(resp.Result())
+		if assert.NotEmpty(t, links["next"]) {
+			assert.Contains(t, links["next"].URI, "after=next")
+		}
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockReviewService)
+		route := NewReviewRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetSpotReviews", mock.Anything, testSpotID, 50, models.Cursor("")).
+			Return([]models.Review(nil), models.Cursor(""), models.ErrParkingSpotNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/spots/"+testSpotID.String()+"/reviews")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,133 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `SavedSearchRoute`
+type SavedSearchServicer interface {
+	// Save a search for `userID`.
+	Create(ctx context.Context, userID int64, input *models.SavedSearchInput) (models.SavedSearch, error)
+	// Get all saved searches of `userID`.
+	GetMany(ctx context.Context, userID int64) ([]models.SavedSearch, error)
+	// Delete the saved search `searchID` if `userID` owns the resource.
+	DeleteByUUID(ctx context.Context, userID int64, searchID uuid.UUID) error
+}
+
+// SavedSearchRoute represents saved search API routes
+type SavedSearchRoute struct {
+	service       SavedSearchServicer
+	sessionGetter SessionDataGetter
+}
+
+type savedSearchOutput struct {
+	Body models.SavedSearch
+}
+
+type savedSearchListOutput struct {
+	Body []models.SavedSearch `nullable:"false"`
+}
+
+var SavedSearchTag = huma.Tag{
+	Name:        "Saved search",
+	Description: "Operations for getting notified when new parking matches a search.",
+}
+
+// Returns a new `SavedSearchRoute`
+func NewSavedSearchRoute(
+	service SavedSearchServicer,
+	sessionGetter SessionDataGetter,
+) *SavedSearchRoute {
+	return &SavedSearchRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+func (r *SavedSearchRoute) RegisterSavedSearchTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &SavedSearchTag)
+}
+
+// Registers saved search routes
+func (r *SavedSearchRoute) RegisterSavedSearchRoutes(api huma.API) {
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "create-saved-search",
+		Method:        http.MethodPost,
+		Path:          "/user/searches",
+		Summary:       "Save a parking spot search",
+		Description:   "A notification is sent when a spot matching the search is listed or has matching time slots added or released, at most once an hour per search.",
+		Tags:          []string{SavedSearchTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.SavedSearchInput
+	},
+	) (*savedSearchOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.Create(ctx, userID, &input.Body)
+		if err != nil {
+			var detail error
+			switch {
+			case errors.Is(err, models.ErrInvalidSavedSearchTime):
+				detail = &huma.ErrorDetail{
+					Location: "body.start_time",
+					Value:    input.Body.StartTime,
+				}
+			case errors.Is(err, models.ErrInvalidMaxPrice):
+				detail = &huma.ErrorDetail{
+					Location: "body.max_price_per_hour",
+					Value:    input.Body.MaxPricePerHour,
+				}
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+		}
+		return &savedSearchOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-saved-searches",
+		Method:      http.MethodGet,
+		Path:        "/user/searches",
+		Summary:     "Get saved searches of the current user",
+		Tags:        []string{SavedSearchTag.Name},
+	}), func(ctx context.Context, _ *struct{}) (*savedSearchListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.GetMany(ctx, userID)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &savedSearchListOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "delete-saved-search",
+		Method:      http.MethodDelete,
+		Path:        "/searches/{id}",
+		Summary:     "Delete the specified saved search",
+		Tags:        []string{SavedSearchTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.DeleteByUUID(ctx, userID, input.ID)
+		if err != nil {
+			if errors.Is(err, models.ErrSavedSearchNotFound) {
+				detail := &huma.ErrorDetail{
+					Location: "path.id",
+					Value:    input.ID,
+				}
+				return nil, NewHumaError(ctx, http.StatusNotFound, err, detail)
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return nil, nil
+	})
+}
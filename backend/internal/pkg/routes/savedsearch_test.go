@@ -0,0 +1,203 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSavedSearchService struct {
+	mock.Mock
+}
+
+// Create implements SavedSearchServicer.
+func (m *mockSavedSearchService) Create(ctx context.Context, userID int64, input *models.SavedSearchInput) (models.SavedSearch, error) {
+	args := m.Called(ctx, userID, input)
+	return args.Get(0).(models.SavedSearch), args.Error(1)
+}
+
+// GetMany implements SavedSearchServicer.
+func (m *mockSavedSearchService) GetMany(ctx context.Context, userID int64) ([]models.SavedSearch, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.SavedSearch), args.Error(1)
+}
+
+// DeleteByUUID implements SavedSearchServicer.
+func (m *mockSavedSearchService) DeleteByUUID(ctx context.Context, userID int64, searchID uuid.UUID) error {
+	args := m.Called(ctx, userID, searchID)
+	return args.Error(0)
+}
+
+func TestCreateSavedSearch(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testInput := models.SavedSearchInput{
+		Name:            "Office",
+		StartTime:       "08:00",
+		EndTime:         "17:00",
+		Weekdays:        []string{"monday", "friday"},
+		Longitude:       -97.13517,
+		Latitude:        49.8075,
+		MaxPricePerHour: 5,
+		Distance:        500,
+		Features:        models.ParkingSpotFeatures{Shelter: true},
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockSavedSearchService)
+		route := NewSavedSearchRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		expected := models.SavedSearch{
+			SavedSearchInput: testInput,
+			ID:               uuid.New(),
+		}
+		srv.On("Create", mock.Anything, testUserID, &testInput).
+			Return(expected, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/user/searches", testInput)
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.SavedSearch
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected.ID, result.ID)
+		assert.Equal(t, expected.SavedSearchInput, result.SavedSearchInput)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("invalid weekday", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockSavedSearchService)
+		route := NewSavedSearchRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		input := testInput
+		input.Weekdays = []string{"someday"}
+		resp := api.PostCtx(ctx, "/user/searches", input)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid time band", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockSavedSearchService)
+		route := NewSavedSearchRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("Create", mock.Anything, testUserID, mock.Anything).
+			Return(models.SavedSearch{}, models.ErrInvalidSavedSearchTime).
+			Once()
+
+		resp := api.PostCtx(ctx, "/user/searches", testInput)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "body.start_time",
+			Value:    testInput.StartTime,
+		}
+		assert.Equal(t, models.CodeSavedSearchInvalid.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestListSavedSearches(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	srv := new(mockSavedSearchService)
+	route := NewSavedSearchRoute(srv, fakeSessionDataGetter{})
+	_, api := humatest.New(t)
+	huma.AutoRegister(api, route)
+
+	srv.On("GetMany", mock.Anything, testUserID).
+		Return([]models.SavedSearch{}, nil).
+		Once()
+
+	resp := api.GetCtx(ctx, "/user/searches")
+	assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+	assert.JSONEq(t, "[]", resp.Body.String())
+
+	srv.AssertExpectations(t)
+}
+
+func TestDeleteSavedSearch(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	testSearchID := uuid.New()
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockSavedSearchService)
+		route := NewSavedSearchRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("DeleteByUUID", mock.Anything, testUserID, testSearchID).
+			Return(nil).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/searches/"+testSearchID.String())
+		assert.Equal(t, http.StatusNoContent, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("search not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockSavedSearchService)
+		route := NewSavedSearchRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("DeleteByUUID", mock.Anything, testUserID, testSearchID).
+			Return(models.ErrSavedSearchNotFound).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/searches/"+testSearchID.String())
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+}
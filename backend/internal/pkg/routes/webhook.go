@@ -0,0 +1,213 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// Service provider for `WebhookRoute`
+type WebhookServicer interface {
+	// Create a webhook of `userID`.
+	//
+	// The returned secret is used to sign deliveries and can not be retrieved afterwards.
+	Create(ctx context.Context, userID int64, input *models.WebhookInput) (models.WebhookWithSecret, error)
+	// Get all webhooks of `userID`.
+	GetMany(ctx context.Context, userID int64) ([]models.Webhook, error)
+	// Delete the webhook `webhookID` if `userID` owns the resource.
+	DeleteByUUID(ctx context.Context, userID int64, webhookID uuid.UUID) error
+	// Get at most `count` deliveries to the webhook `webhookID` if `userID` owns the resource, newest first.
+	//
+	// If there are more entries following the result, a non-empty cursor will be returned
+	// which can be passed to the next invocation to get the next entries.
+	GetDeliveries(ctx context.Context, userID int64, webhookID uuid.UUID, count int, after models.Cursor) ([]models.WebhookDelivery, models.Cursor, error)
+	// Queue a test event for delivery to the webhook `webhookID` if `userID` owns the resource.
+	SendTest(ctx context.Context, userID int64, webhookID uuid.UUID) (models.WebhookDelivery, error)
+}
+
+// WebhookRoute represents webhook API routes
+type WebhookRoute struct {
+	service       WebhookServicer
+	sessionGetter SessionDataGetter
+}
+
+type webhookWithSecretOutput struct {
+	Body models.WebhookWithSecret
+}
+
+type webhookListOutput struct {
+	Body []models.Webhook `nullable:"false"`
+}
+
+type webhookDeliveryOutput struct {
+	Body models.WebhookDelivery
+}
+
+type webhookDeliveryListOutput struct {
+	Link []string                 `header:"Link" doc:"Contains details on getting the next page of resources" example:"<https://example.com/webhooks/2d6a2b4e-0b6f-4a4b-8c4e-3f4b2a9a1c7d/deliveries?after=gQL>; rel=\"next\""`
+	Body []models.WebhookDelivery `nullable:"false"`
+}
+
+var WebhookTag = huma.Tag{
+	Name: "Webhook",
+	Description: "Operations for receiving events on your own HTTPS endpoints.\n\n" +
+		"Events are POSTed as JSON with the `X-Parkeasy-Event` and `X-Parkeasy-Delivery` headers. " +
+		"The `X-Parkeasy-Signature` header has the form `t=<unix time>,v1=<signature>`, where the signature is the hex-encoded " +
+		"HMAC-SHA256 of the time, a dot and the request body, keyed with the webhook secret.\n\n" +
+		"Any 2xx response acknowledges the event, other responses are retried with exponential backoff. " +
+		"The same event may be delivered more than once.",
+}
+
+// Returns a new `WebhookRoute`
+func NewWebhookRoute(
+	service WebhookServicer,
+	sessionGetter SessionDataGetter,
+) *WebhookRoute {
+	return &WebhookRoute{
+		service:       service,
+		sessionGetter: sessionGetter,
+	}
+}
+
+func (r *WebhookRoute) RegisterWebhookTag(api huma.API) {
+	api.OpenAPI().Tags = append(api.OpenAPI().Tags, &WebhookTag)
+}
+
+// Registers webhook routes
+func (r *WebhookRoute) RegisterWebhookRoutes(api huma.API) {
+	apiPrefix := getAPIPrefix(api.OpenAPI())
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "create-webhook",
+		Method:        http.MethodPost,
+		Path:          "/user/webhooks",
+		Summary:       "Create a webhook receiving the specified events",
+		Description:   "The secret used to sign deliveries is only returned on creation.",
+		Tags:          []string{WebhookTag.Name},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{http.StatusUnprocessableEntity},
+	}), func(ctx context.Context, input *struct {
+		Body models.WebhookInput
+	},
+	) (*webhookWithSecretOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.Create(ctx, userID, &input.Body)
+		if err != nil {
+			var detail error
+			if errors.Is(err, models.ErrInvalidWebhookURL) {
+				detail = &huma.ErrorDetail{
+					Location: "body.url",
+					Value:    input.Body.URL,
+				}
+			}
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err, detail)
+		}
+		return &webhookWithSecretOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-webhooks",
+		Method:      http.MethodGet,
+		Path:        "/user/webhooks",
+		Summary:     "Get webhooks of the current user",
+		Tags:        []string{WebhookTag.Name},
+	}), func(ctx context.Context, _ *struct{}) (*webhookListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.GetMany(ctx, userID)
+		if err != nil {
+			return nil, NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+		}
+		return &webhookListOutput{Body: result}, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "delete-webhook",
+		Method:      http.MethodDelete,
+		Path:        "/webhooks/{id}",
+		Summary:     "Delete the specified webhook",
+		Description: "Pending deliveries to the webhook are discarded.",
+		Tags:        []string{WebhookTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*struct{}, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		err := r.service.DeleteByUUID(ctx, userID, input.ID)
+		if err != nil {
+			return nil, webhookError(ctx, input.ID, err)
+		}
+		return nil, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID: "list-webhook-deliveries",
+		Method:      http.MethodGet,
+		Path:        "/webhooks/{id}/deliveries",
+		Summary:     "Get deliveries to the specified webhook",
+		Description: "Deliveries are ordered from newest to oldest.",
+		Tags:        []string{WebhookTag.Name},
+		Errors:      []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		After models.Cursor `query:"after" doc:"Token used for requesting the next page of resources"`
+		Count int           `query:"count" minimum:"1" default:"50" doc:"The maximum number of deliveries that appear per page."`
+		ID    uuid.UUID     `path:"id"`
+	},
+	) (*webhookDeliveryListOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		deliveries, nextCursor, err := r.service.GetDeliveries(ctx, userID, input.ID, input.Count, input.After)
+		if err != nil {
+			return nil, webhookError(ctx, input.ID, err)
+		}
+
+		result := webhookDeliveryListOutput{Body: deliveries}
+		if nextCursor != "" {
+			nextURL := apiPrefix.JoinPath("/webhooks", input.ID.String(), "deliveries")
+			nextURL.RawQuery = url.Values{
+				"count": []string{strconv.Itoa(input.Count)},
+				"after": []string{string(nextCursor)},
+			}.Encode()
+			result.Link = append(result.Link, "<"+nextURL.String()+`>; rel="next"`)
+		}
+		return &result, nil
+	})
+
+	huma.Register(api, *withUserID(&huma.Operation{
+		OperationID:   "test-webhook",
+		Method:        http.MethodPost,
+		Path:          "/webhooks/{id}/test",
+		Summary:       "Send a test event to the specified webhook",
+		Description:   "A `webhook.test` event is queued for delivery, its outcome can be followed in the delivery log.",
+		Tags:          []string{WebhookTag.Name},
+		DefaultStatus: http.StatusAccepted,
+		Errors:        []int{http.StatusNotFound},
+	}), func(ctx context.Context, input *struct {
+		ID uuid.UUID `path:"id"`
+	},
+	) (*webhookDeliveryOutput, error) {
+		userID := r.sessionGetter.Get(ctx, SessionKeyUserID).(int64)
+		result, err := r.service.SendTest(ctx, userID, input.ID)
+		if err != nil {
+			return nil, webhookError(ctx, input.ID, err)
+		}
+		return &webhookDeliveryOutput{Body: result}, nil
+	})
+}
+
+// Convert errors about the webhook `webhookID` into API errors
+func webhookError(ctx context.Context, webhookID uuid.UUID, err error) error {
+	if errors.Is(err, models.ErrWebhookNotFound) {
+		detail := &huma.ErrorDetail{
+			Location: "path.id",
+			Value:    webhookID,
+		}
+		return NewHumaError(ctx, http.StatusNotFound, err, detail)
+	}
+	return NewHumaError(ctx, http.StatusUnprocessableEntity, err)
+}
@@ -0,0 +1,308 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/google/uuid"
+	"github.com/peterhellberg/link"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWebhookService struct {
+	mock.Mock
+}
+
+// Create implements WebhookServicer.
+func (m *mockWebhookService) Create(ctx context.Context, userID int64, input *models.WebhookInput) (models.WebhookWithSecret, error) {
+	args := m.Called(ctx, userID, input)
+	return args.Get(0).(models.WebhookWithSecret), args.Error(1)
+}
+
+// GetMany implements WebhookServicer.
+func (m *mockWebhookService) GetMany(ctx context.Context, userID int64) ([]models.Webhook, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.Webhook), args.Error(1)
+}
+
+// DeleteByUUID implements WebhookServicer.
+func (m *mockWebhookService) DeleteByUUID(ctx context.Context, userID int64, webhookID uuid.UUID) error {
+	args := m.Called(ctx, userID, webhookID)
+	return args.Error(0)
+}
+
+// GetDeliveries implements WebhookServicer.
+func (m *mockWebhookService) GetDeliveries(ctx context.Context, userID int64, webhookID uuid.UUID, count int, after models.Cursor) ([]models.WebhookDelivery, models.Cursor, error) {
+	args := m.Called(ctx, userID, webhookID, count, after)
+	return args.Get(0).([]models.WebhookDelivery), args.Get(1).(models.Cursor), args.Error(2)
+}
+
+// SendTest implements WebhookServicer.
+func (m *mockWebhookService) SendTest(ctx context.Context, userID int64, webhookID uuid.UUID) (models.WebhookDelivery, error) {
+	args := m.Called(ctx, userID, webhookID)
+	return args.Get(0).(models.WebhookDelivery), args.Error(1)
+}
+
+func TestCreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	input := models.WebhookInput{
+		URL:    "https://example.com/hook",
+		Events: []string{models.WebhookEventBookingCreated, models.WebhookEventAvailabilityChanged},
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockWebhookService)
+		route := NewWebhookRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		expected := models.WebhookWithSecret{
+			Secret: "whsec_secret",
+			Webhook: models.Webhook{
+				WebhookInput: input,
+				ID:           uuid.New(),
+			},
+		}
+		srv.On("Create", mock.Anything, testUserID, &input).
+			Return(expected, nil).
+			Once()
+
+		resp := api.PostCtx(ctx, "/user/webhooks", input)
+		assert.Equal(t, http.StatusCreated, resp.Result().StatusCode)
+
+		var result models.WebhookWithSecret
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, expected.Secret, result.Secret)
+		assert.Equal(t, expected.ID, result.ID)
+		assert.Equal(t, input, result.WebhookInput)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("unknown event type", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockWebhookService)
+		route := NewWebhookRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		resp := api.PostCtx(ctx, "/user/webhooks", models.WebhookInput{
+			URL:    input.URL,
+			Events: []string{models.WebhookEventTest},
+		})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("invalid URL", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockWebhookService)
+		route := NewWebhookRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		insecure := models.WebhookInput{
+			URL:    "http://example.com/hook",
+			Events: input.Events,
+		}
+		srv.On("Create", mock.Anything, testUserID, &insecure).
+			Return(models.WebhookWithSecret{}, models.ErrInvalidWebhookURL).
+			Once()
+
+		resp := api.PostCtx(ctx, "/user/webhooks", insecure)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "body.url",
+			Value:    insecure.URL,
+		}
+		assert.Equal(t, models.CodeWebhookInvalid.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	webhookID := uuid.New()
+	deliveries := []models.WebhookDelivery{
+		{
+			EventType: models.WebhookEventBookingCreated,
+			Status:    models.WebhookDeliverySucceeded,
+			Attempts:  1,
+			EventID:   uuid.New(),
+			ID:        uuid.New(),
+		},
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockWebhookService)
+		route := NewWebhookRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetDeliveries", mock.Anything, testUserID, webhookID, 1, models.Cursor("")).
+			Return(deliveries, models.Cursor("next"), nil).
+			Once()
+
+		resp := api.GetCtx(ctx, "/webhooks/"+webhookID.String()+"/deliveries?count=1")
+		assert.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		var result []models.WebhookDelivery
+		err := json.NewDecoder(resp.Result().Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, deliveries, result)
+
+		links := link.ParseResponse(resp.Result())
+		if assert.NotEmpty(t, links["next"]) {
+			assert.Contains(t, links["next"].URI, "/webhooks/"+webhookID.String()+"/deliveries")
+			assert.Contains(t, links["next"].URI, "after=next")
+		}
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("webhook not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockWebhookService)
+		route := NewWebhookRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("GetDeliveries", mock.Anything, testUserID, webhookID, 50, models.Cursor("")).
+			Return([]models.WebhookDelivery(nil), models.Cursor(""), models.ErrWebhookNotFound).
+			Once()
+
+		resp := api.GetCtx(ctx, "/webhooks/"+webhookID.String()+"/deliveries")
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		var errModel huma.ErrorModel
+		err := json.NewDecoder(resp.Result().Body).Decode(&errModel)
+		require.NoError(t, err)
+
+		testDetail := huma.ErrorDetail{
+			Location: "path.id",
+			Value:    jsonAnyify(webhookID),
+		}
+		assert.Equal(t, models.CodeNotFound.TypeURI(), errModel.Type)
+		assert.Contains(t, errModel.Errors, &testDetail)
+
+		srv.AssertExpectations(t)
+	})
+}
+
+func TestSendTestWebhook(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	webhookID := uuid.New()
+
+	srv := new(mockWebhookService)
+	route := NewWebhookRoute(srv, fakeSessionDataGetter{})
+	_, api := humatest.New(t)
+	huma.AutoRegister(api, route)
+
+	expected := models.WebhookDelivery{
+		EventType: models.WebhookEventTest,
+		Status:    models.WebhookDeliveryPending,
+		EventID:   uuid.New(),
+		ID:        uuid.New(),
+	}
+	srv.On("SendTest", mock.Anything, testUserID, webhookID).
+		Return(expected, nil).
+		Once()
+
+	resp := api.PostCtx(ctx, "/webhooks/"+webhookID.String()+"/test")
+	assert.Equal(t, http.StatusAccepted, resp.Result().StatusCode)
+
+	var result models.WebhookDelivery
+	err := json.NewDecoder(resp.Result().Body).Decode(&result)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	srv.AssertExpectations(t)
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	const testUserID = int64(0)
+	ctx = context.WithValue(ctx, fakeSessionDataKey(SessionKeyUserID), testUserID)
+
+	webhookID := uuid.New()
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockWebhookService)
+		route := NewWebhookRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("DeleteByUUID", mock.Anything, testUserID, webhookID).
+			Return(nil).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/webhooks/"+webhookID.String())
+		assert.Equal(t, http.StatusNoContent, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		srv := new(mockWebhookService)
+		route := NewWebhookRoute(srv, fakeSessionDataGetter{})
+		_, api := humatest.New(t)
+		huma.AutoRegister(api, route)
+
+		srv.On("DeleteByUUID", mock.Anything, testUserID, webhookID).
+			Return(models.ErrWebhookNotFound).
+			Once()
+
+		resp := api.DeleteCtx(ctx, "/webhooks/"+webhookID.String())
+		assert.Equal(t, http.StatusNotFound, resp.Result().StatusCode)
+
+		srv.AssertExpectations(t)
+	})
+}
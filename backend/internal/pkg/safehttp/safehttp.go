@@ -0,0 +1,137 @@
+// HTTP requests to URLs provided by users, which must not reach the internal network
+package safehttp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"time"
+)
+
+// Largest number of redirects followed per request
+const MaxRedirects = 10
+
+var ErrForbiddenAddress = errors.New("address is not publicly routable")
+
+// Addresses that are not publicly routable, but not reported by netip either
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "This network"
+	netip.MustParsePrefix("100.64.0.0/10"),   // Carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),   // Benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),     // Reserved
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // Local-use IPv4/IPv6 translation
+	netip.MustParsePrefix("fec0::/10"),       // Deprecated site-local
+	netip.MustParsePrefix("::ffff:0:0:0/96"), // IPv4-translated
+}
+
+// Guard refuses connections to loopback, private, link-local and other addresses that are not
+// publicly routable.
+//
+// Addresses are checked when connecting rather than only when a URL is accepted, so hosts resolving
+// to other addresses afterwards or redirecting to internal URLs are refused as well.
+type Guard struct {
+	resolver      *net.Resolver
+	dialer        net.Dialer
+	allowLoopback bool
+}
+
+// Create a new guard.
+//
+// `allowLoopback` allows connections to loopback addresses, and should only be set for tests and
+// development.
+func New(allowLoopback bool) *Guard {
+	return &Guard{
+		resolver: net.DefaultResolver,
+		dialer: net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		},
+		allowLoopback: allowLoopback,
+	}
+}
+
+// Whether connections to `addr` are allowed
+func (g *Guard) Allowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() {
+		return g.allowLoopback
+	}
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Resolve `host` to the addresses to connect to.
+//
+// Returns ErrForbiddenAddress if any address is not allowed.
+func (g *Guard) Resolve(ctx context.Context, host string) ([]netip.Addr, error) {
+	addrs, err := g.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		if !g.Allowed(addr) {
+			return nil, fmt.Errorf("%w: %v resolves to %v", ErrForbiddenAddress, host, addr)
+		}
+	}
+	return addrs, nil
+}
+
+// Connect to `address` if it only resolves to allowed addresses.
+//
+// The connection is made to the checked address, so the host can not be resolved again to
+// another address.
+func (g *Guard) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := g.Resolve(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, addr := range addrs {
+		conn, err := g.dialer.DialContext(ctx, network, net.JoinHostPort(addr.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errors.Join(errs...)
+}
+
+// Create an HTTP client connecting through the guard.
+//
+// Proxies are not used, and redirects are only followed to HTTP or HTTPS URLs of allowed hosts.
+func (g *Guard) Client() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = g.DialContext
+	return &http.Client{
+		Transport:     transport,
+		CheckRedirect: g.checkRedirect,
+	}
+}
+
+func (g *Guard) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= MaxRedirects {
+		return fmt.Errorf("stopped after %d redirects", MaxRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("redirect to unsupported scheme %q", req.URL.Scheme)
+	}
+	_, err := g.Resolve(req.Context(), req.URL.Hostname())
+	return err
+}
@@ -0,0 +1,81 @@
+package safehttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowed(t *testing.T) {
+	t.Parallel()
+
+	guard := New(false)
+	for _, raw := range []string{"8.8.8.8", "203.0.113.10", "2606:4700::1111", "::ffff:8.8.8.8"} {
+		assert.True(t, guard.Allowed(netip.MustParseAddr(raw)), raw)
+	}
+	for _, raw := range []string{
+		"127.0.0.1", "::1", "::ffff:127.0.0.1",
+		"10.1.2.3", "172.16.0.1", "192.168.1.1", "fd00::1",
+		"169.254.169.254", "fe80::1",
+		"0.0.0.0", "::",
+		"100.64.0.1", "224.0.0.1", "255.255.255.255",
+	} {
+		assert.False(t, guard.Allowed(netip.MustParseAddr(raw)), raw)
+	}
+
+	assert.True(t, New(true).Allowed(netip.MustParseAddr("127.0.0.1")))
+	assert.False(t, New(true).Allowed(netip.MustParseAddr("10.1.2.3")))
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	get := func(client *http.Client, url string) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return resp, err
+	}
+
+	t.Run("loopback is refused", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := get(New(false).Client(), server.URL)
+		require.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+
+	t.Run("loopback is allowed if enabled", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := get(New(true).Client(), server.URL)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("redirects to internal addresses are refused", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := get(New(true).Client(), server.URL+"/internal")
+		require.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+}
@@ -0,0 +1,176 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/alert"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/notification"
+	"github.com/google/uuid"
+)
+
+// Minimum duration between two notifications of the same alert
+const Cooldown = time.Hour
+
+// Service notifies drivers when time slots of the spots or areas they watch become available.
+type Service struct {
+	repo     alert.Repository
+	spotRepo parkingspot.Repository
+	sender   notification.Sender
+}
+
+func New(repo alert.Repository, spotRepo parkingspot.Repository, sender notification.Sender) *Service {
+	return &Service{
+		repo:     repo,
+		spotRepo: spotRepo,
+		sender:   sender,
+	}
+}
+
+// Create an alert for `userID` on availability of the spot `spotID`.
+func (s *Service) CreateForSpot(ctx context.Context, userID int64, spotID uuid.UUID) (models.AvailabilityAlert, error) {
+	spotEntry, err := s.spotRepo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return models.AvailabilityAlert{}, err
+	}
+
+	return s.create(ctx, &alert.CreateInput{
+		UserID: userID,
+		SpotID: spotEntry.InternalID,
+	})
+}
+
+// Create an alert for `userID` on availability of spots within `area`.
+func (s *Service) CreateForArea(ctx context.Context, userID int64, area *models.AvailabilityAlertArea) (models.AvailabilityAlert, error) {
+	return s.create(ctx, &alert.CreateInput{
+		Area:   area,
+		UserID: userID,
+	})
+}
+
+// Get all alerts of `userID`, newest first.
+func (s *Service) GetMany(ctx context.Context, userID int64) ([]models.AvailabilityAlert, error) {
+	entries, err := s.repo.GetMany(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.AvailabilityAlert, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.AvailabilityAlert)
+	}
+	return result, nil
+}
+
+// Delete the alert `alertID` of `userID`.
+func (s *Service) DeleteByUUID(ctx context.Context, userID int64, alertID uuid.UUID) error {
+	entry, err := s.repo.GetByUUID(ctx, alertID)
+	if err != nil {
+		if errors.Is(err, alert.ErrNotFound) {
+			err = models.ErrAlertNotFound
+		}
+		return err
+	}
+	// Pretend that alerts of other users do not exist
+	if entry.UserID != userID {
+		return models.ErrAlertNotFound
+	}
+
+	err = s.repo.DeleteByUUID(ctx, alertID)
+	if err != nil {
+		if errors.Is(err, alert.ErrNotFound) {
+			err = models.ErrAlertNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) create(ctx context.Context, input *alert.CreateInput) (models.AvailabilityAlert, error) {
+	existing, err := s.repo.GetMany(ctx, input.UserID)
+	if err != nil {
+		return models.AvailabilityAlert{}, err
+	}
+	if len(existing) >= models.MaximumAlertsPerUser {
+		return models.AvailabilityAlert{}, models.ErrTooManyAlerts
+	}
+
+	entry, err := s.repo.Create(ctx, input)
+	if err != nil {
+		if errors.Is(err, alert.ErrDuplicate) {
+			err = models.ErrAlertDuplicate
+		}
+		return models.AvailabilityAlert{}, err
+	}
+	return entry.AvailabilityAlert, nil
+}
+
+// Notify the drivers watching the spot or area of `event` when its time slots become available.
+//
+// Subscribes to availability events of the outbox.
+func (s *Service) HandleAvailability(ctx context.Context, event *models.AvailabilityEvent) error {
+	var verb string
+	switch event.Type {
+	case models.AvailabilityEventAdded:
+		verb = "now available"
+	case models.AvailabilityEventReleased:
+		verb = "released"
+	default:
+		// Slots are not becoming available
+		return nil
+	}
+
+	spotEntry, err := s.spotRepo.GetByUUID(ctx, event.SpotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			// The spot was deleted since
+			return nil
+		}
+		return err
+	}
+
+	now := time.Now()
+	alerts, err := s.repo.ClaimMatching(ctx, &alert.Target{
+		Longitude: spotEntry.Location.Longitude,
+		Latitude:  spotEntry.Location.Latitude,
+		SpotID:    spotEntry.InternalID,
+		OwnerID:   spotEntry.OwnerID,
+	}, now.Add(-Cooldown), now)
+	if err != nil {
+		return err
+	}
+
+	slots := "time slots are"
+	if len(event.Times) == 1 {
+		slots = "time slot is"
+	}
+	input := models.NotificationInput{
+		Type:      models.NotificationSpotAvailable,
+		Title:     "Parking available",
+		Body:      fmt.Sprintf("%d %s %s at %s, %s.", len(event.Times), slots, verb, spotEntry.Location.StreetAddress, spotEntry.Location.City),
+		SubjectID: event.SpotID,
+		EventID:   event.ID,
+	}
+
+	// The same user might have multiple matching alerts
+	notified := make(map[int64]struct{}, len(alerts))
+	for _, entry := range alerts {
+		if _, ok := notified[entry.UserID]; ok {
+			continue
+		}
+		notified[entry.UserID] = struct{}{}
+
+		_, err := s.sender.Send(ctx, entry.UserID, &input)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/alert"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+// Create implements alert.Repository.
+func (m *mockRepo) Create(ctx context.Context, input *alert.CreateInput) (alert.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(alert.Entry), args.Error(1)
+}
+
+// GetByUUID implements alert.Repository.
+func (m *mockRepo) GetByUUID(ctx context.Context, alertID uuid.UUID) (alert.Entry, error) {
+	args := m.Called(ctx, alertID)
+	return args.Get(0).(alert.Entry), args.Error(1)
+}
+
+// GetMany implements alert.Repository.
+func (m *mockRepo) GetMany(ctx context.Context, userID int64) ([]alert.Entry, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]alert.Entry), args.Error(1)
+}
+
+// DeleteByUUID implements alert.Repository.
+func (m *mockRepo) DeleteByUUID(ctx context.Context, alertID uuid.UUID) error {
+	args := m.Called(ctx, alertID)
+	return args.Error(0)
+}
+
+// ClaimMatching implements alert.Repository.
+func (m *mockRepo) ClaimMatching(ctx context.Context, target *alert.Target, notifiedBefore, now time.Time) ([]alert.Entry, error) {
+	args := m.Called(ctx, target, notifiedBefore, now)
+	return args.Get(0).([]alert.Entry), args.Error(1)
+}
+
+type mockParkingspotRepo struct {
+	mock.Mock
+}
+
+// Create implements parkingspot.Repository.
+func (m *mockParkingspotRepo) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (parkingspot.Entry, []models.TimeUnit, error) {
+	args := m.Called(ctx, userID, spot)
+	return args.Get(0).(parkingspot.Entry), args.Get(1).([]models.TimeUnit), args.Error(2)
+}
+
+// GetByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetByUUID(ctx context.Context, spotID uuid.UUID) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// GetOwnerByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetOwnerByUUID(ctx context.Context, spotID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetAvailByUUID(ctx context.Context, spotID uuid.UUID, startDate, endDate time.Time) ([]models.TimeUnit, error) {
+	args := m.Called(ctx, spotID, startDate, endDate)
+	return args.Get(0).([]models.TimeUnit), args.Error(1)
+}
+
+// GetMany implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetMany(ctx context.Context, limit int, filter *parkingspot.Filter) ([]parkingspot.GetManyEntry, error) {
+	args := m.Called(ctx, limit, filter)
+	return args.Get(0).([]parkingspot.GetManyEntry), args.Error(1)
+}
+
+// UpdateSpotByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateSpotByUUID(ctx context.Context, spotID uuid.UUID, updateSpot *models.ParkingSpotUpdateInput) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID, updateSpot)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// UpdateAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateAvailByUUID(ctx context.Context, spotID uuid.UUID, updateTimes *models.ParkingSpotAvailUpdateInput) error {
+	args := m.Called(ctx, spotID, updateTimes)
+	return args.Error(0)
+}
+
+type mockSender struct {
+	mock.Mock
+}
+
+// Send implements notification.Sender.
+func (m *mockSender) Send(ctx context.Context, userID int64, input *models.NotificationInput) (models.Notification, error) {
+	args := m.Called(ctx, userID, input)
+	return args.Get(0).(models.Notification), args.Error(1)
+}
+
+const (
+	testOwnerID        = int64(1)
+	testUserID         = int64(2)
+	testSpotInternalID = int64(3)
+)
+
+var (
+	testSpotUUID  = uuid.New()
+	testSpotEntry = parkingspot.Entry{
+		ParkingSpot: models.ParkingSpot{
+			Location: models.ParkingSpotLocation{
+				StreetAddress: "5 Niagara Parkway",
+				City:          "Niagara Falls",
+				Latitude:      43.07923,
+				Longitude:     -79.07887,
+			},
+			ID: testSpotUUID,
+		},
+		InternalID: testSpotInternalID,
+		OwnerID:    testOwnerID,
+	}
+	testTimes = []models.TimeUnit{
+		{
+			StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			StartTime: time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 30, 0, 0, time.UTC),
+		},
+	}
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("alert for a spot", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		srv := New(repo, spotRepo, nil)
+
+		expected := alert.Entry{
+			AvailabilityAlert: models.AvailabilityAlert{
+				SpotID: testSpotUUID,
+				ID:     uuid.New(),
+			},
+			InternalID: 1,
+			UserID:     testUserID,
+			SpotID:     testSpotInternalID,
+		}
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return([]alert.Entry{}, nil).
+			Once()
+		repo.On("Create", mock.Anything, &alert.CreateInput{UserID: testUserID, SpotID: testSpotInternalID}).
+			Return(expected, nil).
+			Once()
+
+		result, err := srv.CreateForSpot(ctx, testUserID, testSpotUUID)
+		require.NoError(t, err)
+		assert.Equal(t, expected.AvailabilityAlert, result)
+		repo.AssertExpectations(t)
+		spotRepo.AssertExpectations(t)
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		srv := New(nil, spotRepo, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).
+			Once()
+
+		_, err := srv.CreateForSpot(ctx, testUserID, testSpotUUID)
+		require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		spotRepo.AssertExpectations(t)
+	})
+
+	t.Run("duplicate spot alert", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		srv := New(repo, spotRepo, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return([]alert.Entry{}, nil).
+			Once()
+		repo.On("Create", mock.Anything, mock.Anything).
+			Return(alert.Entry{}, alert.ErrDuplicate).
+			Once()
+
+		_, err := srv.CreateForSpot(ctx, testUserID, testSpotUUID)
+		require.ErrorIs(t, err, models.ErrAlertDuplicate)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("too many alerts", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil)
+
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return(make([]alert.Entry, models.MaximumAlertsPerUser), nil).
+			Once()
+
+		_, err := srv.CreateForArea(ctx, testUserID, &models.AvailabilityAlertArea{
+			Longitude: -79.07887,
+			Latitude:  43.07923,
+			Distance:  500,
+		})
+		require.ErrorIs(t, err, models.ErrTooManyAlerts)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	alertID := uuid.New()
+
+	t.Run("delete own alert", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil)
+
+		repo.On("GetByUUID", mock.Anything, alertID).
+			Return(alert.Entry{UserID: testUserID}, nil).
+			Once()
+		repo.On("DeleteByUUID", mock.Anything, alertID).
+			Return(nil).
+			Once()
+
+		err := srv.DeleteByUUID(ctx, testUserID, alertID)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("alerts of others are hidden", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil)
+
+		repo.On("GetByUUID", mock.Anything, alertID).
+			Return(alert.Entry{UserID: testOwnerID}, nil).
+			Once()
+
+		err := srv.DeleteByUUID(ctx, testUserID, alertID)
+		require.ErrorIs(t, err, models.ErrAlertNotFound)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestHandleAvailability(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := new(mockRepo)
+	spotRepo := new(mockParkingspotRepo)
+	sender := new(mockSender)
+	srv := New(repo, spotRepo, sender)
+
+	spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+		Return(testSpotEntry, nil).
+		Once()
+	repo.On("ClaimMatching", mock.Anything, &alert.Target{
+		Longitude: testSpotEntry.Location.Longitude,
+		Latitude:  testSpotEntry.Location.Latitude,
+		SpotID:    testSpotInternalID,
+		OwnerID:   testOwnerID,
+	}, mock.Anything, mock.Anything).
+		Return([]alert.Entry{
+			{InternalID: 1, UserID: testUserID},
+			// Area alert of the same user
+			{InternalID: 2, UserID: testUserID},
+		}, nil).
+		Once()
+
+	sent := make(chan *models.NotificationInput, 1)
+	sender.On("Send", mock.Anything, testUserID, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent <- args.Get(2).(*models.NotificationInput)
+		}).
+		Return(models.Notification{}, nil).
+		Once()
+
+	// Not an event of new availability
+	err := srv.HandleAvailability(ctx, &models.AvailabilityEvent{
+		Type:   models.AvailabilityEventBooked,
+		Times:  testTimes,
+		SpotID: testSpotUUID,
+	})
+	require.NoError(t, err)
+	eventID := uuid.New()
+	err = srv.HandleAvailability(ctx, &models.AvailabilityEvent{
+		Type:   models.AvailabilityEventAdded,
+		Times:  testTimes,
+		SpotID: testSpotUUID,
+		ID:     eventID,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 1)
+	input := <-sent
+	assert.Equal(t, models.NotificationSpotAvailable, input.Type)
+	assert.Equal(t, testSpotUUID, input.SubjectID)
+	assert.Equal(t, eventID, input.EventID)
+	assert.Equal(t, "2 time slots are now available at 5 Niagara Parkway, Niagara Falls.", input.Body)
+	spotRepo.AssertExpectations(t)
+	repo.AssertExpectations(t)
+	sender.AssertExpectations(t)
+}
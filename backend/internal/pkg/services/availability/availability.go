@@ -0,0 +1,145 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/availability"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Number of events buffered for each subscriber.
+//
+// Events are dropped for subscribers that fall further behind.
+const subscriberBuffer = 32
+
+// Delay before listening again after the listener failed
+const retryDelay = 5 * time.Second
+
+// Mean radius of the Earth in meters
+const earthRadius = 6371000
+
+type subscriber struct {
+	events chan models.AvailabilityEvent
+	match  func(event *models.AvailabilityEvent) bool
+}
+
+// Service delivers availability events published by any server to subscribers of this server.
+type Service struct {
+	listener    availability.Listener
+	spotRepo    parkingspot.Repository
+	subscribers map[*subscriber]struct{}
+	mu          sync.Mutex
+}
+
+func New(listener availability.Listener, spotRepo parkingspot.Repository) *Service {
+	return &Service{
+		listener:    listener,
+		spotRepo:    spotRepo,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Deliver events to subscribers until `ctx` is cancelled.
+func (s *Service) Run(ctx context.Context) {
+	for {
+		err := s.listener.Listen(ctx, s.publish)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Ctx(ctx).Err(err).Msg("availability listener stopped, retrying")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+// Subscribe to availability events of the spot `spotID`.
+//
+// The returned channel is closed once `ctx` is cancelled.
+func (s *Service) SubscribeSpot(ctx context.Context, spotID uuid.UUID) (<-chan models.AvailabilityEvent, error) {
+	_, err := s.spotRepo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return nil, err
+	}
+
+	return s.subscribe(ctx, func(event *models.AvailabilityEvent) bool {
+		return event.SpotID == spotID
+	}), nil
+}
+
+// Subscribe to availability events of spots within `filter`.
+//
+// The returned channel is closed once `ctx` is cancelled.
+func (s *Service) SubscribeArea(ctx context.Context, filter *models.AvailabilityAreaFilter) (<-chan models.AvailabilityEvent, error) {
+	if filter.Distance < 1 || filter.Distance > models.MaxAreaDistance {
+		return nil, models.ErrInvalidAreaDistance
+	}
+
+	latitude, longitude, radius := filter.Latitude, filter.Longitude, float64(filter.Distance)
+	return s.subscribe(ctx, func(event *models.AvailabilityEvent) bool {
+		return distance(latitude, longitude, event.Latitude, event.Longitude) <= radius
+	}), nil
+}
+
+func (s *Service) subscribe(ctx context.Context, match func(event *models.AvailabilityEvent) bool) <-chan models.AvailabilityEvent {
+	sub := &subscriber{
+		events: make(chan models.AvailabilityEvent, subscriberBuffer),
+		match:  match,
+	}
+
+	s.mu.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}()
+
+	return sub.events
+}
+
+func (s *Service) publish(event *models.AvailabilityEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subscribers {
+		if !sub.match(event) {
+			continue
+		}
+		select {
+		case sub.events <- *event:
+		default:
+			log.Warn().Stringer("spotid", event.SpotID).Msg("subscriber is falling behind, dropping availability event")
+		}
+	}
+}
+
+// Returns the great-circle distance in meters between two coordinates
+func distance(lat1, long1, lat2, long2 float64) float64 {
+	phi1 := lat1 * math.Pi / 180
+	phi2 := lat2 * math.Pi / 180
+	deltaPhi := (lat2 - lat1) * math.Pi / 180
+	deltaLambda := (long2 - long1) * math.Pi / 180
+
+	a := math.Sin(deltaPhi/2)*math.Sin(deltaPhi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(deltaLambda/2)*math.Sin(deltaLambda/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,176 @@
+package availability
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockParkingspotRepo struct {
+	mock.Mock
+}
+
+// Create implements parkingspot.Repository.
+func (m *mockParkingspotRepo) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (parkingspot.Entry, []models.TimeUnit, error) {
+	args := m.Called(ctx, userID, spot)
+	return args.Get(0).(parkingspot.Entry), args.Get(1).([]models.TimeUnit), args.Error(2)
+}
+
+// GetByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetByUUID(ctx context.Context, spotID uuid.UUID) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// GetOwnerByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetOwnerByUUID(ctx context.Context, spotID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetAvailByUUID(ctx context.Context, spotID uuid.UUID, startDate, endDate time.Time) ([]models.TimeUnit, error) {
+	args := m.Called(ctx, spotID, startDate, endDate)
+	return args.Get(0).([]models.TimeUnit), args.Error(1)
+}
+
+// GetMany implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetMany(ctx context.Context, limit int, filter *parkingspot.Filter) ([]parkingspot.GetManyEntry, error) {
+	args := m.Called(ctx, limit, filter)
+	return args.Get(0).([]parkingspot.GetManyEntry), args.Error(1)
+}
+
+// UpdateSpotByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateSpotByUUID(ctx context.Context, spotID uuid.UUID, updateSpot *models.ParkingSpotUpdateInput) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID, updateSpot)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// UpdateAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateAvailByUUID(ctx context.Context, spotID uuid.UUID, updateTimes *models.ParkingSpotAvailUpdateInput) error {
+	args := m.Called(ctx, spotID, updateTimes)
+	return args.Error(0)
+}
+
+// A listener delivering events sent on a channel
+type chanListener chan models.AvailabilityEvent
+
+func (c chanListener) Listen(ctx context.Context, handle func(event *models.AvailabilityEvent)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-c:
+			handle(&event)
+		}
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	listener := make(chanListener)
+	spotRepo := new(mockParkingspotRepo)
+	service := New(listener, spotRepo)
+	go service.Run(ctx)
+
+	testSpotID := uuid.New()
+	spotRepo.On("GetByUUID", mock.Anything, testSpotID).
+		Return(parkingspot.Entry{}, nil).
+		Once()
+
+	spotCtx, spotCancel := context.WithCancel(ctx)
+	spotEvents, err := service.SubscribeSpot(spotCtx, testSpotID)
+	require.NoError(t, err)
+
+	areaEvents, err := service.SubscribeArea(ctx, &models.AvailabilityAreaFilter{
+		Longitude: -79.07887,
+		Latitude:  43.07923,
+		Distance:  1000,
+	})
+	require.NoError(t, err)
+
+	near := models.AvailabilityEvent{
+		Type:      models.AvailabilityEventAdded,
+		Longitude: -79.07880,
+		Latitude:  43.07920,
+		SpotID:    testSpotID,
+	}
+	// Roughly 1.1km north of the area centre
+	far := models.AvailabilityEvent{
+		Type:      models.AvailabilityEventBooked,
+		Longitude: -79.07887,
+		Latitude:  43.08923,
+		SpotID:    uuid.New(),
+	}
+	listener <- near
+	listener <- far
+
+	assert.Equal(t, near, <-spotEvents)
+	assert.Equal(t, near, <-areaEvents)
+
+	// Events of other spots and outside the area are not delivered
+	select {
+	case event := <-spotEvents:
+		t.Errorf("unexpected spot event: %v", event)
+	case event := <-areaEvents:
+		t.Errorf("unexpected area event: %v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	spotCancel()
+	_, ok := <-spotEvents
+	assert.False(t, ok, "channel should be closed once the subscription ends")
+
+	spotRepo.AssertExpectations(t)
+}
+
+func TestSubscribeSpotNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	spotRepo := new(mockParkingspotRepo)
+	service := New(nil, spotRepo)
+
+	testSpotID := uuid.New()
+	spotRepo.On("GetByUUID", mock.Anything, testSpotID).
+		Return(parkingspot.Entry{}, parkingspot.ErrNotFound).
+		Once()
+
+	_, err := service.SubscribeSpot(ctx, testSpotID)
+	require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+}
+
+func TestSubscribeAreaInvalidDistance(t *testing.T) {
+	t.Parallel()
+
+	service := New(nil, nil)
+	for _, distance := range []int32{0, models.MaxAreaDistance + 1} {
+		_, err := service.SubscribeArea(context.Background(), &models.AvailabilityAreaFilter{
+			Longitude: -79.07887,
+			Latitude:  43.07923,
+			Distance:  distance,
+		})
+		assert.ErrorIs(t, err, models.ErrInvalidAreaDistance, distance)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	t.Parallel()
+
+	// Toronto to Montreal
+	assert.InDelta(t, 504000, distance(43.6532, -79.3832, 45.5019, -73.5674), 2000)
+	assert.InDelta(t, 0, distance(43.07923, -79.07887, 43.07923, -79.07887), 0.001)
+}
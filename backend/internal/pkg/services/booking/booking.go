@@ -4,11 +4,20 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"time"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/region"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/car"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/hold"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/promocode"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/quote"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/review"
+	carService "github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/car"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/notification"
 	"github.com/aarondl/opt/omit"
 	"github.com/fxamacker/cbor/v2"
 	"github.com/google/uuid"
@@ -18,17 +27,32 @@ import (
 // Largest number of entries returned per request
 const MaximumCount = 1000
 
+// Duration for which a booking quote can be used
+const QuoteLifetime = 15 * time.Minute
+
 type Service struct {
-	repo     booking.Repository
-	spotRepo parkingspot.Repository
-	carRepo  car.Repository
+	repo          booking.Repository
+	spotRepo      parkingspot.Repository
+	carRepo       car.Repository
+	pricingRepo   pricing.Repository
+	quoteRepo     quote.Repository
+	promoCodeRepo promocode.Repository
+	reviewRepo    review.Repository
+	holdRepo      hold.Repository
+	sender        notification.Sender
 }
 
-func New(repo booking.Repository, spotRepo parkingspot.Repository, carRepo car.Repository) *Service {
+func New(repo booking.Repository, spotRepo parkingspot.Repository, carRepo car.Repository, pricingRepo pricing.Repository, quoteRepo quote.Repository, promoCodeRepo promocode.Repository, reviewRepo review.Repository, holdRepo hold.Repository, sender notification.Sender) *Service {
 	return &Service{
-		repo:     repo,
-		spotRepo: spotRepo,
-		carRepo:  carRepo,
+		repo:          repo,
+		spotRepo:      spotRepo,
+		carRepo:       carRepo,
+		pricingRepo:   pricingRepo,
+		quoteRepo:     quoteRepo,
+		promoCodeRepo: promoCodeRepo,
+		reviewRepo:    reviewRepo,
+		holdRepo:      holdRepo,
+		sender:        sender,
 	}
 }
 
@@ -59,21 +83,55 @@ func (s *Service) Create(ctx context.Context, userID int64, spotUUID uuid.UUID,
 	if carEntry.OwnerID != userID {
 		return 0, models.BookingWithTimes{}, models.ErrCarNotOwned
 	}
+	// Check if the car is allowed on the parking spot
+	if !carService.SizeOf(&carEntry.Details).Fits(&parkingSpot.Restrictions) {
+		return 0, models.BookingWithTimes{}, models.ErrCarDoesNotFit
+	}
 
-	// Calculate amount for booking
-	amount := calculateAmount(len(bookingDetails.BookedTimes), parkingSpot.PricePerHour)
 	creationInput := booking.CreateInput{
 		BookedTimes: bookingDetails.BookedTimes,
 		UserID:      userID,
 		SpotID:      parkingSpot.InternalID,
 		CarID:       carEntry.InternalID,
-		PaidAmount:  amount,
+	}
+
+	// Convert the hold placed during checkout, if any
+	if bookingDetails.HoldID != uuid.Nil {
+		holdEntry, err := s.getHold(ctx, userID, parkingSpot.InternalID, bookingDetails.HoldID)
+		if err != nil {
+			return 0, models.BookingWithTimes{}, err
+		}
+		creationInput.HoldID = holdEntry.InternalID
+	}
+
+	// Calculate amount for booking, using the quoted price if there is one
+	if bookingDetails.QuoteID != uuid.Nil {
+		creationInput.QuoteID = bookingDetails.QuoteID
+		if bookingDetails.PromoCode != "" {
+			creationInput.QuotePromoCode = promocode.NormalizeCode(bookingDetails.PromoCode)
+		}
+	} else {
+		priceQuote, promoCodeID, err := s.quote(ctx, &parkingSpot, bookingDetails.BookedTimes, bookingDetails.PromoCode)
+		if err != nil {
+			return 0, models.BookingWithTimes{}, err
+		}
+		creationInput.PaidAmount = priceQuote.Total
+		creationInput.PromoCodeID = promoCodeID
+		creationInput.DiscountAmount = priceQuote.Discount
+		creationInput.PayoutAmount = payoutAmount(&priceQuote)
 	}
 
 	result, err := s.repo.Create(ctx, &creationInput)
 	if err != nil {
-		if errors.Is(err, booking.ErrTimeAlreadyBooked) {
+		switch {
+		case errors.Is(err, booking.ErrTimeAlreadyBooked):
 			err = models.ErrDuplicateBooking
+		case errors.Is(err, booking.ErrPromoCodeExhausted):
+			err = models.ErrPromoCodeExhausted
+		case errors.Is(err, booking.ErrQuoteInvalid):
+			err = models.ErrQuoteInvalid
+		case errors.Is(err, booking.ErrPromoCodeMismatch):
+			err = models.ErrPromoCodeQuoteMismatch
 		}
 
 		return 0, models.BookingWithTimes{}, err
@@ -207,61 +265,211 @@ func (s *Service) GetManyForBuyer(ctx context.Context, userID int64, count int,
 }
 
 func (s *Service) GetByUUID(ctx context.Context, userID int64, bookingID uuid.UUID) (models.BookingWithDetailsAndTimes, error) {
-	entry, err := s.repo.GetByUUID(ctx, bookingID)
+	entry, _, err := s.getAsParticipant(ctx, userID, bookingID)
 	if err != nil {
-		if errors.Is(err, booking.ErrNotFound) {
-			return models.BookingWithDetailsAndTimes{}, models.ErrBookingNotFound
-		}
+		return models.BookingWithDetailsAndTimes{}, err
 	}
 
-	// Retrieve the parkingspot owner ID
-	spotOwner, err := s.spotRepo.GetOwnerByUUID(ctx, entry.Entry.ParkingSpotID)
+	// The access instructions are kept private to the participants of a booking
+	spot, err := s.spotRepo.GetByUUID(ctx, entry.Entry.ParkingSpotID)
 	if err != nil {
 		return models.BookingWithDetailsAndTimes{}, err
 	}
 
-	// Check if the user is booker or seller
-	if (userID != entry.Entry.BookerID) && (userID != spotOwner) {
-		return models.BookingWithDetailsAndTimes{}, models.ErrBookingNotFound
-	}
-
 	result := models.BookingWithDetailsAndTimes{
 		BookingWithDetails: models.BookingWithDetails{
 			Booking:             entry.Entry.Booking,
 			ParkingSpotLocation: entry.ParkingSpotLocation,
 			CarDetails:          entry.CarDetails,
 		},
-		BookedTimes: entry.BookedTimes,
+		AccessInstructions: spot.AccessInstructions,
+		BookedTimes:        entry.BookedTimes,
 	}
 
 	return result, nil
 }
 
 func (s *Service) GetBookedTimesByUUID(ctx context.Context, userID int64, bookingID uuid.UUID) ([]models.TimeUnit, error) {
-	// Check if the booking exists and get the booker
+	// Only the booker or seller can request the booked times
+	entry, _, err := s.getAsParticipant(ctx, userID, bookingID)
+	if err != nil {
+		return []models.TimeUnit{}, err
+	}
+
+	return entry.BookedTimes, nil
+}
+
+// Get the booking `bookingID` as `userID`.
+//
+// Returns the booking and the ID of the spot owner, or models.ErrBookingNotFound if `userID` is
+// neither the booker nor the spot owner.
+func (s *Service) getAsParticipant(ctx context.Context, userID int64, bookingID uuid.UUID) (booking.EntryWithTimes, int64, error) {
 	entry, err := s.repo.GetByUUID(ctx, bookingID)
 	if err != nil {
 		if errors.Is(err, booking.ErrNotFound) {
-			return []models.TimeUnit{}, models.ErrBookingNotFound
+			err = models.ErrBookingNotFound
 		}
+		return booking.EntryWithTimes{}, 0, err
 	}
 
 	// Retrieve the parkingspot owner ID
 	spotOwner, err := s.spotRepo.GetOwnerByUUID(ctx, entry.Entry.ParkingSpotID)
 	if err != nil {
-		return []models.TimeUnit{}, err
+		return booking.EntryWithTimes{}, 0, err
 	}
 
-	// Only the booker or seller can request the booked times
+	// Check if the user is booker or seller
 	if (userID != entry.Entry.BookerID) && (userID != spotOwner) {
-		return []models.TimeUnit{}, models.ErrBookingNotFound
+		return booking.EntryWithTimes{}, 0, models.ErrBookingNotFound
 	}
 
-	return entry.BookedTimes, nil
+	return entry, spotOwner, nil
+}
+
+// Send a notification to `userID`.
+//
+// Failures are logged since they should not fail the operation being notified about.
+func (s *Service) notify(ctx context.Context, userID int64, input *models.NotificationInput) {
+	_, err := s.sender.Send(ctx, userID, input)
+	if err != nil {
+		log.Err(err).
+			Int64("userid", userID).
+			Str("type", input.Type).
+			Msg("could not send notification")
+	}
 }
 
-func calculateAmount(numSlots int, pricePerHour float64) float64 {
-	return (float64(numSlots) / 2) * pricePerHour
+// Get the price of booking the spot `spotID` between `startTime` and `endTime`.
+func (s *Service) GetQuote(ctx context.Context, spotID uuid.UUID, startTime, endTime time.Time) (models.PriceQuote, error) {
+	slots := quoteSlots(startTime, endTime)
+	if slots == nil {
+		return models.PriceQuote{}, models.ErrInvalidQuoteTimeRange
+	}
+
+	parkingSpot, err := s.spotRepo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return models.PriceQuote{}, err
+	}
+
+	result, _, err := s.quote(ctx, &parkingSpot, slots, "")
+	return result, err
+}
+
+// Create a quote for booking `input` time slots of the spot `spotID` by `userID`.
+//
+// The quote can be passed to `Create` within `QuoteLifetime` to book at the quoted price.
+func (s *Service) CreateQuote(ctx context.Context, userID int64, spotID uuid.UUID, input *models.BookingQuoteInput) (models.BookingQuote, error) {
+	if len(input.BookedTimes) == 0 {
+		return models.BookingQuote{}, models.ErrEmptyBookingTimes
+	}
+	if len(input.BookedTimes) > maximumQuoteSlots {
+		return models.BookingQuote{}, models.ErrTooManyQuotedTimes
+	}
+	slots := make([]models.TimeUnit, 0, len(input.BookedTimes))
+	for _, unit := range input.BookedTimes {
+		if unit.EndTime != unit.StartTime.Add(slotDuration) {
+			return models.BookingQuote{}, models.ErrInvalidTimeUnit
+		}
+		slots = append(slots, models.TimeUnit{
+			StartTime: unit.StartTime,
+			EndTime:   unit.EndTime,
+		})
+	}
+
+	parkingSpot, err := s.spotRepo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return models.BookingQuote{}, err
+	}
+
+	priceQuote, promoCodeID, err := s.quote(ctx, &parkingSpot, slots, input.PromoCode)
+	if err != nil {
+		return models.BookingQuote{}, err
+	}
+
+	entry := quote.Entry{
+		ExpiresAt:   time.Now().Add(QuoteLifetime),
+		BookedTimes: slots,
+		Total:       priceQuote.Total,
+		Discount:    priceQuote.Discount,
+		Payout:      payoutAmount(&priceQuote),
+		UserID:      userID,
+		SpotID:      parkingSpot.InternalID,
+		ID:          uuid.New(),
+	}
+	if promoCodeID != 0 {
+		entry.PromoCode = promocode.NormalizeCode(input.PromoCode)
+		entry.PromoCodeID = promoCodeID
+	}
+	err = s.quoteRepo.Create(ctx, &entry)
+	if err != nil {
+		return models.BookingQuote{}, err
+	}
+
+	return models.BookingQuote{
+		ExpiresAt:  entry.ExpiresAt,
+		PriceQuote: priceQuote,
+		ID:         entry.ID,
+	}, nil
+}
+
+// Price `slots` of `spot`, including discounts, fees and taxes.
+//
+// If `code` is not empty, the promo code with it is applied and its internal ID is returned.
+func (s *Service) quote(ctx context.Context, spot *parkingspot.Entry, slots []models.TimeUnit, code string) (models.PriceQuote, int64, error) {
+	spotPricing, err := s.pricingRepo.GetBySpotID(ctx, spot.InternalID)
+	if err != nil {
+		return models.PriceQuote{}, 0, err
+	}
+
+	loc := region.TimeZone(spot.Location.CountryCode, spot.Location.State)
+	result := calculateAmount(slots, spot.PricePerHour, &spotPricing, loc)
+
+	var promoCodeID int64
+	if code != "" {
+		promoCode, err := s.getPromoCode(ctx, spot, code)
+		if err != nil {
+			return models.PriceQuote{}, 0, err
+		}
+		if result.Subtotal < promoCode.MinimumSpend {
+			return models.PriceQuote{}, 0, models.ErrPromoCodeMinimumSpend
+		}
+		applyDiscount(&result, &promoCode)
+		promoCodeID = promoCode.InternalID
+	}
+
+	addCharges(&result, region.SalesTaxes(spot.Location.CountryCode, spot.Location.State))
+	result.Currency = region.Currency(spot.Location.CountryCode)
+	return result, promoCodeID, nil
+}
+
+// Get the promo code with `code` if it can be used for booking `spot`.
+//
+// Per-user usage limits are only enforced when the booking is created.
+func (s *Service) getPromoCode(ctx context.Context, spot *parkingspot.Entry, code string) (promocode.Entry, error) {
+	entry, err := s.promoCodeRepo.GetByCode(ctx, promocode.NormalizeCode(code))
+	if err != nil {
+		if errors.Is(err, promocode.ErrNotFound) {
+			err = models.ErrPromoCodeInvalid
+		}
+		return promocode.Entry{}, err
+	}
+
+	if entry.ExpiresAt != nil && !time.Now().Before(*entry.ExpiresAt) {
+		return promocode.Entry{}, models.ErrPromoCodeInvalid
+	}
+	if (entry.SpotID != 0 && entry.SpotID != spot.InternalID) || (entry.OwnerID != 0 && entry.OwnerID != spot.OwnerID) {
+		return promocode.Entry{}, models.ErrPromoCodeNotApplicable
+	}
+	if entry.MaxUses > 0 && entry.Uses >= int64(entry.MaxUses) {
+		return promocode.Entry{}, models.ErrPromoCodeExhausted
+	}
+	return entry, nil
 }
 
 func decodeCursor(cursor models.Cursor) omit.Val[booking.Cursor] {
@@ -3,6 +3,7 @@ package booking
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +11,9 @@ import (
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/car"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/promocode"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/quote"
 	"github.com/aarondl/opt/omit"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
@@ -30,6 +34,18 @@ type mockParkingspotRepo struct {
 	mock.Mock
 }
 
+type mockPricingRepo struct {
+	mock.Mock
+}
+
+type mockQuoteRepo struct {
+	mock.Mock
+}
+
+type mockPromoCodeRepo struct {
+	mock.Mock
+}
+
 // Create implements car.Repository.
 func (m *carRepo) Create(ctx context.Context, userID int64, carModel *models.CarCreationInput) (int64, car.Entry, error) {
 	args := m.Called(ctx, userID, carModel)
@@ -106,6 +122,54 @@ func (m *mockParkingspotRepo) UpdateAvailByUUID(ctx context.Context, spotID uuid
 	return args.Error(0)
 }
 
+// GetBySpotID implements pricing.Repository.
+func (m *mockPricingRepo) GetBySpotID(ctx context.Context, spotID int64) (pricing.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(pricing.Entry), args.Error(1)
+}
+
+// UpdateBySpotID implements pricing.Repository.
+func (m *mockPricingRepo) UpdateBySpotID(ctx context.Context, spotID int64, spotPricing *pricing.Entry) (pricing.Entry, error) {
+	args := m.Called(ctx, spotID, spotPricing)
+	return args.Get(0).(pricing.Entry), args.Error(1)
+}
+
+// Create implements quote.Repository.
+func (m *mockQuoteRepo) Create(ctx context.Context, entry *quote.Entry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+// Create implements promocode.Repository.
+func (m *mockPromoCodeRepo) Create(ctx context.Context, input *promocode.CreateInput) (promocode.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(promocode.Entry), args.Error(1)
+}
+
+// GetByUUID implements promocode.Repository.
+func (m *mockPromoCodeRepo) GetByUUID(ctx context.Context, id uuid.UUID) (promocode.Entry, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(promocode.Entry), args.Error(1)
+}
+
+// GetByCode implements promocode.Repository.
+func (m *mockPromoCodeRepo) GetByCode(ctx context.Context, code string) (promocode.Entry, error) {
+	args := m.Called(ctx, code)
+	return args.Get(0).(promocode.Entry), args.Error(1)
+}
+
+// GetMany implements promocode.Repository.
+func (m *mockPromoCodeRepo) GetMany(ctx context.Context, limit int, after omit.Val[promocode.Cursor]) ([]promocode.Entry, error) {
+	args := m.Called(ctx, limit, after)
+	return args.Get(0).([]promocode.Entry), args.Error(1)
+}
+
+// ExpireByUUID implements promocode.Repository.
+func (m *mockPromoCodeRepo) ExpireByUUID(ctx context.Context, id uuid.UUID) (promocode.Entry, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(promocode.Entry), args.Error(1)
+}
+
 // Create implements booking.Repository.
 func (m *mockRepo) Create(ctx context.Context, input *booking.CreateInput) (booking.EntryWithTimes, error) {
 	args := m.Called(ctx, input)
@@ -130,6 +194,28 @@ func (m *mockRepo) GetManyForBuyer(ctx context.Context, limit int, after omit.Va
 	return args.Get(0).([]booking.EntryWithDetails), args.Error(1)
 }
 
+// ClaimUpcoming implements booking.Repository.
+func (m *mockRepo) ClaimUpcoming(ctx context.Context, after, before, now time.Time) ([]booking.Upcoming, error) {
+	args := m.Called(ctx, after, before, now)
+	return args.Get(0).([]booking.Upcoming), args.Error(1)
+}
+
+// GetManyForCalendar implements booking.Repository.
+func (m *mockRepo) GetManyForCalendar(ctx context.Context, userID int64, after time.Time, limit int) ([]booking.EntryWithTimes, error) {
+	args := m.Called(ctx, userID, after, limit)
+	return args.Get(0).([]booking.EntryWithTimes), args.Error(1)
+}
+
+type mockSender struct {
+	mock.Mock
+}
+
+// Send implements notification.Sender.
+func (m *mockSender) Send(ctx context.Context, userID int64, input *models.NotificationInput) (models.Notification, error) {
+	args := m.Called(ctx, userID, input)
+	return args.Get(0).(models.Notification), args.Error(1)
+}
+
 // Define constants and sample for consistent test values
 const (
 	testOwnerID             = int64(1)
@@ -214,6 +300,25 @@ var (
 
 	testpaidAmount = (float64(len(sampleTimeUnit)) / 2) * testPrice
 
+	// Price of sampleTimeUnit at testSpotEntry, including the service fee and GST
+	testQuotedAmount = 11.03
+
+	// A 10% promo code usable anywhere
+	testPromoCode = promocode.Entry{
+		PromoCode: models.PromoCode{
+			PromoCodeCreationInput: models.PromoCodeCreationInput{
+				Code:          "SAVE10",
+				DiscountType:  models.DiscountPercentage,
+				DiscountValue: 10,
+			},
+			ID: uuid.New(),
+		},
+		InternalID: 8,
+	}
+
+	// Price of sampleTimeUnit at testSpotEntry with testPromoCode applied
+	testDiscountedAmount = 9.92
+
 	testBookingDetails = &models.BookingCreationInput{
 		CarID:       testCarUUID,
 		BookedTimes: sampleTimeUnit,
@@ -275,7 +380,8 @@ func TestCreateBooking(t *testing.T) {
 		repo := new(mockRepo)
 		carRepo := new(carRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, carRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(repo, spotRepo, carRepo, pricingRepo, nil, nil, nil, nil, nil)
 
 		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
 			Return(testSpotEntry, nil).
@@ -283,13 +389,16 @@ func TestCreateBooking(t *testing.T) {
 		carRepo.On("GetByUUID", mock.Anything, testCarUUID).
 			Return(testCarEntry, nil).
 			Once()
-
+		pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+			Return(pricing.Entry{}, nil).
+			Once()
 		expectedCreationInput := booking.CreateInput{
-			BookedTimes: testBookingDetails.BookedTimes,
-			UserID:      testUserID,
-			SpotID:      testSpotInternalID,
-			CarID:       testCarInternalID,
-			PaidAmount:  testpaidAmount,
+			BookedTimes:  testBookingDetails.BookedTimes,
+			UserID:       testUserID,
+			SpotID:       testSpotInternalID,
+			CarID:        testCarInternalID,
+			PaidAmount:   testQuotedAmount,
+			PayoutAmount: testpaidAmount,
 		}
 
 		repo.On("Create", mock.Anything, &expectedCreationInput).
@@ -303,6 +412,90 @@ func TestCreateBooking(t *testing.T) {
 		assert.Empty(t, cmp.Diff(testBookingWithTimes, result))
 		spotRepo.AssertExpectations(t)
 		carRepo.AssertExpectations(t)
+		pricingRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("applies a promo code", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		carRepo := new(carRepo)
+		spotRepo := new(mockParkingspotRepo)
+		pricingRepo := new(mockPricingRepo)
+		promoCodeRepo := new(mockPromoCodeRepo)
+		service := New(repo, spotRepo, carRepo, pricingRepo, nil, promoCodeRepo, nil, nil, nil)
+
+		details := *testBookingDetails
+		details.PromoCode = " save10"
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		carRepo.On("GetByUUID", mock.Anything, testCarUUID).
+			Return(testCarEntry, nil).
+			Once()
+		pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+			Return(pricing.Entry{}, nil).
+			Once()
+		promoCodeRepo.On("GetByCode", mock.Anything, testPromoCode.Code).
+			Return(testPromoCode, nil).
+			Once()
+
+		expectedCreationInput := booking.CreateInput{
+			BookedTimes:    testBookingDetails.BookedTimes,
+			UserID:         testUserID,
+			SpotID:         testSpotInternalID,
+			CarID:          testCarInternalID,
+			PaidAmount:     testDiscountedAmount,
+			PromoCodeID:    testPromoCode.InternalID,
+			DiscountAmount: 1,
+			PayoutAmount:   9,
+		}
+		repo.On("Create", mock.Anything, &expectedCreationInput).
+			Return(testBookingEntryForCreate, nil).
+			Once()
+
+		_, _, err := service.Create(ctx, testUserID, testSpotUUID, &details)
+		require.NoError(t, err)
+		spotRepo.AssertExpectations(t)
+		carRepo.AssertExpectations(t)
+		pricingRepo.AssertExpectations(t)
+		promoCodeRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("fails when the promo code usage limit is reached", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		carRepo := new(carRepo)
+		spotRepo := new(mockParkingspotRepo)
+		pricingRepo := new(mockPricingRepo)
+		promoCodeRepo := new(mockPromoCodeRepo)
+		service := New(repo, spotRepo, carRepo, pricingRepo, nil, promoCodeRepo, nil, nil, nil)
+
+		details := *testBookingDetails
+		details.PromoCode = testPromoCode.Code
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		carRepo.On("GetByUUID", mock.Anything, testCarUUID).
+			Return(testCarEntry, nil).
+			Once()
+		pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+			Return(pricing.Entry{}, nil).
+			Once()
+		promoCodeRepo.On("GetByCode", mock.Anything, testPromoCode.Code).
+			Return(testPromoCode, nil).
+			Once()
+		repo.On("Create", mock.Anything, mock.Anything).
+			Return(booking.EntryWithTimes{}, booking.ErrPromoCodeExhausted).
+			Once()
+
+		_, _, err := service.Create(ctx, testUserID, testSpotUUID, &details)
+		assert.ErrorIs(t, err, models.ErrPromoCodeExhausted)
 		repo.AssertExpectations(t)
 	})
 
@@ -312,7 +505,8 @@ func TestCreateBooking(t *testing.T) {
 		repo := new(mockRepo)
 		carRepo := new(carRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, carRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(repo, spotRepo, carRepo, pricingRepo, nil, nil, nil, nil, nil)
 
 		emptyDetails := &models.BookingCreationInput{}
 		_, _, err := service.Create(ctx, testUserID, testSpotUUID, emptyDetails)
@@ -329,7 +523,8 @@ func TestCreateBooking(t *testing.T) {
 		repo := new(mockRepo)
 		carRepo := new(carRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, carRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(repo, spotRepo, carRepo, pricingRepo, nil, nil, nil, nil, nil)
 
 		spotRepo.On("GetByUUID", mock.Anything, mock.Anything).
 			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).
@@ -350,7 +545,8 @@ func TestCreateBooking(t *testing.T) {
 		repo := new(mockRepo)
 		carRepo := new(carRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, carRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(repo, spotRepo, carRepo, pricingRepo, nil, nil, nil, nil, nil)
 
 		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
 			Return(testSpotEntry, nil).
@@ -374,7 +570,8 @@ func TestCreateBooking(t *testing.T) {
 		repo := new(mockRepo)
 		carRepo := new(carRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, carRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(repo, spotRepo, carRepo, pricingRepo, nil, nil, nil, nil, nil)
 
 		// Not owned by user
 		carEntry := car.Entry{
@@ -402,13 +599,43 @@ func TestCreateBooking(t *testing.T) {
 		repo.AssertNotCalled(t, "Create")
 	})
 
+	t.Run("fails when car does not fit the parking spot", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		carRepo := new(carRepo)
+		spotRepo := new(mockParkingspotRepo)
+		service := New(repo, spotRepo, carRepo, nil, nil, nil, nil, nil, nil)
+
+		spotEntry := testSpotEntry
+		spotEntry.Restrictions = models.ParkingSpotRestrictions{MaxHeight: 170}
+		carEntry := testCarEntry
+		carEntry.Details.Class = models.VehicleClassSUV
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(spotEntry, nil).
+			Once()
+		carRepo.On("GetByUUID", mock.Anything, testCarUUID).
+			Return(carEntry, nil).
+			Once()
+
+		_, _, err := service.Create(ctx, testUserID, testSpotUUID, testBookingDetails)
+		if assert.Error(t, err) {
+			assert.ErrorIs(t, err, models.ErrCarDoesNotFit)
+		}
+		spotRepo.AssertExpectations(t)
+		carRepo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Create")
+	})
+
 	t.Run("fails when time slot is already booked", func(t *testing.T) {
 		t.Parallel()
 
 		repo := new(mockRepo)
 		carRepo := new(carRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, carRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(repo, spotRepo, carRepo, pricingRepo, nil, nil, nil, nil, nil)
 
 		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
 			Return(testSpotEntry, nil).
@@ -416,6 +643,9 @@ func TestCreateBooking(t *testing.T) {
 		carRepo.On("GetByUUID", mock.Anything, testCarUUID).
 			Return(testCarEntry, nil).
 			Once()
+		pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+			Return(pricing.Entry{}, nil).
+			Once()
 		repo.On("Create", mock.Anything, mock.AnythingOfType("*booking.CreateInput")).
 			Return(booking.EntryWithTimes{}, booking.ErrTimeAlreadyBooked).
 			Once()
@@ -426,6 +656,95 @@ func TestCreateBooking(t *testing.T) {
 		}
 		spotRepo.AssertExpectations(t)
 		carRepo.AssertExpectations(t)
+		pricingRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("books at the quoted price", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		carRepo := new(carRepo)
+		spotRepo := new(mockParkingspotRepo)
+		service := New(repo, spotRepo, carRepo, nil, nil, nil, nil, nil, nil)
+
+		quoteID := uuid.New()
+		details := *testBookingDetails
+		details.QuoteID = quoteID
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		carRepo.On("GetByUUID", mock.Anything, testCarUUID).
+			Return(testCarEntry, nil).
+			Once()
+		// The quote is consumed and priced by the repository
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(input *booking.CreateInput) bool {
+			return input.QuoteID == quoteID && input.QuotePromoCode == "" && input.PaidAmount == 0
+		})).
+			Return(testBookingEntryForCreate, nil).
+			Once()
+
+		_, _, err := service.Create(ctx, testUserID, testSpotUUID, &details)
+		require.NoError(t, err)
+		spotRepo.AssertExpectations(t)
+		carRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("fails when quote is not usable", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		carRepo := new(carRepo)
+		spotRepo := new(mockParkingspotRepo)
+		service := New(repo, spotRepo, carRepo, nil, nil, nil, nil, nil, nil)
+
+		details := *testBookingDetails
+		details.QuoteID = uuid.New()
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		carRepo.On("GetByUUID", mock.Anything, testCarUUID).
+			Return(testCarEntry, nil).
+			Once()
+		repo.On("Create", mock.Anything, mock.Anything).
+			Return(booking.EntryWithTimes{}, booking.ErrQuoteInvalid).
+			Once()
+
+		_, _, err := service.Create(ctx, testUserID, testSpotUUID, &details)
+		assert.ErrorIs(t, err, models.ErrQuoteInvalid)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("fails when promo code does not match the quote", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		carRepo := new(carRepo)
+		spotRepo := new(mockParkingspotRepo)
+		service := New(repo, spotRepo, carRepo, nil, nil, nil, nil, nil, nil)
+
+		details := *testBookingDetails
+		details.QuoteID = uuid.New()
+		details.PromoCode = " " + strings.ToLower(testPromoCode.Code)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		carRepo.On("GetByUUID", mock.Anything, testCarUUID).
+			Return(testCarEntry, nil).
+			Once()
+		// Promo codes are compared in their normalized form
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(input *booking.CreateInput) bool {
+			return input.QuotePromoCode == testPromoCode.Code
+		})).
+			Return(booking.EntryWithTimes{}, booking.ErrPromoCodeMismatch).
+			Once()
+
+		_, _, err := service.Create(ctx, testUserID, testSpotUUID, &details)
+		assert.ErrorIs(t, err, models.ErrPromoCodeQuoteMismatch)
 		repo.AssertExpectations(t)
 	})
 }
@@ -441,7 +760,7 @@ func TestGetManyForBuyer(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		bookings, cursor, err := service.GetManyForBuyer(ctx, testUserID, 0, "", models.BookingFilter{})
 		require.NoError(t, err)
@@ -455,7 +774,7 @@ func TestGetManyForBuyer(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		nonExistentSpotID := uuid.New()
 		filter := models.BookingFilter{ParkingSpotID: nonExistentSpotID}
@@ -477,7 +796,7 @@ func TestGetManyForBuyer(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockBookings := []booking.EntryWithDetails{
 			{
@@ -515,7 +834,7 @@ func TestGetManyForBuyer(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockBookings := []booking.EntryWithDetails{
 			{
@@ -551,7 +870,7 @@ func TestGetManyForBuyer(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		repo.On("GetManyForBuyer", mock.Anything, 11, mock.Anything, testUserID, &booking.Filter{}).
 			Return([]booking.EntryWithDetails{}, assert.AnError).
@@ -571,7 +890,7 @@ func TestGetManyForBuyer(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockBookings := []booking.EntryWithDetails{
 			{
@@ -635,7 +954,7 @@ func TestGetManyForBuyer(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockBookings := []booking.EntryWithDetails{
 			{
@@ -691,7 +1010,7 @@ func TestGetManyForOwner(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		bookings, cursor, err := service.GetManyForOwner(ctx, testUserID, 0, "", models.BookingFilter{})
 		require.NoError(t, err)
@@ -705,7 +1024,7 @@ func TestGetManyForOwner(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		nonExistentSpotID := uuid.New()
 		filter := models.BookingFilter{ParkingSpotID: nonExistentSpotID}
@@ -727,7 +1046,7 @@ func TestGetManyForOwner(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		otherOwnerID := int64(999)
 		spotEntry := parkingspot.Entry{
@@ -756,7 +1075,7 @@ func TestGetManyForOwner(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockBookings := []booking.EntryWithDetails{
 			{
@@ -786,7 +1105,7 @@ func TestGetManyForOwner(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		spotEntry := parkingspot.Entry{
 			ParkingSpot: models.ParkingSpot{ID: testSpotUUID},
@@ -827,7 +1146,7 @@ func TestGetManyForOwner(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		repo.On("GetManyForOwner", mock.Anything, 11, omit.Val[booking.Cursor]{}, testUserID, &booking.Filter{}).
 			Return([]booking.EntryWithDetails{}, assert.AnError).
@@ -855,7 +1174,7 @@ func TestGetByUUID(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockEntry := booking.EntryWithTimes{
 			EntryWithDetails: booking.EntryWithDetails{
@@ -873,6 +1192,9 @@ func TestGetByUUID(t *testing.T) {
 		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
 			Return(testUserID, nil).
 			Once()
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(parkingspot.Entry{AccessInstructions: "Gate code 1234"}, nil).
+			Once()
 
 		repo.On("GetByUUID", mock.Anything, testBookingUUID).
 			Return(mockEntry, nil).
@@ -882,6 +1204,7 @@ func TestGetByUUID(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, cmp.Diff(mockEntry.Entry.Booking, result.Booking))
 		assert.Empty(t, cmp.Diff(mockEntry.BookedTimes, result.BookedTimes))
+		assert.Equal(t, "Gate code 1234", result.AccessInstructions)
 
 		spotRepo.AssertExpectations(t)
 		repo.AssertExpectations(t)
@@ -892,7 +1215,7 @@ func TestGetByUUID(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		repo.On("GetByUUID", mock.Anything, testBookingUUID).
 			Return(booking.EntryWithTimes{}, booking.ErrNotFound).
@@ -913,7 +1236,7 @@ func TestGetByUUID(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockEntry := booking.EntryWithTimes{
 			EntryWithDetails: booking.EntryWithDetails{
@@ -949,7 +1272,7 @@ func TestGetByUUID(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockEntry := booking.EntryWithTimes{
 			EntryWithDetails: booking.EntryWithDetails{
@@ -1007,7 +1330,7 @@ func TestGetBookedTimesByUUID(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
 			Return(testUserID, nil).
@@ -1029,7 +1352,7 @@ func TestGetBookedTimesByUUID(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		repo.On("GetByUUID", mock.Anything, testBookingUUID).
 			Return(booking.EntryWithTimes{}, booking.ErrNotFound).
@@ -1050,7 +1373,7 @@ func TestGetBookedTimesByUUID(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		repo.On("GetByUUID", mock.Anything, testBookingUUID).
 			Return(mockEntry, nil).
@@ -1073,7 +1396,7 @@ func TestGetBookedTimesByUUID(t *testing.T) {
 
 		repo := new(mockRepo)
 		spotRepo := new(mockParkingspotRepo)
-		service := New(repo, spotRepo, nil)
+		service := New(repo, spotRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockEntry := booking.EntryWithTimes{
 			EntryWithDetails: booking.EntryWithDetails{
@@ -0,0 +1,48 @@
+package booking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+)
+
+// Notify the spot owner and the booker of new bookings.
+//
+// Subscribes to domain events of the outbox.
+func (s *Service) HandleEvent(ctx context.Context, event *models.DomainEvent) error {
+	if event.Type != models.EventBookingCreated {
+		return nil
+	}
+
+	var created models.BookingCreatedEvent
+	err := json.Unmarshal(event.Data, &created)
+	if err != nil {
+		return fmt.Errorf("could not decode booking event: %w", err)
+	}
+
+	count := len(created.Booking.BookedTimes)
+	slots := "time slots"
+	if count == 1 {
+		slots = "time slot"
+	}
+	location := &created.Location
+
+	_, ownerErr := s.sender.Send(ctx, created.OwnerID, &models.NotificationInput{
+		Type:      models.NotificationBookingCreated,
+		Title:     "New booking",
+		Body:      fmt.Sprintf("%d %s booked at %s, %s.", count, slots, location.StreetAddress, location.City),
+		SubjectID: created.Booking.ID,
+		EventID:   event.ID,
+	})
+	_, bookerErr := s.sender.Send(ctx, created.BookerID, &models.NotificationInput{
+		Type:      models.NotificationBookingConfirmed,
+		Title:     "Booking confirmed",
+		Body:      fmt.Sprintf("Your booking of %d %s at %s, %s is confirmed.", count, slots, location.StreetAddress, location.City),
+		SubjectID: created.Booking.ID,
+		EventID:   event.ID,
+	})
+	return errors.Join(ownerErr, bookerErr)
+}
@@ -0,0 +1,75 @@
+package booking
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("notifies owner and booker of new bookings", func(t *testing.T) {
+		t.Parallel()
+
+		sender := new(mockSender)
+		service := New(nil, nil, nil, nil, nil, nil, nil, nil, sender)
+
+		data, err := json.Marshal(&models.BookingCreatedEvent{
+			Booking:  testBookingWithTimes,
+			Location: testSpotEntry.Location,
+			BookerID: testUserID,
+			OwnerID:  testOwnerID,
+		})
+		require.NoError(t, err)
+		eventID := uuid.New()
+
+		sender.On("Send", mock.Anything, testOwnerID, &models.NotificationInput{
+			Type:      models.NotificationBookingCreated,
+			Title:     "New booking",
+			Body:      "2 time slots booked at 6650 Niagara Parkway, Niagara Falls.",
+			SubjectID: testBooking.ID,
+			EventID:   eventID,
+		}).
+			Return(models.Notification{}, nil).
+			Once()
+		sender.On("Send", mock.Anything, testUserID, &models.NotificationInput{
+			Type:      models.NotificationBookingConfirmed,
+			Title:     "Booking confirmed",
+			Body:      "Your booking of 2 time slots at 6650 Niagara Parkway, Niagara Falls is confirmed.",
+			SubjectID: testBooking.ID,
+			EventID:   eventID,
+		}).
+			Return(models.Notification{}, nil).
+			Once()
+
+		err = service.HandleEvent(ctx, &models.DomainEvent{
+			Type: models.EventBookingCreated,
+			Data: data,
+			ID:   eventID,
+		})
+		require.NoError(t, err)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("ignores other events", func(t *testing.T) {
+		t.Parallel()
+
+		service := New(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		err := service.HandleEvent(ctx, &models.DomainEvent{
+			Type: models.EventAvailabilityChanged,
+			Data: json.RawMessage(`{}`),
+			ID:   uuid.New(),
+		})
+		require.NoError(t, err)
+	})
+}
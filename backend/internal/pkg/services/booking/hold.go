@@ -0,0 +1,125 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/hold"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Duration for which time slots are held during checkout
+const HoldDuration = 10 * time.Minute
+
+// Interval between sweeps for expired holds
+const HoldExpiryInterval = 30 * time.Second
+
+// Hold time slots of spot `spotID` for `userID` while they check out.
+//
+// Any previous hold of the user on the same spot is released.
+func (s *Service) CreateHold(ctx context.Context, userID int64, spotID uuid.UUID, input *models.HoldCreationInput) (models.Hold, error) {
+	if len(input.HeldTimes) == 0 {
+		return models.Hold{}, models.ErrEmptyHoldTimes
+	}
+	if len(input.HeldTimes) > maximumQuoteSlots {
+		return models.Hold{}, models.ErrTooManyQuotedTimes
+	}
+	for _, unit := range input.HeldTimes {
+		if unit.EndTime != unit.StartTime.Add(slotDuration) {
+			return models.Hold{}, models.ErrInvalidTimeUnit
+		}
+	}
+
+	spotEntry, err := s.spotRepo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return models.Hold{}, err
+	}
+
+	result, err := s.holdRepo.Create(ctx, &hold.CreateInput{
+		ExpiresAt: time.Now().Add(HoldDuration),
+		HeldTimes: input.HeldTimes,
+		UserID:    userID,
+		SpotID:    spotEntry.InternalID,
+	})
+	if err != nil {
+		if errors.Is(err, hold.ErrTimeUnavailable) {
+			err = models.ErrDuplicateBooking
+		}
+		return models.Hold{}, err
+	}
+	return result.Hold, nil
+}
+
+// Release hold `holdID` placed by `userID`
+func (s *Service) ReleaseHold(ctx context.Context, userID int64, holdID uuid.UUID) error {
+	entry, err := s.holdRepo.GetByUUID(ctx, holdID)
+	if err != nil {
+		if errors.Is(err, hold.ErrNotFound) {
+			err = models.ErrHoldNotFound
+		}
+		return err
+	}
+	// Pretend that holds of other users do not exist
+	if entry.UserID != userID {
+		return models.ErrHoldNotFound
+	}
+
+	err = s.holdRepo.DeleteByUUID(ctx, holdID)
+	if err != nil {
+		if errors.Is(err, hold.ErrNotFound) {
+			err = models.ErrHoldNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Periodically release expired holds until `ctx` is done
+func (s *Service) RunHoldExpiry(ctx context.Context) {
+	ticker := time.NewTicker(HoldExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			released, err := s.holdRepo.DeleteExpired(ctx, now)
+			if err != nil && ctx.Err() == nil {
+				log.Err(err).Msg("could not release expired holds")
+				continue
+			}
+			if released > 0 {
+				log.Debug().Int64("count", released).Msg("released expired holds")
+			}
+		}
+	}
+}
+
+func (s *Service) getHold(ctx context.Context, userID, spotID int64, holdID uuid.UUID) (hold.Entry, error) {
+	entry, err := s.holdRepo.GetByUUID(ctx, holdID)
+	if err != nil {
+		if errors.Is(err, hold.ErrNotFound) {
+			err = models.ErrHoldNotFound
+		}
+		return hold.Entry{}, err
+	}
+
+	if entry.UserID != userID {
+		return hold.Entry{}, models.ErrHoldNotFound
+	}
+	if entry.SpotID != spotID {
+		return hold.Entry{}, models.ErrHoldSpotMismatch
+	}
+	if !entry.ExpiresAt.After(time.Now()) {
+		return hold.Entry{}, models.ErrHoldExpired
+	}
+	return entry, nil
+}
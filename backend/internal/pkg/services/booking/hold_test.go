@@ -0,0 +1,342 @@
+package booking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/hold"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockHoldRepo struct {
+	mock.Mock
+}
+
+// Create implements hold.Repository.
+func (m *mockHoldRepo) Create(ctx context.Context, input *hold.CreateInput) (hold.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(hold.Entry), args.Error(1)
+}
+
+// GetByUUID implements hold.Repository.
+func (m *mockHoldRepo) GetByUUID(ctx context.Context, holdID uuid.UUID) (hold.Entry, error) {
+	args := m.Called(ctx, holdID)
+	return args.Get(0).(hold.Entry), args.Error(1)
+}
+
+// DeleteByUUID implements hold.Repository.
+func (m *mockHoldRepo) DeleteByUUID(ctx context.Context, holdID uuid.UUID) error {
+	args := m.Called(ctx, holdID)
+	return args.Error(0)
+}
+
+// DeleteExpired implements hold.Repository.
+func (m *mockHoldRepo) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestCreateHold(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("holds the time slots", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		holdRepo := new(mockHoldRepo)
+		service := New(nil, spotRepo, nil, nil, nil, nil, nil, holdRepo, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		expected := hold.Entry{
+			Hold: models.Hold{
+				ExpiresAt: time.Now().Add(HoldDuration),
+				HeldTimes: sampleTimeUnit,
+				SpotID:    testSpotUUID,
+				ID:        uuid.New(),
+			},
+			InternalID: 1,
+			UserID:     testUserID,
+			SpotID:     testSpotInternalID,
+		}
+		holdRepo.On("Create", mock.Anything, mock.MatchedBy(func(input *hold.CreateInput) bool {
+			return input.UserID == testUserID && input.SpotID == testSpotInternalID &&
+				input.ExpiresAt.After(time.Now()) && len(input.HeldTimes) == len(sampleTimeUnit)
+		})).
+			Return(expected, nil).
+			Once()
+
+		result, err := service.CreateHold(ctx, testUserID, testSpotUUID, &models.HoldCreationInput{
+			HeldTimes: sampleTimeUnit,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expected.Hold, result)
+		spotRepo.AssertExpectations(t)
+		holdRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid time slots", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		holdRepo := new(mockHoldRepo)
+		service := New(nil, spotRepo, nil, nil, nil, nil, nil, holdRepo, nil)
+
+		start := sampleTimeUnit[0].StartTime
+		tooMany := make([]models.TimeUnit, 0, maximumQuoteSlots+1)
+		for slot := start; len(tooMany) <= maximumQuoteSlots; slot = slot.Add(30 * time.Minute) {
+			tooMany = append(tooMany, models.TimeUnit{StartTime: slot, EndTime: slot.Add(30 * time.Minute)})
+		}
+
+		tests := []struct {
+			err   error
+			name  string
+			times []models.TimeUnit
+		}{
+			{name: "empty", times: []models.TimeUnit{}, err: models.ErrEmptyHoldTimes},
+			{
+				name:  "not 30 minutes",
+				times: []models.TimeUnit{{StartTime: start, EndTime: start.Add(time.Hour)}},
+				err:   models.ErrInvalidTimeUnit,
+			},
+			{
+				name:  "too many slots",
+				times: tooMany,
+				err:   models.ErrTooManyQuotedTimes,
+			},
+		}
+
+		for _, test := range tests {
+			_, err := service.CreateHold(ctx, testUserID, testSpotUUID, &models.HoldCreationInput{HeldTimes: test.times})
+			assert.ErrorIs(t, err, test.err, test.name)
+		}
+		spotRepo.AssertNotCalled(t, "GetByUUID")
+		holdRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		service := New(nil, spotRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).
+			Once()
+
+		_, err := service.CreateHold(ctx, testUserID, testSpotUUID, &models.HoldCreationInput{
+			HeldTimes: sampleTimeUnit,
+		})
+		require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		spotRepo.AssertExpectations(t)
+	})
+
+	t.Run("times already booked or held", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		holdRepo := new(mockHoldRepo)
+		service := New(nil, spotRepo, nil, nil, nil, nil, nil, holdRepo, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		holdRepo.On("Create", mock.Anything, mock.Anything).
+			Return(hold.Entry{}, hold.ErrTimeUnavailable).
+			Once()
+
+		_, err := service.CreateHold(ctx, testUserID, testSpotUUID, &models.HoldCreationInput{
+			HeldTimes: sampleTimeUnit,
+		})
+		require.ErrorIs(t, err, models.ErrDuplicateBooking)
+		spotRepo.AssertExpectations(t)
+		holdRepo.AssertExpectations(t)
+	})
+}
+
+func TestReleaseHold(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	holdID := uuid.New()
+
+	t.Run("releases own hold", func(t *testing.T) {
+		t.Parallel()
+
+		holdRepo := new(mockHoldRepo)
+		service := New(nil, nil, nil, nil, nil, nil, nil, holdRepo, nil)
+
+		holdRepo.On("GetByUUID", mock.Anything, holdID).
+			Return(hold.Entry{UserID: testUserID}, nil).
+			Once()
+		holdRepo.On("DeleteByUUID", mock.Anything, holdID).
+			Return(nil).
+			Once()
+
+		err := service.ReleaseHold(ctx, testUserID, holdID)
+		require.NoError(t, err)
+		holdRepo.AssertExpectations(t)
+	})
+
+	t.Run("hides holds of other users", func(t *testing.T) {
+		t.Parallel()
+
+		holdRepo := new(mockHoldRepo)
+		service := New(nil, nil, nil, nil, nil, nil, nil, holdRepo, nil)
+
+		holdRepo.On("GetByUUID", mock.Anything, holdID).
+			Return(hold.Entry{UserID: testOwnerID}, nil).
+			Once()
+
+		err := service.ReleaseHold(ctx, testUserID, holdID)
+		require.ErrorIs(t, err, models.ErrHoldNotFound)
+		holdRepo.AssertExpectations(t)
+	})
+
+	t.Run("hold not found", func(t *testing.T) {
+		t.Parallel()
+
+		holdRepo := new(mockHoldRepo)
+		service := New(nil, nil, nil, nil, nil, nil, nil, holdRepo, nil)
+
+		holdRepo.On("GetByUUID", mock.Anything, holdID).
+			Return(hold.Entry{}, hold.ErrNotFound).
+			Once()
+
+		err := service.ReleaseHold(ctx, testUserID, holdID)
+		require.ErrorIs(t, err, models.ErrHoldNotFound)
+		holdRepo.AssertExpectations(t)
+	})
+}
+
+func TestCreateWithHold(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	holdID := uuid.New()
+	validHold := hold.Entry{
+		Hold: models.Hold{
+			ExpiresAt: time.Now().Add(HoldDuration),
+			HeldTimes: sampleTimeUnit,
+			SpotID:    testSpotUUID,
+			ID:        holdID,
+		},
+		InternalID: 9,
+		UserID:     testUserID,
+		SpotID:     testSpotInternalID,
+	}
+
+	t.Run("converts the hold into the booking", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		carRepo := new(carRepo)
+		spotRepo := new(mockParkingspotRepo)
+		pricingRepo := new(mockPricingRepo)
+		holdRepo := new(mockHoldRepo)
+		service := New(repo, spotRepo, carRepo, pricingRepo, nil, nil, nil, holdRepo, nil)
+
+		details := *testBookingDetails
+		details.HoldID = holdID
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		carRepo.On("GetByUUID", mock.Anything, testCarUUID).
+			Return(testCarEntry, nil).
+			Once()
+		holdRepo.On("GetByUUID", mock.Anything, holdID).
+			Return(validHold, nil).
+			Once()
+		pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+			Return(pricing.Entry{}, nil).
+			Once()
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(input *booking.CreateInput) bool {
+			return input.HoldID == validHold.InternalID
+		})).
+			Return(testBookingEntryForCreate, nil).
+			Once()
+
+		_, _, err := service.Create(ctx, testUserID, testSpotUUID, &details)
+		require.NoError(t, err)
+		holdRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		err   error
+		name  string
+		entry hold.Entry
+	}{
+		{
+			name: "expired hold",
+			entry: func() hold.Entry {
+				entry := validHold
+				entry.ExpiresAt = time.Now().Add(-time.Minute)
+				return entry
+			}(),
+			err: models.ErrHoldExpired,
+		},
+		{
+			name: "hold of another spot",
+			entry: func() hold.Entry {
+				entry := validHold
+				entry.SpotID = testSpotInternalID_1
+				return entry
+			}(),
+			err: models.ErrHoldSpotMismatch,
+		},
+		{
+			name: "hold of another user",
+			entry: func() hold.Entry {
+				entry := validHold
+				entry.UserID = testOwnerID
+				return entry
+			}(),
+			err: models.ErrHoldNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			carRepo := new(carRepo)
+			spotRepo := new(mockParkingspotRepo)
+			holdRepo := new(mockHoldRepo)
+			service := New(nil, spotRepo, carRepo, nil, nil, nil, nil, holdRepo, nil)
+
+			details := *testBookingDetails
+			details.HoldID = holdID
+
+			spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+				Return(testSpotEntry, nil).
+				Once()
+			carRepo.On("GetByUUID", mock.Anything, testCarUUID).
+				Return(testCarEntry, nil).
+				Once()
+			holdRepo.On("GetByUUID", mock.Anything, holdID).
+				Return(tc.entry, nil).
+				Once()
+
+			_, _, err := service.Create(ctx, testUserID, testSpotUUID, &details)
+			require.ErrorIs(t, err, tc.err)
+			holdRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package booking
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/region"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/promocode"
+)
+
+// Length of a bookable time slot
+const slotDuration = 30 * time.Minute
+
+// Largest number of slots that can be quoted at once (two weeks)
+const maximumQuoteSlots = 14 * 24 * 2
+
+// Platform service fee as a fraction of the subtotal
+const ServiceFeeRate = 0.05
+
+// Calculate the subtotal of booking `slots` of a spot.
+//
+// `pricePerHour` is the spot price used when no rule in `spotPricing` matches a slot.
+// Rules are evaluated in the spot local time `loc`.
+//
+// Fees and taxes are not included, see `addCharges`.
+func calculateAmount(slots []models.TimeUnit, pricePerHour float64, spotPricing *pricing.Entry, loc *time.Location) models.PriceQuote {
+	sorted := slices.Clone(slots)
+	slices.SortFunc(sorted, func(a, b models.TimeUnit) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	quote := models.PriceQuote{
+		Items:       make([]models.PriceQuoteItem, 0, len(sorted)),
+		Adjustments: []models.PriceQuoteAdjustment{},
+		Taxes:       []models.PriceQuoteTax{},
+	}
+
+	var dates []string
+	dailyTotals := make(map[string]float64)
+	for _, slot := range sorted {
+		local := slot.StartTime.In(loc)
+		rate := slotRate(local, pricePerHour, spotPricing)
+		amount := roundCents(rate * slotDuration.Hours())
+		quote.Items = append(quote.Items, models.PriceQuoteItem{
+			StartTime:    slot.StartTime,
+			EndTime:      slot.EndTime,
+			PricePerHour: rate,
+			Amount:       amount,
+		})
+
+		date := local.Format(time.DateOnly)
+		if _, ok := dailyTotals[date]; !ok {
+			dates = append(dates, date)
+		}
+		dailyTotals[date] += amount
+		quote.Subtotal += amount
+	}
+
+	if spotPricing != nil && spotPricing.DailyMaximum > 0 {
+		for _, date := range dates {
+			over := roundCents(dailyTotals[date] - spotPricing.DailyMaximum)
+			if over > 0 {
+				quote.Adjustments = append(quote.Adjustments, models.PriceQuoteAdjustment{
+					Reason: "daily_maximum",
+					Date:   date,
+					Amount: -over,
+				})
+				quote.Subtotal -= over
+			}
+		}
+	}
+
+	if spotPricing != nil && len(sorted) > 0 {
+		under := roundCents(spotPricing.MinimumCharge - quote.Subtotal)
+		if under > 0 {
+			quote.Adjustments = append(quote.Adjustments, models.PriceQuoteAdjustment{
+				Reason: "minimum_charge",
+				Amount: under,
+			})
+			quote.Subtotal += under
+		}
+	}
+
+	quote.Subtotal = roundCents(quote.Subtotal)
+	return quote
+}
+
+// Take the discount of `promoCode` off the subtotal of `quote`.
+//
+// The discount never exceeds the subtotal.
+func applyDiscount(quote *models.PriceQuote, promoCode *promocode.Entry) {
+	var discount float64
+	switch promoCode.DiscountType {
+	case models.DiscountPercentage:
+		discount = roundCents(quote.Subtotal * promoCode.DiscountValue / 100)
+	case models.DiscountFixed:
+		discount = roundCents(promoCode.DiscountValue)
+	}
+	quote.Discount = min(discount, quote.Subtotal)
+}
+
+// Add the service fee and `taxes` to `quote`, then compute its total.
+//
+// Both are charged on the subtotal after discounts.
+func addCharges(quote *models.PriceQuote, taxes []region.Tax) {
+	discounted := quote.Subtotal - quote.Discount
+	quote.ServiceFee = roundCents(discounted * ServiceFeeRate)
+	taxable := discounted + quote.ServiceFee
+
+	quote.Taxes = make([]models.PriceQuoteTax, 0, len(taxes))
+	quote.Total = taxable
+	for _, tax := range taxes {
+		amount := roundCents(taxable * tax.Rate)
+		quote.Taxes = append(quote.Taxes, models.PriceQuoteTax{
+			Name:   tax.Name,
+			Rate:   tax.Rate,
+			Amount: amount,
+		})
+		quote.Total += amount
+	}
+	quote.Total = roundCents(quote.Total)
+}
+
+// Returns the price per hour of the slot starting at local time `t`.
+func slotRate(t time.Time, pricePerHour float64, spotPricing *pricing.Entry) float64 {
+	if spotPricing == nil {
+		return pricePerHour
+	}
+
+	// Rules for specific dates take precedence
+	for idx := range spotPricing.Rules {
+		rule := &spotPricing.Rules[idx]
+		if rule.Date.IsSet() && rule.Matches(t) {
+			return rule.PricePerHour
+		}
+	}
+	for idx := range spotPricing.Rules {
+		rule := &spotPricing.Rules[idx]
+		if !rule.Date.IsSet() && rule.Matches(t) {
+			return rule.PricePerHour
+		}
+	}
+	return pricePerHour
+}
+
+// Returns the amount paid out to the spot owner for `quote`.
+//
+// Owners receive the discounted subtotal, the service fee and taxes are kept by the platform.
+func payoutAmount(quote *models.PriceQuote) float64 {
+	return roundCents(quote.Subtotal - quote.Discount)
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// Split [start, end) into bookable slots.
+//
+// Returns nil if the range is empty, too long or not aligned to the slot duration.
+func quoteSlots(start, end time.Time) []models.TimeUnit {
+	duration := end.Sub(start)
+	if duration <= 0 || duration%slotDuration != 0 || duration/slotDuration > maximumQuoteSlots {
+		return nil
+	}
+
+	result := make([]models.TimeUnit, 0, duration/slotDuration)
+	for t := start; t.Before(end); t = t.Add(slotDuration) {
+		result = append(result, models.TimeUnit{
+			StartTime: t,
+			EndTime:   t.Add(slotDuration),
+		})
+	}
+	return result
+}
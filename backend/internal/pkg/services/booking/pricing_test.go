@@ -0,0 +1,553 @@
+package booking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/region"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/promocode"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/quote"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/timeofday"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateAmount(t *testing.T) {
+	t.Parallel()
+
+	// Monday, October 21, 2024 at 8:00 AM UTC
+	start := time.Date(2024, time.October, 21, 8, 0, 0, 0, time.UTC)
+
+	t.Run("no pricing uses spot price", func(t *testing.T) {
+		t.Parallel()
+
+		quote := calculateAmount(quoteSlots(start, start.Add(2*time.Hour)), testPrice, nil, time.UTC)
+		assert.Len(t, quote.Items, 4)
+		assert.Empty(t, quote.Adjustments)
+		assert.InDelta(t, 2*testPrice, quote.Subtotal, 0.001)
+	})
+
+	t.Run("slots are priced by the first matching rule", func(t *testing.T) {
+		t.Parallel()
+
+		spotPricing := pricing.Entry{
+			Rules: []pricing.Rule{
+				{
+					Weekdays:     1 << time.Saturday,
+					StartMinute:  0,
+					EndMinute:    timeofday.MinutesPerDay,
+					PricePerHour: 1,
+				},
+				{
+					Weekdays:     timeofday.AllWeekdays,
+					StartMinute:  9 * 60,
+					EndMinute:    17 * 60,
+					PricePerHour: 20,
+				},
+				{
+					Weekdays:     timeofday.AllWeekdays,
+					StartMinute:  9 * 60,
+					EndMinute:    10 * 60,
+					PricePerHour: 30,
+				},
+			},
+		}
+
+		quote := calculateAmount(quoteSlots(start, start.Add(2*time.Hour)), testPrice, &spotPricing, time.UTC)
+		expectedItems := []models.PriceQuoteItem{
+			{StartTime: start, EndTime: start.Add(30 * time.Minute), PricePerHour: testPrice, Amount: 5},
+			{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(time.Hour), PricePerHour: testPrice, Amount: 5},
+			{StartTime: start.Add(time.Hour), EndTime: start.Add(90 * time.Minute), PricePerHour: 20, Amount: 10},
+			{StartTime: start.Add(90 * time.Minute), EndTime: start.Add(2 * time.Hour), PricePerHour: 20, Amount: 10},
+		}
+		assert.Empty(t, cmp.Diff(expectedItems, quote.Items))
+		assert.InDelta(t, 30, quote.Subtotal, 0.001)
+	})
+
+	t.Run("rules with dates take precedence", func(t *testing.T) {
+		t.Parallel()
+
+		spotPricing := pricing.Entry{
+			Rules: []pricing.Rule{
+				{
+					Weekdays:     timeofday.AllWeekdays,
+					StartMinute:  0,
+					EndMinute:    timeofday.MinutesPerDay,
+					PricePerHour: 20,
+				},
+				{
+					Date:         omit.From(time.Date(2024, time.October, 21, 0, 0, 0, 0, time.UTC)),
+					Weekdays:     timeofday.AllWeekdays,
+					StartMinute:  0,
+					EndMinute:    timeofday.MinutesPerDay,
+					PricePerHour: 40,
+				},
+			},
+		}
+
+		quote := calculateAmount(quoteSlots(start, start.Add(time.Hour)), testPrice, &spotPricing, time.UTC)
+		assert.InDelta(t, 40, quote.Subtotal, 0.001)
+
+		nextDay := start.Add(24 * time.Hour)
+		quote = calculateAmount(quoteSlots(nextDay, nextDay.Add(time.Hour)), testPrice, &spotPricing, time.UTC)
+		assert.InDelta(t, 20, quote.Subtotal, 0.001)
+	})
+
+	t.Run("rules are evaluated in local time", func(t *testing.T) {
+		t.Parallel()
+
+		loc, err := time.LoadLocation("America/Winnipeg")
+		require.NoError(t, err)
+
+		spotPricing := pricing.Entry{
+			Rules: []pricing.Rule{
+				{
+					Weekdays:     timeofday.AllWeekdays,
+					StartMinute:  8 * 60,
+					EndMinute:    9 * 60,
+					PricePerHour: 20,
+				},
+			},
+		}
+
+		// 8:00 AM in Winnipeg is 1:00 PM UTC
+		localStart := time.Date(2024, time.October, 21, 13, 0, 0, 0, time.UTC)
+		quote := calculateAmount(quoteSlots(localStart, localStart.Add(time.Hour)), testPrice, &spotPricing, loc)
+		assert.InDelta(t, 20, quote.Subtotal, 0.001)
+
+		quote = calculateAmount(quoteSlots(start, start.Add(time.Hour)), testPrice, &spotPricing, loc)
+		assert.InDelta(t, testPrice, quote.Subtotal, 0.001)
+	})
+
+	t.Run("daily maximum caps each day", func(t *testing.T) {
+		t.Parallel()
+
+		spotPricing := pricing.Entry{DailyMaximum: 25}
+
+		// 8:00 AM to 8:00 AM the next day: 16 hours on the first day, 8 on the second
+		quote := calculateAmount(quoteSlots(start, start.Add(24*time.Hour)), testPrice, &spotPricing, time.UTC)
+		expectedAdjustments := []models.PriceQuoteAdjustment{
+			{Reason: "daily_maximum", Date: "2024-10-21", Amount: -135},
+			{Reason: "daily_maximum", Date: "2024-10-22", Amount: -55},
+		}
+		assert.Empty(t, cmp.Diff(expectedAdjustments, quote.Adjustments))
+		assert.InDelta(t, 50, quote.Subtotal, 0.001)
+	})
+
+	t.Run("minimum charge tops up the total", func(t *testing.T) {
+		t.Parallel()
+
+		spotPricing := pricing.Entry{MinimumCharge: 12.5}
+
+		quote := calculateAmount(quoteSlots(start, start.Add(30*time.Minute)), testPrice, &spotPricing, time.UTC)
+		expectedAdjustments := []models.PriceQuoteAdjustment{
+			{Reason: "minimum_charge", Amount: 7.5},
+		}
+		assert.Empty(t, cmp.Diff(expectedAdjustments, quote.Adjustments))
+		assert.InDelta(t, 12.5, quote.Subtotal, 0.001)
+	})
+
+	t.Run("amounts are rounded to cents", func(t *testing.T) {
+		t.Parallel()
+
+		quote := calculateAmount(quoteSlots(start, start.Add(30*time.Minute)), 3.33, nil, time.UTC)
+		assert.InDelta(t, 1.67, quote.Subtotal, 0.0001)
+	})
+}
+
+func TestApplyDiscount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("percentage discount", func(t *testing.T) {
+		t.Parallel()
+
+		quote := models.PriceQuote{Subtotal: 12.5}
+		applyDiscount(&quote, &promocode.Entry{PromoCode: models.PromoCode{
+			PromoCodeCreationInput: models.PromoCodeCreationInput{DiscountType: models.DiscountPercentage, DiscountValue: 15},
+		}})
+		assert.InDelta(t, 1.88, quote.Discount, 0.0001)
+	})
+
+	t.Run("fixed discount is capped at the subtotal", func(t *testing.T) {
+		t.Parallel()
+
+		quote := models.PriceQuote{Subtotal: 4}
+		applyDiscount(&quote, &promocode.Entry{PromoCode: models.PromoCode{
+			PromoCodeCreationInput: models.PromoCodeCreationInput{DiscountType: models.DiscountFixed, DiscountValue: 5},
+		}})
+		assert.InDelta(t, 4, quote.Discount, 0.0001)
+		assert.InDelta(t, 0, payoutAmount(&quote), 0.0001)
+	})
+}
+
+func TestAddCharges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("harmonized sales tax", func(t *testing.T) {
+		t.Parallel()
+
+		quote := models.PriceQuote{Subtotal: 100}
+		addCharges(&quote, region.SalesTaxes("CA", "ON"))
+		assert.InDelta(t, 5, quote.ServiceFee, 0.001)
+		assert.Empty(t, cmp.Diff([]models.PriceQuoteTax{{Name: "HST", Rate: 0.13, Amount: 13.65}}, quote.Taxes))
+		assert.InDelta(t, 118.65, quote.Total, 0.001)
+	})
+
+	t.Run("federal and provincial sales tax", func(t *testing.T) {
+		t.Parallel()
+
+		quote := models.PriceQuote{Subtotal: 20}
+		addCharges(&quote, region.SalesTaxes("CA", "BC"))
+		expectedTaxes := []models.PriceQuoteTax{
+			{Name: "GST", Rate: 0.05, Amount: 1.05},
+			{Name: "PST", Rate: 0.07, Amount: 1.47},
+		}
+		assert.Empty(t, cmp.Diff(expectedTaxes, quote.Taxes))
+		assert.InDelta(t, 23.52, quote.Total, 0.001)
+	})
+
+	t.Run("fee and taxes are charged on the discounted subtotal", func(t *testing.T) {
+		t.Parallel()
+
+		quote := models.PriceQuote{Subtotal: 100, Discount: 20}
+		addCharges(&quote, region.SalesTaxes("CA", "ON"))
+		assert.InDelta(t, 4, quote.ServiceFee, 0.001)
+		assert.Empty(t, cmp.Diff([]models.PriceQuoteTax{{Name: "HST", Rate: 0.13, Amount: 10.92}}, quote.Taxes))
+		assert.InDelta(t, 94.92, quote.Total, 0.001)
+	})
+
+	t.Run("states without sales tax", func(t *testing.T) {
+		t.Parallel()
+
+		quote := models.PriceQuote{Subtotal: 20}
+		addCharges(&quote, region.SalesTaxes("US", "OR"))
+		assert.Empty(t, quote.Taxes)
+		assert.InDelta(t, 21, quote.Total, 0.001)
+	})
+
+	t.Run("unknown regions are not taxed", func(t *testing.T) {
+		t.Parallel()
+
+		quote := models.PriceQuote{Subtotal: 20}
+		addCharges(&quote, region.SalesTaxes("FR", "IDF"))
+		assert.Empty(t, quote.Taxes)
+		assert.InDelta(t, 21, quote.Total, 0.001)
+	})
+}
+
+func TestGetQuote(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	start := time.Date(2024, time.October, 21, 14, 0, 0, 0, time.UTC)
+
+	t.Run("quotes the spot price", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(nil, spotRepo, nil, pricingRepo, nil, nil, nil, nil, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+			Return(pricing.Entry{MinimumCharge: 5}, nil).
+			Once()
+
+		quote, err := service.GetQuote(ctx, testSpotUUID, start, start.Add(90*time.Minute))
+		require.NoError(t, err)
+		assert.Len(t, quote.Items, 3)
+		assert.Empty(t, quote.Adjustments)
+		assert.InDelta(t, 15, quote.Subtotal, 0.001)
+		assert.InDelta(t, 0.75, quote.ServiceFee, 0.001)
+		assert.Empty(t, cmp.Diff([]models.PriceQuoteTax{{Name: "GST", Rate: 0.05, Amount: 0.79}}, quote.Taxes))
+		assert.InDelta(t, 16.54, quote.Total, 0.001)
+		assert.Equal(t, "CAD", quote.Currency)
+		spotRepo.AssertExpectations(t)
+		pricingRepo.AssertExpectations(t)
+	})
+
+	t.Run("quotes spots in the United States with state sales tax", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(nil, spotRepo, nil, pricingRepo, nil, nil, nil, nil, nil)
+
+		spot := testSpotEntry
+		spot.Location.CountryCode = "US"
+		spot.Location.State = "NY"
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(spot, nil).
+			Once()
+		pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+			Return(pricing.Entry{}, nil).
+			Once()
+
+		quote, err := service.GetQuote(ctx, testSpotUUID, start, start.Add(90*time.Minute))
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff([]models.PriceQuoteTax{{Name: "Sales Tax", Rate: 0.04, Amount: 0.63}}, quote.Taxes))
+		assert.InDelta(t, 16.38, quote.Total, 0.001)
+		assert.Equal(t, "USD", quote.Currency)
+		spotRepo.AssertExpectations(t)
+		pricingRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid time ranges", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(nil, spotRepo, nil, pricingRepo, nil, nil, nil, nil, nil)
+
+		tests := []struct {
+			end  time.Time
+			name string
+		}{
+			{name: "empty", end: start},
+			{name: "reversed", end: start.Add(-time.Hour)},
+			{name: "not aligned", end: start.Add(45 * time.Minute)},
+			{name: "too long", end: start.Add(15 * 24 * time.Hour)},
+		}
+
+		for _, test := range tests {
+			_, err := service.GetQuote(ctx, testSpotUUID, start, test.end)
+			assert.ErrorIs(t, err, models.ErrInvalidQuoteTimeRange, test.name)
+		}
+		spotRepo.AssertNotCalled(t, "GetByUUID")
+		pricingRepo.AssertNotCalled(t, "GetBySpotID")
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		pricingRepo := new(mockPricingRepo)
+		service := New(nil, spotRepo, nil, pricingRepo, nil, nil, nil, nil, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).
+			Once()
+
+		_, err := service.GetQuote(ctx, testSpotUUID, start, start.Add(time.Hour))
+		assert.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		spotRepo.AssertExpectations(t)
+		pricingRepo.AssertNotCalled(t, "GetBySpotID")
+	})
+}
+
+func TestCreateQuote(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("creates a quote", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		pricingRepo := new(mockPricingRepo)
+		quoteRepo := new(mockQuoteRepo)
+		service := New(nil, spotRepo, nil, pricingRepo, quoteRepo, nil, nil, nil, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+			Return(pricing.Entry{}, nil).
+			Once()
+
+		var stored quote.Entry
+		quoteRepo.On("Create", mock.Anything, mock.AnythingOfType("*quote.Entry")).
+			Run(func(args mock.Arguments) {
+				stored = *args.Get(1).(*quote.Entry)
+			}).
+			Return(nil).
+			Once()
+
+		before := time.Now()
+		input := models.BookingQuoteInput{BookedTimes: sampleTimeUnit}
+		result, err := service.CreateQuote(ctx, testUserID, testSpotUUID, &input)
+		require.NoError(t, err)
+		assert.InDelta(t, testQuotedAmount, result.Total, 0.001)
+		assert.Len(t, result.Items, len(sampleTimeUnit))
+		assert.NotEqual(t, uuid.Nil, result.ID)
+		assert.WithinRange(t, result.ExpiresAt, before.Add(QuoteLifetime), time.Now().Add(QuoteLifetime))
+
+		assert.Equal(t, result.ID, stored.ID)
+		assert.Equal(t, result.ExpiresAt, stored.ExpiresAt)
+		assert.Equal(t, testUserID, stored.UserID)
+		assert.Equal(t, testSpotInternalID, stored.SpotID)
+		assert.InDelta(t, result.Total, stored.Total, 0.001)
+		assert.True(t, stored.MatchesTimes(sampleTimeUnit))
+		spotRepo.AssertExpectations(t)
+		pricingRepo.AssertExpectations(t)
+		quoteRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid time slots", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		quoteRepo := new(mockQuoteRepo)
+		service := New(nil, spotRepo, nil, nil, quoteRepo, nil, nil, nil, nil)
+
+		start := sampleTimeUnit[0].StartTime
+		tooMany := make([]models.TimeUnit, 0, maximumQuoteSlots+1)
+		for slot := start; len(tooMany) <= maximumQuoteSlots; slot = slot.Add(30 * time.Minute) {
+			tooMany = append(tooMany, models.TimeUnit{StartTime: slot, EndTime: slot.Add(30 * time.Minute)})
+		}
+
+		tests := []struct {
+			err   error
+			name  string
+			times []models.TimeUnit
+		}{
+			{name: "empty", times: []models.TimeUnit{}, err: models.ErrEmptyBookingTimes},
+			{
+				name:  "not 30 minutes",
+				times: []models.TimeUnit{{StartTime: start, EndTime: start.Add(time.Hour)}},
+				err:   models.ErrInvalidTimeUnit,
+			},
+			{
+				name:  "too many slots",
+				times: tooMany,
+				err:   models.ErrTooManyQuotedTimes,
+			},
+		}
+
+		for _, test := range tests {
+			_, err := service.CreateQuote(ctx, testUserID, testSpotUUID, &models.BookingQuoteInput{BookedTimes: test.times})
+			assert.ErrorIs(t, err, test.err, test.name)
+		}
+		spotRepo.AssertNotCalled(t, "GetByUUID")
+		quoteRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		quoteRepo := new(mockQuoteRepo)
+		service := New(nil, spotRepo, nil, nil, quoteRepo, nil, nil, nil, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).
+			Once()
+
+		_, err := service.CreateQuote(ctx, testUserID, testSpotUUID, &models.BookingQuoteInput{BookedTimes: sampleTimeUnit})
+		assert.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		quoteRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("applies a promo code", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		pricingRepo := new(mockPricingRepo)
+		quoteRepo := new(mockQuoteRepo)
+		promoCodeRepo := new(mockPromoCodeRepo)
+		service := New(nil, spotRepo, nil, pricingRepo, quoteRepo, promoCodeRepo, nil, nil, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Once()
+		pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+			Return(pricing.Entry{}, nil).
+			Once()
+		promoCodeRepo.On("GetByCode", mock.Anything, testPromoCode.Code).
+			Return(testPromoCode, nil).
+			Once()
+
+		var stored quote.Entry
+		quoteRepo.On("Create", mock.Anything, mock.AnythingOfType("*quote.Entry")).
+			Run(func(args mock.Arguments) {
+				stored = *args.Get(1).(*quote.Entry)
+			}).
+			Return(nil).
+			Once()
+
+		input := models.BookingQuoteInput{BookedTimes: sampleTimeUnit, PromoCode: "Save10"}
+		result, err := service.CreateQuote(ctx, testUserID, testSpotUUID, &input)
+		require.NoError(t, err)
+		assert.InDelta(t, 1, result.Discount, 0.001)
+		assert.InDelta(t, testDiscountedAmount, result.Total, 0.001)
+
+		assert.Equal(t, testPromoCode.Code, stored.PromoCode)
+		assert.Equal(t, testPromoCode.InternalID, stored.PromoCodeID)
+		assert.InDelta(t, 1, stored.Discount, 0.001)
+		assert.InDelta(t, 9, stored.Payout, 0.001)
+		assert.InDelta(t, testDiscountedAmount, stored.Total, 0.001)
+		promoCodeRepo.AssertExpectations(t)
+		quoteRepo.AssertExpectations(t)
+	})
+
+	t.Run("promo codes that can not be applied", func(t *testing.T) {
+		t.Parallel()
+
+		expired := testPromoCode
+		expiresAt := time.Now().Add(-time.Minute)
+		expired.ExpiresAt = &expiresAt
+		otherSpot := testPromoCode
+		otherSpot.SpotID = testSpotInternalID_1
+		otherOwner := testPromoCode
+		otherOwner.OwnerID = testUserID
+		minimumSpend := testPromoCode
+		minimumSpend.MinimumSpend = 25
+		exhausted := testPromoCode
+		exhausted.MaxUses = 3
+		exhausted.Uses = 3
+
+		tests := []struct {
+			repoErr error
+			err     error
+			name    string
+			entry   promocode.Entry
+		}{
+			{name: "unknown", repoErr: promocode.ErrNotFound, err: models.ErrPromoCodeInvalid},
+			{name: "expired", entry: expired, err: models.ErrPromoCodeInvalid},
+			{name: "restricted to another spot", entry: otherSpot, err: models.ErrPromoCodeNotApplicable},
+			{name: "restricted to another owner", entry: otherOwner, err: models.ErrPromoCodeNotApplicable},
+			{name: "below minimum spend", entry: minimumSpend, err: models.ErrPromoCodeMinimumSpend},
+			{name: "usage limit reached", entry: exhausted, err: models.ErrPromoCodeExhausted},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				t.Parallel()
+
+				spotRepo := new(mockParkingspotRepo)
+				pricingRepo := new(mockPricingRepo)
+				quoteRepo := new(mockQuoteRepo)
+				promoCodeRepo := new(mockPromoCodeRepo)
+				service := New(nil, spotRepo, nil, pricingRepo, quoteRepo, promoCodeRepo, nil, nil, nil)
+
+				spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+					Return(testSpotEntry, nil).
+					Once()
+				pricingRepo.On("GetBySpotID", mock.Anything, testSpotInternalID).
+					Return(pricing.Entry{}, nil).
+					Once()
+				promoCodeRepo.On("GetByCode", mock.Anything, testPromoCode.Code).
+					Return(test.entry, test.repoErr).
+					Once()
+
+				input := models.BookingQuoteInput{BookedTimes: sampleTimeUnit, PromoCode: testPromoCode.Code}
+				_, err := service.CreateQuote(ctx, testUserID, testSpotUUID, &input)
+				assert.ErrorIs(t, err, test.err)
+				quoteRepo.AssertNotCalled(t, "Create")
+			})
+		}
+	})
+}
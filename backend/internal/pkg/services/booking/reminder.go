@@ -0,0 +1,58 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/region"
+	"github.com/rs/zerolog/log"
+)
+
+// How long before the start of a booking the booker is reminded of it
+const ReminderLead = time.Hour
+
+// Interval between sweeps for upcoming bookings
+const ReminderInterval = time.Minute
+
+// Periodically remind bookers of their upcoming bookings until `ctx` is done
+func (s *Service) RunReminders(ctx context.Context) {
+	ticker := time.NewTicker(ReminderInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.sendReminders(ctx, now)
+		}
+	}
+}
+
+func (s *Service) sendReminders(ctx context.Context, now time.Time) {
+	upcoming, err := s.repo.ClaimUpcoming(ctx, now, now.Add(ReminderLead), now)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Err(err).Msg("could not get upcoming bookings")
+		}
+		return
+	}
+
+	for i := range upcoming {
+		entry := &upcoming[i]
+		loc := region.TimeZone(entry.ParkingSpotLocation.CountryCode, entry.ParkingSpotLocation.State)
+		s.notify(ctx, entry.BookerID, &models.NotificationInput{
+			Type:  models.NotificationBookingReminder,
+			Title: "Upcoming booking",
+			Body: fmt.Sprintf(
+				"Your booking at %s, %s starts at %s.",
+				entry.ParkingSpotLocation.StreetAddress,
+				entry.ParkingSpotLocation.City,
+				entry.StartTime.In(loc).Format("15:04"),
+			),
+			SubjectID: entry.BookingID,
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendReminders(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	now := time.Date(2024, time.October, 21, 14, 0, 0, 0, time.UTC)
+
+	t.Run("reminds bookers in the spot local time", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		sender := new(mockSender)
+		service := New(repo, nil, nil, nil, nil, nil, nil, nil, sender)
+
+		bookingID := uuid.New()
+		repo.On("ClaimUpcoming", mock.Anything, now, now.Add(ReminderLead), now).
+			Return([]booking.Upcoming{
+				{
+					StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+					ParkingSpotLocation: models.ParkingSpotLocation{
+						CountryCode:   "CA",
+						State:         "MB",
+						City:          "Winnipeg",
+						StreetAddress: "180 Main St",
+					},
+					BookingID: bookingID,
+					BookerID:  testUserID,
+				},
+			}, nil).
+			Once()
+		sender.On("Send", mock.Anything, testUserID, &models.NotificationInput{
+			Type:      models.NotificationBookingReminder,
+			Title:     "Upcoming booking",
+			Body:      "Your booking at 180 Main St, Winnipeg starts at 09:30.",
+			SubjectID: bookingID,
+		}).
+			Return(models.Notification{}, nil).
+			Once()
+
+		service.sendReminders(ctx, now)
+
+		repo.AssertExpectations(t)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("nothing is sent if bookings could not be claimed", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		sender := new(mockSender)
+		service := New(repo, nil, nil, nil, nil, nil, nil, nil, sender)
+
+		repo.On("ClaimUpcoming", mock.Anything, now, now.Add(ReminderLead), now).
+			Return([]booking.Upcoming(nil), errors.New("some error")).
+			Once()
+
+		service.sendReminders(ctx, now)
+
+		repo.AssertExpectations(t)
+		sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,173 @@
+package booking
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/review"
+	"github.com/aarondl/opt/omit"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Review the booking `bookingID` as `userID`.
+//
+// The booker reviews the spot and the spot owner reviews the driver. Bookings can only be
+// reviewed once by each side, after all booked times have ended.
+func (s *Service) CreateReview(ctx context.Context, userID int64, bookingID uuid.UUID, input *models.ReviewCreationInput) (models.Review, error) {
+	if input.Rating < 1 || input.Rating > 5 {
+		return models.Review{}, models.ErrInvalidRating
+	}
+	if len(input.Comment) > models.MaximumReviewCommentLength {
+		return models.Review{}, models.ErrInvalidReviewComment
+	}
+
+	entry, spotOwner, err := s.getAsParticipant(ctx, userID, bookingID)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	if !isCompleted(entry.BookedTimes, time.Now()) {
+		return models.Review{}, models.ErrBookingNotCompleted
+	}
+
+	subject := models.ReviewSubjectDriver
+	recipient := entry.Entry.BookerID
+	body := "You received a %d-star review as a driver."
+	if userID == entry.Entry.BookerID {
+		subject = models.ReviewSubjectSpot
+		recipient = spotOwner
+		body = "Your parking spot received a %d-star review."
+	}
+
+	result, err := s.reviewRepo.Create(ctx, &review.CreateInput{
+		ReviewCreationInput: *input,
+		Subject:             subject,
+		BookingID:           entry.Entry.InternalID,
+		ReviewerID:          userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, review.ErrDuplicateReview):
+			err = models.ErrReviewDuplicate
+		case errors.Is(err, review.ErrInvalidRating):
+			err = models.ErrInvalidRating
+		}
+		return models.Review{}, err
+	}
+
+	s.notify(ctx, recipient, &models.NotificationInput{
+		Type:      models.NotificationReviewReceived,
+		Title:     "New review",
+		Body:      fmt.Sprintf(body, input.Rating),
+		SubjectID: bookingID,
+	})
+
+	return result.Review, nil
+}
+
+// Get the reviews of the booking `bookingID`.
+//
+// Only the booker and the spot owner can view the reviews of a booking.
+func (s *Service) GetReviews(ctx context.Context, userID int64, bookingID uuid.UUID) ([]models.Review, error) {
+	entry, _, err := s.getAsParticipant(ctx, userID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.reviewRepo.GetManyForBooking(ctx, entry.Entry.InternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Review, 0, len(entries))
+	for idx := range entries {
+		result = append(result, entries[idx].Review)
+	}
+	return result, nil
+}
+
+// Get at most `count` reviews left by drivers for the spot `spotID`, newest first.
+func (s *Service) GetSpotReviews(ctx context.Context, spotID uuid.UUID, count int, after models.Cursor) (reviews []models.Review, next models.Cursor, err error) {
+	if count <= 0 {
+		return []models.Review{}, "", nil
+	}
+
+	spot, err := s.spotRepo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return nil, "", err
+	}
+
+	cursor := decodeReviewCursor(after)
+	count = min(count, MaximumCount)
+	entries, err := s.reviewRepo.GetManyForSpot(ctx, count+1, cursor, spot.InternalID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(entries) > count {
+		entries = entries[:len(entries)-1]
+
+		next, err = encodeReviewCursor(review.Cursor{
+			ID: entries[len(entries)-1].InternalID,
+		})
+		// This is an issue, but not enough to abort the request
+		if err != nil {
+			log.Err(err).
+				Int64("reviewid", entries[len(entries)-1].InternalID).
+				Msg("could not encode next cursor")
+		}
+	}
+
+	result := make([]models.Review, 0, len(entries))
+	for idx := range entries {
+		result = append(result, entries[idx].Review)
+	}
+	return result, next, nil
+}
+
+// Returns whether all `bookedTimes` have ended by `now`.
+func isCompleted(bookedTimes []models.TimeUnit, now time.Time) bool {
+	if len(bookedTimes) == 0 {
+		return false
+	}
+	for _, unit := range bookedTimes {
+		if unit.EndTime.After(now) {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeReviewCursor(cursor models.Cursor) omit.Val[review.Cursor] {
+	raw, err := base64.RawURLEncoding.DecodeString(string(cursor))
+	if err != nil {
+		return omit.Val[review.Cursor]{}
+	}
+
+	var result review.Cursor
+	err = cbor.Unmarshal(raw, &result)
+	if err != nil {
+		return omit.Val[review.Cursor]{}
+	}
+
+	return omit.From(result)
+}
+
+func encodeReviewCursor(cursor review.Cursor) (models.Cursor, error) {
+	raw, err := cbor.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return models.Cursor(base64.RawURLEncoding.EncodeToString(raw)), nil
+}
@@ -0,0 +1,294 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/review"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockReviewRepo struct {
+	mock.Mock
+}
+
+// Create implements review.Repository.
+func (m *mockReviewRepo) Create(ctx context.Context, input *review.CreateInput) (review.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(review.Entry), args.Error(1)
+}
+
+// GetManyForBooking implements review.Repository.
+func (m *mockReviewRepo) GetManyForBooking(ctx context.Context, bookingID int64) ([]review.Entry, error) {
+	args := m.Called(ctx, bookingID)
+	return args.Get(0).([]review.Entry), args.Error(1)
+}
+
+// GetManyForSpot implements review.Repository.
+func (m *mockReviewRepo) GetManyForSpot(ctx context.Context, limit int, after omit.Val[review.Cursor], spotID int64) ([]review.Entry, error) {
+	args := m.Called(ctx, limit, after, spotID)
+	return args.Get(0).([]review.Entry), args.Error(1)
+}
+
+func TestCreateReview(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// sampleTimeUnit has ended long ago
+	completedEntry := booking.EntryWithTimes{
+		EntryWithDetails: booking.EntryWithDetails{
+			Entry: booking.Entry{
+				Booking:    testBooking,
+				InternalID: testBookingInternalID,
+				BookerID:   testUserID,
+			},
+		},
+		BookedTimes: sampleTimeUnit,
+	}
+	testInput := models.ReviewCreationInput{
+		Comment: "Easy to find",
+		Rating:  5,
+	}
+
+	t.Run("drivers review the spot", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		reviewRepo := new(mockReviewRepo)
+		sender := new(mockSender)
+		service := New(repo, spotRepo, nil, nil, nil, nil, reviewRepo, nil, sender)
+
+		repo.On("GetByUUID", mock.Anything, testBookingUUID).
+			Return(completedEntry, nil).
+			Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
+			Return(testOwnerID, nil).
+			Once()
+		sender.On("Send", mock.Anything, testOwnerID, &models.NotificationInput{
+			Type:      models.NotificationReviewReceived,
+			Title:     "New review",
+			Body:      "Your parking spot received a 5-star review.",
+			SubjectID: testBookingUUID,
+		}).
+			Return(models.Notification{}, nil).
+			Once()
+		expected := models.Review{
+			Subject:             models.ReviewSubjectSpot,
+			ReviewCreationInput: testInput,
+			ID:                  uuid.New(),
+			BookingID:           testBookingUUID,
+		}
+		reviewRepo.On("Create", mock.Anything, &review.CreateInput{
+			ReviewCreationInput: testInput,
+			Subject:             models.ReviewSubjectSpot,
+			BookingID:           testBookingInternalID,
+			ReviewerID:          testUserID,
+		}).
+			Return(review.Entry{Review: expected}, nil).
+			Once()
+
+		result, err := service.CreateReview(ctx, testUserID, testBookingUUID, &testInput)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		repo.AssertExpectations(t)
+		spotRepo.AssertExpectations(t)
+		reviewRepo.AssertExpectations(t)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("hosts review the driver", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		reviewRepo := new(mockReviewRepo)
+		sender := new(mockSender)
+		service := New(repo, spotRepo, nil, nil, nil, nil, reviewRepo, nil, sender)
+
+		repo.On("GetByUUID", mock.Anything, testBookingUUID).
+			Return(completedEntry, nil).
+			Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
+			Return(testOwnerID, nil).
+			Once()
+		reviewRepo.On("Create", mock.Anything, &review.CreateInput{
+			ReviewCreationInput: testInput,
+			Subject:             models.ReviewSubjectDriver,
+			BookingID:           testBookingInternalID,
+			ReviewerID:          testOwnerID,
+		}).
+			Return(review.Entry{}, nil).
+			Once()
+		// Failing to notify the driver does not fail the review
+		sender.On("Send", mock.Anything, testUserID, mock.Anything).
+			Return(models.Notification{}, errors.New("database error")).
+			Once()
+
+		_, err := service.CreateReview(ctx, testOwnerID, testBookingUUID, &testInput)
+		require.NoError(t, err)
+
+		reviewRepo.AssertExpectations(t)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("only participants can review", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		reviewRepo := new(mockReviewRepo)
+		service := New(repo, spotRepo, nil, nil, nil, nil, reviewRepo, nil, nil)
+
+		repo.On("GetByUUID", mock.Anything, testBookingUUID).
+			Return(completedEntry, nil).
+			Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
+			Return(testOwnerID, nil).
+			Once()
+
+		_, err := service.CreateReview(ctx, testUserID+1, testBookingUUID, &testInput)
+		require.ErrorIs(t, err, models.ErrBookingNotFound)
+
+		reviewRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("bookings must be completed", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		reviewRepo := new(mockReviewRepo)
+		service := New(repo, spotRepo, nil, nil, nil, nil, reviewRepo, nil, nil)
+
+		start := time.Now().Truncate(slotDuration)
+		upcomingEntry := completedEntry
+		upcomingEntry.BookedTimes = []models.TimeUnit{
+			{StartTime: start, EndTime: start.Add(slotDuration)},
+		}
+		repo.On("GetByUUID", mock.Anything, testBookingUUID).
+			Return(upcomingEntry, nil).
+			Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
+			Return(testOwnerID, nil).
+			Once()
+
+		_, err := service.CreateReview(ctx, testUserID, testBookingUUID, &testInput)
+		require.ErrorIs(t, err, models.ErrBookingNotCompleted)
+
+		reviewRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("bookings can only be reviewed once", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		reviewRepo := new(mockReviewRepo)
+		service := New(repo, spotRepo, nil, nil, nil, nil, reviewRepo, nil, nil)
+
+		repo.On("GetByUUID", mock.Anything, testBookingUUID).
+			Return(completedEntry, nil).
+			Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
+			Return(testOwnerID, nil).
+			Once()
+		reviewRepo.On("Create", mock.Anything, mock.Anything).
+			Return(review.Entry{}, review.ErrDuplicateReview).
+			Once()
+
+		_, err := service.CreateReview(ctx, testUserID, testBookingUUID, &testInput)
+		require.ErrorIs(t, err, models.ErrReviewDuplicate)
+
+		reviewRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		service := New(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := service.CreateReview(ctx, testUserID, testBookingUUID, &models.ReviewCreationInput{Rating: 0})
+		require.ErrorIs(t, err, models.ErrInvalidRating)
+
+		_, err = service.CreateReview(ctx, testUserID, testBookingUUID, &models.ReviewCreationInput{Rating: 6})
+		require.ErrorIs(t, err, models.ErrInvalidRating)
+
+		long := make([]byte, models.MaximumReviewCommentLength+1)
+		_, err = service.CreateReview(ctx, testUserID, testBookingUUID, &models.ReviewCreationInput{
+			Comment: string(long),
+			Rating:  3,
+		})
+		require.ErrorIs(t, err, models.ErrInvalidReviewComment)
+	})
+}
+
+func TestGetSpotReviews(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("paginates reviews", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		reviewRepo := new(mockReviewRepo)
+		service := New(nil, spotRepo, nil, nil, nil, nil, reviewRepo, nil, nil)
+
+		entries := []review.Entry{
+			{Review: models.Review{ID: uuid.New()}, InternalID: 3},
+			{Review: models.Review{ID: uuid.New()}, InternalID: 2},
+		}
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(testSpotEntry, nil).
+			Twice()
+		reviewRepo.On("GetManyForSpot", mock.Anything, 2, omit.Val[review.Cursor]{}, testSpotInternalID).
+			Return(entries, nil).
+			Once()
+
+		result, next, err := service.GetSpotReviews(ctx, testSpotUUID, 1, "")
+		require.NoError(t, err)
+		assert.Equal(t, []models.Review{entries[0].Review}, result)
+		assert.NotEmpty(t, next)
+
+		reviewRepo.On("GetManyForSpot", mock.Anything, 2, omit.From(review.Cursor{ID: 3}), testSpotInternalID).
+			Return(entries[1:], nil).
+			Once()
+
+		result, next, err = service.GetSpotReviews(ctx, testSpotUUID, 1, next)
+		require.NoError(t, err)
+		assert.Equal(t, []models.Review{entries[1].Review}, result)
+		assert.Empty(t, next)
+
+		spotRepo.AssertExpectations(t)
+		reviewRepo.AssertExpectations(t)
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		spotRepo := new(mockParkingspotRepo)
+		reviewRepo := new(mockReviewRepo)
+		service := New(nil, spotRepo, nil, nil, nil, nil, reviewRepo, nil, nil)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).
+			Once()
+
+		_, _, err := service.GetSpotReviews(ctx, testSpotUUID, 10, "")
+		require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+	})
+}
@@ -0,0 +1,260 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/ical"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/region"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/calendarfeed"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/calendarimport"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/safehttp"
+	"github.com/google/uuid"
+)
+
+// Largest number of bookings in a calendar feed
+const FeedLimit = 500
+
+// Name of calendar feeds shown by calendar applications
+const FeedName = "ParkEasy"
+
+// Size of feed tokens in bytes
+const tokenSize = 32
+
+// Notifier tells spot owners about the changes made by polling their imported calendars
+type Notifier interface {
+	// Notify the owner of `spotID` of `result`
+	NotifyCalendarSync(ctx context.Context, spotID uuid.UUID, result *models.CalendarImportResult) error
+}
+
+type Service struct {
+	repo        calendarfeed.Repository
+	importRepo  calendarimport.Repository
+	bookingRepo booking.Repository
+	spotRepo    parkingspot.Repository
+	notifier    Notifier
+	guard       *safehttp.Guard
+	client      *http.Client
+}
+
+// Create a new calendar service, only importing calendars from hosts allowed by `guard`.
+//
+// Spot owners are told through `notifier` when polling their imported calendar changes availability.
+func New(
+	repo calendarfeed.Repository,
+	importRepo calendarimport.Repository,
+	bookingRepo booking.Repository,
+	spotRepo parkingspot.Repository,
+	notifier Notifier,
+	guard *safehttp.Guard,
+) *Service {
+	return &Service{
+		repo:        repo,
+		importRepo:  importRepo,
+		bookingRepo: bookingRepo,
+		spotRepo:    spotRepo,
+		notifier:    notifier,
+		guard:       guard,
+		client:      guard.Client(),
+	}
+}
+
+// Get the booking `bookingID` as an iCalendar file if `userID` is the booker or the spot owner
+func (s *Service) GetBooking(ctx context.Context, userID int64, bookingID uuid.UUID) ([]byte, error) {
+	entry, err := s.bookingRepo.GetByUUID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, booking.ErrNotFound) {
+			err = models.ErrBookingNotFound
+		}
+		return nil, err
+	}
+
+	spotOwner, err := s.spotRepo.GetOwnerByUUID(ctx, entry.ParkingSpotID)
+	if err != nil {
+		return nil, err
+	}
+	if userID != entry.BookerID && userID != spotOwner {
+		return nil, models.ErrBookingNotFound
+	}
+
+	cal := ical.Calendar{
+		Events: bookingEvents(&entry, userID),
+	}
+	return encode(&cal)
+}
+
+// Get the calendar feed URL token of `userID`
+func (s *Service) GetFeed(ctx context.Context, userID int64) (models.CalendarFeed, error) {
+	entry, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, calendarfeed.ErrNotFound) {
+			err = models.ErrCalendarFeedNotFound
+		}
+		return models.CalendarFeed{}, err
+	}
+	return feedFromEntry(&entry), nil
+}
+
+// Create a calendar feed for `userID`, replacing the token of any existing feed
+func (s *Service) ResetFeed(ctx context.Context, userID int64) (models.CalendarFeed, error) {
+	token, err := generateToken()
+	if err != nil {
+		return models.CalendarFeed{}, err
+	}
+
+	entry, err := s.repo.Upsert(ctx, userID, token)
+	if err != nil {
+		return models.CalendarFeed{}, err
+	}
+	return feedFromEntry(&entry), nil
+}
+
+// Delete the calendar feed of `userID`, its URL stops working
+func (s *Service) DeleteFeed(ctx context.Context, userID int64) error {
+	err := s.repo.DeleteByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, calendarfeed.ErrNotFound) {
+			err = models.ErrCalendarFeedNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Get the calendar feed identified by `token` as an iCalendar file.
+//
+// The feed has the bookings and leasings of the feed owner that did not end by `now`.
+func (s *Service) GetFeedCalendar(ctx context.Context, token string, now time.Time) ([]byte, error) {
+	feed, err := s.repo.GetByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, calendarfeed.ErrNotFound) {
+			err = models.ErrCalendarFeedNotFound
+		}
+		return nil, err
+	}
+
+	entries, err := s.bookingRepo.GetManyForCalendar(ctx, feed.UserID, now, FeedLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	cal := ical.Calendar{
+		Name:   FeedName,
+		Events: make([]ical.Event, 0, len(entries)),
+	}
+	for idx := range entries {
+		cal.Events = append(cal.Events, bookingEvents(&entries[idx], feed.UserID)...)
+	}
+	return encode(&cal)
+}
+
+// Returns the events of `entry` as seen by `userID`, one for each contiguous range of booked times
+func bookingEvents(entry *booking.EntryWithTimes, userID int64) []ical.Event {
+	loc := region.TimeZone(entry.ParkingSpotLocation.CountryCode, entry.ParkingSpotLocation.State)
+	location := &entry.ParkingSpotLocation
+	car := &entry.CarDetails
+
+	summary := "Parking at " + location.StreetAddress
+	if userID != entry.BookerID {
+		summary = "Spot booked at " + location.StreetAddress
+	}
+	description := fmt.Sprintf(
+		"Booking %v\nCar: %s %s %s (%s)",
+		entry.ID,
+		car.Color,
+		car.Make,
+		car.Model,
+		car.LicensePlate,
+	)
+
+	ranges := mergeTimes(entry.BookedTimes)
+	result := make([]ical.Event, 0, len(ranges))
+	for _, unit := range ranges {
+		result = append(result, ical.Event{
+			Start:       unit.StartTime.In(loc),
+			End:         unit.EndTime.In(loc),
+			Stamp:       entry.CreatedAt,
+			UID:         fmt.Sprintf("%v-%d@parkeasy", entry.ID, unit.StartTime.Unix()),
+			Summary:     summary,
+			Description: description,
+			Location:    formatLocation(location),
+			Latitude:    location.Latitude,
+			Longitude:   location.Longitude,
+		})
+	}
+	return result
+}
+
+// Returns the contiguous ranges covered by `units`, in chronological order
+func mergeTimes(units []models.TimeUnit) []models.TimeUnit {
+	sorted := slices.Clone(units)
+	slices.SortFunc(sorted, func(a, b models.TimeUnit) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	result := make([]models.TimeUnit, 0, len(sorted))
+	for _, unit := range sorted {
+		if len(result) > 0 {
+			last := &result[len(result)-1]
+			if !unit.StartTime.After(last.EndTime) {
+				if unit.EndTime.After(last.EndTime) {
+					last.EndTime = unit.EndTime
+				}
+				continue
+			}
+		}
+		result = append(result, models.TimeUnit{
+			StartTime: unit.StartTime,
+			EndTime:   unit.EndTime,
+		})
+	}
+	return result
+}
+
+// Format `location` as a single line address
+func formatLocation(location *models.ParkingSpotLocation) string {
+	area := strings.TrimSpace(location.State + " " + location.PostalCode)
+	parts := make([]string, 0, 4)
+	for _, part := range []string{location.StreetAddress, location.City, area, location.CountryCode} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func encode(cal *ical.Calendar) ([]byte, error) {
+	var buf bytes.Buffer
+	err := cal.Encode(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode calendar: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func feedFromEntry(entry *calendarfeed.Entry) models.CalendarFeed {
+	return models.CalendarFeed{
+		CreatedAt: entry.CreatedAt,
+		Token:     entry.Token,
+	}
+}
+
+func generateToken() (string, error) {
+	raw := make([]byte, tokenSize)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", fmt.Errorf("could not generate calendar feed token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
@@ -0,0 +1,350 @@
+package calendar
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/calendarfeed"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/safehttp"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+type mockBookingRepo struct {
+	mock.Mock
+}
+
+type mockParkingspotRepo struct {
+	mock.Mock
+}
+
+// Upsert implements calendarfeed.Repository.
+func (m *mockRepo) Upsert(ctx context.Context, userID int64, token string) (calendarfeed.Entry, error) {
+	args := m.Called(ctx, userID, token)
+	return args.Get(0).(calendarfeed.Entry), args.Error(1)
+}
+
+// GetByUser implements calendarfeed.Repository.
+func (m *mockRepo) GetByUser(ctx context.Context, userID int64) (calendarfeed.Entry, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(calendarfeed.Entry), args.Error(1)
+}
+
+// GetByToken implements calendarfeed.Repository.
+func (m *mockRepo) GetByToken(ctx context.Context, token string) (calendarfeed.Entry, error) {
+	args := m.Called(ctx, token)
+	return args.Get(0).(calendarfeed.Entry), args.Error(1)
+}
+
+// DeleteByUser implements calendarfeed.Repository.
+func (m *mockRepo) DeleteByUser(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// Create implements booking.Repository.
+func (m *mockBookingRepo) Create(ctx context.Context, input *booking.CreateInput) (booking.EntryWithTimes, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(booking.EntryWithTimes), args.Error(1)
+}
+
+// GetByUUID implements booking.Repository.
+func (m *mockBookingRepo) GetByUUID(ctx context.Context, bookingID uuid.UUID) (booking.EntryWithTimes, error) {
+	args := m.Called(ctx, bookingID)
+	return args.Get(0).(booking.EntryWithTimes), args.Error(1)
+}
+
+// GetManyForOwner implements booking.Repository.
+func (m *mockBookingRepo) GetManyForOwner(ctx context.Context, limit int, after omit.Val[booking.Cursor], userID int64, filter *booking.Filter) ([]booking.EntryWithDetails, error) {
+	args := m.Called(ctx, limit, after, userID, filter)
+	return args.Get(0).([]booking.EntryWithDetails), args.Error(1)
+}
+
+// GetManyForBuyer implements booking.Repository.
+func (m *mockBookingRepo) GetManyForBuyer(ctx context.Context, limit int, after omit.Val[booking.Cursor], userID int64, filter *booking.Filter) ([]booking.EntryWithDetails, error) {
+	args := m.Called(ctx, limit, after, userID, filter)
+	return args.Get(0).([]booking.EntryWithDetails), args.Error(1)
+}
+
+// ClaimUpcoming implements booking.Repository.
+func (m *mockBookingRepo) ClaimUpcoming(ctx context.Context, after, before, now time.Time) ([]booking.Upcoming, error) {
+	args := m.Called(ctx, after, before, now)
+	return args.Get(0).([]booking.Upcoming), args.Error(1)
+}
+
+// GetManyForCalendar implements booking.Repository.
+func (m *mockBookingRepo) GetManyForCalendar(ctx context.Context, userID int64, after time.Time, limit int) ([]booking.EntryWithTimes, error) {
+	args := m.Called(ctx, userID, after, limit)
+	return args.Get(0).([]booking.EntryWithTimes), args.Error(1)
+}
+
+// Create implements parkingspot.Repository.
+func (m *mockParkingspotRepo) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (parkingspot.Entry, []models.TimeUnit, error) {
+	args := m.Called(ctx, userID, spot)
+	return args.Get(0).(parkingspot.Entry), args.Get(1).([]models.TimeUnit), args.Error(2)
+}
+
+// GetByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetByUUID(ctx context.Context, spotID uuid.UUID) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// GetOwnerByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetOwnerByUUID(ctx context.Context, spotID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetAvailByUUID(ctx context.Context, spotID uuid.UUID, startDate, endDate time.Time) ([]models.TimeUnit, error) {
+	args := m.Called(ctx, spotID, startDate, endDate)
+	return args.Get(0).([]models.TimeUnit), args.Error(1)
+}
+
+// GetMany implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetMany(ctx context.Context, limit int, filter *parkingspot.Filter) ([]parkingspot.GetManyEntry, error) {
+	args := m.Called(ctx, limit, filter)
+	return args.Get(0).([]parkingspot.GetManyEntry), args.Error(1)
+}
+
+// UpdateSpotByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateSpotByUUID(ctx context.Context, spotID uuid.UUID, updateSpot *models.ParkingSpotUpdateInput) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID, updateSpot)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// UpdateAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateAvailByUUID(ctx context.Context, spotID uuid.UUID, updateTimes *models.ParkingSpotAvailUpdateInput) error {
+	args := m.Called(ctx, spotID, updateTimes)
+	return args.Error(0)
+}
+
+const (
+	testBookerID = int64(0)
+	testOwnerID  = int64(1)
+	testOtherID  = int64(2)
+)
+
+// Returns a booking of two contiguous slots and a separate slot, in Winnipeg during daylight saving time
+func testEntry() booking.EntryWithTimes {
+	start := time.Date(2024, time.October, 30, 14, 0, 0, 0, time.UTC)
+	return booking.EntryWithTimes{
+		EntryWithDetails: booking.EntryWithDetails{
+			ParkingSpotLocation: models.ParkingSpotLocation{
+				PostalCode:    "R3T 2N2",
+				CountryCode:   "CA",
+				City:          "Winnipeg",
+				State:         "MB",
+				StreetAddress: "66 Chancellors Cir",
+				Latitude:      49.8075,
+				Longitude:     -97.1366,
+			},
+			CarDetails: models.CarDetails{
+				LicensePlate: "ABC123",
+				Make:         "Honda",
+				Model:        "Civic",
+				Color:        "Red",
+			},
+			Entry: booking.Entry{
+				Booking: models.Booking{
+					CreatedAt:     start.Add(-24 * time.Hour),
+					ID:            uuid.New(),
+					ParkingSpotID: uuid.New(),
+				},
+				BookerID: testBookerID,
+			},
+		},
+		BookedTimes: []models.TimeUnit{
+			{StartTime: start.Add(3 * time.Hour), EndTime: start.Add(3*time.Hour + 30*time.Minute)},
+			{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(time.Hour)},
+			{StartTime: start, EndTime: start.Add(30 * time.Minute)},
+		},
+	}
+}
+
+func TestGetBooking(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	entry := testEntry()
+
+	t.Run("booker gets merged events", func(t *testing.T) {
+		t.Parallel()
+
+		bookingRepo := new(mockBookingRepo)
+		spotRepo := new(mockParkingspotRepo)
+		srv := New(nil, nil, bookingRepo, spotRepo, nil, safehttp.New(false))
+
+		bookingRepo.On("GetByUUID", mock.Anything, entry.ID).Return(entry, nil).Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, entry.ParkingSpotID).Return(testOwnerID, nil).Once()
+
+		result, err := srv.GetBooking(ctx, testBookerID, entry.ID)
+		require.NoError(t, err)
+		bookingRepo.AssertExpectations(t)
+		spotRepo.AssertExpectations(t)
+
+		cal := string(result)
+		assert.Equal(t, 2, strings.Count(cal, "BEGIN:VEVENT"))
+		assert.Contains(t, cal, "TZID:America/Winnipeg\r\n")
+		assert.Contains(t, cal, "DTSTART;TZID=America/Winnipeg:20241030T090000\r\nDTEND;TZID=America/Winnipeg:20241030T100000\r\n")
+		assert.Contains(t, cal, "DTSTART;TZID=America/Winnipeg:20241030T120000\r\nDTEND;TZID=America/Winnipeg:20241030T123000\r\n")
+		assert.Contains(t, cal, "SUMMARY:Parking at 66 Chancellors Cir\r\n")
+		assert.Contains(t, cal, `LOCATION:66 Chancellors Cir\, Winnipeg\, MB R3T 2N2\, CA`+"\r\n")
+	})
+
+	t.Run("owner sees a leasing", func(t *testing.T) {
+		t.Parallel()
+
+		bookingRepo := new(mockBookingRepo)
+		spotRepo := new(mockParkingspotRepo)
+		srv := New(nil, nil, bookingRepo, spotRepo, nil, safehttp.New(false))
+
+		bookingRepo.On("GetByUUID", mock.Anything, entry.ID).Return(entry, nil).Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, entry.ParkingSpotID).Return(testOwnerID, nil).Once()
+
+		result, err := srv.GetBooking(ctx, testOwnerID, entry.ID)
+		require.NoError(t, err)
+		assert.Contains(t, string(result), "SUMMARY:Spot booked at 66 Chancellors Cir\r\n")
+	})
+
+	t.Run("other users can not see the booking", func(t *testing.T) {
+		t.Parallel()
+
+		bookingRepo := new(mockBookingRepo)
+		spotRepo := new(mockParkingspotRepo)
+		srv := New(nil, nil, bookingRepo, spotRepo, nil, safehttp.New(false))
+
+		bookingRepo.On("GetByUUID", mock.Anything, entry.ID).Return(entry, nil).Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, entry.ParkingSpotID).Return(testOwnerID, nil).Once()
+
+		_, err := srv.GetBooking(ctx, testOtherID, entry.ID)
+		require.ErrorIs(t, err, models.ErrBookingNotFound)
+	})
+
+	t.Run("booking not found", func(t *testing.T) {
+		t.Parallel()
+
+		bookingRepo := new(mockBookingRepo)
+		srv := New(nil, nil, bookingRepo, nil, nil, safehttp.New(false))
+
+		bookingRepo.On("GetByUUID", mock.Anything, entry.ID).Return(booking.EntryWithTimes{}, booking.ErrNotFound).Once()
+
+		_, err := srv.GetBooking(ctx, testBookerID, entry.ID)
+		require.ErrorIs(t, err, models.ErrBookingNotFound)
+	})
+}
+
+func TestFeed(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("reset generates a new token", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil, nil, nil, safehttp.New(false))
+
+		var tokens []string
+		repo.On("Upsert", mock.Anything, testBookerID, mock.Anything).
+			Run(func(args mock.Arguments) {
+				tokens = append(tokens, args.String(2))
+			}).
+			Return(calendarfeed.Entry{Token: "token", UserID: testBookerID}, nil).
+			Twice()
+
+		_, err := srv.ResetFeed(ctx, testBookerID)
+		require.NoError(t, err)
+		_, err = srv.ResetFeed(ctx, testBookerID)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+
+		require.Len(t, tokens, 2)
+		assert.NotEmpty(t, tokens[0])
+		assert.NotEqual(t, tokens[0], tokens[1])
+	})
+
+	t.Run("feed has bookings and leasings", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		bookingRepo := new(mockBookingRepo)
+		srv := New(repo, nil, bookingRepo, nil, nil, safehttp.New(false))
+
+		now := time.Now()
+		booked := testEntry()
+		leased := testEntry()
+		leased.BookerID = testOtherID
+
+		repo.On("GetByToken", mock.Anything, "token").
+			Return(calendarfeed.Entry{Token: "token", UserID: testBookerID}, nil).
+			Once()
+		bookingRepo.On("GetManyForCalendar", mock.Anything, testBookerID, now, FeedLimit).
+			Return([]booking.EntryWithTimes{booked, leased}, nil).
+			Once()
+
+		result, err := srv.GetFeedCalendar(ctx, "token", now)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+		bookingRepo.AssertExpectations(t)
+
+		cal := string(result)
+		assert.Contains(t, cal, "X-WR-CALNAME:"+FeedName+"\r\n")
+		assert.Equal(t, 1, strings.Count(cal, "BEGIN:VTIMEZONE"))
+		assert.Equal(t, 4, strings.Count(cal, "BEGIN:VEVENT"))
+		assert.Equal(t, 2, strings.Count(cal, "SUMMARY:Parking at"))
+		assert.Equal(t, 2, strings.Count(cal, "SUMMARY:Spot booked at"))
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil, nil, nil, safehttp.New(false))
+
+		repo.On("GetByToken", mock.Anything, "token").
+			Return(calendarfeed.Entry{}, calendarfeed.ErrNotFound).
+			Once()
+
+		_, err := srv.GetFeedCalendar(ctx, "token", time.Now())
+		require.ErrorIs(t, err, models.ErrCalendarFeedNotFound)
+	})
+}
+
+func TestMergeTimes(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.October, 30, 14, 0, 0, 0, time.UTC)
+	slot := func(offset time.Duration) models.TimeUnit {
+		return models.TimeUnit{
+			StartTime: start.Add(offset),
+			EndTime:   start.Add(offset + 30*time.Minute),
+			Status:    "booked",
+		}
+	}
+
+	assert.Empty(t, mergeTimes(nil))
+	assert.Equal(t,
+		[]models.TimeUnit{
+			{StartTime: start, EndTime: start.Add(90 * time.Minute)},
+			{StartTime: start.Add(2 * time.Hour), EndTime: start.Add(150 * time.Minute)},
+		},
+		mergeTimes([]models.TimeUnit{slot(time.Hour), slot(2 * time.Hour), slot(0), slot(30 * time.Minute)}),
+	)
+}
@@ -0,0 +1,435 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/ical"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/region"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/calendarimport"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/safehttp"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// How far ahead busy times of imported calendars block availability
+const ImportHorizon = 90 * 24 * time.Hour
+
+// Largest imported calendar in bytes
+const MaxImportSize = 1 << 20
+
+// Interval between two polls of an imported calendar
+const ImportInterval = 15 * time.Minute
+
+const (
+	// Largest number of imported calendars polled per check
+	importBatch = 10
+	// Longest time taken to fetch a calendar
+	fetchTimeout = 20 * time.Second
+	// Duration for which claimed imports are not polled by other servers.
+	//
+	// Must be longer than polling a whole batch.
+	importLease = 2 * importBatch * fetchTimeout
+	// Interval between two checks for imports due to be polled
+	importCheckInterval = time.Minute
+	// Number of times availability is read again when a slot is booked while it is being removed
+	applyAttempts = 3
+	// Longest poll error recorded
+	maxErrorLength = 512
+)
+
+// Remove the unbooked slots of `spotID` overlapping the busy times of the iCalendar file `data`,
+// if `userID` owns the spot.
+//
+// Only busy times between `now` and `ImportHorizon` after it are considered.
+func (s *Service) ImportCalendar(
+	ctx context.Context,
+	userID int64,
+	spotID uuid.UUID,
+	data []byte,
+	now time.Time,
+) (models.CalendarImportResult, error) {
+	spot, err := s.getOwnedSpot(ctx, userID, spotID)
+	if err != nil {
+		return models.CalendarImportResult{}, err
+	}
+	if len(data) > MaxImportSize {
+		return models.CalendarImportResult{}, models.ErrCalendarTooLarge
+	}
+
+	return s.apply(ctx, &spot, data, now)
+}
+
+// Poll the calendar at `input.URL` to block the availability of `spotID`, if `userID` owns the spot.
+//
+// The calendar is imported immediately, then every `ImportInterval`. Any calendar previously polled
+// for the spot is replaced.
+func (s *Service) SetImport(
+	ctx context.Context,
+	userID int64,
+	spotID uuid.UUID,
+	input *models.CalendarImportInput,
+	now time.Time,
+) (models.CalendarImport, error) {
+	spot, err := s.getOwnedSpot(ctx, userID, spotID)
+	if err != nil {
+		return models.CalendarImport{}, err
+	}
+	fetchURL, err := parseImportURL(input.URL)
+	if err != nil {
+		return models.CalendarImport{}, err
+	}
+	// Polls are checked again when connecting, in case the host is later resolved elsewhere
+	_, err = s.guard.Resolve(ctx, fetchURL.Hostname())
+	if err != nil {
+		return models.CalendarImport{}, models.ErrInvalidCalendarURL
+	}
+
+	// Calendars that can not be imported are rejected rather than polled
+	data, err := s.fetch(ctx, fetchURL)
+	if err != nil {
+		return models.CalendarImport{}, err
+	}
+	result, err := s.apply(ctx, &spot, data, now)
+	if err != nil {
+		return models.CalendarImport{}, err
+	}
+
+	next := now.Add(ImportInterval)
+	entry, err := s.importRepo.Upsert(ctx, spot.InternalID, input.URL, next)
+	if err != nil {
+		return models.CalendarImport{}, err
+	}
+	err = s.importRepo.RecordSync(ctx, spot.InternalID, now, next, &result, "")
+	if err != nil {
+		return models.CalendarImport{}, err
+	}
+
+	entry.LastSyncedAt = now
+	entry.LastResult = &result
+	entry.LastError = ""
+	return importFromEntry(&entry), nil
+}
+
+// Get the calendar polled to block the availability of `spotID`, if `userID` owns the spot
+func (s *Service) GetImport(ctx context.Context, userID int64, spotID uuid.UUID) (models.CalendarImport, error) {
+	spot, err := s.getOwnedSpot(ctx, userID, spotID)
+	if err != nil {
+		return models.CalendarImport{}, err
+	}
+
+	entry, err := s.importRepo.GetBySpot(ctx, spot.InternalID)
+	if err != nil {
+		if errors.Is(err, calendarimport.ErrNotFound) {
+			err = models.ErrCalendarImportNotFound
+		}
+		return models.CalendarImport{}, err
+	}
+	return importFromEntry(&entry), nil
+}
+
+// Stop polling the calendar of `spotID`, if `userID` owns the spot.
+//
+// Slots already removed are not restored.
+func (s *Service) DeleteImport(ctx context.Context, userID int64, spotID uuid.UUID) error {
+	spot, err := s.getOwnedSpot(ctx, userID, spotID)
+	if err != nil {
+		return err
+	}
+
+	err = s.importRepo.DeleteBySpot(ctx, spot.InternalID)
+	if err != nil {
+		if errors.Is(err, calendarimport.ErrNotFound) {
+			err = models.ErrCalendarImportNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Poll imported calendars when they are due until `ctx` is cancelled
+func (s *Service) RunImports(ctx context.Context) {
+	ticker := time.NewTicker(importCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		err := s.syncDue(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			log.Ctx(ctx).Err(err).Msg("could not poll imported calendars")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) syncDue(ctx context.Context, now time.Time) error {
+	entries, err := s.importRepo.ClaimDue(ctx, now, now.Add(importLease), importBatch)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for idx := range entries {
+		entry := &entries[idx]
+		result, err := s.sync(ctx, entry, now)
+		if ctx.Err() != nil {
+			// The import will be polled again once the lease expires
+			return ctx.Err()
+		}
+
+		syncErr := ""
+		if err != nil {
+			var userFacingError *models.UserFacingError
+			if errors.As(err, &userFacingError) {
+				syncErr = err.Error()
+			} else {
+				log.Ctx(ctx).
+					Err(err).
+					Stringer("spot_id", entry.SpotUUID).
+					Msg("could not apply imported calendar")
+				syncErr = "internal error"
+			}
+			if len(syncErr) > maxErrorLength {
+				syncErr = syncErr[:maxErrorLength]
+			}
+		}
+
+		err = s.importRepo.RecordSync(ctx, entry.SpotID, now, now.Add(ImportInterval), result, syncErr)
+		if err != nil && !errors.Is(err, calendarimport.ErrNotFound) {
+			errs = append(errs, err)
+		}
+
+		if result != nil {
+			err = s.notifier.NotifyCalendarSync(ctx, entry.SpotUUID, result)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Poll the calendar of `entry`, returning the result if it was applied
+func (s *Service) sync(ctx context.Context, entry *calendarimport.Entry, now time.Time) (*models.CalendarImportResult, error) {
+	spot, err := s.spotRepo.GetByUUID(ctx, entry.SpotUUID)
+	if err != nil {
+		return nil, err
+	}
+	fetchURL, err := parseImportURL(entry.URL)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.fetch(ctx, fetchURL)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.apply(ctx, &spot, data, now)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Remove the unbooked slots of `spot` overlapping the busy times in `data`
+func (s *Service) apply(ctx context.Context, spot *parkingspot.Entry, data []byte, now time.Time) (models.CalendarImportResult, error) {
+	loc := region.TimeZone(spot.Location.CountryCode, spot.Location.State)
+	busy, err := ical.DecodeBusy(bytes.NewReader(data), loc, now, now.Add(ImportHorizon))
+	if err != nil {
+		if errors.Is(err, ical.ErrInvalidCalendar) || errors.Is(err, ical.ErrTooManyOccurrences) {
+			err = fmt.Errorf("%w: %w", models.ErrCalendarUnreadable, err)
+		}
+		return models.CalendarImportResult{}, err
+	}
+
+	result := models.CalendarImportResult{
+		Removed:   []models.TimeUnit{},
+		Conflicts: []models.CalendarImportConflict{},
+		Events:    len(busy.Events),
+	}
+	for idx := range busy.Unexpanded {
+		event := &busy.Unexpanded[idx]
+		name := event.Summary
+		if name == "" {
+			name = event.UID
+		}
+		result.Warnings = append(
+			result.Warnings,
+			fmt.Sprintf("only the first occurrence of the recurring event %q is imported, its recurrence rule is not supported", name),
+		)
+	}
+	if len(busy.Events) == 0 {
+		return result, nil
+	}
+
+	start := busy.Events[0].Start
+	end := busy.Events[0].End
+	for idx := range busy.Events {
+		end = maxTime(end, busy.Events[idx].End)
+	}
+
+	for attempt := 0; ; attempt++ {
+		units, err := s.spotRepo.GetAvailByUUID(ctx, spot.ID, start, end)
+		if err != nil {
+			return models.CalendarImportResult{}, err
+		}
+
+		removed, conflicts := matchBusy(units, busy.Events)
+		if len(removed) > 0 {
+			err = s.spotRepo.UpdateAvailByUUID(ctx, spot.ID, &models.ParkingSpotAvailUpdateInput{
+				RemoveAvailability: removed,
+			})
+			if errors.Is(err, parkingspot.ErrDeleteBookedTimeUnit) && attempt+1 < applyAttempts {
+				// A slot was booked or held since availability was read
+				continue
+			}
+			if err != nil {
+				return models.CalendarImportResult{}, err
+			}
+		}
+
+		result.Removed = append(result.Removed, removed...)
+		result.Conflicts = append(result.Conflicts, conflicts...)
+		return result, nil
+	}
+}
+
+// Returns the available slots in `units` overlapping `events`, and the conflicts between `events` and
+// the other slots.
+//
+// `events` must be sorted by start.
+func matchBusy(units []models.TimeUnit, events []ical.Event) ([]models.TimeUnit, []models.CalendarImportConflict) {
+	removed := make([]models.TimeUnit, 0)
+	conflicts := make([]models.CalendarImportConflict, 0)
+	for _, unit := range units {
+		for idx := range events {
+			event := &events[idx]
+			if !event.Start.Before(unit.EndTime) {
+				break
+			}
+			if !event.End.After(unit.StartTime) {
+				continue
+			}
+
+			if unit.Status == "available" {
+				removed = append(removed, models.TimeUnit{
+					StartTime: unit.StartTime,
+					EndTime:   unit.EndTime,
+				})
+			} else {
+				conflicts = append(conflicts, models.CalendarImportConflict{
+					EventStart: event.Start,
+					EventEnd:   event.End,
+					Summary:    event.Summary,
+					Slot:       unit,
+				})
+			}
+			break
+		}
+	}
+	return removed, conflicts
+}
+
+// Fetch the calendar at `fetchURL`
+func (s *Service) fetch(ctx context.Context, fetchURL *url.URL) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fetchURL.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", models.ErrCalendarFetch, err)
+	}
+	req.Header.Set("Accept", "text/calendar")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		if errors.Is(err, safehttp.ErrForbiddenAddress) {
+			return nil, fmt.Errorf("%w: %w", models.ErrInvalidCalendarURL, err)
+		}
+		return nil, fmt.Errorf("%w: %w", models.ErrCalendarFetch, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("%w: unexpected response status: %s", models.ErrCalendarFetch, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxImportSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", models.ErrCalendarFetch, err)
+	}
+	if len(data) > MaxImportSize {
+		return nil, models.ErrCalendarTooLarge
+	}
+	return data, nil
+}
+
+// Returns the URL to fetch the calendar at `raw` from
+func parseImportURL(raw string) (*url.URL, error) {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return nil, models.ErrInvalidCalendarURL
+	}
+
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+	case "webcal":
+		parsed.Scheme = "https"
+	default:
+		return nil, models.ErrInvalidCalendarURL
+	}
+	return parsed, nil
+}
+
+// Get `spotID` if `userID` owns it
+func (s *Service) getOwnedSpot(ctx context.Context, userID int64, spotID uuid.UUID) (parkingspot.Entry, error) {
+	spot, err := s.spotRepo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return parkingspot.Entry{}, err
+	}
+	if spot.OwnerID != userID {
+		// Yields not found to prevent leaking existence information
+		return parkingspot.Entry{}, models.ErrParkingSpotNotFound
+	}
+	return spot, nil
+}
+
+func importFromEntry(entry *calendarimport.Entry) models.CalendarImport {
+	result := models.CalendarImport{
+		CreatedAt:  entry.CreatedAt,
+		NextSyncAt: entry.NextSyncAt,
+		LastResult: entry.LastResult,
+		LastError:  entry.LastError,
+		CalendarImportInput: models.CalendarImportInput{
+			URL: entry.URL,
+		},
+	}
+	if !entry.LastSyncedAt.IsZero() {
+		lastSyncedAt := entry.LastSyncedAt
+		result.LastSyncedAt = &lastSyncedAt
+	}
+	return result
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
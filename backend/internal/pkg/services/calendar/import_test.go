@@ -0,0 +1,375 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/calendarimport"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/safehttp"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockImportRepo struct {
+	mock.Mock
+}
+
+// Upsert implements calendarimport.Repository.
+func (m *mockImportRepo) Upsert(ctx context.Context, spotID int64, url string, next time.Time) (calendarimport.Entry, error) {
+	args := m.Called(ctx, spotID, url, next)
+	return args.Get(0).(calendarimport.Entry), args.Error(1)
+}
+
+// GetBySpot implements calendarimport.Repository.
+func (m *mockImportRepo) GetBySpot(ctx context.Context, spotID int64) (calendarimport.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(calendarimport.Entry), args.Error(1)
+}
+
+// DeleteBySpot implements calendarimport.Repository.
+func (m *mockImportRepo) DeleteBySpot(ctx context.Context, spotID int64) error {
+	args := m.Called(ctx, spotID)
+	return args.Error(0)
+}
+
+// ClaimDue implements calendarimport.Repository.
+func (m *mockImportRepo) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]calendarimport.Entry, error) {
+	args := m.Called(ctx, now, leaseUntil, limit)
+	return args.Get(0).([]calendarimport.Entry), args.Error(1)
+}
+
+// RecordSync implements calendarimport.Repository.
+func (m *mockImportRepo) RecordSync(
+	ctx context.Context,
+	spotID int64,
+	syncedAt, next time.Time,
+	result *models.CalendarImportResult,
+	syncErr string,
+) error {
+	args := m.Called(ctx, spotID, syncedAt, next, result, syncErr)
+	return args.Error(0)
+}
+
+type mockNotifier struct {
+	mock.Mock
+}
+
+// NotifyCalendarSync implements Notifier.
+func (m *mockNotifier) NotifyCalendarSync(ctx context.Context, spotID uuid.UUID, result *models.CalendarImportResult) error {
+	args := m.Called(ctx, spotID, result)
+	return args.Error(0)
+}
+
+const testSpotInternalID = int64(10)
+
+// A busy event on 2024-11-04 from 9:00 to 10:00 in Winnipeg
+const testBusyCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:busy@example\r\n" +
+	"SUMMARY:Using the driveway\r\n" +
+	"DTSTART:20241104T090000\r\n" +
+	"DTEND:20241104T100000\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func testSpot() parkingspot.Entry {
+	return parkingspot.Entry{
+		ParkingSpot: models.ParkingSpot{
+			Location: models.ParkingSpotLocation{
+				CountryCode: "CA",
+				State:       "MB",
+			},
+			ID: uuid.New(),
+		},
+		InternalID: testSpotInternalID,
+		OwnerID:    testOwnerID,
+	}
+}
+
+// Returns the slots around the busy event of `testBusyCalendar`, the one starting at 9:30 is booked
+func testSlots() []models.TimeUnit {
+	// 9:00 in Winnipeg
+	start := time.Date(2024, time.November, 4, 15, 0, 0, 0, time.UTC)
+	return []models.TimeUnit{
+		{
+			StartTime: start.Add(-30 * time.Minute),
+			EndTime:   start,
+			Status:    "available",
+		},
+		{
+			StartTime: start,
+			EndTime:   start.Add(30 * time.Minute),
+			Status:    "available",
+		},
+		{
+			StartTime: start.Add(30 * time.Minute),
+			EndTime:   start.Add(time.Hour),
+			Status:    "booked",
+		},
+		{
+			StartTime: start.Add(time.Hour),
+			EndTime:   start.Add(90 * time.Minute),
+			Status:    "available",
+		},
+	}
+}
+
+func TestImportCalendar(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("removes unbooked slots and reports conflicts", func(t *testing.T) {
+		t.Parallel()
+
+		spot := testSpot()
+		slots := testSlots()
+		spotRepo := new(mockParkingspotRepo)
+		spotRepo.On("GetByUUID", mock.Anything, spot.ID).Return(spot, nil).Once()
+		spotRepo.On("GetAvailByUUID", mock.Anything, spot.ID, mock.Anything, mock.Anything).
+			Return(slots, nil).Once()
+		spotRepo.On("UpdateAvailByUUID", mock.Anything, spot.ID, &models.ParkingSpotAvailUpdateInput{
+			RemoveAvailability: []models.TimeUnit{
+				{StartTime: slots[1].StartTime, EndTime: slots[1].EndTime},
+			},
+		}).Return(nil).Once()
+		srv := New(nil, nil, nil, spotRepo, nil, safehttp.New(false))
+
+		result, err := srv.ImportCalendar(ctx, testOwnerID, spot.ID, []byte(testBusyCalendar), now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Events)
+		require.Len(t, result.Removed, 1)
+		assert.Equal(t, slots[1].StartTime, result.Removed[0].StartTime)
+		require.Len(t, result.Conflicts, 1)
+		assert.Equal(t, slots[2], result.Conflicts[0].Slot)
+		assert.Equal(t, "Using the driveway", result.Conflicts[0].Summary)
+		spotRepo.AssertExpectations(t)
+	})
+
+	t.Run("reads availability again if a slot is booked meanwhile", func(t *testing.T) {
+		t.Parallel()
+
+		spot := testSpot()
+		slots := testSlots()
+		bookedSlots := testSlots()
+		bookedSlots[1].Status = "held"
+		spotRepo := new(mockParkingspotRepo)
+		spotRepo.On("GetByUUID", mock.Anything, spot.ID).Return(spot, nil).Once()
+		spotRepo.On("GetAvailByUUID", mock.Anything, spot.ID, mock.Anything, mock.Anything).
+			Return(slots, nil).Once()
+		spotRepo.On("UpdateAvailByUUID", mock.Anything, spot.ID, mock.Anything).
+			Return(parkingspot.ErrDeleteBookedTimeUnit).Once()
+		spotRepo.On("GetAvailByUUID", mock.Anything, spot.ID, mock.Anything, mock.Anything).
+			Return(bookedSlots, nil).Once()
+		srv := New(nil, nil, nil, spotRepo, nil, safehttp.New(false))
+
+		result, err := srv.ImportCalendar(ctx, testOwnerID, spot.ID, []byte(testBusyCalendar), now)
+		require.NoError(t, err)
+		assert.Empty(t, result.Removed)
+		assert.Len(t, result.Conflicts, 2)
+		spotRepo.AssertExpectations(t)
+	})
+
+	t.Run("not the owner", func(t *testing.T) {
+		t.Parallel()
+
+		spot := testSpot()
+		spotRepo := new(mockParkingspotRepo)
+		spotRepo.On("GetByUUID", mock.Anything, spot.ID).Return(spot, nil).Once()
+		srv := New(nil, nil, nil, spotRepo, nil, safehttp.New(false))
+
+		_, err := srv.ImportCalendar(ctx, testOtherID, spot.ID, []byte(testBusyCalendar), now)
+		require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		spotRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid calendar", func(t *testing.T) {
+		t.Parallel()
+
+		spot := testSpot()
+		spotRepo := new(mockParkingspotRepo)
+		spotRepo.On("GetByUUID", mock.Anything, spot.ID).Return(spot, nil).Once()
+		srv := New(nil, nil, nil, spotRepo, nil, safehttp.New(false))
+
+		_, err := srv.ImportCalendar(ctx, testOwnerID, spot.ID, []byte("not a calendar"), now)
+		require.ErrorIs(t, err, models.ErrCalendarUnreadable)
+		spotRepo.AssertExpectations(t)
+	})
+}
+
+func TestSetImport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/busy.ics" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar")
+		_, _ = w.Write([]byte(testBusyCalendar))
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("imports immediately", func(t *testing.T) {
+		t.Parallel()
+
+		spot := testSpot()
+		slots := testSlots()
+		calendarURL := server.URL + "/busy.ics"
+		spotRepo := new(mockParkingspotRepo)
+		spotRepo.On("GetByUUID", mock.Anything, spot.ID).Return(spot, nil).Once()
+		spotRepo.On("GetAvailByUUID", mock.Anything, spot.ID, mock.Anything, mock.Anything).
+			Return(slots, nil).Once()
+		spotRepo.On("UpdateAvailByUUID", mock.Anything, spot.ID, mock.Anything).Return(nil).Once()
+		repo := new(mockImportRepo)
+		next := now.Add(ImportInterval)
+		repo.On("Upsert", mock.Anything, testSpotInternalID, calendarURL, next).
+			Return(calendarimport.Entry{
+				CreatedAt:  now,
+				NextSyncAt: next,
+				URL:        calendarURL,
+				SpotID:     testSpotInternalID,
+				SpotUUID:   spot.ID,
+			}, nil).Once()
+		repo.On("RecordSync", mock.Anything, testSpotInternalID, now, next, mock.Anything, "").
+			Return(nil).Once()
+		srv := New(nil, repo, nil, spotRepo, nil, safehttp.New(true))
+
+		result, err := srv.SetImport(ctx, testOwnerID, spot.ID, &models.CalendarImportInput{URL: calendarURL}, now)
+		require.NoError(t, err)
+		assert.Equal(t, calendarURL, result.URL)
+		require.NotNil(t, result.LastSyncedAt)
+		assert.Equal(t, now, *result.LastSyncedAt)
+		require.NotNil(t, result.LastResult)
+		assert.Len(t, result.LastResult.Removed, 1)
+		assert.Len(t, result.LastResult.Conflicts, 1)
+		spotRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("calendars that can not be fetched are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		spot := testSpot()
+		spotRepo := new(mockParkingspotRepo)
+		spotRepo.On("GetByUUID", mock.Anything, spot.ID).Return(spot, nil)
+		repo := new(mockImportRepo)
+		srv := New(nil, repo, nil, spotRepo, nil, safehttp.New(true))
+
+		_, err := srv.SetImport(ctx, testOwnerID, spot.ID, &models.CalendarImportInput{URL: server.URL + "/missing.ics"}, now)
+		require.ErrorIs(t, err, models.ErrCalendarFetch)
+
+		_, err = srv.SetImport(ctx, testOwnerID, spot.ID, &models.CalendarImportInput{URL: "ftp://example.com/cal.ics"}, now)
+		require.ErrorIs(t, err, models.ErrInvalidCalendarURL)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("internal addresses are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		spot := testSpot()
+		spotRepo := new(mockParkingspotRepo)
+		spotRepo.On("GetByUUID", mock.Anything, spot.ID).Return(spot, nil)
+		repo := new(mockImportRepo)
+		srv := New(nil, repo, nil, spotRepo, nil, safehttp.New(false))
+
+		for _, calendarURL := range []string{server.URL + "/busy.ics", "http://10.0.0.5/cal.ics", "webcal://169.254.169.254/cal.ics"} {
+			_, err := srv.SetImport(ctx, testOwnerID, spot.ID, &models.CalendarImportInput{URL: calendarURL}, now)
+			require.ErrorIs(t, err, models.ErrInvalidCalendarURL, calendarURL)
+		}
+		repo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSyncDue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/busy.ics" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(testBusyCalendar))
+	}))
+	t.Cleanup(server.Close)
+
+	spot := testSpot()
+	missingSpot := testSpot()
+	missingSpot.InternalID = testSpotInternalID + 1
+
+	spotRepo := new(mockParkingspotRepo)
+	spotRepo.On("GetByUUID", mock.Anything, spot.ID).Return(spot, nil)
+	spotRepo.On("GetByUUID", mock.Anything, missingSpot.ID).Return(missingSpot, nil)
+	spotRepo.On("GetAvailByUUID", mock.Anything, spot.ID, mock.Anything, mock.Anything).
+		Return(testSlots(), nil).Once()
+	spotRepo.On("UpdateAvailByUUID", mock.Anything, spot.ID, mock.Anything).Return(nil).Once()
+
+	repo := new(mockImportRepo)
+	repo.On("ClaimDue", mock.Anything, now, now.Add(importLease), importBatch).
+		Return([]calendarimport.Entry{
+			{
+				URL:      server.URL + "/busy.ics",
+				SpotID:   spot.InternalID,
+				SpotUUID: spot.ID,
+			},
+			{
+				URL:      server.URL + "/missing.ics",
+				SpotID:   missingSpot.InternalID,
+				SpotUUID: missingSpot.ID,
+			},
+		}, nil).Once()
+	next := now.Add(ImportInterval)
+	repo.On("RecordSync", mock.Anything, spot.InternalID, now, next, mock.MatchedBy(func(result *models.CalendarImportResult) bool {
+		return result != nil && len(result.Removed) == 1 && len(result.Conflicts) == 1
+	}), "").Return(nil).Once()
+	repo.On("RecordSync", mock.Anything, missingSpot.InternalID, now, next, (*models.CalendarImportResult)(nil), mock.MatchedBy(func(syncErr string) bool {
+		return strings.HasPrefix(syncErr, models.ErrCalendarFetch.Error())
+	})).Return(nil).Once()
+	notifier := new(mockNotifier)
+	notifier.On("NotifyCalendarSync", mock.Anything, spot.ID, mock.MatchedBy(func(result *models.CalendarImportResult) bool {
+		return len(result.Removed) == 1 && len(result.Conflicts) == 1
+	})).Return(nil).Once()
+	srv := New(nil, repo, nil, spotRepo, notifier, safehttp.New(true))
+
+	err := srv.syncDue(ctx, now)
+	require.NoError(t, err)
+	spotRepo.AssertExpectations(t)
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestParseImportURL(t *testing.T) {
+	t.Parallel()
+
+	for raw, expected := range map[string]string{
+		"https://example.com/cal.ics":    "https://example.com/cal.ics",
+		"http://127.0.0.1:8080/cal.ics":  "http://127.0.0.1:8080/cal.ics",
+		"webcal://example.com/cal.ics":   "https://example.com/cal.ics",
+		"WEBCAL://example.com/cal.ics?a": "https://example.com/cal.ics?a",
+	} {
+		result, err := parseImportURL(raw)
+		require.NoError(t, err, raw)
+		assert.Equal(t, expected, result.String())
+	}
+
+	for _, raw := range []string{"", "/cal.ics", "ftp://example.com/cal.ics", "https:///cal.ics", "::"} {
+		_, err := parseImportURL(raw)
+		require.ErrorIs(t, err, models.ErrInvalidCalendarURL, raw)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/region"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/car"
 	"github.com/aarondl/opt/omit"
 	"github.com/fxamacker/cbor/v2"
@@ -27,11 +28,42 @@ func New(repo car.Repository) *Service {
 	}
 }
 
-func validateCarCreationInput(input *models.CarCreationInput) error {
-	licensePlatePattern := regexp.MustCompile(`^[A-Za-z0-9 ]{2,8}$`)
-	if !licensePlatePattern.MatchString(input.LicensePlate) {
+// Matches license plates of unknown jurisdictions, in their canonical form
+var licensePlatePattern = regexp.MustCompile(`^[A-Z0-9]{2,8}$`)
+
+// Returns `input` with its license plate in canonical form
+func normalizeCarInput(input *models.CarCreationInput) models.CarCreationInput {
+	result := *input
+	result.LicensePlate = car.NormalizeLicensePlate(input.LicensePlate)
+	return result
+}
+
+// Validate the license plate of `details`, in canonical form, against its jurisdiction if any
+func validateLicensePlate(details *models.CarDetails) error {
+	if details.CountryCode == "" && details.State == "" {
+		if !licensePlatePattern.MatchString(details.LicensePlate) {
+			return models.ErrInvalidLicensePlate
+		}
+		return nil
+	}
+
+	country := region.Lookup(details.CountryCode)
+	if country == nil {
+		return models.ErrPlateStateInvalid
+	}
+	if _, ok := country.Subdivisions[details.State]; !ok {
+		return models.ErrPlateStateInvalid
+	}
+	if !country.ValidLicensePlate(details.State, details.LicensePlate) {
 		return models.ErrInvalidLicensePlate
 	}
+	return nil
+}
+
+func validateCarCreationInput(input *models.CarCreationInput) error {
+	if err := validateLicensePlate(&input.CarDetails); err != nil {
+		return err
+	}
 	if input.Make == "" {
 		return models.ErrInvalidMake
 	}
@@ -41,16 +73,20 @@ func validateCarCreationInput(input *models.CarCreationInput) error {
 	if input.Color == "" {
 		return models.ErrInvalidColor
 	}
-	return nil
+	return validateSize(&input.CarDetails)
 }
 
 func (s *Service) Create(ctx context.Context, userID int64, carModel *models.CarCreationInput) (int64, models.Car, error) {
-	if err := validateCarCreationInput(carModel); err != nil {
+	input := normalizeCarInput(carModel)
+	if err := validateCarCreationInput(&input); err != nil {
 		return 0, models.Car{}, err
 	}
 
-	internalID, result, err := s.repo.Create(ctx, userID, carModel)
+	internalID, result, err := s.repo.Create(ctx, userID, &input)
 	if err != nil {
+		if errors.Is(err, car.ErrDuplicatePlate) {
+			err = models.ErrCarDuplicate
+		}
 		return 0, models.Car{}, err
 	}
 	return internalID, result.Car, nil
@@ -132,12 +168,16 @@ func (s *Service) UpdateByUUID(ctx context.Context, userID int64, carID uuid.UUI
 		return models.Car{}, models.ErrCarNotFound
 	}
 
-	if err := validateCarCreationInput(carModel); err != nil {
+	input := normalizeCarInput(carModel)
+	if err := validateCarCreationInput(&input); err != nil {
 		return models.Car{}, err
 	}
 
-	result, err := s.repo.UpdateByUUID(ctx, carID, carModel)
+	result, err := s.repo.UpdateByUUID(ctx, carID, &input)
 	if err != nil {
+		if errors.Is(err, car.ErrDuplicatePlate) {
+			err = models.ErrCarDuplicate
+		}
 		return models.Car{}, err
 	}
 	return result.Car, nil
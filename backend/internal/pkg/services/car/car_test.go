@@ -83,6 +83,9 @@ var sampleDetails = models.CarDetails{
 	Color:        "Blue",
 }
 
+// License plate of `sampleDetails` in canonical form
+const sampleStoredPlate = "HTV678"
+
 func TestCreate(t *testing.T) {
 	t.Parallel()
 
@@ -98,12 +101,14 @@ func TestCreate(t *testing.T) {
 		input := &models.CarCreationInput{
 			CarDetails: sampleDetails,
 		}
-		repo.On("Create", mock.Anything, int64(0), input).
+		stored := *input
+		stored.LicensePlate = sampleStoredPlate
+		repo.On("Create", mock.Anything, int64(0), &stored).
 			Return(
 				int64(0),
 				car.Entry{
 					Car: models.Car{
-						Details: input.CarDetails,
+						Details: stored.CarDetails,
 					},
 					InternalID: 0,
 					OwnerID:    0,
@@ -183,6 +188,78 @@ func TestCreate(t *testing.T) {
 		}
 		repo.AssertNotCalled(t, "Create")
 	})
+
+	t.Run("license plate jurisdiction check", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo)
+
+		details := sampleDetails
+		details.CountryCode = "CA"
+		details.State = "ZZ"
+		_, _, err := srv.Create(ctx, 0, &models.CarCreationInput{
+			CarDetails: details,
+		})
+		if assert.Error(t, err) {
+			assert.ErrorIs(t, err, models.ErrPlateStateInvalid)
+		}
+
+		// Plates are at most 6 characters in British Columbia
+		details.State = "BC"
+		details.LicensePlate = "ABCD 123"
+		_, _, err = srv.Create(ctx, 0, &models.CarCreationInput{
+			CarDetails: details,
+		})
+		if assert.Error(t, err) {
+			assert.ErrorIs(t, err, models.ErrInvalidLicensePlate)
+		}
+		repo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("duplicate license plate", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("Create", mock.Anything, int64(0), mock.Anything).
+			Return(int64(0), car.Entry{}, car.ErrDuplicatePlate).
+			Once()
+		srv := New(repo)
+
+		_, _, err := srv.Create(ctx, 0, &models.CarCreationInput{
+			CarDetails: sampleDetails,
+		})
+		if assert.Error(t, err) {
+			assert.ErrorIs(t, err, models.ErrCarDuplicate)
+		}
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("size check", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo)
+
+		details := sampleDetails
+		details.Class = "bus"
+		_, _, err := srv.Create(ctx, 0, &models.CarCreationInput{
+			CarDetails: details,
+		})
+		if assert.Error(t, err) {
+			assert.ErrorIs(t, err, models.ErrInvalidCarSize)
+		}
+
+		details = sampleDetails
+		details.Height = -1
+		_, _, err = srv.Create(ctx, 0, &models.CarCreationInput{
+			CarDetails: details,
+		})
+		if assert.Error(t, err) {
+			assert.ErrorIs(t, err, models.ErrInvalidCarSize)
+		}
+		repo.AssertNotCalled(t, "Create")
+	})
 }
 
 func TestGet(t *testing.T) {
@@ -414,11 +491,14 @@ func TestUpdate(t *testing.T) {
 			OwnerID:    testOwnerID,
 		}
 
+		stored := models.CarCreationInput{
+			CarDetails: sampleDetails,
+		}
+		stored.LicensePlate = sampleStoredPlate
+
 		repo := new(mockRepo)
 		repo.AddGetCalls()
-		repo.On("UpdateByUUID", mock.Anything, testOwnerCarID, &models.CarCreationInput{
-			CarDetails: sampleDetails,
-		}).
+		repo.On("UpdateByUUID", mock.Anything, testOwnerCarID, &stored).
 			Return(resultEntry, nil).Once()
 		srv := New(repo)
 
@@ -0,0 +1,75 @@
+package car
+
+import "github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+
+// Size of a vehicle in centimetres, 0 if unknown
+type Size struct {
+	Height int32
+	Length int32
+	Width  int32
+	Truck  bool // Whether the vehicle is a truck
+}
+
+// Typical size of each vehicle class, used for unknown dimensions
+var classSizes = map[string]Size{
+	models.VehicleClassCompact:  {Height: 150, Length: 440, Width: 180},
+	models.VehicleClassMidsize:  {Height: 150, Length: 480, Width: 185},
+	models.VehicleClassFullsize: {Height: 150, Length: 510, Width: 190},
+	models.VehicleClassSUV:      {Height: 180, Length: 490, Width: 195},
+	models.VehicleClassVan:      {Height: 200, Length: 520, Width: 200},
+	models.VehicleClassTruck:    {Height: 195, Length: 590, Width: 205, Truck: true},
+}
+
+// Largest dimensions of a car, in centimetres
+const (
+	maxCarHeight = 1000
+	maxCarLength = 3000
+	maxCarWidth  = 1000
+)
+
+// Returns the size of the car `details`.
+//
+// Unknown dimensions are the typical ones of the car class, or stay unknown if the class is not set.
+func SizeOf(details *models.CarDetails) Size {
+	result := classSizes[details.Class]
+	if details.Height > 0 {
+		result.Height = details.Height
+	}
+	if details.Length > 0 {
+		result.Length = details.Length
+	}
+	if details.Width > 0 {
+		result.Width = details.Width
+	}
+	return result
+}
+
+// Whether a vehicle of this size is allowed by `restrictions`.
+//
+// Unknown dimensions are assumed to fit.
+func (s Size) Fits(restrictions *models.ParkingSpotRestrictions) bool {
+	if s.Truck && restrictions.NoTrucks {
+		return false
+	}
+	return fitsLimit(s.Height, restrictions.MaxHeight) &&
+		fitsLimit(s.Length, restrictions.MaxLength) &&
+		fitsLimit(s.Width, restrictions.MaxWidth)
+}
+
+// Whether `size` is within `limit`, where 0 is no limit
+func fitsLimit(size, limit int32) bool {
+	return size == 0 || limit == 0 || size <= limit
+}
+
+// Validate vehicle class and dimensions static rules
+func validateSize(details *models.CarDetails) error {
+	if _, ok := classSizes[details.Class]; details.Class != "" && !ok {
+		return models.ErrInvalidCarSize
+	}
+	if details.Height < 0 || details.Height > maxCarHeight ||
+		details.Length < 0 || details.Length > maxCarLength ||
+		details.Width < 0 || details.Width > maxCarWidth {
+		return models.ErrInvalidCarSize
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package car
+
+import (
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSizeOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, Size{}, SizeOf(&models.CarDetails{}))
+	assert.Equal(t, Size{Height: 140}, SizeOf(&models.CarDetails{Height: 140}))
+
+	// Known dimensions take precedence over the class ones
+	suv := SizeOf(&models.CarDetails{Class: models.VehicleClassSUV, Height: 170})
+	assert.Equal(t, Size{Height: 170, Length: 490, Width: 195}, suv)
+
+	truck := SizeOf(&models.CarDetails{Class: models.VehicleClassTruck})
+	assert.True(t, truck.Truck)
+}
+
+func TestFits(t *testing.T) {
+	t.Parallel()
+
+	size := Size{Height: 180, Length: 490, Width: 195}
+	tests := []struct {
+		name         string
+		size         Size
+		restrictions models.ParkingSpotRestrictions
+		fits         bool
+	}{
+		{name: "no restrictions", size: size, fits: true},
+		{name: "within limits", size: size, restrictions: models.ParkingSpotRestrictions{MaxHeight: 180, MaxLength: 500, MaxWidth: 200}, fits: true},
+		{name: "too tall", size: size, restrictions: models.ParkingSpotRestrictions{MaxHeight: 170}},
+		{name: "too long", size: size, restrictions: models.ParkingSpotRestrictions{MaxLength: 450}},
+		{name: "too wide", size: size, restrictions: models.ParkingSpotRestrictions{MaxWidth: 190}},
+		{name: "unknown size", restrictions: models.ParkingSpotRestrictions{MaxHeight: 170}, fits: true},
+		{name: "no trucks", size: Size{Truck: true}, restrictions: models.ParkingSpotRestrictions{NoTrucks: true}},
+		{name: "trucks allowed", size: Size{Truck: true}, fits: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.fits, test.size.Fits(&test.restrictions))
+		})
+	}
+}
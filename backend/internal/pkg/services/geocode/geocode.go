@@ -0,0 +1,60 @@
+package geocode
+
+import (
+	"context"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/geocoding"
+	"github.com/rs/zerolog/log"
+)
+
+type Service struct {
+	geocoder geocoding.Geocoder
+}
+
+func New(geocoder geocoding.Geocoder) *Service {
+	return &Service{
+		geocoder: geocoder,
+	}
+}
+
+// Returns the addresses at or near the given location, nearest first
+func (s *Service) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]models.GeocodeResult, error) {
+	result, err := s.geocoder.ReverseGeocode(ctx, latitude, longitude)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Float64("latitude", latitude).Float64("longitude", longitude).Msg("reverse geocoding failed")
+		return nil, models.ErrGeocodingUnavailable
+	}
+	return toModels(result), nil
+}
+
+// Returns the addresses the partially written address `query` might be, most likely first
+func (s *Service) Suggest(ctx context.Context, query string) ([]models.GeocodeResult, error) {
+	result, err := s.geocoder.Suggest(ctx, query)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("address suggestion failed")
+		return nil, models.ErrGeocodingUnavailable
+	}
+	return toModels(result), nil
+}
+
+func toModels(results []geocoding.Result) []models.GeocodeResult {
+	out := make([]models.GeocodeResult, 0, len(results))
+	for i := range results {
+		r := &results[i]
+		out = append(out, models.GeocodeResult{
+			FormattedAddress: r.FormattedAddress,
+			ParkingSpotLocation: models.ParkingSpotLocation{
+				PostalCode:    r.Address.PostalCode,
+				CountryCode:   r.Address.Country,
+				City:          r.Address.City,
+				State:         r.Address.State,
+				StreetAddress: r.Address.Street,
+				Longitude:     r.Longitude,
+				Latitude:      r.Latitude,
+			},
+			Accuracy: r.Accuracy,
+		})
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+package geocode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/geocoding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockGeocoder struct {
+	mock.Mock
+}
+
+// Geocode implements geocoding.Geocoder.
+func (m *mockGeocoder) Geocode(ctx context.Context, address *geocoding.Address) ([]geocoding.Result, error) {
+	args := m.Called(ctx, address)
+	return args.Get(0).([]geocoding.Result), args.Error(1)
+}
+
+// ReverseGeocode implements geocoding.Geocoder.
+func (m *mockGeocoder) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]geocoding.Result, error) {
+	args := m.Called(ctx, latitude, longitude)
+	return args.Get(0).([]geocoding.Result), args.Error(1)
+}
+
+// Suggest implements geocoding.Geocoder.
+func (m *mockGeocoder) Suggest(ctx context.Context, query string) ([]geocoding.Result, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]geocoding.Result), args.Error(1)
+}
+
+// Search implements geocoding.Geocoder.
+func (m *mockGeocoder) Search(ctx context.Context, query string) ([]geocoding.Result, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]geocoding.Result), args.Error(1)
+}
+
+var sampleResults = []geocoding.Result{{
+	Address: geocoding.Address{
+		Street:     "66 Chancellors Cir",
+		City:       "Winnipeg",
+		State:      "MB",
+		PostalCode: "R3T 2N2",
+		Country:    "CA",
+	},
+	FormattedAddress: "66 Chancellors Cir, Winnipeg, MB R3T 2N2",
+	Latitude:         49.8075,
+	Longitude:        -97.1366,
+	Accuracy:         1,
+}}
+
+var sampleGeocodeResult = models.GeocodeResult{
+	FormattedAddress: "66 Chancellors Cir, Winnipeg, MB R3T 2N2",
+	ParkingSpotLocation: models.ParkingSpotLocation{
+		PostalCode:    "R3T 2N2",
+		CountryCode:   "CA",
+		City:          "Winnipeg",
+		State:         "MB",
+		StreetAddress: "66 Chancellors Cir",
+		Longitude:     -97.1366,
+		Latitude:      49.8075,
+	},
+	Accuracy: 1,
+}
+
+func TestReverseGeocode(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	geocoder := new(mockGeocoder)
+	geocoder.On("ReverseGeocode", mock.Anything, 49.8075, -97.1366).
+		Return(sampleResults, nil).
+		On("ReverseGeocode", mock.Anything, 0.0, 0.0).
+		Return([]geocoding.Result(nil), errors.New("server error"))
+	srv := New(geocoder)
+
+	result, err := srv.ReverseGeocode(ctx, 49.8075, -97.1366)
+	require.NoError(t, err)
+	assert.Equal(t, []models.GeocodeResult{sampleGeocodeResult}, result)
+
+	_, err = srv.ReverseGeocode(ctx, 0, 0)
+	require.ErrorIs(t, err, models.ErrGeocodingUnavailable)
+}
+
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	geocoder := new(mockGeocoder)
+	geocoder.On("Suggest", mock.Anything, "66 chanc").
+		Return(sampleResults, nil).
+		On("Suggest", mock.Anything, "nowhere").
+		Return([]geocoding.Result{}, nil).
+		On("Suggest", mock.Anything, "down").
+		Return([]geocoding.Result(nil), geocoding.ErrCircuitOpen)
+	srv := New(geocoder)
+
+	result, err := srv.Suggest(ctx, "66 chanc")
+	require.NoError(t, err)
+	assert.Equal(t, []models.GeocodeResult{sampleGeocodeResult}, result)
+
+	result, err = srv.Suggest(ctx, "nowhere")
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	_, err = srv.Suggest(ctx, "down")
+	require.ErrorIs(t, err, models.ErrGeocodingUnavailable)
+}
@@ -0,0 +1,222 @@
+package message
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/message"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/aarondl/opt/omit"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Largest number of entries returned per request
+const MaximumCount = 1000
+
+// A booking is confirmed once its first booked time is this close.
+//
+// Until then, messages go through the moderators so that contact details are not
+// exchanged before the booking is settled.
+const ContactRevealWindow = 24 * time.Hour
+
+type Service struct {
+	repo        message.Repository
+	bookingRepo booking.Repository
+	spotRepo    parkingspot.Repository
+	moderators  []Moderator
+}
+
+// Returns a new messaging service. `moderators` are applied in order to messages
+// sent before their booking is confirmed.
+func New(repo message.Repository, bookingRepo booking.Repository, spotRepo parkingspot.Repository, moderators ...Moderator) *Service {
+	return &Service{
+		repo:        repo,
+		bookingRepo: bookingRepo,
+		spotRepo:    spotRepo,
+		moderators:  moderators,
+	}
+}
+
+// Send a message as `userID` in the thread of the booking `bookingID`.
+func (s *Service) Send(ctx context.Context, userID int64, bookingID uuid.UUID, input *models.MessageCreationInput) (models.Message, error) {
+	if input.Body == "" || len(input.Body) > models.MaximumMessageLength || !utf8.ValidString(input.Body) {
+		return models.Message{}, models.ErrInvalidMessage
+	}
+
+	entry, err := s.getAsParticipant(ctx, userID, bookingID)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	body := input.Body
+	redacted := false
+	if !isConfirmed(entry.BookedTimes, time.Now()) {
+		for _, moderator := range s.moderators {
+			var modified bool
+			body, modified = moderator.Moderate(body)
+			redacted = redacted || modified
+		}
+	}
+
+	result, err := s.repo.Create(ctx, &message.CreateInput{
+		Body:      body,
+		BookingID: entry.Entry.InternalID,
+		SenderID:  userID,
+		Redacted:  redacted,
+	})
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	return toModel(&result, entry.Entry.BookerID), nil
+}
+
+// Get at most `count` messages in the thread of the booking `bookingID`, newest first.
+//
+// Only the booker and the spot owner can view the messages of a booking.
+func (s *Service) GetMany(ctx context.Context, userID int64, bookingID uuid.UUID, count int, after models.Cursor) (messages []models.Message, next models.Cursor, err error) {
+	if count <= 0 {
+		return []models.Message{}, "", nil
+	}
+
+	entry, err := s.getAsParticipant(ctx, userID, bookingID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cursor := decodeCursor(after)
+	count = min(count, MaximumCount)
+	entries, err := s.repo.GetMany(ctx, count+1, cursor, entry.Entry.InternalID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(entries) > count {
+		entries = entries[:len(entries)-1]
+
+		next, err = encodeCursor(message.Cursor{
+			ID: entries[len(entries)-1].InternalID,
+		})
+		// This is an issue, but not enough to abort the request
+		if err != nil {
+			log.Err(err).
+				Int64("messageid", entries[len(entries)-1].InternalID).
+				Msg("could not encode next cursor")
+		}
+	}
+
+	result := make([]models.Message, 0, len(entries))
+	for idx := range entries {
+		result = append(result, toModel(&entries[idx], entry.Entry.BookerID))
+	}
+	return result, next, nil
+}
+
+// Mark all messages sent to `userID` in the thread of the booking `bookingID` as read.
+func (s *Service) MarkRead(ctx context.Context, userID int64, bookingID uuid.UUID) error {
+	entry, err := s.getAsParticipant(ctx, userID, bookingID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.MarkRead(ctx, entry.Entry.InternalID, userID)
+}
+
+// Get the number of unread messages sent to `userID`.
+func (s *Service) GetUnread(ctx context.Context, userID int64) (models.UnreadMessages, error) {
+	entries, err := s.repo.GetUnreadCounts(ctx, userID)
+	if err != nil {
+		return models.UnreadMessages{}, err
+	}
+
+	result := models.UnreadMessages{
+		Bookings: make([]models.UnreadMessageCount, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		result.Bookings = append(result.Bookings, models.UnreadMessageCount{
+			BookingID: entry.BookingID,
+			Count:     entry.Count,
+		})
+		result.Total += entry.Count
+	}
+	return result, nil
+}
+
+// Get the booking `bookingID` as `userID`.
+//
+// Returns models.ErrBookingNotFound if `userID` is neither the booker nor the spot owner.
+func (s *Service) getAsParticipant(ctx context.Context, userID int64, bookingID uuid.UUID) (booking.EntryWithTimes, error) {
+	entry, err := s.bookingRepo.GetByUUID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, booking.ErrNotFound) {
+			err = models.ErrBookingNotFound
+		}
+		return booking.EntryWithTimes{}, err
+	}
+
+	spotOwner, err := s.spotRepo.GetOwnerByUUID(ctx, entry.Entry.ParkingSpotID)
+	if err != nil {
+		return booking.EntryWithTimes{}, err
+	}
+
+	if userID != entry.Entry.BookerID && userID != spotOwner {
+		return booking.EntryWithTimes{}, models.ErrBookingNotFound
+	}
+
+	return entry, nil
+}
+
+// Returns whether a booking of `bookedTimes` is confirmed at `now`.
+func isConfirmed(bookedTimes []models.TimeUnit, now time.Time) bool {
+	if len(bookedTimes) == 0 {
+		return false
+	}
+	start := bookedTimes[0].StartTime
+	for _, unit := range bookedTimes[1:] {
+		if unit.StartTime.Before(start) {
+			start = unit.StartTime
+		}
+	}
+	return !now.Before(start.Add(-ContactRevealWindow))
+}
+
+func toModel(entry *message.Entry, bookerID int64) models.Message {
+	result := entry.Message
+	if entry.SenderID == bookerID {
+		result.Sender = models.MessageSenderDriver
+	} else {
+		result.Sender = models.MessageSenderHost
+	}
+	return result
+}
+
+func decodeCursor(cursor models.Cursor) omit.Val[message.Cursor] {
+	raw, err := base64.RawURLEncoding.DecodeString(string(cursor))
+	if err != nil {
+		return omit.Val[message.Cursor]{}
+	}
+
+	var result message.Cursor
+	err = cbor.Unmarshal(raw, &result)
+	if err != nil {
+		return omit.Val[message.Cursor]{}
+	}
+
+	return omit.From(result)
+}
+
+func encodeCursor(cursor message.Cursor) (models.Cursor, error) {
+	raw, err := cbor.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return models.Cursor(base64.RawURLEncoding.EncodeToString(raw)), nil
+}
@@ -0,0 +1,362 @@
+package message
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/booking"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/message"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+type mockBookingRepo struct {
+	mock.Mock
+}
+
+type mockParkingspotRepo struct {
+	mock.Mock
+}
+
+// Create implements message.Repository.
+func (m *mockRepo) Create(ctx context.Context, input *message.CreateInput) (message.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(message.Entry), args.Error(1)
+}
+
+// GetMany implements message.Repository.
+func (m *mockRepo) GetMany(ctx context.Context, limit int, after omit.Val[message.Cursor], bookingID int64) ([]message.Entry, error) {
+	args := m.Called(ctx, limit, after, bookingID)
+	return args.Get(0).([]message.Entry), args.Error(1)
+}
+
+// MarkRead implements message.Repository.
+func (m *mockRepo) MarkRead(ctx context.Context, bookingID, readerID int64) error {
+	args := m.Called(ctx, bookingID, readerID)
+	return args.Error(0)
+}
+
+// GetUnreadCounts implements message.Repository.
+func (m *mockRepo) GetUnreadCounts(ctx context.Context, userID int64) ([]message.UnreadEntry, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]message.UnreadEntry), args.Error(1)
+}
+
+// Create implements booking.Repository.
+func (m *mockBookingRepo) Create(ctx context.Context, input *booking.CreateInput) (booking.EntryWithTimes, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(booking.EntryWithTimes), args.Error(1)
+}
+
+// GetByUUID implements booking.Repository.
+func (m *mockBookingRepo) GetByUUID(ctx context.Context, bookingID uuid.UUID) (booking.EntryWithTimes, error) {
+	args := m.Called(ctx, bookingID)
+	return args.Get(0).(booking.EntryWithTimes), args.Error(1)
+}
+
+// GetManyForOwner implements booking.Repository.
+func (m *mockBookingRepo) GetManyForOwner(ctx context.Context, limit int, after omit.Val[booking.Cursor], userID int64, filter *booking.Filter) ([]booking.EntryWithDetails, error) {
+	args := m.Called(ctx, limit, after, userID, filter)
+	return args.Get(0).([]booking.EntryWithDetails), args.Error(1)
+}
+
+// GetManyForBuyer implements booking.Repository.
+func (m *mockBookingRepo) GetManyForBuyer(ctx context.Context, limit int, after omit.Val[booking.Cursor], userID int64, filter *booking.Filter) ([]booking.EntryWithDetails, error) {
+	args := m.Called(ctx, limit, after, userID, filter)
+	return args.Get(0).([]booking.EntryWithDetails), args.Error(1)
+}
+
+// ClaimUpcoming implements booking.Repository.
+func (m *mockBookingRepo) ClaimUpcoming(ctx context.Context, after, before, now time.Time) ([]booking.Upcoming, error) {
+	args := m.Called(ctx, after, before, now)
+	return args.Get(0).([]booking.Upcoming), args.Error(1)
+}
+
+// GetManyForCalendar implements booking.Repository.
+func (m *mockBookingRepo) GetManyForCalendar(ctx context.Context, userID int64, after time.Time, limit int) ([]booking.EntryWithTimes, error) {
+	args := m.Called(ctx, userID, after, limit)
+	return args.Get(0).([]booking.EntryWithTimes), args.Error(1)
+}
+
+// Create implements parkingspot.Repository.
+func (m *mockParkingspotRepo) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (parkingspot.Entry, []models.TimeUnit, error) {
+	args := m.Called(ctx, userID, spot)
+	return args.Get(0).(parkingspot.Entry), args.Get(1).([]models.TimeUnit), args.Error(2)
+}
+
+// GetByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetByUUID(ctx context.Context, spotID uuid.UUID) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// GetOwnerByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetOwnerByUUID(ctx context.Context, spotID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetAvailByUUID(ctx context.Context, spotID uuid.UUID, startDate, endDate time.Time) ([]models.TimeUnit, error) {
+	args := m.Called(ctx, spotID, startDate, endDate)
+	return args.Get(0).([]models.TimeUnit), args.Error(1)
+}
+
+// GetMany implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetMany(ctx context.Context, limit int, filter *parkingspot.Filter) ([]parkingspot.GetManyEntry, error) {
+	args := m.Called(ctx, limit, filter)
+	return args.Get(0).([]parkingspot.GetManyEntry), args.Error(1)
+}
+
+// UpdateSpotByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateSpotByUUID(ctx context.Context, spotID uuid.UUID, updateSpot *models.ParkingSpotUpdateInput) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID, updateSpot)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// UpdateAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateAvailByUUID(ctx context.Context, spotID uuid.UUID, updateTimes *models.ParkingSpotAvailUpdateInput) error {
+	args := m.Called(ctx, spotID, updateTimes)
+	return args.Error(0)
+}
+
+const (
+	testBookerID          = int64(0)
+	testOwnerID           = int64(1)
+	testBookingInternalID = int64(4)
+)
+
+var (
+	testBookingUUID = uuid.New()
+	testSpotUUID    = uuid.New()
+)
+
+// Returns a booking entry starting `in` from now
+func testEntry(in time.Duration) booking.EntryWithTimes {
+	start := time.Now().Add(in).Truncate(30 * time.Minute)
+	return booking.EntryWithTimes{
+		EntryWithDetails: booking.EntryWithDetails{
+			Entry: booking.Entry{
+				Booking: models.Booking{
+					ID:            testBookingUUID,
+					ParkingSpotID: testSpotUUID,
+				},
+				InternalID: testBookingInternalID,
+				BookerID:   testBookerID,
+			},
+		},
+		BookedTimes: []models.TimeUnit{
+			{StartTime: start, EndTime: start.Add(30 * time.Minute)},
+		},
+	}
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	const contactBody = "Call me at 204-555-0199 or j.smith@gmail.com"
+
+	t.Run("contact details removed before confirmation", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		bookingRepo := new(mockBookingRepo)
+		spotRepo := new(mockParkingspotRepo)
+		service := New(repo, bookingRepo, spotRepo, ContactRedactor{})
+
+		bookingRepo.On("GetByUUID", mock.Anything, testBookingUUID).
+			Return(testEntry(7*24*time.Hour), nil).
+			Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
+			Return(testOwnerID, nil).
+			Once()
+		repo.On("Create", mock.Anything, &message.CreateInput{
+			Body:      "Call me at [removed] or [removed]",
+			BookingID: testBookingInternalID,
+			SenderID:  testBookerID,
+			Redacted:  true,
+		}).
+			Return(message.Entry{
+				Message:  models.Message{Body: "Call me at [removed] or [removed]", Redacted: true},
+				SenderID: testBookerID,
+			}, nil).
+			Once()
+
+		result, err := service.Send(ctx, testBookerID, testBookingUUID, &models.MessageCreationInput{Body: contactBody})
+		require.NoError(t, err)
+		assert.Equal(t, models.MessageSenderDriver, result.Sender)
+		assert.True(t, result.Redacted)
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("contact details kept once confirmed", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		bookingRepo := new(mockBookingRepo)
+		spotRepo := new(mockParkingspotRepo)
+		service := New(repo, bookingRepo, spotRepo, ContactRedactor{})
+
+		bookingRepo.On("GetByUUID", mock.Anything, testBookingUUID).
+			Return(testEntry(time.Hour), nil).
+			Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
+			Return(testOwnerID, nil).
+			Once()
+		repo.On("Create", mock.Anything, &message.CreateInput{
+			Body:      contactBody,
+			BookingID: testBookingInternalID,
+			SenderID:  testOwnerID,
+		}).
+			Return(message.Entry{
+				Message:  models.Message{Body: contactBody},
+				SenderID: testOwnerID,
+			}, nil).
+			Once()
+
+		result, err := service.Send(ctx, testOwnerID, testBookingUUID, &models.MessageCreationInput{Body: contactBody})
+		require.NoError(t, err)
+		assert.Equal(t, models.MessageSenderHost, result.Sender)
+		assert.False(t, result.Redacted)
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("only participants can send", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		bookingRepo := new(mockBookingRepo)
+		spotRepo := new(mockParkingspotRepo)
+		service := New(repo, bookingRepo, spotRepo)
+
+		bookingRepo.On("GetByUUID", mock.Anything, testBookingUUID).
+			Return(testEntry(time.Hour), nil).
+			Once()
+		spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
+			Return(testOwnerID, nil).
+			Once()
+
+		_, err := service.Send(ctx, testOwnerID+1, testBookingUUID, &models.MessageCreationInput{Body: "Hi"})
+		require.ErrorIs(t, err, models.ErrBookingNotFound)
+
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("booking not found", func(t *testing.T) {
+		t.Parallel()
+
+		bookingRepo := new(mockBookingRepo)
+		service := New(nil, bookingRepo, nil)
+
+		bookingRepo.On("GetByUUID", mock.Anything, testBookingUUID).
+			Return(booking.EntryWithTimes{}, booking.ErrNotFound).
+			Once()
+
+		_, err := service.Send(ctx, testBookerID, testBookingUUID, &models.MessageCreationInput{Body: "Hi"})
+		require.ErrorIs(t, err, models.ErrBookingNotFound)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		t.Parallel()
+
+		service := New(nil, nil, nil)
+
+		_, err := service.Send(ctx, testBookerID, testBookingUUID, &models.MessageCreationInput{})
+		require.ErrorIs(t, err, models.ErrInvalidMessage)
+
+		long := make([]byte, models.MaximumMessageLength+1)
+		_, err = service.Send(ctx, testBookerID, testBookingUUID, &models.MessageCreationInput{Body: string(long)})
+		require.ErrorIs(t, err, models.ErrInvalidMessage)
+	})
+}
+
+func TestGetMany(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := new(mockRepo)
+	bookingRepo := new(mockBookingRepo)
+	spotRepo := new(mockParkingspotRepo)
+	service := New(repo, bookingRepo, spotRepo)
+
+	entries := []message.Entry{
+		{Message: models.Message{ID: uuid.New()}, InternalID: 3, SenderID: testOwnerID},
+		{Message: models.Message{ID: uuid.New()}, InternalID: 2, SenderID: testBookerID},
+	}
+	bookingRepo.On("GetByUUID", mock.Anything, testBookingUUID).
+		Return(testEntry(time.Hour), nil).
+		Twice()
+	spotRepo.On("GetOwnerByUUID", mock.Anything, testSpotUUID).
+		Return(testOwnerID, nil).
+		Twice()
+	repo.On("GetMany", mock.Anything, 2, omit.Val[message.Cursor]{}, testBookingInternalID).
+		Return(entries, nil).
+		Once()
+
+	result, next, err := service.GetMany(ctx, testBookerID, testBookingUUID, 1, "")
+	require.NoError(t, err)
+	if assert.Len(t, result, 1) {
+		assert.Equal(t, entries[0].ID, result[0].ID)
+		assert.Equal(t, models.MessageSenderHost, result[0].Sender)
+	}
+	assert.NotEmpty(t, next)
+
+	repo.On("GetMany", mock.Anything, 2, omit.From(message.Cursor{ID: 3}), testBookingInternalID).
+		Return(entries[1:], nil).
+		Once()
+
+	result, next, err = service.GetMany(ctx, testBookerID, testBookingUUID, 1, next)
+	require.NoError(t, err)
+	if assert.Len(t, result, 1) {
+		assert.Equal(t, entries[1].ID, result[0].ID)
+		assert.Equal(t, models.MessageSenderDriver, result[0].Sender)
+	}
+	assert.Empty(t, next)
+
+	repo.AssertExpectations(t)
+}
+
+func TestGetUnread(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := new(mockRepo)
+	service := New(repo, nil, nil)
+
+	entries := []message.UnreadEntry{
+		{Count: 2, BookingID: uuid.New()},
+		{Count: 3, BookingID: uuid.New()},
+	}
+	repo.On("GetUnreadCounts", mock.Anything, testOwnerID).
+		Return(entries, nil).
+		Once()
+
+	result, err := service.GetUnread(ctx, testOwnerID)
+	require.NoError(t, err)
+	assert.Equal(t, models.UnreadMessages{
+		Bookings: []models.UnreadMessageCount{
+			{BookingID: entries[0].BookingID, Count: 2},
+			{BookingID: entries[1].BookingID, Count: 3},
+		},
+		Total: 5,
+	}, result)
+}
@@ -0,0 +1,44 @@
+package message
+
+import "regexp"
+
+// Moderator rewrites message bodies before they are stored.
+type Moderator interface {
+	// Returns the moderated `body`, and whether it was modified
+	Moderate(body string) (string, bool)
+}
+
+// Text that replaces removed contact details
+const redactedText = "[removed]"
+
+// Minimum number of digits for a sequence to be considered a phone number
+const minPhoneDigits = 7
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	phonePattern = regexp.MustCompile(`\+?\(?\d[\d\s().-]*\d`)
+)
+
+// ContactRedactor removes phone numbers and email addresses from messages.
+type ContactRedactor struct{}
+
+func (ContactRedactor) Moderate(body string) (string, bool) {
+	result := emailPattern.ReplaceAllString(body, redactedText)
+	result = phonePattern.ReplaceAllStringFunc(result, func(match string) string {
+		if countDigits(match) < minPhoneDigits {
+			return match
+		}
+		return redactedText
+	})
+	return result, result != body
+}
+
+func countDigits(s string) int {
+	result := 0
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			result++
+		}
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContactRedactor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		modified bool
+	}{
+		{
+			name:     "plain message",
+			input:    "Which side of the driveway should I use?",
+			expected: "Which side of the driveway should I use?",
+		},
+		{
+			name:     "short numbers are kept",
+			input:    "Park in spot 12, the gate code is 4821",
+			expected: "Park in spot 12, the gate code is 4821",
+		},
+		{
+			name:     "email",
+			input:    "Email me at j.smith@gmail.com",
+			expected: "Email me at [removed]",
+			modified: true,
+		},
+		{
+			name:     "phone number",
+			input:    "Text +1 (204) 555-0199 when you arrive",
+			expected: "Text [removed] when you arrive",
+			modified: true,
+		},
+		{
+			name:     "unformatted phone number",
+			input:    "2045550199",
+			expected: "[removed]",
+			modified: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, modified := ContactRedactor{}.Moderate(test.input)
+			assert.Equal(t, test.expected, result)
+			assert.Equal(t, test.modified, modified)
+		})
+	}
+}
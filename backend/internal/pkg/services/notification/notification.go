@@ -0,0 +1,158 @@
+package notification
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/notification"
+	"github.com/aarondl/opt/omit"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Largest number of entries returned per request
+const MaximumCount = 1000
+
+// Sender records and delivers notifications to users
+type Sender interface {
+	// Send a notification to `userID`
+	Send(ctx context.Context, userID int64, input *models.NotificationInput) (models.Notification, error)
+}
+
+// Notifier delivers notifications to users outside of the in-app inbox.
+type Notifier interface {
+	// Deliver the notification `sent` to `userID`
+	Notify(ctx context.Context, userID int64, sent *models.Notification) error
+}
+
+type Service struct {
+	repo      notification.Repository
+	notifiers []Notifier
+}
+
+// Create a new notification service, delivering notifications through `notifiers`
+// in addition to the in-app inbox.
+func New(repo notification.Repository, notifiers ...Notifier) *Service {
+	return &Service{
+		repo:      repo,
+		notifiers: notifiers,
+	}
+}
+
+// Send a notification to `userID`.
+//
+// The notification is recorded in the inbox of the user, then delivered through every notifier.
+// Delivery failures are logged and do not fail the call.
+//
+// If the user was already sent a notification of the same type for `input.EventID`, nothing is sent
+// and a zero notification is returned.
+func (s *Service) Send(ctx context.Context, userID int64, input *models.NotificationInput) (models.Notification, error) {
+	entry, err := s.repo.Create(ctx, &notification.CreateInput{
+		NotificationInput: *input,
+		UserID:            userID,
+	})
+	if err != nil {
+		if errors.Is(err, notification.ErrDuplicateEvent) {
+			return models.Notification{}, nil
+		}
+		return models.Notification{}, err
+	}
+
+	for _, notifier := range s.notifiers {
+		err := notifier.Notify(ctx, userID, &entry.Notification)
+		if err != nil {
+			log.Ctx(ctx).
+				Err(err).
+				Int64("userid", userID).
+				Stringer("notificationid", entry.ID).
+				Msg("could not deliver notification")
+		}
+	}
+
+	return entry.Notification, nil
+}
+
+// Get at most `count` notifications of `userID`, newest first.
+//
+// If there are more entries following the result, a non-empty cursor will be returned
+// which can be passed to the next invocation to get the next entries.
+func (s *Service) GetMany(ctx context.Context, userID int64, count int, after models.Cursor) (notifications []models.Notification, next models.Cursor, err error) {
+	if count <= 0 {
+		return []models.Notification{}, "", nil
+	}
+
+	cursor := decodeCursor(after)
+	count = min(count, MaximumCount)
+	entries, err := s.repo.GetMany(ctx, count+1, cursor, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(entries) > count {
+		entries = entries[:len(entries)-1]
+
+		next, err = encodeCursor(notification.Cursor{
+			ID: entries[len(entries)-1].InternalID,
+		})
+		// This is an issue, but not enough to abort the request
+		if err != nil {
+			log.Err(err).
+				Int64("notificationid", entries[len(entries)-1].InternalID).
+				Msg("could not encode next cursor")
+		}
+	}
+
+	result := make([]models.Notification, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.Notification)
+	}
+	return result, next, nil
+}
+
+// Mark the notification `notificationID` of `userID` as read.
+//
+// Notifications read before keep their original read time.
+func (s *Service) MarkRead(ctx context.Context, userID int64, notificationID uuid.UUID) (models.Notification, error) {
+	entry, err := s.repo.MarkRead(ctx, userID, notificationID, time.Now())
+	if err != nil {
+		if errors.Is(err, notification.ErrNotFound) {
+			err = models.ErrNotificationNotFound
+		}
+		return models.Notification{}, err
+	}
+	return entry.Notification, nil
+}
+
+// Mark all notifications of `userID` as read.
+func (s *Service) MarkAllRead(ctx context.Context, userID int64) error {
+	_, err := s.repo.MarkAllRead(ctx, userID, time.Now())
+	return err
+}
+
+func decodeCursor(cursor models.Cursor) omit.Val[notification.Cursor] {
+	raw, err := base64.RawURLEncoding.DecodeString(string(cursor))
+	if err != nil {
+		return omit.Val[notification.Cursor]{}
+	}
+
+	var result notification.Cursor
+	err = cbor.Unmarshal(raw, &result)
+	if err != nil {
+		return omit.Val[notification.Cursor]{}
+	}
+
+	return omit.From(result)
+}
+
+func encodeCursor(cursor notification.Cursor) (models.Cursor, error) {
+	raw, err := cbor.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return models.Cursor(base64.RawURLEncoding.EncodeToString(raw)), nil
+}
@@ -0,0 +1,278 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/notification"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+// Create implements notification.Repository.
+func (m *mockRepo) Create(ctx context.Context, input *notification.CreateInput) (notification.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(notification.Entry), args.Error(1)
+}
+
+// GetMany implements notification.Repository.
+func (m *mockRepo) GetMany(ctx context.Context, limit int, after omit.Val[notification.Cursor], userID int64) ([]notification.Entry, error) {
+	args := m.Called(ctx, limit, after, userID)
+	return args.Get(0).([]notification.Entry), args.Error(1)
+}
+
+// MarkRead implements notification.Repository.
+func (m *mockRepo) MarkRead(ctx context.Context, userID int64, notificationID uuid.UUID, now time.Time) (notification.Entry, error) {
+	args := m.Called(ctx, userID, notificationID, now)
+	return args.Get(0).(notification.Entry), args.Error(1)
+}
+
+// MarkAllRead implements notification.Repository.
+func (m *mockRepo) MarkAllRead(ctx context.Context, userID int64, now time.Time) (int64, error) {
+	args := m.Called(ctx, userID, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type mockNotifier struct {
+	mock.Mock
+}
+
+// Notify implements Notifier.
+func (m *mockNotifier) Notify(ctx context.Context, userID int64, sent *models.Notification) error {
+	args := m.Called(ctx, userID, sent)
+	return args.Error(0)
+}
+
+const testUserID = int64(1)
+
+var testInput = models.NotificationInput{
+	Type:      models.NotificationSpotAvailable,
+	Title:     "Parking available",
+	Body:      "2 time slots are now available at 5 Niagara Parkway, Niagara Falls.",
+	SubjectID: uuid.New(),
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	created := notification.Entry{
+		Notification: models.Notification{
+			Type:      testInput.Type,
+			Title:     testInput.Title,
+			Body:      testInput.Body,
+			SubjectID: testInput.SubjectID,
+			ID:        uuid.New(),
+		},
+		InternalID: 1,
+		UserID:     testUserID,
+	}
+
+	t.Run("records and delivers the notification", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		notifier := new(mockNotifier)
+		srv := New(repo, notifier)
+
+		repo.On("Create", mock.Anything, &notification.CreateInput{
+			NotificationInput: testInput,
+			UserID:            testUserID,
+		}).
+			Return(created, nil).
+			Once()
+		notifier.On("Notify", mock.Anything, testUserID, &created.Notification).
+			Return(nil).
+			Once()
+
+		result, err := srv.Send(ctx, testUserID, &testInput)
+		require.NoError(t, err)
+		assert.Equal(t, created.Notification, result)
+		repo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("delivery failures do not fail sending", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		failing := new(mockNotifier)
+		notifier := new(mockNotifier)
+		srv := New(repo, failing, notifier)
+
+		repo.On("Create", mock.Anything, mock.Anything).
+			Return(created, nil).
+			Once()
+		failing.On("Notify", mock.Anything, testUserID, mock.Anything).
+			Return(errors.New("unreachable")).
+			Once()
+		notifier.On("Notify", mock.Anything, testUserID, mock.Anything).
+			Return(nil).
+			Once()
+
+		_, err := srv.Send(ctx, testUserID, &testInput)
+		require.NoError(t, err)
+		failing.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("nothing is delivered if it can not be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		notifier := new(mockNotifier)
+		srv := New(repo, notifier)
+
+		repo.On("Create", mock.Anything, mock.Anything).
+			Return(notification.Entry{}, errors.New("db error")).
+			Once()
+
+		_, err := srv.Send(ctx, testUserID, &testInput)
+		require.Error(t, err)
+		notifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("notifications already sent for an event are not delivered again", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		notifier := new(mockNotifier)
+		srv := New(repo, notifier)
+
+		input := testInput
+		input.EventID = uuid.New()
+		repo.On("Create", mock.Anything, mock.Anything).
+			Return(notification.Entry{}, notification.ErrDuplicateEvent).
+			Once()
+
+		result, err := srv.Send(ctx, testUserID, &input)
+		require.NoError(t, err)
+		assert.Zero(t, result)
+		notifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetMany(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	entries := []notification.Entry{
+		{Notification: models.Notification{ID: uuid.New()}, InternalID: 3, UserID: testUserID},
+		{Notification: models.Notification{ID: uuid.New()}, InternalID: 2, UserID: testUserID},
+		{Notification: models.Notification{ID: uuid.New()}, InternalID: 1, UserID: testUserID},
+	}
+
+	t.Run("paginates", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo)
+
+		repo.On("GetMany", mock.Anything, 3, omit.Val[notification.Cursor]{}, testUserID).
+			Return(entries, nil).
+			Once()
+		repo.On("GetMany", mock.Anything, 3, omit.From(notification.Cursor{ID: 2}), testUserID).
+			Return(entries[2:], nil).
+			Once()
+
+		result, next, err := srv.GetMany(ctx, testUserID, 2, "")
+		require.NoError(t, err)
+		assert.Equal(t, []models.Notification{entries[0].Notification, entries[1].Notification}, result)
+		require.NotEmpty(t, next)
+
+		result, next, err = srv.GetMany(ctx, testUserID, 2, next)
+		require.NoError(t, err)
+		assert.Equal(t, []models.Notification{entries[2].Notification}, result)
+		assert.Empty(t, next)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("no count", func(t *testing.T) {
+		t.Parallel()
+
+		srv := New(nil)
+
+		result, next, err := srv.GetMany(ctx, testUserID, 0, "")
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		assert.Empty(t, next)
+	})
+}
+
+func TestMarkRead(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	notificationID := uuid.New()
+
+	t.Run("marks the notification", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo)
+
+		readAt := time.Now()
+		entry := notification.Entry{
+			Notification: models.Notification{ReadAt: &readAt, ID: notificationID},
+			InternalID:   1,
+			UserID:       testUserID,
+		}
+		repo.On("MarkRead", mock.Anything, testUserID, notificationID, mock.Anything).
+			Return(entry, nil).
+			Once()
+
+		result, err := srv.MarkRead(ctx, testUserID, notificationID)
+		require.NoError(t, err)
+		assert.Equal(t, entry.Notification, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("notification not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo)
+
+		repo.On("MarkRead", mock.Anything, testUserID, notificationID, mock.Anything).
+			Return(notification.Entry{}, notification.ErrNotFound).
+			Once()
+
+		_, err := srv.MarkRead(ctx, testUserID, notificationID)
+		require.ErrorIs(t, err, models.ErrNotificationNotFound)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestMarkAllRead(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := new(mockRepo)
+	srv := New(repo)
+
+	repo.On("MarkAllRead", mock.Anything, testUserID, mock.Anything).
+		Return(int64(3), nil).
+		Once()
+
+	err := srv.MarkAllRead(ctx, testUserID)
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
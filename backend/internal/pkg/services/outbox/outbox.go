@@ -0,0 +1,186 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/outbox"
+	"github.com/rs/zerolog/log"
+)
+
+// Interval between two checks for unpublished events
+const RelayInterval = time.Second
+
+// Duration for which published events are kept
+const Retention = 7 * 24 * time.Hour
+
+// Delay before relaying an event again after a subscriber failed, doubled on every following failure
+const BaseRetryDelay = time.Second
+
+// Longest delay between two relay attempts of an event
+const MaxRetryDelay = 10 * time.Minute
+
+const (
+	// Largest number of events relayed per check
+	relayBatch = 100
+	// Duration for which claimed events are not relayed by other servers.
+	//
+	// Must be longer than relaying a whole batch.
+	relayLease = time.Minute
+	// Interval between two deletions of old events
+	cleanupInterval = time.Hour
+)
+
+// Handler processes a domain event.
+//
+// Events are delivered at least once, so handlers must tolerate receiving an event again.
+// Handlers are given every event and should ignore the types they are not interested in.
+type Handler func(ctx context.Context, event *models.DomainEvent) error
+
+// Returns a handler that gives availability events to `handle` and ignores other events.
+//
+// The ID of the availability event is set to the ID of the domain event, so that it stays the same
+// when the event is relayed again.
+func AvailabilityHandler(handle func(ctx context.Context, event *models.AvailabilityEvent) error) Handler {
+	return func(ctx context.Context, event *models.DomainEvent) error {
+		if event.Type != models.EventAvailabilityChanged {
+			return nil
+		}
+
+		var changed models.AvailabilityEvent
+		err := json.Unmarshal(event.Data, &changed)
+		if err != nil {
+			return fmt.Errorf("could not decode availability event: %w", err)
+		}
+		changed.ID = event.ID
+		return handle(ctx, &changed)
+	}
+}
+
+type subscriber struct {
+	handle Handler
+	name   string
+}
+
+// Service relays domain events recorded in the outbox to in-process subscribers.
+type Service struct {
+	repo        outbox.Repository
+	subscribers []subscriber
+}
+
+func New(repo outbox.Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// Deliver all events to `handle`, `name` identifies the subscriber in logs and in the outbox.
+//
+// Names must be unique and should not change, as events are not relayed again to the subscriber
+// with the name that handled them.
+//
+// Subscribers must be added before `Run` is called.
+func (s *Service) Subscribe(name string, handle Handler) {
+	s.subscribers = append(s.subscribers, subscriber{
+		handle: handle,
+		name:   name,
+	})
+}
+
+// Relay events to subscribers until `ctx` is cancelled.
+//
+// Events are only marked as published once all subscribers handled them, otherwise they are relayed
+// again after a delay to the subscribers that failed.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(RelayInterval)
+	defer ticker.Stop()
+
+	var lastCleanup time.Time
+	for {
+		now := time.Now()
+		err := s.relayDue(ctx, now)
+		if err != nil && ctx.Err() == nil {
+			log.Ctx(ctx).Err(err).Msg("could not relay domain events")
+		}
+
+		if now.Sub(lastCleanup) >= cleanupInterval {
+			_, err = s.repo.DeletePublishedBefore(ctx, now.Add(-Retention))
+			if err != nil && ctx.Err() == nil {
+				log.Ctx(ctx).Err(err).Msg("could not delete published domain events")
+			}
+			lastCleanup = now
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) relayDue(ctx context.Context, now time.Time) error {
+	events, err := s.repo.ClaimDue(ctx, now, now.Add(relayLease), relayBatch)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for idx := range events {
+		event := &events[idx]
+		err := s.relay(ctx, event)
+		if ctx.Err() != nil {
+			// The event will be relayed again once the lease expires
+			return ctx.Err()
+		}
+
+		if err != nil {
+			log.Ctx(ctx).
+				Err(err).
+				Stringer("eventid", event.ID).
+				Str("type", event.Type).
+				Int32("attempts", event.Attempts+1).
+				Msg("could not relay domain event, retrying later")
+			err = s.repo.Reschedule(ctx, event.InternalID, now.Add(retryBackoff(event.Attempts+1)))
+		} else {
+			err = s.repo.MarkPublished(ctx, event.InternalID, now)
+		}
+		if err != nil && !errors.Is(err, outbox.ErrNotFound) {
+			errs = append(errs, fmt.Errorf("could not record relay of event %v: %w", event.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Deliver `event` to every subscriber that did not handle it yet
+func (s *Service) relay(ctx context.Context, event *outbox.Entry) error {
+	var errs []error
+	for _, sub := range s.subscribers {
+		if slices.Contains(event.DeliveredTo, sub.name) {
+			continue
+		}
+
+		err := sub.handle(ctx, &event.DomainEvent)
+		if err == nil {
+			err = s.repo.MarkDelivered(ctx, event.InternalID, sub.name)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", sub.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Delay before the next relay attempt of an event that failed `attempts` times
+func retryBackoff(attempts int32) time.Duration {
+	delay := BaseRetryDelay
+	for i := int32(1); i < attempts && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxRetryDelay)
+}
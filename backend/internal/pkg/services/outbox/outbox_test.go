@@ -0,0 +1,247 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/outbox"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+// ClaimDue implements outbox.Repository.
+func (m *mockRepo) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]outbox.Entry, error) {
+	args := m.Called(ctx, now, leaseUntil, limit)
+	return args.Get(0).([]outbox.Entry), args.Error(1)
+}
+
+// MarkDelivered implements outbox.Repository.
+func (m *mockRepo) MarkDelivered(ctx context.Context, eventID int64, subscriber string) error {
+	args := m.Called(ctx, eventID, subscriber)
+	return args.Error(0)
+}
+
+// MarkPublished implements outbox.Repository.
+func (m *mockRepo) MarkPublished(ctx context.Context, eventID int64, now time.Time) error {
+	args := m.Called(ctx, eventID, now)
+	return args.Error(0)
+}
+
+// Reschedule implements outbox.Repository.
+func (m *mockRepo) Reschedule(ctx context.Context, eventID int64, next time.Time) error {
+	args := m.Called(ctx, eventID, next)
+	return args.Error(0)
+}
+
+// DeletePublishedBefore implements outbox.Repository.
+func (m *mockRepo) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestRelayDue(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	now := time.Now()
+	events := []outbox.Entry{
+		{
+			DomainEvent: models.DomainEvent{
+				Type: models.EventBookingCreated,
+				Data: []byte(`{}`),
+				ID:   uuid.New(),
+			},
+			InternalID: 1,
+		},
+		{
+			DomainEvent: models.DomainEvent{
+				Type: models.EventAvailabilityChanged,
+				Data: []byte(`{}`),
+				ID:   uuid.New(),
+			},
+			InternalID: 2,
+			Attempts:   2,
+		},
+	}
+
+	t.Run("all subscribers succeed", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo)
+
+		var first, second []uuid.UUID
+		srv.Subscribe("first", func(_ context.Context, event *models.DomainEvent) error {
+			first = append(first, event.ID)
+			return nil
+		})
+		srv.Subscribe("second", func(_ context.Context, event *models.DomainEvent) error {
+			second = append(second, event.ID)
+			return nil
+		})
+
+		repo.On("ClaimDue", mock.Anything, now, now.Add(relayLease), relayBatch).
+			Return(events, nil).
+			Once()
+		for _, sub := range []string{"first", "second"} {
+			repo.On("MarkDelivered", mock.Anything, int64(1), sub).Return(nil).Once()
+			repo.On("MarkDelivered", mock.Anything, int64(2), sub).Return(nil).Once()
+		}
+		repo.On("MarkPublished", mock.Anything, int64(1), now).Return(nil).Once()
+		repo.On("MarkPublished", mock.Anything, int64(2), now).Return(nil).Once()
+
+		err := srv.relayDue(ctx, now)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+
+		expected := []uuid.UUID{events[0].ID, events[1].ID}
+		assert.Equal(t, expected, first)
+		assert.Equal(t, expected, second)
+	})
+
+	t.Run("failed subscriber reschedules the event", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo)
+
+		var relayed []uuid.UUID
+		srv.Subscribe("failing", func(_ context.Context, event *models.DomainEvent) error {
+			if event.Type == models.EventAvailabilityChanged {
+				return errors.New("unavailable")
+			}
+			return nil
+		})
+		srv.Subscribe("working", func(_ context.Context, event *models.DomainEvent) error {
+			relayed = append(relayed, event.ID)
+			return nil
+		})
+
+		repo.On("ClaimDue", mock.Anything, now, now.Add(relayLease), relayBatch).
+			Return(events, nil).
+			Once()
+		repo.On("MarkDelivered", mock.Anything, int64(1), "failing").Return(nil).Once()
+		repo.On("MarkDelivered", mock.Anything, int64(1), "working").Return(nil).Once()
+		repo.On("MarkDelivered", mock.Anything, int64(2), "working").Return(nil).Once()
+		repo.On("MarkPublished", mock.Anything, int64(1), now).Return(nil).Once()
+		repo.On("Reschedule", mock.Anything, int64(2), now.Add(4*BaseRetryDelay)).Return(nil).Once()
+
+		err := srv.relayDue(ctx, now)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+
+		// Other subscribers still receive the event
+		assert.Equal(t, []uuid.UUID{events[0].ID, events[1].ID}, relayed)
+	})
+
+	t.Run("retries skip subscribers that handled the event", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo)
+
+		var first, second []uuid.UUID
+		srv.Subscribe("first", func(_ context.Context, event *models.DomainEvent) error {
+			first = append(first, event.ID)
+			return nil
+		})
+		srv.Subscribe("second", func(_ context.Context, event *models.DomainEvent) error {
+			second = append(second, event.ID)
+			return nil
+		})
+
+		retried := events[1]
+		retried.DeliveredTo = []string{"first"}
+		repo.On("ClaimDue", mock.Anything, now, now.Add(relayLease), relayBatch).
+			Return([]outbox.Entry{retried}, nil).
+			Once()
+		repo.On("MarkDelivered", mock.Anything, int64(2), "second").Return(nil).Once()
+		repo.On("MarkPublished", mock.Anything, int64(2), now).Return(nil).Once()
+
+		err := srv.relayDue(ctx, now)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+
+		assert.Empty(t, first)
+		assert.Equal(t, []uuid.UUID{retried.ID}, second)
+	})
+
+	t.Run("claim failure", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo)
+
+		claimErr := errors.New("connection lost")
+		repo.On("ClaimDue", mock.Anything, now, now.Add(relayLease), relayBatch).
+			Return([]outbox.Entry(nil), claimErr).
+			Once()
+
+		err := srv.relayDue(ctx, now)
+		require.ErrorIs(t, err, claimErr)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestAvailabilityHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var handled []models.AvailabilityEvent
+	handle := AvailabilityHandler(func(_ context.Context, event *models.AvailabilityEvent) error {
+		handled = append(handled, *event)
+		return nil
+	})
+
+	eventID := uuid.New()
+	spotID := uuid.New()
+	err := handle(ctx, &models.DomainEvent{
+		Type: models.EventAvailabilityChanged,
+		Data: []byte(`{"type":"released","spot_id":"` + spotID.String() + `","expired":true}`),
+		ID:   eventID,
+	})
+	require.NoError(t, err)
+
+	err = handle(ctx, &models.DomainEvent{
+		Type: models.EventBookingCreated,
+		Data: []byte(`{}`),
+		ID:   uuid.New(),
+	})
+	require.NoError(t, err)
+
+	err = handle(ctx, &models.DomainEvent{
+		Type: models.EventAvailabilityChanged,
+		Data: []byte(`[]`),
+		ID:   uuid.New(),
+	})
+	require.Error(t, err)
+
+	expected := []models.AvailabilityEvent{{
+		Type:    models.AvailabilityEventReleased,
+		SpotID:  spotID,
+		ID:      eventID,
+		Expired: true,
+	}}
+	assert.Equal(t, expected, handled)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, BaseRetryDelay, retryBackoff(1))
+	assert.Equal(t, 2*BaseRetryDelay, retryBackoff(2))
+	assert.Equal(t, 8*BaseRetryDelay, retryBackoff(4))
+	assert.Equal(t, MaxRetryDelay, retryBackoff(100))
+}
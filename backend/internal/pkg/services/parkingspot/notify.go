@@ -0,0 +1,92 @@
+package parkingspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/google/uuid"
+)
+
+// Notify the owner of the spot of `event` when its held time slots are released by an expired hold.
+//
+// Holds released by their driver or converted into a booking are not notified. Subscribes to
+// availability events of the outbox.
+func (s *Service) HandleAvailability(ctx context.Context, event *models.AvailabilityEvent) error {
+	if event.Type != models.AvailabilityEventReleased || !event.Expired {
+		return nil
+	}
+
+	spot, err := s.repo.GetByUUID(ctx, event.SpotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			// The spot was deleted since
+			return nil
+		}
+		return err
+	}
+
+	_, err = s.sender.Send(ctx, spot.OwnerID, &models.NotificationInput{
+		Type:  models.NotificationAvailabilityChanged,
+		Title: "Hold expired",
+		Body: fmt.Sprintf(
+			"A hold at %s, %s expired without being booked, %s available again.",
+			spot.Location.StreetAddress,
+			spot.Location.City,
+			countSlots(len(event.Times), "1 time slot is", "%d time slots are"),
+		),
+		SubjectID: event.SpotID,
+		EventID:   event.ID,
+	})
+	return err
+}
+
+// Notify the owner of `spotID` of the slots removed and conflicts found by polling its imported
+// calendar.
+//
+// Nothing is sent if the poll did not change anything.
+func (s *Service) NotifyCalendarSync(ctx context.Context, spotID uuid.UUID, result *models.CalendarImportResult) error {
+	if len(result.Removed) == 0 && len(result.Conflicts) == 0 {
+		return nil
+	}
+
+	spot, err := s.repo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	body := fmt.Sprintf("Your imported calendar was applied to %s, %s.", spot.Location.StreetAddress, spot.Location.City)
+	if len(result.Removed) > 0 {
+		body += fmt.Sprintf(
+			" %s removed from the availability.",
+			countSlots(len(result.Removed), "1 time slot was", "%d time slots were"),
+		)
+	}
+	if len(result.Conflicts) > 0 {
+		body += fmt.Sprintf(
+			" %s busy times and cannot be removed.",
+			countSlots(len(result.Conflicts), "1 booked or held time slot overlaps", "%d booked or held time slots overlap"),
+		)
+	}
+
+	_, err = s.sender.Send(ctx, spot.OwnerID, &models.NotificationInput{
+		Type:      models.NotificationAvailabilityChanged,
+		Title:     "Availability updated from your calendar",
+		Body:      body,
+		SubjectID: spotID,
+	})
+	return err
+}
+
+// Returns `one` if `count` is 1, or `many` formatted with `count` otherwise
+func countSlots(count int, one, many string) string {
+	if count == 1 {
+		return one
+	}
+	return fmt.Sprintf(many, count)
+}
@@ -0,0 +1,144 @@
+package parkingspot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSender struct {
+	mock.Mock
+}
+
+// Send implements notification.Sender.
+func (m *mockSender) Send(ctx context.Context, userID int64, input *models.NotificationInput) (models.Notification, error) {
+	args := m.Called(ctx, userID, input)
+	return args.Get(0).(models.Notification), args.Error(1)
+}
+
+const testSpotOwnerID = int64(5)
+
+func TestHandleAvailability(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	entry := sampleEntry
+	entry.OwnerID = testSpotOwnerID
+
+	t.Run("expired holds notify the owner", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, testSpotID).Return(entry, nil).Once()
+		sender := new(mockSender)
+		event := models.AvailabilityEvent{
+			Type:    models.AvailabilityEventReleased,
+			Times:   sampleAvailability,
+			SpotID:  testSpotID,
+			ID:      uuid.New(),
+			Expired: true,
+		}
+		sender.On("Send", mock.Anything, testSpotOwnerID, &models.NotificationInput{
+			Type:      models.NotificationAvailabilityChanged,
+			Title:     "Hold expired",
+			Body:      "A hold at 6650 Niagara Parkway, Niagara Falls expired without being booked, 2 time slots are available again.",
+			SubjectID: testSpotID,
+			EventID:   event.ID,
+		}).Return(models.Notification{}, nil).Once()
+		srv := New(repo, nil, nil, nil, nil, nil, sender)
+
+		err := srv.HandleAvailability(ctx, &event)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("changes made by the owner are ignored", func(t *testing.T) {
+		t.Parallel()
+
+		srv := New(nil, nil, nil, nil, nil, nil, nil)
+		for _, eventType := range []string{models.AvailabilityEventAdded, models.AvailabilityEventRemoved, models.AvailabilityEventBooked, models.AvailabilityEventHeld} {
+			err := srv.HandleAvailability(ctx, &models.AvailabilityEvent{Type: eventType, SpotID: testSpotID})
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("holds released before expiring are ignored", func(t *testing.T) {
+		t.Parallel()
+
+		srv := New(nil, nil, nil, nil, nil, nil, nil)
+		err := srv.HandleAvailability(ctx, &models.AvailabilityEvent{
+			Type:   models.AvailabilityEventReleased,
+			Times:  sampleAvailability,
+			SpotID: testSpotID,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("deleted spots are ignored", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, testSpotID).Return(parkingspot.Entry{}, parkingspot.ErrNotFound).Once()
+		srv := New(repo, nil, nil, nil, nil, nil, nil)
+
+		err := srv.HandleAvailability(ctx, &models.AvailabilityEvent{
+			Type:    models.AvailabilityEventReleased,
+			SpotID:  testSpotID,
+			Expired: true,
+		})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestNotifyCalendarSync(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	entry := sampleEntry
+	entry.OwnerID = testSpotOwnerID
+
+	t.Run("removals and conflicts notify the owner", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, testSpotID).Return(entry, nil).Once()
+		sender := new(mockSender)
+		sender.On("Send", mock.Anything, testSpotOwnerID, mock.MatchedBy(func(input *models.NotificationInput) bool {
+			return input.Type == models.NotificationAvailabilityChanged && input.SubjectID == testSpotID
+		})).Return(models.Notification{}, nil).Once()
+		srv := New(repo, nil, nil, nil, nil, nil, sender)
+
+		err := srv.NotifyCalendarSync(ctx, testSpotID, &models.CalendarImportResult{
+			Removed:   sampleAvailability,
+			Conflicts: []models.CalendarImportConflict{{}},
+		})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+		sender.AssertExpectations(t)
+
+		input := sender.Calls[0].Arguments.Get(2).(*models.NotificationInput)
+		assert.Equal(
+			t,
+			"Your imported calendar was applied to 6650 Niagara Parkway, Niagara Falls. "+
+				"2 time slots were removed from the availability. "+
+				"1 booked or held time slot overlaps busy times and cannot be removed.",
+			input.Body,
+		)
+	})
+
+	t.Run("unchanged availability is not notified", func(t *testing.T) {
+		t.Parallel()
+
+		srv := New(nil, nil, nil, nil, nil, nil, nil)
+		err := srv.NotifyCalendarSync(ctx, testSpotID, &models.CalendarImportResult{})
+		require.NoError(t, err)
+	})
+}
@@ -6,13 +6,19 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"regexp"
 	"time"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/region"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/car"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/geocoding"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/preferencespot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/spotphoto"
+	carService "github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/car"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/notification"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/photo"
 	"github.com/aarondl/opt/omit"
 	"github.com/fxamacker/cbor/v2"
 	"github.com/google/uuid"
@@ -26,42 +32,32 @@ type Service struct {
 	repo           parkingspot.Repository
 	geocoder       geocoding.Geocoder
 	preferenceRepo preferencespot.Repository
+	pricingRepo    pricing.Repository
+	photoRepo      spotphoto.Repository
+	carRepo        car.Repository
+	sender         notification.Sender
 }
 
-func New(repo parkingspot.Repository, geocoder geocoding.Geocoder, preferenceRepo preferencespot.Repository) *Service {
+func New(
+	repo parkingspot.Repository,
+	geocoder geocoding.Geocoder,
+	preferenceRepo preferencespot.Repository,
+	pricingRepo pricing.Repository,
+	photoRepo spotphoto.Repository,
+	carRepo car.Repository,
+	sender notification.Sender,
+) *Service {
 	return &Service{
 		repo:           repo,
 		geocoder:       geocoder,
 		preferenceRepo: preferenceRepo,
+		pricingRepo:    pricingRepo,
+		photoRepo:      photoRepo,
+		carRepo:        carRepo,
+		sender:         sender,
 	}
 }
 
-func isValidProvinceCode(code string) bool {
-	switch code {
-	case "AB", "BC", "MB", "NB", "NL", "NT", "NS", "NU", "ON", "PE", "QC", "SK", "YT":
-		return true
-	default:
-		return false
-	}
-}
-
-// Map between province and IANA time zone names
-var provinceToTz = map[string]string{ //nolint // This won't be used until later
-	"AB": "America/Edmonton",
-	"BC": "America/Vancouver",
-	"MB": "America/Winnipeg",
-	"NB": "America/Moncton",
-	"NL": "America/St_Johns",
-	"NS": "America/Halifax",
-	"NU": "America/Iqaluit",
-	"ON": "America/Toronto",
-	"PE": "America/Halifax",
-	"QC": "America/Montreal",
-	"SK": "America/Regina",
-	"YT": "America/Whitehorse",
-	"NT": "America/Yellowknife",
-}
-
 func (s *Service) Create(ctx context.Context, userID int64, input *models.ParkingSpotCreationInput) (int64, models.ParkingSpotWithAvailability, error) {
 	err := validateCreationInput(input)
 	if err != nil {
@@ -111,9 +107,15 @@ func (s *Service) Create(ctx context.Context, userID int64, input *models.Parkin
 				Longitude:     result.Location.Longitude,
 				Latitude:      result.Location.Latitude,
 			},
-			Features:     result.Features,
-			PricePerHour: result.PricePerHour,
-			ID:           result.ID,
+			Label:              result.Label,
+			Description:        result.Description,
+			AccessInstructions: result.AccessInstructions,
+			Restrictions:       result.Restrictions,
+			Features:           result.Features,
+			Accessibility:      result.Accessibility,
+			PricePerHour:       result.PricePerHour,
+			ID:                 result.ID,
+			LocationID:         result.LocationID,
 		},
 		Availability: availability,
 	}
@@ -138,6 +140,10 @@ func (s *Service) UpdateSpotByUUID(ctx context.Context, userID int64, spotID uui
 	if err != nil {
 		return models.ParkingSpot{}, err
 	}
+	err = validateRestrictions(&input.Restrictions)
+	if err != nil {
+		return models.ParkingSpot{}, err
+	}
 
 	result, err := s.repo.UpdateSpotByUUID(ctx, spotID, input)
 	if err != nil {
@@ -154,9 +160,17 @@ func (s *Service) UpdateSpotByUUID(ctx context.Context, userID int64, spotID uui
 			Longitude:     result.Location.Longitude,
 			Latitude:      result.Location.Latitude,
 		},
-		Features:     result.Features,
-		PricePerHour: result.PricePerHour,
-		ID:           result.ID,
+		Label:              result.Label,
+		Description:        result.Description,
+		AccessInstructions: result.AccessInstructions,
+		Restrictions:       result.Restrictions,
+		Features:           result.Features,
+		Accessibility:      result.Accessibility,
+		RatingCount:        result.RatingCount,
+		PricePerHour:       result.PricePerHour,
+		Rating:             result.Rating,
+		ID:                 result.ID,
+		LocationID:         result.LocationID,
 	}
 
 	return out, nil
@@ -215,10 +229,27 @@ func (s *Service) GetByUUID(ctx context.Context, userID int64, spotID uuid.UUID)
 			Longitude:     result.Location.Longitude,
 			Latitude:      result.Location.Latitude,
 		},
-		Features:     result.Features,
-		PricePerHour: result.PricePerHour,
-		ID:           result.ID,
+		Label:         result.Label,
+		Description:   result.Description,
+		Restrictions:  result.Restrictions,
+		Features:      result.Features,
+		Accessibility: result.Accessibility,
+		RatingCount:   result.RatingCount,
+		PricePerHour:  result.PricePerHour,
+		Rating:        result.Rating,
+		ID:            result.ID,
+		LocationID:    result.LocationID,
+	}
+	// Drivers only get the access instructions with their bookings
+	if result.OwnerID == userID {
+		out.AccessInstructions = result.AccessInstructions
+	}
+
+	photos, err := s.photoRepo.GetBySpot(ctx, result.InternalID)
+	if err != nil {
+		return models.ParkingSpot{}, err
 	}
+	out.Photos = photo.FromEntries(photos)
 
 	return out, nil
 }
@@ -268,6 +299,29 @@ func (s *Service) GetMany(ctx context.Context, userID int64, count int, filter m
 		}),
 		Availability: omit.From(repoAvailFilter),
 	}
+	if filter.Sort == models.SpotSortRating {
+		repoFilter.Sort = parkingspot.SortRating
+	}
+	vehicle := carService.Size{
+		Height: filter.VehicleHeight,
+		Length: filter.VehicleLength,
+		Width:  filter.VehicleWidth,
+		Truck:  filter.Truck,
+	}
+	if filter.CarID != uuid.Nil {
+		vehicle, err = s.carSize(ctx, userID, filter.CarID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if vehicle != (carService.Size{}) {
+		repoFilter.Vehicle = omit.From(parkingspot.FilterVehicle(vehicle))
+	}
+	repoFilter.Accessibility = models.ParkingSpotAccessibility{
+		Accessible: filter.Accessible,
+		StepFree:   filter.StepFree,
+		WellLit:    filter.WellLit,
+	}
 	spotEntries, err := s.repo.GetMany(ctx, count, &repoFilter)
 	if err != nil {
 		return nil, err
@@ -284,6 +338,55 @@ func (s *Service) GetMany(ctx context.Context, userID int64, count int, filter m
 	return result, nil
 }
 
+// Get the locations with spaces matching `filter`, grouping the spaces found by `GetMany`.
+//
+// Locations are ordered by their first space, `count` limits the number of spaces searched.
+func (s *Service) GetManyLocations(ctx context.Context, userID int64, count int, filter models.ParkingSpotFilter) ([]models.ParkingLocationWithSpaces, error) {
+	spots, err := s.GetMany(ctx, userID, count, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.ParkingLocationWithSpaces, 0, len(spots))
+	indices := make(map[uuid.UUID]int, len(spots))
+	for i := range spots {
+		spot := &spots[i]
+		index, ok := indices[spot.LocationID]
+		if !ok {
+			index = len(result)
+			indices[spot.LocationID] = index
+			result = append(result, models.ParkingLocationWithSpaces{
+				Location:           spot.Location,
+				Spaces:             make([]models.ParkingSpotWithDistance, 0, 1),
+				DistanceToLocation: spot.DistanceToLocation,
+				ID:                 spot.LocationID,
+			})
+		}
+		location := &result[index]
+		location.Spaces = append(location.Spaces, *spot)
+		location.AvailableSpaces++
+	}
+	return result, nil
+}
+
+// Set the coordinates of `filter` to the location of its address, if it has one
+func (s *Service) ResolveSearchCentre(ctx context.Context, filter *models.ParkingSpotFilter) error {
+	if filter.Address == "" {
+		return nil
+	}
+	gcr, err := s.geocoder.Search(ctx, filter.Address)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("could not resolve the searched location")
+		return models.ErrGeocodingUnavailable
+	}
+	if len(gcr) == 0 {
+		return models.ErrSearchLocationNotFound
+	}
+	filter.Latitude = gcr[0].Latitude
+	filter.Longitude = gcr[0].Longitude
+	return nil
+}
+
 func (s *Service) GetManyForUser(ctx context.Context, userID int64, count int) (spots []models.ParkingSpot, err error) {
 	if count <= 0 {
 		return []models.ParkingSpot{}, nil
@@ -302,7 +405,9 @@ func (s *Service) GetManyForUser(ctx context.Context, userID int64, count int) (
 	result := make([]models.ParkingSpot, 0, len(spotEntries))
 	for i := range spotEntries {
 		entry := &spotEntries[i]
-		result = append(result, entry.ParkingSpot)
+		spot := entry.ParkingSpot
+		spot.AccessInstructions = entry.AccessInstructions
+		result = append(result, spot)
 	}
 	return result, nil
 }
@@ -324,9 +429,46 @@ func validateCreationInput(input *models.ParkingSpotCreationInput) error {
 		return models.ErrNoAvailability
 	}
 
+	err = validateRestrictions(&input.Restrictions)
+	if err != nil {
+		return err
+	}
+
 	return validatePricePerHour(input.PricePerHour)
 }
 
+// Returns the size of the car `carID` of `userID`
+func (s *Service) carSize(ctx context.Context, userID int64, carID uuid.UUID) (carService.Size, error) {
+	carEntry, err := s.carRepo.GetByUUID(ctx, carID)
+	if err != nil {
+		if errors.Is(err, car.ErrNotFound) {
+			err = models.ErrCarNotFound
+		}
+		return carService.Size{}, err
+	}
+	if carEntry.OwnerID != userID {
+		return carService.Size{}, models.ErrCarNotFound
+	}
+	return carService.SizeOf(&carEntry.Details), nil
+}
+
+// Largest vehicle sizes a spot can be limited to, in centimetres
+const (
+	maxRestrictedHeight = 1000
+	maxRestrictedLength = 3000
+	maxRestrictedWidth  = 1000
+)
+
+// Validate vehicle restrictions static rules
+func validateRestrictions(restrictions *models.ParkingSpotRestrictions) error {
+	if restrictions.MaxHeight < 0 || restrictions.MaxHeight > maxRestrictedHeight ||
+		restrictions.MaxLength < 0 || restrictions.MaxLength > maxRestrictedLength ||
+		restrictions.MaxWidth < 0 || restrictions.MaxWidth > maxRestrictedWidth {
+		return models.ErrInvalidRestrictions
+	}
+	return nil
+}
+
 // Validate price per hour static rules
 func validatePricePerHour(pricePerHour float64) error {
 	if pricePerHour < 0 || math.IsNaN(pricePerHour) || math.IsInf(pricePerHour, 0) {
@@ -348,15 +490,14 @@ func validateSpotAvail(availability []models.TimeUnit) error {
 
 // Validate location input static rules
 func validateSpotLocation(location *models.ParkingSpotLocation) error {
-	// NOTE: We only support Canadian spots at the moment
-	if location.CountryCode != "CA" {
+	country := region.Lookup(location.CountryCode)
+	if country == nil {
 		return models.ErrCountryNotSupported
 	}
-	if !isValidProvinceCode(location.State) {
+	if _, ok := country.Subdivisions[location.State]; !ok {
 		return models.ErrProvinceNotSupported
 	}
-	canadianPostalCodeRegexp := regexp.MustCompile("^[A-Z][0-9][A-Z][0-9][A-Z][0-9]$")
-	if !canadianPostalCodeRegexp.MatchString(location.PostalCode) {
+	if !country.ValidPostalCode(location.PostalCode) {
 		return models.ErrInvalidPostalCode
 	}
 	if location.StreetAddress == "" {
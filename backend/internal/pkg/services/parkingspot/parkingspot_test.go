@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/car"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/geocoding"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
 	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/preferencespot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/spotphoto"
 	"github.com/aarondl/opt/omit"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -29,16 +32,58 @@ type mockPreferenceSpotRepo struct {
 	mock.Mock
 }
 
+type mockPhotoRepo struct {
+	mock.Mock
+}
+
+type mockPricingRepo struct {
+	mock.Mock
+}
+
+type mockCarRepo struct {
+	mock.Mock
+}
+
 const (
 	testOwnerID = int64(0)
 )
 
+// GetBySpotID implements pricing.Repository.
+func (m *mockPricingRepo) GetBySpotID(ctx context.Context, spotID int64) (pricing.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(pricing.Entry), args.Error(1)
+}
+
+// UpdateBySpotID implements pricing.Repository.
+func (m *mockPricingRepo) UpdateBySpotID(ctx context.Context, spotID int64, spotPricing *pricing.Entry) (pricing.Entry, error) {
+	args := m.Called(ctx, spotID, spotPricing)
+	return args.Get(0).(pricing.Entry), args.Error(1)
+}
+
 // Geocode implements geocoding.Geocoder.
 func (m *mockGeocodingRepo) Geocode(ctx context.Context, address *geocoding.Address) ([]geocoding.Result, error) {
 	args := m.Called(address)
 	return args.Get(0).([]geocoding.Result), args.Error(1)
 }
 
+// ReverseGeocode implements geocoding.Geocoder.
+func (m *mockGeocodingRepo) ReverseGeocode(ctx context.Context, latitude, longitude float64) ([]geocoding.Result, error) {
+	args := m.Called(latitude, longitude)
+	return args.Get(0).([]geocoding.Result), args.Error(1)
+}
+
+// Suggest implements geocoding.Geocoder.
+func (m *mockGeocodingRepo) Suggest(ctx context.Context, query string) ([]geocoding.Result, error) {
+	args := m.Called(query)
+	return args.Get(0).([]geocoding.Result), args.Error(1)
+}
+
+// Search implements geocoding.Geocoder.
+func (m *mockGeocodingRepo) Search(ctx context.Context, query string) ([]geocoding.Result, error) {
+	args := m.Called(query)
+	return args.Get(0).([]geocoding.Result), args.Error(1)
+}
+
 // Create implements parkingspot.Repository.
 func (m *mockRepo) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (parkingspot.Entry, []models.TimeUnit, error) {
 	args := m.Called(ctx, userID, spot)
@@ -104,6 +149,72 @@ func (m *mockPreferenceSpotRepo) GetBySpotID(ctx context.Context, userID, spotID
 	return args.Bool(0), args.Error(1)
 }
 
+// Create implements spotphoto.Repository.
+func (m *mockPhotoRepo) Create(ctx context.Context, entry *spotphoto.Entry, limit int) (spotphoto.Entry, error) {
+	args := m.Called(ctx, entry, limit)
+	return args.Get(0).(spotphoto.Entry), args.Error(1)
+}
+
+// GetBySpot implements spotphoto.Repository.
+func (m *mockPhotoRepo) GetBySpot(ctx context.Context, spotID int64) ([]spotphoto.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).([]spotphoto.Entry), args.Error(1)
+}
+
+// GetByUUID implements spotphoto.Repository.
+func (m *mockPhotoRepo) GetByUUID(ctx context.Context, spotID int64, photoID uuid.UUID) (spotphoto.Entry, error) {
+	args := m.Called(ctx, spotID, photoID)
+	return args.Get(0).(spotphoto.Entry), args.Error(1)
+}
+
+// Delete implements spotphoto.Repository.
+func (m *mockPhotoRepo) Delete(ctx context.Context, spotID int64, photoID uuid.UUID) (spotphoto.Entry, error) {
+	args := m.Called(ctx, spotID, photoID)
+	return args.Get(0).(spotphoto.Entry), args.Error(1)
+}
+
+// Reorder implements spotphoto.Repository.
+func (m *mockPhotoRepo) Reorder(ctx context.Context, spotID int64, order []uuid.UUID) ([]spotphoto.Entry, error) {
+	args := m.Called(ctx, spotID, order)
+	return args.Get(0).([]spotphoto.Entry), args.Error(1)
+}
+
+// Create implements car.Repository.
+func (m *mockCarRepo) Create(ctx context.Context, userID int64, carModel *models.CarCreationInput) (int64, car.Entry, error) {
+	args := m.Called(ctx, userID, carModel)
+	return args.Get(0).(int64), args.Get(1).(car.Entry), args.Error(2)
+}
+
+// GetMany implements car.Repository.
+func (m *mockCarRepo) GetMany(ctx context.Context, userID int64, limit int, after omit.Val[car.Cursor]) ([]car.Entry, error) {
+	args := m.Called(ctx, userID, limit, after)
+	return args.Get(0).([]car.Entry), args.Error(1)
+}
+
+// GetByUUID implements car.Repository.
+func (m *mockCarRepo) GetByUUID(ctx context.Context, carID uuid.UUID) (car.Entry, error) {
+	args := m.Called(ctx, carID)
+	return args.Get(0).(car.Entry), args.Error(1)
+}
+
+// GetOwnerByUUID implements car.Repository.
+func (m *mockCarRepo) GetOwnerByUUID(ctx context.Context, carID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, carID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// DeleteByUUID implements car.Repository.
+func (m *mockCarRepo) DeleteByUUID(ctx context.Context, carID uuid.UUID) error {
+	args := m.Called(ctx, carID)
+	return args.Error(0)
+}
+
+// UpdateByUUID implements car.Repository.
+func (m *mockCarRepo) UpdateByUUID(ctx context.Context, carID uuid.UUID, carModel *models.CarCreationInput) (car.Entry, error) {
+	args := m.Called(ctx, carID, carModel)
+	return args.Get(0).(car.Entry), args.Error(1)
+}
+
 const (
 	sampleLatitudeFloat  = float64(43.07923)
 	sampleLongitudeFloat = float64(-79.07887)
@@ -219,7 +330,7 @@ func TestCreate(t *testing.T) {
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 		input := &models.ParkingSpotCreationInput{
 			Location:     sampleLocation,
 			Availability: sampleAvailability,
@@ -250,7 +361,7 @@ func TestCreate(t *testing.T) {
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		input := &models.ParkingSpotCreationInput{
 			Location:     sampleLocation,
@@ -270,17 +381,17 @@ func TestCreate(t *testing.T) {
 		repo.AssertExpectations(t)
 	})
 
-	t.Run("only canadian addresses at the moment", func(t *testing.T) {
+	t.Run("only supported countries", func(t *testing.T) {
 		t.Parallel()
 
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		location := sampleLocation
-		location.CountryCode = "US"
+		location.CountryCode = "FR"
 		_, _, err := srv.Create(ctx, 0, &models.ParkingSpotCreationInput{
 			Location: location,
 		})
@@ -290,14 +401,14 @@ func TestCreate(t *testing.T) {
 		repo.AssertNotCalled(t, "Create")
 	})
 
-	t.Run("canadian postal code fit check", func(t *testing.T) {
+	t.Run("postal code fit check", func(t *testing.T) {
 		t.Parallel()
 
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		location := sampleLocation
 		location.PostalCode += " addon"
@@ -317,7 +428,7 @@ func TestCreate(t *testing.T) {
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		location := sampleLocation
 		location.StreetAddress = ""
@@ -330,14 +441,14 @@ func TestCreate(t *testing.T) {
 		repo.AssertNotCalled(t, "Create")
 	})
 
-	t.Run("only canadian province check", func(t *testing.T) {
+	t.Run("province check", func(t *testing.T) {
 		t.Parallel()
 
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		location := sampleLocation
 		location.State = "Test"
@@ -357,7 +468,7 @@ func TestCreate(t *testing.T) {
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		location := sampleLocation
 		availability := append([]models.TimeUnit(nil), sampleAvailability...)
@@ -379,7 +490,7 @@ func TestCreate(t *testing.T) {
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		_, _, err := srv.Create(ctx, 0, &models.ParkingSpotCreationInput{
 			Location:     sampleLocation,
@@ -399,7 +510,7 @@ func TestCreate(t *testing.T) {
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		_, _, err := srv.Create(ctx, 0, &models.ParkingSpotCreationInput{
 			Location:     sampleLocation,
@@ -419,7 +530,7 @@ func TestCreate(t *testing.T) {
 		geoRepo := new(mockGeocodingRepo)
 		geoRepo.AddGeocodeCall()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		location := sampleLocation
 		_, _, err := srv.Create(ctx, 0, &models.ParkingSpotCreationInput{
@@ -430,6 +541,52 @@ func TestCreate(t *testing.T) {
 		}
 		repo.AssertNotCalled(t, "Create")
 	})
+
+	t.Run("restrictions check", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		geoRepo := new(mockGeocodingRepo)
+		geoRepo.AddGeocodeCall()
+		preferenceRepo := new(mockPreferenceSpotRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
+
+		_, _, err := srv.Create(ctx, 0, &models.ParkingSpotCreationInput{
+			Location:     sampleLocation,
+			Availability: sampleAvailability,
+			PricePerHour: samplePricePerHour,
+			Restrictions: models.ParkingSpotRestrictions{MaxHeight: -1},
+		})
+		if assert.Error(t, err) {
+			assert.ErrorIs(t, err, models.ErrInvalidRestrictions)
+		}
+		repo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestValidateSpotLocation(t *testing.T) {
+	t.Parallel()
+
+	us := models.ParkingSpotLocation{
+		PostalCode:    "14303",
+		CountryCode:   "US",
+		State:         "NY",
+		City:          "Niagara Falls",
+		StreetAddress: "332 Prospect St",
+	}
+	require.NoError(t, validateSpotLocation(&us))
+
+	zipPlusFour := us
+	zipPlusFour.PostalCode = "14303-1234"
+	require.NoError(t, validateSpotLocation(&zipPlusFour))
+
+	canadianPostalCode := us
+	canadianPostalCode.PostalCode = sampleLocation.PostalCode
+	require.ErrorIs(t, validateSpotLocation(&canadianPostalCode), models.ErrInvalidPostalCode)
+
+	province := us
+	province.State = sampleLocation.State
+	require.ErrorIs(t, validateSpotLocation(&province), models.ErrProvinceNotSupported)
 }
 
 func TestGetByUUID(t *testing.T) {
@@ -445,7 +602,7 @@ func TestGetByUUID(t *testing.T) {
 			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).Once()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		_, err := srv.GetByUUID(ctx, testOwnerID, uuid.Nil)
 		if assert.Error(t, err) {
@@ -462,19 +619,47 @@ func TestGetByUUID(t *testing.T) {
 			Return(sampleEntry, nil).Once()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		photoRepo := new(mockPhotoRepo)
+		photoID := uuid.New()
+		photoRepo.On("GetBySpot", mock.Anything, testInternalID).
+			Return([]spotphoto.Entry{{ID: photoID, SpotID: testInternalID, Width: 640, Height: 480}}, nil).Once()
+		srv := New(repo, geoRepo, preferenceRepo, nil, photoRepo, nil, nil)
 
 		output := models.ParkingSpot{
 			Location:     sampleEntry.Location,
 			Features:     sampleEntry.Features,
 			PricePerHour: sampleEntry.PricePerHour,
 			ID:           sampleEntry.ID,
+			Photos:       []models.ParkingSpotPhoto{{ID: photoID, Width: 640, Height: 480}},
 		}
 
 		spot, err := srv.GetByUUID(ctx, testOwnerID, testSpotID)
 		require.NoError(t, err)
 		assert.Equal(t, output, spot)
 		repo.AssertExpectations(t)
+		photoRepo.AssertExpectations(t)
+	})
+
+	t.Run("access instructions only for owner", func(t *testing.T) {
+		t.Parallel()
+
+		entry := sampleEntry
+		entry.AccessInstructions = "Gate code 1234"
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, testSpotID, mock.Anything, mock.Anything).
+			Return(entry, nil)
+		photoRepo := new(mockPhotoRepo)
+		photoRepo.On("GetBySpot", mock.Anything, testInternalID).
+			Return([]spotphoto.Entry{}, nil)
+		srv := New(repo, nil, nil, nil, photoRepo, nil, nil)
+
+		spot, err := srv.GetByUUID(ctx, testUserID, testSpotID)
+		require.NoError(t, err)
+		assert.Equal(t, entry.AccessInstructions, spot.AccessInstructions)
+
+		spot, err = srv.GetByUUID(ctx, testUserID+1, testSpotID)
+		require.NoError(t, err)
+		assert.Empty(t, spot.AccessInstructions)
 	})
 }
 
@@ -490,7 +675,7 @@ func TestUpdateSpotByUUID(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		input := &models.ParkingSpotUpdateInput{
 			PricePerHour: sampleUpdatePricePerHour,
@@ -512,7 +697,7 @@ func TestUpdateSpotByUUID(t *testing.T) {
 		repo.AddGetNotFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		input := &models.ParkingSpotUpdateInput{
 			PricePerHour: samplePricePerHour,
@@ -534,7 +719,7 @@ func TestUpdateSpotByUUID(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		input := &models.ParkingSpotUpdateInput{
 			PricePerHour: -0.01,
@@ -560,7 +745,7 @@ func TestUpdateAvailByUUID(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		input := &models.ParkingSpotAvailUpdateInput{
 			AddAvailability:    sampleAvailability,
@@ -582,7 +767,7 @@ func TestUpdateAvailByUUID(t *testing.T) {
 		repo.AddGetNotFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		input := &models.ParkingSpotAvailUpdateInput{
 			AddAvailability:    sampleAvailability,
@@ -604,7 +789,7 @@ func TestUpdateAvailByUUID(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		input := &models.ParkingSpotAvailUpdateInput{
 			AddAvailability:    sampleAvailability,
@@ -629,7 +814,7 @@ func TestUpdateAvailByUUID(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		input := &models.ParkingSpotAvailUpdateInput{
 			AddAvailability:    sampleAvailability,
@@ -654,7 +839,7 @@ func TestUpdateAvailByUUID(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		invalidAvailability := make([]models.TimeUnit, len(sampleAvailability))
 		copy(invalidAvailability, sampleAvailability)
@@ -678,7 +863,7 @@ func TestUpdateAvailByUUID(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		invalidAvailability := make([]models.TimeUnit, len(sampleAvailability))
 		copy(invalidAvailability, sampleAvailability)
@@ -709,7 +894,7 @@ func TestGetAvailByUUID(t *testing.T) {
 			Return(sampleAvailability, nil).Once()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		_, err := srv.GetAvailByUUID(ctx, testSpotID, sampleAvailability[0].StartTime, sampleAvailability[1].EndTime)
 		require.NoError(t, err)
@@ -724,7 +909,7 @@ func TestGetAvailByUUID(t *testing.T) {
 			Return(sampleAvailability, nil).Once()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		_, err := srv.GetAvailByUUID(ctx, testSpotID, sampleAvailability[0].StartTime, time.Time{})
 		require.NoError(t, err)
@@ -739,7 +924,7 @@ func TestGetAvailByUUID(t *testing.T) {
 			Return([]models.TimeUnit{}, parkingspot.ErrNotFound).Once()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		_, err := srv.GetAvailByUUID(ctx, uuid.Nil, time.Now(), time.Now())
 		if assert.Error(t, err) {
@@ -760,7 +945,7 @@ func TestGetManyForUser(t *testing.T) {
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		result, err := srv.GetManyForUser(ctx, testOwnerID, 0)
 		assert.Empty(t, result)
@@ -776,7 +961,7 @@ func TestGetManyForUser(t *testing.T) {
 		repo.On("GetMany", 1, mock.Anything).
 			Return(sampleGetManyEntryOutput, nil).Once()
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		result, err := srv.GetManyForUser(ctx, testOwnerID, 1)
 		expectedOutput := []models.ParkingSpot{
@@ -807,7 +992,7 @@ func TestGetMany(t *testing.T) {
 			Return(sampleGetManyEntryOutput, nil).Once()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		filter := models.ParkingSpotFilter{
 			ParkingSpotAvailabilityFilter: models.ParkingSpotAvailabilityFilter{
@@ -836,13 +1021,95 @@ func TestGetMany(t *testing.T) {
 		repo.AssertExpectations(t)
 	})
 
+	t.Run("sort by rating", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetMany", 1, mock.MatchedBy(func(filter *parkingspot.Filter) bool {
+			return filter.Sort == parkingspot.SortRating
+		})).
+			Return(sampleGetManyEntryOutput, nil).Once()
+		srv := New(repo, nil, nil, nil, nil, nil, nil)
+
+		filter := models.ParkingSpotFilter{
+			Sort:      models.SpotSortRating,
+			Latitude:  5,
+			Longitude: 5,
+		}
+
+		_, err := srv.GetMany(ctx, testOwnerID, 1, filter)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("filter by vehicle and accessibility", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetMany", 1, mock.MatchedBy(func(filter *parkingspot.Filter) bool {
+			vehicle, ok := filter.Vehicle.Get()
+			return ok && vehicle == parkingspot.FilterVehicle{Height: 200, Truck: true} &&
+				filter.Accessibility == models.ParkingSpotAccessibility{StepFree: true}
+		})).
+			Return(sampleGetManyEntryOutput, nil).Once()
+		srv := New(repo, nil, nil, nil, nil, nil, nil)
+
+		filter := models.ParkingSpotFilter{
+			Latitude:      5,
+			Longitude:     5,
+			VehicleHeight: 200,
+			Truck:         true,
+			StepFree:      true,
+		}
+
+		_, err := srv.GetMany(ctx, testOwnerID, 1, filter)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("filter by car", func(t *testing.T) {
+		t.Parallel()
+
+		carID := uuid.New()
+		carRepo := new(mockCarRepo)
+		carRepo.On("GetByUUID", mock.Anything, carID).
+			Return(car.Entry{
+				Car: models.Car{
+					Details: models.CarDetails{Class: models.VehicleClassVan, Height: 250},
+					ID:      carID,
+				},
+				OwnerID: testUserID,
+			}, nil)
+		repo := new(mockRepo)
+		repo.On("GetMany", 1, mock.MatchedBy(func(filter *parkingspot.Filter) bool {
+			vehicle, ok := filter.Vehicle.Get()
+			return ok && vehicle == parkingspot.FilterVehicle{Height: 250, Length: 520, Width: 200}
+		})).
+			Return(sampleGetManyEntryOutput, nil).Once()
+		srv := New(repo, nil, nil, nil, nil, carRepo, nil)
+
+		filter := models.ParkingSpotFilter{
+			Latitude:  5,
+			Longitude: 5,
+			CarID:     carID,
+		}
+
+		_, err := srv.GetMany(ctx, testUserID, 1, filter)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+
+		// Cars of other users are not found
+		_, err = srv.GetMany(ctx, testUserID+1, 1, filter)
+		require.ErrorIs(t, err, models.ErrCarNotFound)
+	})
+
 	t.Run("get many empty", func(t *testing.T) {
 		t.Parallel()
 
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		result, err := srv.GetMany(ctx, testOwnerID, 0, models.ParkingSpotFilter{})
 		assert.Empty(t, result)
@@ -868,7 +1135,7 @@ func TestGetMany(t *testing.T) {
 			Once()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		filter := models.ParkingSpotFilter{
 			ParkingSpotAvailabilityFilter: models.ParkingSpotAvailabilityFilter{
@@ -889,7 +1156,7 @@ func TestGetMany(t *testing.T) {
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		result, err := srv.GetMany(ctx, testOwnerID, 0, models.ParkingSpotFilter{
 			Latitude: math.NaN(),
@@ -905,7 +1172,7 @@ func TestGetMany(t *testing.T) {
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		result, err := srv.GetMany(ctx, testOwnerID, 0, models.ParkingSpotFilter{
 			Longitude: math.Inf(1),
@@ -916,6 +1183,80 @@ func TestGetMany(t *testing.T) {
 	})
 }
 
+func TestGetManyLocations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	locationID := uuid.New()
+	otherLocationID := uuid.New()
+	space := func(label string, location uuid.UUID, distance float64) parkingspot.GetManyEntry {
+		entry := sampleEntry
+		entry.ID = uuid.New()
+		entry.Label = label
+		entry.LocationID = location
+		return parkingspot.GetManyEntry{Entry: entry, DistanceToLocation: distance}
+	}
+	entries := []parkingspot.GetManyEntry{
+		space("Left", locationID, 10),
+		space("", otherLocationID, 20),
+		space("Right", locationID, 10),
+	}
+
+	repo := new(mockRepo)
+	repo.On("GetMany", 3, mock.Anything).
+		Return(entries, nil).Once()
+	srv := New(repo, nil, nil, nil, nil, nil, nil)
+
+	result, err := srv.GetManyLocations(ctx, testOwnerID, 3, models.ParkingSpotFilter{Latitude: 5, Longitude: 5})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	assert.Equal(t, locationID, result[0].ID)
+	assert.Equal(t, 2, result[0].AvailableSpaces)
+	assert.InDelta(t, 10, result[0].DistanceToLocation, 0)
+	assert.Equal(t, sampleLocation, result[0].Location)
+	if assert.Len(t, result[0].Spaces, 2) {
+		assert.Equal(t, entries[0].ID, result[0].Spaces[0].ID)
+		assert.Equal(t, entries[2].ID, result[0].Spaces[1].ID)
+	}
+
+	assert.Equal(t, otherLocationID, result[1].ID)
+	assert.Equal(t, 1, result[1].AvailableSpaces)
+	repo.AssertExpectations(t)
+}
+
+func TestResolveSearchCentre(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	geoRepo := new(mockGeocodingRepo)
+	geoRepo.On("Search", "R3T 2N2").
+		Return(sampleGeocoderResult, nil).
+		On("Search", "nowhere").
+		Return([]geocoding.Result{}, nil).
+		On("Search", "down").
+		Return([]geocoding.Result(nil), geocoding.ErrCircuitOpen)
+	srv := New(nil, geoRepo, nil, nil, nil, nil, nil)
+
+	filter := models.ParkingSpotFilter{Address: "R3T 2N2"}
+	err := srv.ResolveSearchCentre(ctx, &filter)
+	require.NoError(t, err)
+	assert.InEpsilon(t, sampleGeocoderResult[0].Latitude, filter.Latitude, 1e-9)
+	assert.InEpsilon(t, sampleGeocoderResult[0].Longitude, filter.Longitude, 1e-9)
+
+	// Coordinates are kept without an address
+	filter = models.ParkingSpotFilter{Latitude: 1, Longitude: 2}
+	err = srv.ResolveSearchCentre(ctx, &filter)
+	require.NoError(t, err)
+	assert.Equal(t, models.ParkingSpotFilter{Latitude: 1, Longitude: 2}, filter)
+
+	err = srv.ResolveSearchCentre(ctx, &models.ParkingSpotFilter{Address: "nowhere"})
+	require.ErrorIs(t, err, models.ErrSearchLocationNotFound)
+
+	err = srv.ResolveSearchCentre(ctx, &models.ParkingSpotFilter{Address: "down"})
+	require.ErrorIs(t, err, models.ErrGeocodingUnavailable)
+}
+
 func TestCreatePreference(t *testing.T) {
 	t.Parallel()
 
@@ -929,7 +1270,7 @@ func TestCreatePreference(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		preferenceRepo.On("Create", mock.Anything, testUserID, testInternalID).
 			Return(
@@ -948,7 +1289,7 @@ func TestCreatePreference(t *testing.T) {
 		repo.AddGetNotFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		err := srv.CreatePreference(ctx, testUserID, uuid.Nil)
 		if assert.Error(t, err) {
@@ -971,7 +1312,7 @@ func TestGetBySpotID(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		preferenceRepo.On("GetBySpotID", mock.Anything, testUserID, testInternalID).
 			Return(
@@ -997,7 +1338,7 @@ func TestGetManyPreferences(t *testing.T) {
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 		preferenceRepo.On("GetMany", mock.Anything, testUserID, 3, omit.Val[preferencespot.Cursor]{}).
 			Return([]preferencespot.Entry{{
 				ParkingSpot: sampleEntry.ParkingSpot,
@@ -1079,7 +1420,7 @@ func TestGetManyPreferences(t *testing.T) {
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 		preferenceRepo.On("GetMany", mock.Anything, testUserID, 3, omit.Val[preferencespot.Cursor]{}).
 			Return(sampleEntries, nil).
 			Once()
@@ -1113,7 +1454,7 @@ func TestGetManyPreferences(t *testing.T) {
 		repo := new(mockRepo)
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 		preferenceRepo.On("GetMany", mock.Anything, testUserID, 3, omit.Val[preferencespot.Cursor]{}).
 			Return(sampleEntries, nil).
 			Once()
@@ -1142,7 +1483,7 @@ func TestDelete(t *testing.T) {
 		repo.AddGetFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		preferenceRepo.On("Delete", mock.Anything, testUserID, testInternalID).
 			Return(nil)
@@ -1158,7 +1499,7 @@ func TestDelete(t *testing.T) {
 		repo.AddGetNotFoundCall()
 		geoRepo := new(mockGeocodingRepo)
 		preferenceRepo := new(mockPreferenceSpotRepo)
-		srv := New(repo, geoRepo, preferenceRepo)
+		srv := New(repo, geoRepo, preferenceRepo, nil, nil, nil, nil)
 
 		err := srv.DeletePreference(ctx, testUserID, uuid.Nil)
 		if assert.Error(t, err) {
@@ -0,0 +1,168 @@
+package parkingspot
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/timeofday"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+)
+
+// Largest number of pricing rules per spot
+const MaximumPricingRules = 100
+
+// Get the pricing of the spot with `spotID`.
+func (s *Service) GetPricingByUUID(ctx context.Context, spotID uuid.UUID) (models.ParkingSpotPricing, error) {
+	spot, err := s.repo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return models.ParkingSpotPricing{}, err
+	}
+
+	result, err := s.pricingRepo.GetBySpotID(ctx, spot.InternalID)
+	if err != nil {
+		return models.ParkingSpotPricing{}, err
+	}
+
+	return pricingFromEntry(&result), nil
+}
+
+// Replace the pricing of the spot with `spotID` owned by `userID`.
+func (s *Service) UpdatePricingByUUID(ctx context.Context, userID int64, spotID uuid.UUID, input *models.ParkingSpotPricing) (models.ParkingSpotPricing, error) {
+	spot, err := s.repo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return models.ParkingSpotPricing{}, err
+	}
+	if spot.OwnerID != userID {
+		// Yields not found to prevent leaking existence information
+		return models.ParkingSpotPricing{}, models.ErrParkingSpotNotFound
+	}
+
+	entry, err := pricingToEntry(input)
+	if err != nil {
+		return models.ParkingSpotPricing{}, err
+	}
+
+	result, err := s.pricingRepo.UpdateBySpotID(ctx, spot.InternalID, &entry)
+	if err != nil {
+		if errors.Is(err, pricing.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return models.ParkingSpotPricing{}, err
+	}
+
+	return pricingFromEntry(&result), nil
+}
+
+// Validate and convert `input` into its repository representation
+func pricingToEntry(input *models.ParkingSpotPricing) (pricing.Entry, error) {
+	if len(input.Rules) > MaximumPricingRules {
+		return pricing.Entry{}, models.ErrTooManyPricingRules
+	}
+	if !isValidAmount(input.MinimumCharge) {
+		return pricing.Entry{}, models.ErrInvalidMinimumCharge
+	}
+	if !isValidAmount(input.DailyMaximum) {
+		return pricing.Entry{}, models.ErrInvalidDailyMaximum
+	}
+
+	result := pricing.Entry{
+		Rules:         make([]pricing.Rule, 0, len(input.Rules)),
+		MinimumCharge: input.MinimumCharge,
+		DailyMaximum:  input.DailyMaximum,
+	}
+	for idx := range input.Rules {
+		rule, err := ruleToEntry(&input.Rules[idx])
+		if err != nil {
+			return pricing.Entry{}, err
+		}
+		result.Rules = append(result.Rules, rule)
+	}
+	return result, nil
+}
+
+func ruleToEntry(input *models.PricingRule) (pricing.Rule, error) {
+	if !isValidAmount(input.PricePerHour) {
+		return pricing.Rule{}, models.ErrInvalidPricingRule
+	}
+
+	result := pricing.Rule{
+		Weekdays:     timeofday.AllWeekdays,
+		StartMinute:  0,
+		EndMinute:    timeofday.MinutesPerDay,
+		PricePerHour: input.PricePerHour,
+	}
+
+	if input.Date != "" {
+		date, err := time.Parse(time.DateOnly, input.Date)
+		if err != nil {
+			return pricing.Rule{}, models.ErrInvalidPricingDate
+		}
+		result.Date = omit.From(date)
+	}
+
+	if len(input.Weekdays) > 0 {
+		weekdays, ok := timeofday.ParseWeekdays(input.Weekdays)
+		if !ok {
+			return pricing.Rule{}, models.ErrInvalidPricingRule
+		}
+		result.Weekdays = weekdays
+	}
+
+	if input.StartTime != "" {
+		minute, ok := timeofday.ParseMinute(input.StartTime)
+		if !ok {
+			return pricing.Rule{}, models.ErrInvalidPricingTime
+		}
+		result.StartMinute = minute
+	}
+	if input.EndTime != "" {
+		minute, ok := timeofday.ParseMinute(input.EndTime)
+		if !ok {
+			return pricing.Rule{}, models.ErrInvalidPricingTime
+		}
+		result.EndMinute = minute
+	}
+	if result.StartMinute >= result.EndMinute {
+		return pricing.Rule{}, models.ErrInvalidPricingTime
+	}
+
+	return result, nil
+}
+
+func pricingFromEntry(entry *pricing.Entry) models.ParkingSpotPricing {
+	result := models.ParkingSpotPricing{
+		Rules:         make([]models.PricingRule, 0, len(entry.Rules)),
+		MinimumCharge: entry.MinimumCharge,
+		DailyMaximum:  entry.DailyMaximum,
+	}
+	for idx := range entry.Rules {
+		rule := &entry.Rules[idx]
+		out := models.PricingRule{
+			StartTime:    timeofday.FormatMinute(rule.StartMinute),
+			EndTime:      timeofday.FormatMinute(rule.EndMinute),
+			PricePerHour: rule.PricePerHour,
+		}
+		if date, ok := rule.Date.Get(); ok {
+			out.Date = date.Format(time.DateOnly)
+		}
+		out.Weekdays = timeofday.FormatWeekdays(rule.Weekdays)
+		result.Rules = append(result.Rules, out)
+	}
+	return result
+}
+
+func isValidAmount(amount float64) bool {
+	return amount >= 0 && !math.IsNaN(amount) && !math.IsInf(amount, 0)
+}
@@ -0,0 +1,253 @@
+package parkingspot
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/pricing"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/timeofday"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	samplePricing = models.ParkingSpotPricing{
+		Rules: []models.PricingRule{
+			{
+				Date:         "2024-12-25",
+				StartTime:    "00:00",
+				EndTime:      "24:00",
+				PricePerHour: 2,
+			},
+			{
+				StartTime:    "09:00",
+				EndTime:      "17:30",
+				Weekdays:     []string{"monday", "friday"},
+				PricePerHour: 15,
+			},
+		},
+		MinimumCharge: 5,
+		DailyMaximum:  60,
+	}
+
+	samplePricingEntry = pricing.Entry{
+		Rules: []pricing.Rule{
+			{
+				Date:         omit.From(time.Date(2024, time.December, 25, 0, 0, 0, 0, time.UTC)),
+				Weekdays:     timeofday.AllWeekdays,
+				StartMinute:  0,
+				EndMinute:    timeofday.MinutesPerDay,
+				PricePerHour: 2,
+			},
+			{
+				Weekdays:     1<<time.Monday | 1<<time.Friday,
+				StartMinute:  9 * 60,
+				EndMinute:    17*60 + 30,
+				PricePerHour: 15,
+			},
+		},
+		MinimumCharge: 5,
+		DailyMaximum:  60,
+	}
+)
+
+func TestGetPricingByUUID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("get pricing okay", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, testSpotID).
+			Return(sampleEntry, nil).Once()
+		pricingRepo := new(mockPricingRepo)
+		pricingRepo.On("GetBySpotID", mock.Anything, testInternalID).
+			Return(samplePricingEntry, nil).Once()
+		srv := New(repo, nil, nil, pricingRepo, nil, nil, nil)
+
+		result, err := srv.GetPricingByUUID(ctx, testSpotID)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(samplePricing, result))
+		repo.AssertExpectations(t)
+		pricingRepo.AssertExpectations(t)
+	})
+
+	t.Run("no pricing set", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, testSpotID).
+			Return(sampleEntry, nil).Once()
+		pricingRepo := new(mockPricingRepo)
+		pricingRepo.On("GetBySpotID", mock.Anything, testInternalID).
+			Return(pricing.Entry{}, nil).Once()
+		srv := New(repo, nil, nil, pricingRepo, nil, nil, nil)
+
+		result, err := srv.GetPricingByUUID(ctx, testSpotID)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(models.ParkingSpotPricing{Rules: []models.PricingRule{}}, result))
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, testSpotID).
+			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).Once()
+		pricingRepo := new(mockPricingRepo)
+		srv := New(repo, nil, nil, pricingRepo, nil, nil, nil)
+
+		_, err := srv.GetPricingByUUID(ctx, testSpotID)
+		assert.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		pricingRepo.AssertNotCalled(t, "GetBySpotID")
+	})
+}
+
+func TestUpdatePricingByUUID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("update pricing okay", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, testSpotID).
+			Return(sampleEntry, nil).Once()
+		pricingRepo := new(mockPricingRepo)
+		pricingRepo.On("UpdateBySpotID", mock.Anything, testInternalID, &samplePricingEntry).
+			Return(samplePricingEntry, nil).Once()
+		srv := New(repo, nil, nil, pricingRepo, nil, nil, nil)
+
+		input := samplePricing
+		// Times can be omitted
+		input.Rules = []models.PricingRule{
+			{Date: "2024-12-25", PricePerHour: 2},
+			samplePricing.Rules[1],
+		}
+		result, err := srv.UpdatePricingByUUID(ctx, testUserID, testSpotID, &input)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(samplePricing, result))
+		repo.AssertExpectations(t)
+		pricingRepo.AssertExpectations(t)
+	})
+
+	t.Run("spot not owned", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, testSpotID).
+			Return(sampleEntry, nil).Once()
+		pricingRepo := new(mockPricingRepo)
+		srv := New(repo, nil, nil, pricingRepo, nil, nil, nil)
+
+		_, err := srv.UpdatePricingByUUID(ctx, testUserID+1, testSpotID, &samplePricing)
+		assert.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		pricingRepo.AssertNotCalled(t, "UpdateBySpotID")
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetByUUID", mock.Anything, uuid.Nil).
+			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).Once()
+		pricingRepo := new(mockPricingRepo)
+		srv := New(repo, nil, nil, pricingRepo, nil, nil, nil)
+
+		_, err := srv.UpdatePricingByUUID(ctx, testUserID, uuid.Nil, &samplePricing)
+		assert.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		pricingRepo.AssertNotCalled(t, "UpdateBySpotID")
+	})
+
+	t.Run("invalid pricing", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			err   error
+			name  string
+			input models.ParkingSpotPricing
+		}{
+			{
+				name:  "negative minimum charge",
+				input: models.ParkingSpotPricing{MinimumCharge: -1},
+				err:   models.ErrInvalidMinimumCharge,
+			},
+			{
+				name:  "infinite daily maximum",
+				input: models.ParkingSpotPricing{DailyMaximum: math.Inf(1)},
+				err:   models.ErrInvalidDailyMaximum,
+			},
+			{
+				name: "negative price",
+				input: models.ParkingSpotPricing{
+					Rules: []models.PricingRule{{PricePerHour: -1}},
+				},
+				err: models.ErrInvalidPricingRule,
+			},
+			{
+				name: "invalid date",
+				input: models.ParkingSpotPricing{
+					Rules: []models.PricingRule{{Date: "2024-02-30", PricePerHour: 1}},
+				},
+				err: models.ErrInvalidPricingDate,
+			},
+			{
+				name: "invalid weekday",
+				input: models.ParkingSpotPricing{
+					Rules: []models.PricingRule{{Weekdays: []string{"someday"}, PricePerHour: 1}},
+				},
+				err: models.ErrInvalidPricingRule,
+			},
+			{
+				name: "start after end",
+				input: models.ParkingSpotPricing{
+					Rules: []models.PricingRule{{StartTime: "18:00", EndTime: "09:00", PricePerHour: 1}},
+				},
+				err: models.ErrInvalidPricingTime,
+			},
+			{
+				name: "invalid time",
+				input: models.ParkingSpotPricing{
+					Rules: []models.PricingRule{{StartTime: "24:30", PricePerHour: 1}},
+				},
+				err: models.ErrInvalidPricingTime,
+			},
+			{
+				name: "too many rules",
+				input: models.ParkingSpotPricing{
+					Rules: make([]models.PricingRule, MaximumPricingRules+1),
+				},
+				err: models.ErrTooManyPricingRules,
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				t.Parallel()
+
+				repo := new(mockRepo)
+				repo.On("GetByUUID", mock.Anything, testSpotID).
+					Return(sampleEntry, nil).Once()
+				pricingRepo := new(mockPricingRepo)
+				srv := New(repo, nil, nil, pricingRepo, nil, nil, nil)
+
+				_, err := srv.UpdatePricingByUUID(ctx, testUserID, testSpotID, &test.input)
+				assert.ErrorIs(t, err, test.err)
+				pricingRepo.AssertNotCalled(t, "UpdateBySpotID")
+			})
+		}
+	})
+}
@@ -0,0 +1,252 @@
+package photo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/media"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/blob"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/spotphoto"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Largest number of photos of a parking spot
+const MaxPhotos = 10
+
+// Largest accepted photo upload in bytes
+const MaxUploadSize = media.MaxSize
+
+type Service struct {
+	repo     spotphoto.Repository
+	spotRepo parkingspot.Repository
+	store    blob.BlobStore
+}
+
+// Create a new photo service keeping images in `store`.
+func New(repo spotphoto.Repository, spotRepo parkingspot.Repository, store blob.BlobStore) *Service {
+	return &Service{
+		repo:     repo,
+		spotRepo: spotRepo,
+		store:    store,
+	}
+}
+
+// Add the image `data` after the other photos of `spotID`, if `userID` owns the spot.
+//
+// The image is stored without its metadata, along with a thumbnail.
+func (s *Service) Create(ctx context.Context, userID int64, spotID uuid.UUID, data []byte) (models.ParkingSpotPhoto, error) {
+	spot, err := s.getOwnedSpot(ctx, userID, spotID)
+	if err != nil {
+		return models.ParkingSpotPhoto{}, err
+	}
+
+	// Avoid processing the image if it cannot be added anyway
+	photos, err := s.repo.GetBySpot(ctx, spot.InternalID)
+	if err != nil {
+		return models.ParkingSpotPhoto{}, err
+	}
+	if len(photos) >= MaxPhotos {
+		return models.ParkingSpotPhoto{}, models.ErrTooManyPhotos
+	}
+
+	img, err := media.Process(data)
+	if err != nil {
+		switch {
+		case errors.Is(err, media.ErrUnsupportedType):
+			err = models.ErrPhotoUnsupported
+		case errors.Is(err, media.ErrInvalidImage):
+			err = models.ErrPhotoUnreadable
+		case errors.Is(err, media.ErrTooLarge):
+			err = models.ErrPhotoTooLarge
+		}
+		return models.ParkingSpotPhoto{}, err
+	}
+
+	entry := spotphoto.Entry{
+		ContentType: img.ContentType,
+		SpotID:      spot.InternalID,
+		Width:       img.Width,
+		Height:      img.Height,
+	}
+	entry.ImageKey, entry.ThumbnailKey = blobKeys(spotID, img.ContentType)
+	err = s.store.Put(ctx, entry.ImageKey, img.Data, img.ContentType)
+	if err != nil {
+		return models.ParkingSpotPhoto{}, err
+	}
+	err = s.store.Put(ctx, entry.ThumbnailKey, img.Thumbnail, img.ContentType)
+	if err != nil {
+		s.deleteBlobs(ctx, &entry)
+		return models.ParkingSpotPhoto{}, err
+	}
+
+	result, err := s.repo.Create(ctx, &entry, MaxPhotos)
+	if err != nil {
+		s.deleteBlobs(ctx, &entry)
+		if errors.Is(err, spotphoto.ErrLimitReached) {
+			err = models.ErrTooManyPhotos
+		}
+		return models.ParkingSpotPhoto{}, err
+	}
+	return FromEntry(&result), nil
+}
+
+// Get the photos of `spotID` in display order.
+func (s *Service) GetMany(ctx context.Context, spotID uuid.UUID) ([]models.ParkingSpotPhoto, error) {
+	spot, err := s.getSpot(ctx, spotID)
+	if err != nil {
+		return nil, err
+	}
+	photos, err := s.repo.GetBySpot(ctx, spot.InternalID)
+	if err != nil {
+		return nil, err
+	}
+	return FromEntries(photos), nil
+}
+
+// Get the full size image of the photo `photoID` of `spotID`, and its content type.
+func (s *Service) GetImage(ctx context.Context, spotID, photoID uuid.UUID) ([]byte, string, error) {
+	entry, err := s.getPhoto(ctx, spotID, photoID)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := s.getBlob(ctx, entry.ImageKey)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, entry.ContentType, nil
+}
+
+// Get the thumbnail of the photo `photoID` of `spotID`, and its content type.
+func (s *Service) GetThumbnail(ctx context.Context, spotID, photoID uuid.UUID) ([]byte, string, error) {
+	entry, err := s.getPhoto(ctx, spotID, photoID)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := s.getBlob(ctx, entry.ThumbnailKey)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, entry.ContentType, nil
+}
+
+// Show the photos of `spotID` in the order of `input`, if `userID` owns the spot.
+func (s *Service) Reorder(ctx context.Context, userID int64, spotID uuid.UUID, input *models.ParkingSpotPhotoOrderInput) ([]models.ParkingSpotPhoto, error) {
+	spot, err := s.getOwnedSpot(ctx, userID, spotID)
+	if err != nil {
+		return nil, err
+	}
+	photos, err := s.repo.Reorder(ctx, spot.InternalID, input.Photos)
+	if err != nil {
+		if errors.Is(err, spotphoto.ErrOrderMismatch) {
+			err = models.ErrInvalidPhotoOrder
+		}
+		return nil, err
+	}
+	return FromEntries(photos), nil
+}
+
+// Delete the photo `photoID` of `spotID` along with its images, if `userID` owns the spot.
+func (s *Service) Delete(ctx context.Context, userID int64, spotID, photoID uuid.UUID) error {
+	spot, err := s.getOwnedSpot(ctx, userID, spotID)
+	if err != nil {
+		return err
+	}
+	entry, err := s.repo.Delete(ctx, spot.InternalID, photoID)
+	if err != nil {
+		if errors.Is(err, spotphoto.ErrNotFound) {
+			err = models.ErrPhotoNotFound
+		}
+		return err
+	}
+	s.deleteBlobs(ctx, &entry)
+	return nil
+}
+
+// Converts a photo entry to its model
+func FromEntry(entry *spotphoto.Entry) models.ParkingSpotPhoto {
+	return models.ParkingSpotPhoto{
+		Width:  entry.Width,
+		Height: entry.Height,
+		ID:     entry.ID,
+	}
+}
+
+// Converts photo entries to their models
+func FromEntries(entries []spotphoto.Entry) []models.ParkingSpotPhoto {
+	result := make([]models.ParkingSpotPhoto, 0, len(entries))
+	for i := range entries {
+		result = append(result, FromEntry(&entries[i]))
+	}
+	return result
+}
+
+// Returns new keys for the image and the thumbnail of a photo of `spotID`
+func blobKeys(spotID uuid.UUID, contentType string) (string, string) {
+	extension := ".png"
+	if contentType == "image/jpeg" {
+		extension = ".jpg"
+	}
+	prefix := "spots/" + spotID.String() + "/" + uuid.NewString()
+	return prefix + extension, prefix + "-thumbnail" + extension
+}
+
+// Delete the images of `entry`, failures only leave unused objects behind
+func (s *Service) deleteBlobs(ctx context.Context, entry *spotphoto.Entry) {
+	for _, key := range []string{entry.ImageKey, entry.ThumbnailKey} {
+		err := s.store.Delete(ctx, key)
+		if err != nil {
+			log.Ctx(ctx).Err(err).Str("key", key).Msg("could not delete photo image")
+		}
+	}
+}
+
+func (s *Service) getBlob(ctx context.Context, key string) ([]byte, error) {
+	data, err := s.store.Get(ctx, key)
+	if errors.Is(err, blob.ErrNotFound) {
+		err = models.ErrPhotoNotFound
+	}
+	return data, err
+}
+
+// Get the photo `photoID` if it is a photo of `spotID`
+func (s *Service) getPhoto(ctx context.Context, spotID, photoID uuid.UUID) (spotphoto.Entry, error) {
+	spot, err := s.getSpot(ctx, spotID)
+	if err != nil {
+		return spotphoto.Entry{}, err
+	}
+	entry, err := s.repo.GetByUUID(ctx, spot.InternalID, photoID)
+	if err != nil {
+		if errors.Is(err, spotphoto.ErrNotFound) {
+			err = models.ErrPhotoNotFound
+		}
+		return spotphoto.Entry{}, err
+	}
+	return entry, nil
+}
+
+func (s *Service) getSpot(ctx context.Context, spotID uuid.UUID) (parkingspot.Entry, error) {
+	spot, err := s.spotRepo.GetByUUID(ctx, spotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			err = models.ErrParkingSpotNotFound
+		}
+		return parkingspot.Entry{}, err
+	}
+	return spot, nil
+}
+
+// Get `spotID` if `userID` owns it
+func (s *Service) getOwnedSpot(ctx context.Context, userID int64, spotID uuid.UUID) (parkingspot.Entry, error) {
+	spot, err := s.getSpot(ctx, spotID)
+	if err != nil {
+		return parkingspot.Entry{}, err
+	}
+	if spot.OwnerID != userID {
+		// Yields not found to prevent leaking existence information
+		return parkingspot.Entry{}, models.ErrParkingSpotNotFound
+	}
+	return spot, nil
+}
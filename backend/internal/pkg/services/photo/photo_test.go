@@ -0,0 +1,370 @@
+package photo
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/blob"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/spotphoto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+// Create implements spotphoto.Repository.
+func (m *mockRepo) Create(ctx context.Context, entry *spotphoto.Entry, limit int) (spotphoto.Entry, error) {
+	args := m.Called(ctx, entry, limit)
+	return args.Get(0).(spotphoto.Entry), args.Error(1)
+}
+
+// GetBySpot implements spotphoto.Repository.
+func (m *mockRepo) GetBySpot(ctx context.Context, spotID int64) ([]spotphoto.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).([]spotphoto.Entry), args.Error(1)
+}
+
+// GetByUUID implements spotphoto.Repository.
+func (m *mockRepo) GetByUUID(ctx context.Context, spotID int64, photoID uuid.UUID) (spotphoto.Entry, error) {
+	args := m.Called(ctx, spotID, photoID)
+	return args.Get(0).(spotphoto.Entry), args.Error(1)
+}
+
+// Delete implements spotphoto.Repository.
+func (m *mockRepo) Delete(ctx context.Context, spotID int64, photoID uuid.UUID) (spotphoto.Entry, error) {
+	args := m.Called(ctx, spotID, photoID)
+	return args.Get(0).(spotphoto.Entry), args.Error(1)
+}
+
+// Reorder implements spotphoto.Repository.
+func (m *mockRepo) Reorder(ctx context.Context, spotID int64, order []uuid.UUID) ([]spotphoto.Entry, error) {
+	args := m.Called(ctx, spotID, order)
+	return args.Get(0).([]spotphoto.Entry), args.Error(1)
+}
+
+type mockParkingspotRepo struct {
+	mock.Mock
+}
+
+// Create implements parkingspot.Repository.
+func (m *mockParkingspotRepo) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (parkingspot.Entry, []models.TimeUnit, error) {
+	args := m.Called(ctx, userID, spot)
+	return args.Get(0).(parkingspot.Entry), args.Get(1).([]models.TimeUnit), args.Error(2)
+}
+
+// GetByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetByUUID(ctx context.Context, spotID uuid.UUID) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// GetOwnerByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetOwnerByUUID(ctx context.Context, spotID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetAvailByUUID(ctx context.Context, spotID uuid.UUID, startDate, endDate time.Time) ([]models.TimeUnit, error) {
+	args := m.Called(ctx, spotID, startDate, endDate)
+	return args.Get(0).([]models.TimeUnit), args.Error(1)
+}
+
+// GetMany implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetMany(ctx context.Context, limit int, filter *parkingspot.Filter) ([]parkingspot.GetManyEntry, error) {
+	args := m.Called(ctx, limit, filter)
+	return args.Get(0).([]parkingspot.GetManyEntry), args.Error(1)
+}
+
+// UpdateSpotByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateSpotByUUID(ctx context.Context, spotID uuid.UUID, updateSpot *models.ParkingSpotUpdateInput) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID, updateSpot)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// UpdateAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateAvailByUUID(ctx context.Context, spotID uuid.UUID, updateTimes *models.ParkingSpotAvailUpdateInput) error {
+	args := m.Called(ctx, spotID, updateTimes)
+	return args.Error(0)
+}
+
+const (
+	testOwnerID    = int64(1)
+	testUserID     = int64(2)
+	testSpotIntID  = int64(10)
+	testImageWidth = 64
+)
+
+var testSpotID = uuid.New()
+
+func newSpotRepo() *mockParkingspotRepo {
+	spotRepo := new(mockParkingspotRepo)
+	spotRepo.On("GetByUUID", mock.Anything, testSpotID).
+		Return(parkingspot.Entry{
+			ParkingSpot: models.ParkingSpot{ID: testSpotID},
+			InternalID:  testSpotIntID,
+			OwnerID:     testOwnerID,
+		}, nil)
+	spotRepo.On("GetByUUID", mock.Anything, mock.Anything).
+		Return(parkingspot.Entry{}, parkingspot.ErrNotFound)
+	return spotRepo
+}
+
+func newStore(t *testing.T) *blob.Local {
+	t.Helper()
+
+	store, err := blob.NewLocal(t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func samplePNG(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, testImageWidth, testImageWidth/2))))
+	return buf.Bytes()
+}
+
+// Returns a stored photo of the test spot
+func storedPhoto(t *testing.T, store blob.BlobStore) spotphoto.Entry {
+	t.Helper()
+
+	entry := spotphoto.Entry{
+		ContentType: "image/png",
+		SpotID:      testSpotIntID,
+		ID:          uuid.New(),
+	}
+	entry.ImageKey, entry.ThumbnailKey = blobKeys(testSpotID, entry.ContentType)
+	require.NoError(t, store.Put(context.Background(), entry.ImageKey, []byte("image"), entry.ContentType))
+	require.NoError(t, store.Put(context.Background(), entry.ThumbnailKey, []byte("thumbnail"), entry.ContentType))
+	return entry
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("create okay", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		store := newStore(t)
+		photoID := uuid.New()
+		var created *spotphoto.Entry
+		repo.On("GetBySpot", mock.Anything, testSpotIntID).Return([]spotphoto.Entry{}, nil).Once()
+		repo.On("Create", mock.Anything, mock.Anything, MaxPhotos).
+			Run(func(args mock.Arguments) { created = args.Get(1).(*spotphoto.Entry) }).
+			Return(spotphoto.Entry{ID: photoID, Width: testImageWidth, Height: testImageWidth / 2}, nil).Once()
+		srv := New(repo, newSpotRepo(), store)
+
+		result, err := srv.Create(ctx, testOwnerID, testSpotID, samplePNG(t))
+		require.NoError(t, err)
+		assert.Equal(t, models.ParkingSpotPhoto{ID: photoID, Width: testImageWidth, Height: testImageWidth / 2}, result)
+		repo.AssertExpectations(t)
+
+		require.NotNil(t, created)
+		assert.Equal(t, testSpotIntID, created.SpotID)
+		assert.Equal(t, "image/png", created.ContentType)
+		assert.Equal(t, testImageWidth, created.Width)
+		_, err = store.Get(ctx, created.ImageKey)
+		require.NoError(t, err)
+		_, err = store.Get(ctx, created.ThumbnailKey)
+		require.NoError(t, err)
+	})
+
+	t.Run("only the owner can add photos", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, newSpotRepo(), newStore(t))
+
+		_, err := srv.Create(ctx, testUserID, testSpotID, samplePNG(t))
+		require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		_, err = srv.Create(ctx, testOwnerID, uuid.New(), samplePNG(t))
+		require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("too many photos", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetBySpot", mock.Anything, testSpotIntID).Return(make([]spotphoto.Entry, MaxPhotos), nil).Once()
+		srv := New(repo, newSpotRepo(), newStore(t))
+
+		_, err := srv.Create(ctx, testOwnerID, testSpotID, samplePNG(t))
+		require.ErrorIs(t, err, models.ErrTooManyPhotos)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("unsupported image", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetBySpot", mock.Anything, testSpotIntID).Return([]spotphoto.Entry{}, nil).Once()
+		srv := New(repo, newSpotRepo(), newStore(t))
+
+		_, err := srv.Create(ctx, testOwnerID, testSpotID, []byte("GIF89a"))
+		require.ErrorIs(t, err, models.ErrPhotoUnsupported)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("images are removed when the limit is reached concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		store := newStore(t)
+		var created *spotphoto.Entry
+		repo.On("GetBySpot", mock.Anything, testSpotIntID).Return([]spotphoto.Entry{}, nil).Once()
+		repo.On("Create", mock.Anything, mock.Anything, MaxPhotos).
+			Run(func(args mock.Arguments) { created = args.Get(1).(*spotphoto.Entry) }).
+			Return(spotphoto.Entry{}, spotphoto.ErrLimitReached).Once()
+		srv := New(repo, newSpotRepo(), store)
+
+		_, err := srv.Create(ctx, testOwnerID, testSpotID, samplePNG(t))
+		require.ErrorIs(t, err, models.ErrTooManyPhotos)
+		repo.AssertExpectations(t)
+
+		require.NotNil(t, created)
+		_, err = store.Get(ctx, created.ImageKey)
+		require.ErrorIs(t, err, blob.ErrNotFound)
+		_, err = store.Get(ctx, created.ThumbnailKey)
+		require.ErrorIs(t, err, blob.ErrNotFound)
+	})
+}
+
+func TestGetImage(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	store := newStore(t)
+	entry := storedPhoto(t, store)
+	repo := new(mockRepo)
+	repo.On("GetByUUID", mock.Anything, testSpotIntID, entry.ID).Return(entry, nil)
+	repo.On("GetByUUID", mock.Anything, testSpotIntID, mock.Anything).Return(spotphoto.Entry{}, spotphoto.ErrNotFound)
+	srv := New(repo, newSpotRepo(), store)
+
+	data, contentType, err := srv.GetImage(ctx, testSpotID, entry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("image"), data)
+	assert.Equal(t, "image/png", contentType)
+
+	data, contentType, err = srv.GetThumbnail(ctx, testSpotID, entry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("thumbnail"), data)
+	assert.Equal(t, "image/png", contentType)
+
+	_, _, err = srv.GetImage(ctx, testSpotID, uuid.New())
+	require.ErrorIs(t, err, models.ErrPhotoNotFound)
+	_, _, err = srv.GetThumbnail(ctx, uuid.New(), entry.ID)
+	require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+}
+
+func TestReorder(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("reorder okay", func(t *testing.T) {
+		t.Parallel()
+
+		order := []uuid.UUID{uuid.New(), uuid.New()}
+		repo := new(mockRepo)
+		repo.On("Reorder", mock.Anything, testSpotIntID, order).
+			Return([]spotphoto.Entry{{ID: order[0]}, {ID: order[1]}}, nil).Once()
+		srv := New(repo, newSpotRepo(), newStore(t))
+
+		result, err := srv.Reorder(ctx, testOwnerID, testSpotID, &models.ParkingSpotPhotoOrderInput{Photos: order})
+		require.NoError(t, err)
+		assert.Equal(t, []models.ParkingSpotPhoto{{ID: order[0]}, {ID: order[1]}}, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("order must list every photo", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("Reorder", mock.Anything, testSpotIntID, mock.Anything).
+			Return([]spotphoto.Entry(nil), spotphoto.ErrOrderMismatch).Once()
+		srv := New(repo, newSpotRepo(), newStore(t))
+
+		_, err := srv.Reorder(ctx, testOwnerID, testSpotID, &models.ParkingSpotPhotoOrderInput{Photos: []uuid.UUID{uuid.New()}})
+		require.ErrorIs(t, err, models.ErrInvalidPhotoOrder)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("only the owner can reorder photos", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, newSpotRepo(), newStore(t))
+
+		_, err := srv.Reorder(ctx, testUserID, testSpotID, &models.ParkingSpotPhotoOrderInput{})
+		require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("delete okay", func(t *testing.T) {
+		t.Parallel()
+
+		store := newStore(t)
+		entry := storedPhoto(t, store)
+		repo := new(mockRepo)
+		repo.On("Delete", mock.Anything, testSpotIntID, entry.ID).Return(entry, nil).Once()
+		srv := New(repo, newSpotRepo(), store)
+
+		require.NoError(t, srv.Delete(ctx, testOwnerID, testSpotID, entry.ID))
+		repo.AssertExpectations(t)
+
+		_, err := store.Get(ctx, entry.ImageKey)
+		require.ErrorIs(t, err, blob.ErrNotFound)
+		_, err = store.Get(ctx, entry.ThumbnailKey)
+		require.ErrorIs(t, err, blob.ErrNotFound)
+	})
+
+	t.Run("photo not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("Delete", mock.Anything, testSpotIntID, mock.Anything).Return(spotphoto.Entry{}, spotphoto.ErrNotFound).Once()
+		srv := New(repo, newSpotRepo(), newStore(t))
+
+		err := srv.Delete(ctx, testOwnerID, testSpotID, uuid.New())
+		require.ErrorIs(t, err, models.ErrPhotoNotFound)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("only the owner can delete photos", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, newSpotRepo(), newStore(t))
+
+		err := srv.Delete(ctx, testUserID, testSpotID, uuid.New())
+		require.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,213 @@
+package promocode
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"math"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/admin"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/promocode"
+	"github.com/aarondl/opt/omit"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Largest number of entries returned per request
+const MaximumCount = 1000
+
+type Service struct {
+	repo      promocode.Repository
+	adminRepo admin.Repository
+	spotRepo  parkingspot.Repository
+}
+
+func New(repo promocode.Repository, adminRepo admin.Repository, spotRepo parkingspot.Repository) *Service {
+	return &Service{
+		repo:      repo,
+		adminRepo: adminRepo,
+		spotRepo:  spotRepo,
+	}
+}
+
+func validatePromoCodeCreationInput(input *models.PromoCodeCreationInput) error {
+	if promocode.NormalizeCode(input.Code) == "" {
+		return models.ErrInvalidPromoCode
+	}
+	if math.IsNaN(input.DiscountValue) || math.IsInf(input.DiscountValue, 0) || input.DiscountValue <= 0 {
+		return models.ErrInvalidDiscount
+	}
+	switch input.DiscountType {
+	case models.DiscountPercentage:
+		if input.DiscountValue > 100 {
+			return models.ErrInvalidDiscount
+		}
+	case models.DiscountFixed:
+	default:
+		return models.ErrInvalidDiscount
+	}
+	if math.IsNaN(input.MinimumSpend) || math.IsInf(input.MinimumSpend, 0) || input.MinimumSpend < 0 {
+		return models.ErrInvalidMinimumSpend
+	}
+	if input.MaxUses < 0 || input.MaxUsesPerUser < 0 {
+		return models.ErrInvalidPromoCodeLimit
+	}
+	return nil
+}
+
+// Create a new promo code as the administrator `userID`.
+func (s *Service) Create(ctx context.Context, userID int64, input *models.PromoCodeCreationInput) (models.PromoCode, error) {
+	err := s.checkAdmin(ctx, userID)
+	if err != nil {
+		return models.PromoCode{}, err
+	}
+
+	if err := validatePromoCodeCreationInput(input); err != nil {
+		return models.PromoCode{}, err
+	}
+
+	creationInput := promocode.CreateInput{
+		PromoCodeCreationInput: *input,
+	}
+	creationInput.Code = promocode.NormalizeCode(input.Code)
+	if input.ParkingSpotID != uuid.Nil {
+		spot, err := s.spotRepo.GetByUUID(ctx, input.ParkingSpotID)
+		if err != nil {
+			if errors.Is(err, parkingspot.ErrNotFound) {
+				err = models.ErrParkingSpotNotFound
+			}
+			return models.PromoCode{}, err
+		}
+		creationInput.SpotID = spot.InternalID
+	}
+
+	result, err := s.repo.Create(ctx, &creationInput)
+	if err != nil {
+		switch {
+		case errors.Is(err, promocode.ErrDuplicateCode):
+			err = models.ErrPromoCodeExists
+		case errors.Is(err, promocode.ErrOwnerNotFound):
+			err = models.ErrPromoCodeOwnerNotFound
+		case errors.Is(err, promocode.ErrInvalidAmount):
+			err = models.ErrInvalidDiscount
+		}
+		return models.PromoCode{}, err
+	}
+	return result.PromoCode, nil
+}
+
+// Get at most `count` promo codes as the administrator `userID`.
+//
+// If there are more entries following the result, a non-empty cursor will be returned
+// which can be passed to the next invocation to get the next entries.
+func (s *Service) GetMany(ctx context.Context, userID int64, count int, after models.Cursor) (promoCodes []models.PromoCode, next models.Cursor, err error) {
+	err = s.checkAdmin(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if count <= 0 {
+		return []models.PromoCode{}, "", nil
+	}
+
+	cursor := decodeCursor(after)
+	count = min(count, MaximumCount)
+	entries, err := s.repo.GetMany(ctx, count+1, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(entries) > count {
+		entries = entries[:len(entries)-1]
+
+		next, err = encodeCursor(promocode.Cursor{
+			ID: entries[len(entries)-1].InternalID,
+		})
+		// This is an issue, but not enough to abort the request
+		if err != nil {
+			log.Err(err).
+				Int64("userid", userID).
+				Int64("promocodeid", entries[len(entries)-1].InternalID).
+				Msg("could not encode next cursor")
+		}
+	}
+
+	result := make([]models.PromoCode, 0, len(entries))
+	for idx := range entries {
+		result = append(result, entries[idx].PromoCode)
+	}
+	return result, next, nil
+}
+
+// Get the promo code with `id` as the administrator `userID`.
+func (s *Service) GetByUUID(ctx context.Context, userID int64, id uuid.UUID) (models.PromoCode, error) {
+	err := s.checkAdmin(ctx, userID)
+	if err != nil {
+		return models.PromoCode{}, err
+	}
+
+	result, err := s.repo.GetByUUID(ctx, id)
+	if err != nil {
+		if errors.Is(err, promocode.ErrNotFound) {
+			err = models.ErrPromoCodeNotFound
+		}
+		return models.PromoCode{}, err
+	}
+	return result.PromoCode, nil
+}
+
+// Expire the promo code with `id` immediately as the administrator `userID`.
+//
+// Bookings already made with the code are not affected.
+func (s *Service) ExpireByUUID(ctx context.Context, userID int64, id uuid.UUID) (models.PromoCode, error) {
+	err := s.checkAdmin(ctx, userID)
+	if err != nil {
+		return models.PromoCode{}, err
+	}
+
+	result, err := s.repo.ExpireByUUID(ctx, id)
+	if err != nil {
+		if errors.Is(err, promocode.ErrNotFound) {
+			err = models.ErrPromoCodeNotFound
+		}
+		return models.PromoCode{}, err
+	}
+	return result.PromoCode, nil
+}
+
+func (s *Service) checkAdmin(ctx context.Context, userID int64) error {
+	isAdmin, err := s.adminRepo.IsAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return models.ErrNotAdministrator
+	}
+	return nil
+}
+
+func decodeCursor(cursor models.Cursor) omit.Val[promocode.Cursor] {
+	raw, err := base64.RawURLEncoding.DecodeString(string(cursor))
+	if err != nil {
+		return omit.Val[promocode.Cursor]{}
+	}
+
+	var result promocode.Cursor
+	err = cbor.Unmarshal(raw, &result)
+	if err != nil {
+		return omit.Val[promocode.Cursor]{}
+	}
+
+	return omit.From(result)
+}
+
+func encodeCursor(cursor promocode.Cursor) (models.Cursor, error) {
+	raw, err := cbor.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return models.Cursor(base64.RawURLEncoding.EncodeToString(raw)), nil
+}
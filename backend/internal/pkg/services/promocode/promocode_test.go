@@ -0,0 +1,387 @@
+package promocode
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/promocode"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+type mockAdminRepo struct {
+	mock.Mock
+}
+
+type mockParkingspotRepo struct {
+	mock.Mock
+}
+
+// Create implements promocode.Repository.
+func (m *mockRepo) Create(ctx context.Context, input *promocode.CreateInput) (promocode.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(promocode.Entry), args.Error(1)
+}
+
+// GetByUUID implements promocode.Repository.
+func (m *mockRepo) GetByUUID(ctx context.Context, id uuid.UUID) (promocode.Entry, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(promocode.Entry), args.Error(1)
+}
+
+// GetByCode implements promocode.Repository.
+func (m *mockRepo) GetByCode(ctx context.Context, code string) (promocode.Entry, error) {
+	args := m.Called(ctx, code)
+	return args.Get(0).(promocode.Entry), args.Error(1)
+}
+
+// GetMany implements promocode.Repository.
+func (m *mockRepo) GetMany(ctx context.Context, limit int, after omit.Val[promocode.Cursor]) ([]promocode.Entry, error) {
+	args := m.Called(ctx, limit, after)
+	return args.Get(0).([]promocode.Entry), args.Error(1)
+}
+
+// ExpireByUUID implements promocode.Repository.
+func (m *mockRepo) ExpireByUUID(ctx context.Context, id uuid.UUID) (promocode.Entry, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(promocode.Entry), args.Error(1)
+}
+
+// IsAdmin implements admin.Repository.
+func (m *mockAdminRepo) IsAdmin(ctx context.Context, userID int64) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+// Create implements parkingspot.Repository.
+func (m *mockParkingspotRepo) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (parkingspot.Entry, []models.TimeUnit, error) {
+	args := m.Called(ctx, userID, spot)
+	return args.Get(0).(parkingspot.Entry), args.Get(1).([]models.TimeUnit), args.Error(2)
+}
+
+// GetByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetByUUID(ctx context.Context, spotID uuid.UUID) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// GetOwnerByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetOwnerByUUID(ctx context.Context, spotID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetAvailByUUID(ctx context.Context, spotID uuid.UUID, startDate, endDate time.Time) ([]models.TimeUnit, error) {
+	args := m.Called(ctx, spotID, startDate, endDate)
+	return args.Get(0).([]models.TimeUnit), args.Error(1)
+}
+
+// GetMany implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetMany(ctx context.Context, limit int, filter *parkingspot.Filter) ([]parkingspot.GetManyEntry, error) {
+	args := m.Called(ctx, limit, filter)
+	return args.Get(0).([]parkingspot.GetManyEntry), args.Error(1)
+}
+
+// UpdateSpotByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateSpotByUUID(ctx context.Context, spotID uuid.UUID, updateSpot *models.ParkingSpotUpdateInput) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID, updateSpot)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// UpdateAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateAvailByUUID(ctx context.Context, spotID uuid.UUID, updateTimes *models.ParkingSpotAvailUpdateInput) error {
+	args := m.Called(ctx, spotID, updateTimes)
+	return args.Error(0)
+}
+
+const (
+	testAdminID    = int64(1)
+	testNonAdminID = int64(2)
+	testSpotID     = int64(3)
+)
+
+var testSpotUUID = uuid.New()
+
+var sampleInput = models.PromoCodeCreationInput{
+	Code:          "spring10",
+	DiscountType:  models.DiscountPercentage,
+	DiscountValue: 10,
+}
+
+func newAdminRepo() *mockAdminRepo {
+	adminRepo := new(mockAdminRepo)
+	adminRepo.On("IsAdmin", mock.Anything, testAdminID).Return(true, nil).
+		On("IsAdmin", mock.Anything, mock.Anything).Return(false, nil)
+	return adminRepo
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("creates a normalized code restricted to a spot", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		srv := New(repo, newAdminRepo(), spotRepo)
+
+		input := sampleInput
+		input.ParkingSpotID = testSpotUUID
+		expectedInput := promocode.CreateInput{
+			PromoCodeCreationInput: input,
+			SpotID:                 testSpotID,
+		}
+		expectedInput.Code = "SPRING10"
+		expected := models.PromoCode{
+			PromoCodeCreationInput: expectedInput.PromoCodeCreationInput,
+			ID:                     uuid.New(),
+		}
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(parkingspot.Entry{InternalID: testSpotID}, nil).
+			Once()
+		repo.On("Create", mock.Anything, &expectedInput).
+			Return(promocode.Entry{PromoCode: expected}, nil).
+			Once()
+
+		result, err := srv.Create(ctx, testAdminID, &input)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		spotRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("non-administrators are forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, newAdminRepo(), nil)
+
+		_, err := srv.Create(ctx, testNonAdminID, &sampleInput)
+		assert.ErrorIs(t, err, models.ErrNotAdministrator)
+		repo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("invalid inputs", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			modify func(input *models.PromoCodeCreationInput)
+			err    error
+			name   string
+		}{
+			{name: "blank code", err: models.ErrInvalidPromoCode, modify: func(input *models.PromoCodeCreationInput) { input.Code = "  " }},
+			{name: "zero discount", err: models.ErrInvalidDiscount, modify: func(input *models.PromoCodeCreationInput) { input.DiscountValue = 0 }},
+			{name: "NaN discount", err: models.ErrInvalidDiscount, modify: func(input *models.PromoCodeCreationInput) { input.DiscountValue = math.NaN() }},
+			{name: "percentage over 100", err: models.ErrInvalidDiscount, modify: func(input *models.PromoCodeCreationInput) { input.DiscountValue = 101 }},
+			{name: "unknown discount type", err: models.ErrInvalidDiscount, modify: func(input *models.PromoCodeCreationInput) { input.DiscountType = "bogo" }},
+			{name: "negative minimum spend", err: models.ErrInvalidMinimumSpend, modify: func(input *models.PromoCodeCreationInput) { input.MinimumSpend = -1 }},
+			{name: "negative usage limit", err: models.ErrInvalidPromoCodeLimit, modify: func(input *models.PromoCodeCreationInput) { input.MaxUsesPerUser = -1 }},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				t.Parallel()
+
+				repo := new(mockRepo)
+				srv := New(repo, newAdminRepo(), nil)
+
+				input := sampleInput
+				test.modify(&input)
+				_, err := srv.Create(ctx, testAdminID, &input)
+				assert.ErrorIs(t, err, test.err)
+				repo.AssertNotCalled(t, "Create")
+			})
+		}
+	})
+
+	t.Run("repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			repoErr error
+			err     error
+			name    string
+		}{
+			{name: "duplicate code", repoErr: promocode.ErrDuplicateCode, err: models.ErrPromoCodeExists},
+			{name: "unknown owner", repoErr: promocode.ErrOwnerNotFound, err: models.ErrPromoCodeOwnerNotFound},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				t.Parallel()
+
+				repo := new(mockRepo)
+				srv := New(repo, newAdminRepo(), nil)
+
+				repo.On("Create", mock.Anything, mock.Anything).
+					Return(promocode.Entry{}, test.repoErr).
+					Once()
+
+				_, err := srv.Create(ctx, testAdminID, &sampleInput)
+				assert.ErrorIs(t, err, test.err)
+				repo.AssertExpectations(t)
+			})
+		}
+	})
+
+	t.Run("spot not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		srv := New(repo, newAdminRepo(), spotRepo)
+
+		spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+			Return(parkingspot.Entry{}, parkingspot.ErrNotFound).
+			Once()
+
+		input := sampleInput
+		input.ParkingSpotID = testSpotUUID
+		_, err := srv.Create(ctx, testAdminID, &input)
+		assert.ErrorIs(t, err, models.ErrParkingSpotNotFound)
+		repo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestGetMany(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	samplePromoCodes := []models.PromoCode{
+		{PromoCodeCreationInput: sampleInput, ID: uuid.New()},
+		{PromoCodeCreationInput: sampleInput, ID: uuid.New()},
+		{PromoCodeCreationInput: sampleInput, ID: uuid.New()},
+	}
+	sampleEntries := make([]promocode.Entry, 0, len(samplePromoCodes))
+	for idx, promoCode := range samplePromoCodes {
+		sampleEntries = append(sampleEntries, promocode.Entry{
+			PromoCode:  promoCode,
+			InternalID: int64(idx),
+		})
+	}
+
+	t.Run("request with next cursor", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		repo.On("GetMany", mock.Anything, 3, omit.Val[promocode.Cursor]{}).
+			Return(sampleEntries, nil).
+			Once()
+		srv := New(repo, newAdminRepo(), nil)
+
+		promoCodes, nextCursor, err := srv.GetMany(ctx, testAdminID, 2, "")
+		require.NoError(t, err)
+		assert.NotEmpty(t, nextCursor)
+		assert.Equal(t, samplePromoCodes[:2], promoCodes)
+
+		repo.On("GetMany", mock.Anything, 3, omit.From(promocode.Cursor{ID: sampleEntries[1].InternalID})).
+			Return(sampleEntries[2:], nil).
+			Once()
+		promoCodes, nextCursor, err = srv.GetMany(ctx, testAdminID, 2, nextCursor)
+		require.NoError(t, err)
+		assert.Empty(t, nextCursor)
+		assert.Equal(t, samplePromoCodes[2:], promoCodes)
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("non-administrators are forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, newAdminRepo(), nil)
+
+		_, _, err := srv.GetMany(ctx, testNonAdminID, 2, "")
+		assert.ErrorIs(t, err, models.ErrNotAdministrator)
+		repo.AssertNotCalled(t, "GetMany")
+	})
+}
+
+func TestGetByUUID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, newAdminRepo(), nil)
+
+		id := uuid.New()
+		repo.On("GetByUUID", mock.Anything, id).
+			Return(promocode.Entry{}, promocode.ErrNotFound).
+			Once()
+
+		_, err := srv.GetByUUID(ctx, testAdminID, id)
+		assert.ErrorIs(t, err, models.ErrPromoCodeNotFound)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("non-administrators are forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, newAdminRepo(), nil)
+
+		_, err := srv.GetByUUID(ctx, testNonAdminID, uuid.New())
+		assert.ErrorIs(t, err, models.ErrNotAdministrator)
+		repo.AssertNotCalled(t, "GetByUUID")
+	})
+}
+
+func TestExpireByUUID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("expires the code", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, newAdminRepo(), nil)
+
+		expected := models.PromoCode{PromoCodeCreationInput: sampleInput, ID: uuid.New()}
+		expiresAt := time.Now()
+		expected.ExpiresAt = &expiresAt
+		repo.On("ExpireByUUID", mock.Anything, expected.ID).
+			Return(promocode.Entry{PromoCode: expected}, nil).
+			Once()
+
+		result, err := srv.ExpireByUUID(ctx, testAdminID, expected.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("non-administrators are forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, newAdminRepo(), nil)
+
+		_, err := srv.ExpireByUUID(ctx, testNonAdminID, uuid.New())
+		assert.ErrorIs(t, err, models.ErrNotAdministrator)
+		repo.AssertNotCalled(t, "ExpireByUUID")
+	})
+}
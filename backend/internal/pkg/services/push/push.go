@@ -0,0 +1,143 @@
+package push
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/device"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/push"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Service manages the devices of users and pushes notifications to them.
+type Service struct {
+	repo    device.Repository
+	pushers map[string]push.Pusher
+}
+
+// Create a new push service, delivering to devices of each platform through `pushers`.
+//
+// Devices on platforms without a pusher are still registered, but never receive notifications.
+func New(repo device.Repository, pushers map[string]push.Pusher) *Service {
+	return &Service{
+		repo:    repo,
+		pushers: pushers,
+	}
+}
+
+// Register a device of `userID` to receive push notifications.
+//
+// Registering a token already known moves the device to `userID`.
+func (s *Service) Register(ctx context.Context, userID int64, input *models.DeviceInput) (models.Device, error) {
+	existing, err := s.repo.GetMany(ctx, userID)
+	if err != nil {
+		return models.Device{}, err
+	}
+	if len(existing) >= models.MaximumDevicesPerUser {
+		registered := false
+		for i := range existing {
+			if existing[i].Token == input.Token {
+				registered = true
+				break
+			}
+		}
+		if !registered {
+			return models.Device{}, models.ErrTooManyDevices
+		}
+	}
+
+	entry, err := s.repo.Create(ctx, &device.CreateInput{
+		DeviceInput: *input,
+		UserID:      userID,
+	})
+	if err != nil {
+		return models.Device{}, err
+	}
+	return entry.Device, nil
+}
+
+// Get all devices of `userID`.
+func (s *Service) GetMany(ctx context.Context, userID int64) ([]models.Device, error) {
+	entries, err := s.repo.GetMany(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Device, 0, len(entries))
+	for i := range entries {
+		result = append(result, entries[i].Device)
+	}
+	return result, nil
+}
+
+// Delete the device `deviceID` if `userID` owns the resource.
+func (s *Service) DeleteByUUID(ctx context.Context, userID int64, deviceID uuid.UUID) error {
+	entry, err := s.repo.GetByUUID(ctx, deviceID)
+	if err != nil {
+		if errors.Is(err, device.ErrNotFound) {
+			err = models.ErrDeviceNotFound
+		}
+		return err
+	}
+	// Pretend that devices of other users do not exist
+	if entry.UserID != userID {
+		return models.ErrDeviceNotFound
+	}
+
+	err = s.repo.DeleteByUUID(ctx, deviceID)
+	if err != nil {
+		if errors.Is(err, device.ErrNotFound) {
+			err = models.ErrDeviceNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Push the notification `sent` to every device of `userID`.
+//
+// Devices rejected by their provider are unregistered.
+func (s *Service) Notify(ctx context.Context, userID int64, sent *models.Notification) error {
+	devices, err := s.repo.GetMany(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	message := push.Message{
+		Data: map[string]string{
+			"type":            sent.Type,
+			"notification_id": sent.ID.String(),
+		},
+		Title: sent.Title,
+		Body:  sent.Body,
+	}
+	if sent.SubjectID != uuid.Nil {
+		message.Data["subject_id"] = sent.SubjectID.String()
+	}
+
+	var errs []error
+	for i := range devices {
+		pusher, ok := s.pushers[devices[i].Platform]
+		if !ok {
+			continue
+		}
+
+		err := pusher.Push(ctx, devices[i].Token, &message)
+		if errors.Is(err, push.ErrInvalidToken) {
+			err = s.repo.DeleteByToken(ctx, devices[i].Token)
+			if err == nil || errors.Is(err, device.ErrNotFound) {
+				log.Ctx(ctx).
+					Debug().
+					Stringer("deviceid", devices[i].ID).
+					Msg("unregistered device rejected by push provider")
+				continue
+			}
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,310 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/device"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/push"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+// Create implements device.Repository.
+func (m *mockRepo) Create(ctx context.Context, input *device.CreateInput) (device.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(device.Entry), args.Error(1)
+}
+
+// GetByUUID implements device.Repository.
+func (m *mockRepo) GetByUUID(ctx context.Context, deviceID uuid.UUID) (device.Entry, error) {
+	args := m.Called(ctx, deviceID)
+	return args.Get(0).(device.Entry), args.Error(1)
+}
+
+// GetMany implements device.Repository.
+func (m *mockRepo) GetMany(ctx context.Context, userID int64) ([]device.Entry, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]device.Entry), args.Error(1)
+}
+
+// DeleteByUUID implements device.Repository.
+func (m *mockRepo) DeleteByUUID(ctx context.Context, deviceID uuid.UUID) error {
+	args := m.Called(ctx, deviceID)
+	return args.Error(0)
+}
+
+// DeleteByToken implements device.Repository.
+func (m *mockRepo) DeleteByToken(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+const (
+	testUserID  = int64(1)
+	testOtherID = int64(2)
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	input := &models.DeviceInput{
+		Platform: models.DevicePlatformAndroid,
+		Token:    "some-token",
+	}
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		service := New(repo, nil)
+
+		expected := models.Device{
+			CreatedAt: time.Now(),
+			Platform:  models.DevicePlatformAndroid,
+			ID:        uuid.New(),
+		}
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return([]device.Entry{}, nil).
+			Once()
+		repo.On("Create", mock.Anything, &device.CreateInput{
+			DeviceInput: *input,
+			UserID:      testUserID,
+		}).
+			Return(device.Entry{Device: expected, Token: input.Token, UserID: testUserID}, nil).
+			Once()
+
+		result, err := service.Register(ctx, testUserID, input)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("too many devices", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		service := New(repo, nil)
+
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return(make([]device.Entry, models.MaximumDevicesPerUser), nil).
+			Once()
+
+		_, err := service.Register(ctx, testUserID, input)
+		require.ErrorIs(t, err, models.ErrTooManyDevices)
+
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("registered tokens do not count toward the limit", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		service := New(repo, nil)
+
+		existing := make([]device.Entry, models.MaximumDevicesPerUser)
+		existing[3].Token = input.Token
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return(existing, nil).
+			Once()
+		repo.On("Create", mock.Anything, mock.Anything).
+			Return(device.Entry{}, nil).
+			Once()
+
+		_, err := service.Register(ctx, testUserID, input)
+		require.NoError(t, err)
+
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestDeleteByUUID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	deviceID := uuid.New()
+
+	t.Run("all good", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		service := New(repo, nil)
+
+		repo.On("GetByUUID", mock.Anything, deviceID).
+			Return(device.Entry{UserID: testUserID}, nil).
+			Once()
+		repo.On("DeleteByUUID", mock.Anything, deviceID).
+			Return(nil).
+			Once()
+
+		err := service.DeleteByUUID(ctx, testUserID, deviceID)
+		require.NoError(t, err)
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("device of another user", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		service := New(repo, nil)
+
+		repo.On("GetByUUID", mock.Anything, deviceID).
+			Return(device.Entry{UserID: testOtherID}, nil).
+			Once()
+
+		err := service.DeleteByUUID(ctx, testUserID, deviceID)
+		require.ErrorIs(t, err, models.ErrDeviceNotFound)
+
+		repo.AssertNotCalled(t, "DeleteByUUID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("device not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		service := New(repo, nil)
+
+		repo.On("GetByUUID", mock.Anything, deviceID).
+			Return(device.Entry{}, device.ErrNotFound).
+			Once()
+
+		err := service.DeleteByUUID(ctx, testUserID, deviceID)
+		require.ErrorIs(t, err, models.ErrDeviceNotFound)
+	})
+}
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	sent := &models.Notification{
+		Type:      models.NotificationBookingConfirmed,
+		Title:     "Booking confirmed",
+		Body:      "Your booking is confirmed.",
+		SubjectID: uuid.New(),
+		ID:        uuid.New(),
+	}
+	devices := []device.Entry{
+		{Token: "android-token", Device: models.Device{Platform: models.DevicePlatformAndroid}},
+		{Token: "ios-token", Device: models.Device{Platform: models.DevicePlatformIOS}},
+	}
+
+	t.Run("pushes to every device", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		fcm := push.NewRecorder()
+		apns := push.NewRecorder()
+		service := New(repo, map[string]push.Pusher{
+			models.DevicePlatformAndroid: fcm,
+			models.DevicePlatformIOS:     apns,
+		})
+
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return(devices, nil).
+			Once()
+
+		err := service.Notify(ctx, testUserID, sent)
+		require.NoError(t, err)
+
+		expected := push.Message{
+			Data: map[string]string{
+				"type":            sent.Type,
+				"notification_id": sent.ID.String(),
+				"subject_id":      sent.SubjectID.String(),
+			},
+			Title: sent.Title,
+			Body:  sent.Body,
+		}
+		assert.Equal(t, []push.Recorded{{Message: expected, Token: "android-token"}}, fcm.Pushes())
+		assert.Equal(t, []push.Recorded{{Message: expected, Token: "ios-token"}}, apns.Pushes())
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("devices without a provider are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		fcm := push.NewRecorder()
+		service := New(repo, map[string]push.Pusher{
+			models.DevicePlatformAndroid: fcm,
+		})
+
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return(devices, nil).
+			Once()
+
+		err := service.Notify(ctx, testUserID, sent)
+		require.NoError(t, err)
+		assert.Len(t, fcm.Pushes(), 1)
+	})
+
+	t.Run("invalid tokens are unregistered", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		fcm := push.NewRecorder()
+		fcm.Invalidate("android-token")
+		service := New(repo, map[string]push.Pusher{
+			models.DevicePlatformAndroid: fcm,
+		})
+
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return(devices, nil).
+			Once()
+		repo.On("DeleteByToken", mock.Anything, "android-token").
+			Return(nil).
+			Once()
+
+		err := service.Notify(ctx, testUserID, sent)
+		require.NoError(t, err)
+		assert.Empty(t, fcm.Pushes())
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("push failures are reported", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		pushErr := errors.New("some error")
+		service := New(repo, map[string]push.Pusher{
+			models.DevicePlatformAndroid: failingPusher{err: pushErr},
+		})
+
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return(devices, nil).
+			Once()
+
+		err := service.Notify(ctx, testUserID, sent)
+		require.ErrorIs(t, err, pushErr)
+	})
+}
+
+type failingPusher struct {
+	err error
+}
+
+// Push implements push.Pusher.
+func (p failingPusher) Push(context.Context, string, *push.Message) error {
+	return p.err
+}
@@ -0,0 +1,248 @@
+package savedsearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/region"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/savedsearch"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/services/notification"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/timeofday"
+	"github.com/google/uuid"
+)
+
+// Minimum duration between two notifications of the same saved search
+const Cooldown = time.Hour
+
+// Service notifies drivers when new parking matches the searches they saved.
+type Service struct {
+	repo     savedsearch.Repository
+	spotRepo parkingspot.Repository
+	sender   notification.Sender
+}
+
+func New(repo savedsearch.Repository, spotRepo parkingspot.Repository, sender notification.Sender) *Service {
+	return &Service{
+		repo:     repo,
+		spotRepo: spotRepo,
+		sender:   sender,
+	}
+}
+
+// Save a search for `userID`.
+func (s *Service) Create(ctx context.Context, userID int64, input *models.SavedSearchInput) (models.SavedSearch, error) {
+	criteria, err := criteriaFromInput(input)
+	if err != nil {
+		return models.SavedSearch{}, err
+	}
+
+	existing, err := s.repo.GetMany(ctx, userID)
+	if err != nil {
+		return models.SavedSearch{}, err
+	}
+	if len(existing) >= models.MaximumSavedSearchesPerUser {
+		return models.SavedSearch{}, models.ErrTooManySavedSearches
+	}
+
+	entry, err := s.repo.Create(ctx, &savedsearch.CreateInput{
+		Name:     input.Name,
+		Criteria: criteria,
+		UserID:   userID,
+	})
+	if err != nil {
+		return models.SavedSearch{}, err
+	}
+	return searchFromEntry(&entry), nil
+}
+
+// Get all saved searches of `userID`, newest first.
+func (s *Service) GetMany(ctx context.Context, userID int64) ([]models.SavedSearch, error) {
+	entries, err := s.repo.GetMany(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.SavedSearch, 0, len(entries))
+	for idx := range entries {
+		result = append(result, searchFromEntry(&entries[idx]))
+	}
+	return result, nil
+}
+
+// Delete the saved search `searchID` of `userID`.
+func (s *Service) DeleteByUUID(ctx context.Context, userID int64, searchID uuid.UUID) error {
+	entry, err := s.repo.GetByUUID(ctx, searchID)
+	if err != nil {
+		if errors.Is(err, savedsearch.ErrNotFound) {
+			err = models.ErrSavedSearchNotFound
+		}
+		return err
+	}
+	// Pretend that searches of other users do not exist
+	if entry.UserID != userID {
+		return models.ErrSavedSearchNotFound
+	}
+
+	err = s.repo.DeleteByUUID(ctx, searchID)
+	if err != nil {
+		if errors.Is(err, savedsearch.ErrNotFound) {
+			err = models.ErrSavedSearchNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Notify the drivers whose saved searches match the time slots of `event` that become available.
+//
+// Subscribes to availability events of the outbox.
+func (s *Service) HandleAvailability(ctx context.Context, event *models.AvailabilityEvent) error {
+	switch event.Type {
+	case models.AvailabilityEventAdded, models.AvailabilityEventReleased:
+	default:
+		// Slots are not becoming available
+		return nil
+	}
+
+	spotEntry, err := s.spotRepo.GetByUUID(ctx, event.SpotID)
+	if err != nil {
+		if errors.Is(err, parkingspot.ErrNotFound) {
+			// The spot was deleted since
+			return nil
+		}
+		return err
+	}
+
+	now := time.Now()
+	notifiedBefore := now.Add(-Cooldown)
+	candidates, err := s.repo.GetCandidates(ctx, &savedsearch.Target{
+		Features:     spotEntry.Features,
+		Longitude:    spotEntry.Location.Longitude,
+		Latitude:     spotEntry.Location.Latitude,
+		PricePerHour: spotEntry.PricePerHour,
+		OwnerID:      spotEntry.OwnerID,
+	}, notifiedBefore)
+	if err != nil {
+		return err
+	}
+
+	// Time conditions are evaluated in the local time of the spot
+	loc := region.TimeZone(spotEntry.Location.CountryCode, spotEntry.Location.State)
+	matchCounts := make(map[int64]int, len(candidates))
+	ids := make([]int64, 0, len(candidates))
+	for idx := range candidates {
+		candidate := &candidates[idx]
+		count := 0
+		for _, unit := range event.Times {
+			if candidate.MatchesTime(unit.StartTime.In(loc)) {
+				count++
+			}
+		}
+		if count > 0 {
+			matchCounts[candidate.InternalID] = count
+			ids = append(ids, candidate.InternalID)
+		}
+	}
+
+	claimed, err := s.repo.Claim(ctx, ids, notifiedBefore, now)
+	if err != nil {
+		return err
+	}
+	claimedIDs := make(map[int64]struct{}, len(claimed))
+	for _, id := range claimed {
+		claimedIDs[id] = struct{}{}
+	}
+
+	for idx := range candidates {
+		candidate := &candidates[idx]
+		if _, ok := claimedIDs[candidate.InternalID]; !ok {
+			continue
+		}
+
+		count := matchCounts[candidate.InternalID]
+		slots := "time slots are"
+		if count == 1 {
+			slots = "time slot is"
+		}
+		_, err := s.sender.Send(ctx, candidate.UserID, &models.NotificationInput{
+			Type:      models.NotificationSavedSearchMatch,
+			Title:     fmt.Sprintf("New match for %q", candidate.Name),
+			Body:      fmt.Sprintf("%d matching %s available at %s, %s.", count, slots, spotEntry.Location.StreetAddress, spotEntry.Location.City),
+			SubjectID: event.SpotID,
+			EventID:   event.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func criteriaFromInput(input *models.SavedSearchInput) (savedsearch.Criteria, error) {
+	if input.MaxPricePerHour < 0 || math.IsNaN(input.MaxPricePerHour) || math.IsInf(input.MaxPricePerHour, 0) {
+		return savedsearch.Criteria{}, models.ErrInvalidMaxPrice
+	}
+
+	result := savedsearch.Criteria{
+		Features:        input.Features,
+		Longitude:       input.Longitude,
+		Latitude:        input.Latitude,
+		MaxPricePerHour: input.MaxPricePerHour,
+		Distance:        input.Distance,
+		StartMinute:     0,
+		EndMinute:       timeofday.MinutesPerDay,
+		Weekdays:        timeofday.AllWeekdays,
+	}
+
+	if len(input.Weekdays) > 0 {
+		weekdays, ok := timeofday.ParseWeekdays(input.Weekdays)
+		if !ok {
+			return savedsearch.Criteria{}, models.ErrInvalidSavedSearchTime
+		}
+		result.Weekdays = weekdays
+	}
+
+	if input.StartTime != "" {
+		minute, ok := timeofday.ParseMinute(input.StartTime)
+		if !ok {
+			return savedsearch.Criteria{}, models.ErrInvalidSavedSearchTime
+		}
+		result.StartMinute = minute
+	}
+	if input.EndTime != "" {
+		minute, ok := timeofday.ParseMinute(input.EndTime)
+		if !ok {
+			return savedsearch.Criteria{}, models.ErrInvalidSavedSearchTime
+		}
+		result.EndMinute = minute
+	}
+	if result.StartMinute >= result.EndMinute {
+		return savedsearch.Criteria{}, models.ErrInvalidSavedSearchTime
+	}
+
+	return result, nil
+}
+
+func searchFromEntry(entry *savedsearch.Entry) models.SavedSearch {
+	result := models.SavedSearch{
+		CreatedAt: entry.CreatedAt,
+		SavedSearchInput: models.SavedSearchInput{
+			Name:            entry.Name,
+			StartTime:       timeofday.FormatMinute(entry.StartMinute),
+			EndTime:         timeofday.FormatMinute(entry.EndMinute),
+			Longitude:       entry.Longitude,
+			Latitude:        entry.Latitude,
+			MaxPricePerHour: entry.MaxPricePerHour,
+			Distance:        entry.Distance,
+			Features:        entry.Features,
+		},
+		ID: entry.ID,
+	}
+	result.Weekdays = timeofday.FormatWeekdays(entry.Weekdays)
+	return result
+}
@@ -0,0 +1,390 @@
+package savedsearch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/savedsearch"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/timeofday"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+// Create implements savedsearch.Repository.
+func (m *mockRepo) Create(ctx context.Context, input *savedsearch.CreateInput) (savedsearch.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(savedsearch.Entry), args.Error(1)
+}
+
+// GetByUUID implements savedsearch.Repository.
+func (m *mockRepo) GetByUUID(ctx context.Context, searchID uuid.UUID) (savedsearch.Entry, error) {
+	args := m.Called(ctx, searchID)
+	return args.Get(0).(savedsearch.Entry), args.Error(1)
+}
+
+// GetMany implements savedsearch.Repository.
+func (m *mockRepo) GetMany(ctx context.Context, userID int64) ([]savedsearch.Entry, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]savedsearch.Entry), args.Error(1)
+}
+
+// DeleteByUUID implements savedsearch.Repository.
+func (m *mockRepo) DeleteByUUID(ctx context.Context, searchID uuid.UUID) error {
+	args := m.Called(ctx, searchID)
+	return args.Error(0)
+}
+
+// GetCandidates implements savedsearch.Repository.
+func (m *mockRepo) GetCandidates(ctx context.Context, target *savedsearch.Target, notifiedBefore time.Time) ([]savedsearch.Entry, error) {
+	args := m.Called(ctx, target, notifiedBefore)
+	return args.Get(0).([]savedsearch.Entry), args.Error(1)
+}
+
+// Claim implements savedsearch.Repository.
+func (m *mockRepo) Claim(ctx context.Context, searchIDs []int64, notifiedBefore, now time.Time) ([]int64, error) {
+	args := m.Called(ctx, searchIDs, notifiedBefore, now)
+	return args.Get(0).([]int64), args.Error(1)
+}
+
+type mockParkingspotRepo struct {
+	mock.Mock
+}
+
+// Create implements parkingspot.Repository.
+func (m *mockParkingspotRepo) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (parkingspot.Entry, []models.TimeUnit, error) {
+	args := m.Called(ctx, userID, spot)
+	return args.Get(0).(parkingspot.Entry), args.Get(1).([]models.TimeUnit), args.Error(2)
+}
+
+// GetByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetByUUID(ctx context.Context, spotID uuid.UUID) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// GetOwnerByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetOwnerByUUID(ctx context.Context, spotID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetAvailByUUID(ctx context.Context, spotID uuid.UUID, startDate, endDate time.Time) ([]models.TimeUnit, error) {
+	args := m.Called(ctx, spotID, startDate, endDate)
+	return args.Get(0).([]models.TimeUnit), args.Error(1)
+}
+
+// GetMany implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetMany(ctx context.Context, limit int, filter *parkingspot.Filter) ([]parkingspot.GetManyEntry, error) {
+	args := m.Called(ctx, limit, filter)
+	return args.Get(0).([]parkingspot.GetManyEntry), args.Error(1)
+}
+
+// UpdateSpotByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateSpotByUUID(ctx context.Context, spotID uuid.UUID, updateSpot *models.ParkingSpotUpdateInput) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID, updateSpot)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// UpdateAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateAvailByUUID(ctx context.Context, spotID uuid.UUID, updateTimes *models.ParkingSpotAvailUpdateInput) error {
+	args := m.Called(ctx, spotID, updateTimes)
+	return args.Error(0)
+}
+
+type mockSender struct {
+	mock.Mock
+}
+
+// Send implements notification.Sender.
+func (m *mockSender) Send(ctx context.Context, userID int64, input *models.NotificationInput) (models.Notification, error) {
+	args := m.Called(ctx, userID, input)
+	return args.Get(0).(models.Notification), args.Error(1)
+}
+
+const (
+	testOwnerID = int64(1)
+	testUserID  = int64(2)
+	testOtherID = int64(3)
+)
+
+var (
+	testSpotUUID  = uuid.New()
+	testSpotEntry = parkingspot.Entry{
+		ParkingSpot: models.ParkingSpot{
+			Location: models.ParkingSpotLocation{
+				CountryCode:   "CA",
+				State:         "MB",
+				StreetAddress: "66 Chancellors Cir",
+				City:          "Winnipeg",
+				Latitude:      49.8075,
+				Longitude:     -97.13517,
+			},
+			Features:     models.ParkingSpotFeatures{Shelter: true},
+			PricePerHour: 4.5,
+			ID:           testSpotUUID,
+		},
+		InternalID: 4,
+		OwnerID:    testOwnerID,
+	}
+	// 9:30 and 10:00 on a Monday in Winnipeg
+	testTimes = []models.TimeUnit{
+		{
+			StartTime: time.Date(2024, time.October, 21, 14, 30, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			StartTime: time.Date(2024, time.October, 21, 15, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, time.October, 21, 15, 30, 0, 0, time.UTC),
+		},
+	}
+	testInput = models.SavedSearchInput{
+		Name:            "Office",
+		StartTime:       "08:00",
+		EndTime:         "17:00",
+		Weekdays:        []string{"monday", "tuesday", "wednesday", "thursday", "friday"},
+		Longitude:       -97.13517,
+		Latitude:        49.8075,
+		MaxPricePerHour: 5,
+		Distance:        500,
+		Features:        models.ParkingSpotFeatures{Shelter: true},
+	}
+	testCriteria = savedsearch.Criteria{
+		Features:        models.ParkingSpotFeatures{Shelter: true},
+		Longitude:       -97.13517,
+		Latitude:        49.8075,
+		MaxPricePerHour: 5,
+		Distance:        500,
+		StartMinute:     8 * 60,
+		EndMinute:       17 * 60,
+		Weekdays:        0b0111110,
+	}
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("save a search", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil)
+
+		expected := savedsearch.Entry{
+			Name:       testInput.Name,
+			Criteria:   testCriteria,
+			ID:         uuid.New(),
+			InternalID: 1,
+			UserID:     testUserID,
+		}
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return([]savedsearch.Entry{}, nil).
+			Once()
+		repo.On("Create", mock.Anything, &savedsearch.CreateInput{
+			Name:     testInput.Name,
+			Criteria: testCriteria,
+			UserID:   testUserID,
+		}).
+			Return(expected, nil).
+			Once()
+
+		result, err := srv.Create(ctx, testUserID, &testInput)
+		require.NoError(t, err)
+		assert.Equal(t, expected.ID, result.ID)
+		assert.Equal(t, testInput, result.SavedSearchInput)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("whole days are the default", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil)
+
+		input := models.SavedSearchInput{
+			Name:      "Anywhere",
+			Longitude: -97.13517,
+			Latitude:  49.8075,
+			Distance:  500,
+		}
+		criteria := savedsearch.Criteria{
+			Longitude:   input.Longitude,
+			Latitude:    input.Latitude,
+			Distance:    input.Distance,
+			StartMinute: 0,
+			EndMinute:   24 * 60,
+			Weekdays:    timeofday.AllWeekdays,
+		}
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return([]savedsearch.Entry{}, nil).
+			Once()
+		repo.On("Create", mock.Anything, &savedsearch.CreateInput{
+			Name:     input.Name,
+			Criteria: criteria,
+			UserID:   testUserID,
+		}).
+			Return(savedsearch.Entry{Name: input.Name, Criteria: criteria}, nil).
+			Once()
+
+		result, err := srv.Create(ctx, testUserID, &input)
+		require.NoError(t, err)
+		assert.Equal(t, "00:00", result.StartTime)
+		assert.Equal(t, "24:00", result.EndTime)
+		assert.Empty(t, result.Weekdays)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid time band", func(t *testing.T) {
+		t.Parallel()
+
+		srv := New(nil, nil, nil)
+
+		input := testInput
+		input.StartTime = "17:00"
+		input.EndTime = "08:00"
+		_, err := srv.Create(ctx, testUserID, &input)
+		require.ErrorIs(t, err, models.ErrInvalidSavedSearchTime)
+	})
+
+	t.Run("too many saved searches", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil)
+
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return(make([]savedsearch.Entry, models.MaximumSavedSearchesPerUser), nil).
+			Once()
+
+		_, err := srv.Create(ctx, testUserID, &testInput)
+		require.ErrorIs(t, err, models.ErrTooManySavedSearches)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	searchID := uuid.New()
+
+	t.Run("delete own search", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil)
+
+		repo.On("GetByUUID", mock.Anything, searchID).
+			Return(savedsearch.Entry{UserID: testUserID}, nil).
+			Once()
+		repo.On("DeleteByUUID", mock.Anything, searchID).
+			Return(nil).
+			Once()
+
+		err := srv.DeleteByUUID(ctx, testUserID, searchID)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("searches of others are hidden", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, nil)
+
+		repo.On("GetByUUID", mock.Anything, searchID).
+			Return(savedsearch.Entry{UserID: testOwnerID}, nil).
+			Once()
+
+		err := srv.DeleteByUUID(ctx, testUserID, searchID)
+		require.ErrorIs(t, err, models.ErrSavedSearchNotFound)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestHandleAvailability(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := new(mockRepo)
+	spotRepo := new(mockParkingspotRepo)
+	sender := new(mockSender)
+	srv := New(repo, spotRepo, sender)
+
+	earlyCriteria := testCriteria
+	earlyCriteria.EndMinute = 10 * 60
+	weekendCriteria := testCriteria
+	weekendCriteria.Weekdays = 0b1000001
+
+	spotRepo.On("GetByUUID", mock.Anything, testSpotUUID).
+		Return(testSpotEntry, nil).
+		Once()
+	repo.On("GetCandidates", mock.Anything, &savedsearch.Target{
+		Features:     testSpotEntry.Features,
+		Longitude:    testSpotEntry.Location.Longitude,
+		Latitude:     testSpotEntry.Location.Latitude,
+		PricePerHour: testSpotEntry.PricePerHour,
+		OwnerID:      testOwnerID,
+	}, mock.Anything).
+		Return([]savedsearch.Entry{
+			{Name: "Office", Criteria: testCriteria, InternalID: 1, UserID: testUserID},
+			{Name: "Morning", Criteria: earlyCriteria, InternalID: 2, UserID: testOtherID},
+			{Name: "Weekend", Criteria: weekendCriteria, InternalID: 3, UserID: testOtherID},
+		}, nil).
+		Once()
+	// Search 1 was notified concurrently
+	repo.On("Claim", mock.Anything, []int64{1, 2}, mock.Anything, mock.Anything).
+		Return([]int64{2}, nil).
+		Once()
+
+	sent := make(chan *models.NotificationInput, 1)
+	sender.On("Send", mock.Anything, testOtherID, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent <- args.Get(2).(*models.NotificationInput)
+		}).
+		Return(models.Notification{}, nil).
+		Once()
+
+	// Not an event of new availability
+	err := srv.HandleAvailability(ctx, &models.AvailabilityEvent{
+		Type:   models.AvailabilityEventBooked,
+		Times:  testTimes,
+		SpotID: testSpotUUID,
+	})
+	require.NoError(t, err)
+	eventID := uuid.New()
+	err = srv.HandleAvailability(ctx, &models.AvailabilityEvent{
+		Type:   models.AvailabilityEventAdded,
+		Times:  testTimes,
+		SpotID: testSpotUUID,
+		ID:     eventID,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 1)
+	input := <-sent
+	assert.Equal(t, models.NotificationSavedSearchMatch, input.Type)
+	assert.Equal(t, testSpotUUID, input.SubjectID)
+	assert.Equal(t, eventID, input.EventID)
+	assert.Equal(t, `New match for "Morning"`, input.Title)
+	assert.Equal(t, "1 matching time slot is available at 66 Chancellors Cir, Winnipeg.", input.Body)
+	spotRepo.AssertExpectations(t)
+	repo.AssertExpectations(t)
+	sender.AssertExpectations(t)
+}
@@ -0,0 +1,456 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/webhook"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/safehttp"
+	"github.com/aarondl/opt/omit"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Largest number of deliveries returned per request
+const MaximumCount = 100
+
+// Number of attempts after which a delivery is given up
+const MaxAttempts = 8
+
+// Delay before the first retry of a delivery, doubled on every following retry
+const BaseRetryDelay = 30 * time.Second
+
+// Longest delay between two attempts of a delivery
+const MaxRetryDelay = 6 * time.Hour
+
+// Interval between two checks for due deliveries
+const DeliveryInterval = 5 * time.Second
+
+// Headers sent along with every delivery
+const (
+	EventHeader     = "X-Parkeasy-Event"
+	DeliveryHeader  = "X-Parkeasy-Delivery"
+	SignatureHeader = "X-Parkeasy-Signature"
+)
+
+const (
+	// Time allowed for an endpoint to respond
+	deliveryTimeout = 10 * time.Second
+	// Largest number of deliveries attempted per check
+	deliveryBatch = 10
+	// Duration for which claimed deliveries are not attempted by other workers.
+	//
+	// Must be longer than attempting a whole batch.
+	deliveryLease = 2 * deliveryBatch * deliveryTimeout
+	// Number of random bytes in a signing secret
+	secretSize = 32
+	// Longest error message recorded for an attempt
+	maxErrorLength = 256
+)
+
+// Service manages webhooks of users and delivers events to them.
+type Service struct {
+	repo     webhook.Repository
+	spotRepo parkingspot.Repository
+	guard    *safehttp.Guard
+	client   *http.Client
+}
+
+// Create a new webhook service, only accepting and delivering to endpoints allowed by `guard`.
+func New(repo webhook.Repository, spotRepo parkingspot.Repository, guard *safehttp.Guard) *Service {
+	return &Service{
+		repo:     repo,
+		spotRepo: spotRepo,
+		guard:    guard,
+		client:   guard.Client(),
+	}
+}
+
+// Create a webhook of `userID`.
+//
+// The returned secret is used to sign deliveries and can not be retrieved afterwards.
+func (s *Service) Create(ctx context.Context, userID int64, input *models.WebhookInput) (models.WebhookWithSecret, error) {
+	endpoint, err := url.Parse(input.URL)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		return models.WebhookWithSecret{}, models.ErrInvalidWebhookURL
+	}
+	// Deliveries are checked again when connecting, in case the host is later resolved elsewhere
+	_, err = s.guard.Resolve(ctx, endpoint.Hostname())
+	if err != nil {
+		return models.WebhookWithSecret{}, models.ErrInvalidWebhookURL
+	}
+
+	existing, err := s.repo.GetMany(ctx, userID)
+	if err != nil {
+		return models.WebhookWithSecret{}, err
+	}
+	if len(existing) >= models.MaximumWebhooksPerUser {
+		return models.WebhookWithSecret{}, models.ErrTooManyWebhooks
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return models.WebhookWithSecret{}, err
+	}
+
+	entry, err := s.repo.Create(ctx, &webhook.CreateInput{
+		Secret:       secret,
+		WebhookInput: *input,
+		UserID:       userID,
+	})
+	if err != nil {
+		return models.WebhookWithSecret{}, err
+	}
+	return models.WebhookWithSecret{
+		Secret:  entry.Secret,
+		Webhook: entry.Webhook,
+	}, nil
+}
+
+// Get all webhooks of `userID`, newest first.
+func (s *Service) GetMany(ctx context.Context, userID int64) ([]models.Webhook, error) {
+	entries, err := s.repo.GetMany(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Webhook, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.Webhook)
+	}
+	return result, nil
+}
+
+// Delete the webhook `webhookID` of `userID`, along with its pending deliveries.
+func (s *Service) DeleteByUUID(ctx context.Context, userID int64, webhookID uuid.UUID) error {
+	_, err := s.get(ctx, userID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteByUUID(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, webhook.ErrNotFound) {
+			err = models.ErrWebhookNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Get at most `count` deliveries to the webhook `webhookID` of `userID`, newest first.
+//
+// If there are more entries following the result, a non-empty cursor will be returned
+// which can be passed to the next invocation to get the next entries.
+func (s *Service) GetDeliveries(ctx context.Context, userID int64, webhookID uuid.UUID, count int, after models.Cursor) (deliveries []models.WebhookDelivery, next models.Cursor, err error) {
+	entry, err := s.get(ctx, userID, webhookID)
+	if err != nil {
+		return nil, "", err
+	}
+	if count <= 0 {
+		return []models.WebhookDelivery{}, "", nil
+	}
+
+	cursor := decodeCursor(after)
+	count = min(count, MaximumCount)
+	entries, err := s.repo.GetDeliveries(ctx, entry.InternalID, count+1, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(entries) > count {
+		entries = entries[:len(entries)-1]
+
+		next, err = encodeCursor(webhook.DeliveryCursor{
+			ID: entries[len(entries)-1].InternalID,
+		})
+		// This is an issue, but not enough to abort the request
+		if err != nil {
+			log.Err(err).
+				Int64("deliveryid", entries[len(entries)-1].InternalID).
+				Msg("could not encode next cursor")
+		}
+	}
+
+	result := make([]models.WebhookDelivery, 0, len(entries))
+	for idx := range entries {
+		result = append(result, entries[idx].WebhookDelivery)
+	}
+	return result, next, nil
+}
+
+// Queue a test event for delivery to the webhook `webhookID` of `userID`.
+func (s *Service) SendTest(ctx context.Context, userID int64, webhookID uuid.UUID) (models.WebhookDelivery, error) {
+	entry, err := s.get(ctx, userID, webhookID)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+
+	event, err := newEvent(models.WebhookEventTest, uuid.New(), struct {
+		WebhookID uuid.UUID `json:"webhook_id"`
+	}{WebhookID: entry.ID})
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+
+	delivery, err := s.repo.EnqueueFor(ctx, entry.InternalID, &event)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	return delivery.WebhookDelivery, nil
+}
+
+// Queue an event of `eventType` about `data` for delivery to the webhooks of `userID`.
+//
+// Publishing the same `eventID` again does not deliver it again to webhooks it was queued for.
+func (s *Service) Publish(ctx context.Context, userID int64, eventID uuid.UUID, eventType string, data any) error {
+	event, err := newEvent(eventType, eventID, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.Enqueue(ctx, userID, &event)
+	return err
+}
+
+// Queue booking and availability events for delivery to the webhooks of spot owners.
+//
+// Subscribes to domain events of the outbox. Events relayed again are not delivered twice.
+func (s *Service) HandleEvent(ctx context.Context, event *models.DomainEvent) error {
+	switch event.Type {
+	case models.EventBookingCreated:
+		var created models.BookingCreatedEvent
+		err := json.Unmarshal(event.Data, &created)
+		if err != nil {
+			return fmt.Errorf("could not decode booking event: %w", err)
+		}
+		return s.Publish(ctx, created.OwnerID, event.ID, models.WebhookEventBookingCreated, &created.Booking)
+	case models.EventAvailabilityChanged:
+		var changed models.AvailabilityEvent
+		err := json.Unmarshal(event.Data, &changed)
+		if err != nil {
+			return fmt.Errorf("could not decode availability event: %w", err)
+		}
+		changed.ID = event.ID
+
+		spotEntry, err := s.spotRepo.GetByUUID(ctx, changed.SpotID)
+		if err != nil {
+			if errors.Is(err, parkingspot.ErrNotFound) {
+				// The spot was deleted since
+				return nil
+			}
+			return err
+		}
+		return s.Publish(ctx, spotEntry.OwnerID, event.ID, models.WebhookEventAvailabilityChanged, &changed)
+	}
+	return nil
+}
+
+// Deliver queued events until `ctx` is cancelled.
+//
+// Deliveries are stored, so events queued before a restart are delivered afterwards.
+func (s *Service) RunDeliveries(ctx context.Context) {
+	ticker := time.NewTicker(DeliveryInterval)
+	defer ticker.Stop()
+
+	for {
+		err := s.deliverDue(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			log.Ctx(ctx).Err(err).Msg("could not deliver webhook events")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Signature of `payload` sent at `timestamp` with `secret`, as sent in `SignatureHeader`.
+//
+// The signature is the hex-encoded HMAC-SHA256 of the timestamp in seconds, a dot and the payload.
+func Signature(secret string, timestamp time.Time, payload []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte{'.'})
+	mac.Write(payload)
+	return "t=" + unix + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) get(ctx context.Context, userID int64, webhookID uuid.UUID) (webhook.Entry, error) {
+	entry, err := s.repo.GetByUUID(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, webhook.ErrNotFound) {
+			err = models.ErrWebhookNotFound
+		}
+		return webhook.Entry{}, err
+	}
+	// Pretend that webhooks of other users do not exist
+	if entry.UserID != userID {
+		return webhook.Entry{}, models.ErrWebhookNotFound
+	}
+	return entry, nil
+}
+
+func (s *Service) deliverDue(ctx context.Context, now time.Time) error {
+	deliveries, err := s.repo.ClaimDue(ctx, now, now.Add(deliveryLease), deliveryBatch)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for idx := range deliveries {
+		delivery := &deliveries[idx]
+		result := s.attempt(ctx, delivery)
+		if ctx.Err() != nil {
+			// The delivery will be attempted again once the lease expires
+			return ctx.Err()
+		}
+
+		err := s.repo.RecordAttempt(ctx, delivery.InternalID, &result)
+		if err != nil && !errors.Is(err, webhook.ErrNotFound) {
+			errs = append(errs, fmt.Errorf("could not record attempt of delivery %v: %w", delivery.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Attempt `delivery`, returning its outcome
+func (s *Service) attempt(ctx context.Context, delivery *webhook.DeliveryEntry) webhook.AttemptResult {
+	now := time.Now()
+	result := webhook.AttemptResult{
+		AttemptedAt: now,
+		Status:      models.WebhookDeliverySucceeded,
+	}
+
+	statusCode, err := s.send(ctx, delivery, now)
+	result.StatusCode = int32(statusCode) //nolint:gosec // HTTP status codes are small
+	if err == nil {
+		return result
+	}
+
+	result.Error = err.Error()
+	if len(result.Error) > maxErrorLength {
+		result.Error = result.Error[:maxErrorLength]
+	}
+	attempts := delivery.Attempts + 1
+	if attempts >= MaxAttempts {
+		result.Status = models.WebhookDeliveryFailed
+	} else {
+		result.Status = models.WebhookDeliveryPending
+		result.NextAttemptAt = now.Add(retryBackoff(attempts))
+	}
+	return result
+}
+
+// POST `delivery` to its webhook, returning the response status if any
+func (s *Service) send(ctx context.Context, delivery *webhook.DeliveryEntry, now time.Time) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	payload := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(SignatureHeader, Signature(delivery.Secret, now, payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain some of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Delay before the next attempt of a delivery attempted `attempts` times
+func retryBackoff(attempts int32) time.Duration {
+	delay := BaseRetryDelay
+	for i := int32(1); i < attempts && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxRetryDelay)
+}
+
+func newEvent(eventType string, eventID uuid.UUID, data any) (webhook.EventInput, error) {
+	encodedData, err := json.Marshal(data)
+	if err != nil {
+		return webhook.EventInput{}, fmt.Errorf("could not encode event data: %w", err)
+	}
+
+	payload, err := json.Marshal(&models.WebhookEvent{
+		CreatedAt: time.Now(),
+		Type:      eventType,
+		Data:      encodedData,
+		ID:        eventID,
+	})
+	if err != nil {
+		return webhook.EventInput{}, fmt.Errorf("could not encode event: %w", err)
+	}
+
+	return webhook.EventInput{
+		Type:    eventType,
+		Payload: string(payload),
+		ID:      eventID,
+	}, nil
+}
+
+func generateSecret() (string, error) {
+	raw := make([]byte, secretSize)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", fmt.Errorf("could not generate webhook secret: %w", err)
+	}
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeCursor(cursor models.Cursor) omit.Val[webhook.DeliveryCursor] {
+	raw, err := base64.RawURLEncoding.DecodeString(string(cursor))
+	if err != nil {
+		return omit.Val[webhook.DeliveryCursor]{}
+	}
+
+	var result webhook.DeliveryCursor
+	err = cbor.Unmarshal(raw, &result)
+	if err != nil {
+		return omit.Val[webhook.DeliveryCursor]{}
+	}
+
+	return omit.From(result)
+}
+
+func encodeCursor(cursor webhook.DeliveryCursor) (models.Cursor, error) {
+	raw, err := cbor.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return models.Cursor(base64.RawURLEncoding.EncodeToString(raw)), nil
+}
@@ -0,0 +1,548 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/models"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/parkingspot"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/repositories/webhook"
+	"github.com/ParkWithEase/parkeasy/backend/internal/pkg/safehttp"
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+// Create implements webhook.Repository.
+func (m *mockRepo) Create(ctx context.Context, input *webhook.CreateInput) (webhook.Entry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(webhook.Entry), args.Error(1)
+}
+
+// GetByUUID implements webhook.Repository.
+func (m *mockRepo) GetByUUID(ctx context.Context, webhookID uuid.UUID) (webhook.Entry, error) {
+	args := m.Called(ctx, webhookID)
+	return args.Get(0).(webhook.Entry), args.Error(1)
+}
+
+// GetMany implements webhook.Repository.
+func (m *mockRepo) GetMany(ctx context.Context, userID int64) ([]webhook.Entry, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]webhook.Entry), args.Error(1)
+}
+
+// DeleteByUUID implements webhook.Repository.
+func (m *mockRepo) DeleteByUUID(ctx context.Context, webhookID uuid.UUID) error {
+	args := m.Called(ctx, webhookID)
+	return args.Error(0)
+}
+
+// Enqueue implements webhook.Repository.
+func (m *mockRepo) Enqueue(ctx context.Context, userID int64, event *webhook.EventInput) (int64, error) {
+	args := m.Called(ctx, userID, event)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// EnqueueFor implements webhook.Repository.
+func (m *mockRepo) EnqueueFor(ctx context.Context, webhookID int64, event *webhook.EventInput) (webhook.DeliveryEntry, error) {
+	args := m.Called(ctx, webhookID, event)
+	return args.Get(0).(webhook.DeliveryEntry), args.Error(1)
+}
+
+// GetDeliveries implements webhook.Repository.
+func (m *mockRepo) GetDeliveries(ctx context.Context, webhookID int64, limit int, after omit.Val[webhook.DeliveryCursor]) ([]webhook.DeliveryEntry, error) {
+	args := m.Called(ctx, webhookID, limit, after)
+	return args.Get(0).([]webhook.DeliveryEntry), args.Error(1)
+}
+
+// ClaimDue implements webhook.Repository.
+func (m *mockRepo) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]webhook.DeliveryEntry, error) {
+	args := m.Called(ctx, now, leaseUntil, limit)
+	return args.Get(0).([]webhook.DeliveryEntry), args.Error(1)
+}
+
+// RecordAttempt implements webhook.Repository.
+func (m *mockRepo) RecordAttempt(ctx context.Context, deliveryID int64, result *webhook.AttemptResult) error {
+	args := m.Called(ctx, deliveryID, result)
+	return args.Error(0)
+}
+
+type mockParkingspotRepo struct {
+	mock.Mock
+}
+
+// Create implements parkingspot.Repository.
+func (m *mockParkingspotRepo) Create(ctx context.Context, userID int64, spot *models.ParkingSpotCreationInput) (parkingspot.Entry, []models.TimeUnit, error) {
+	args := m.Called(ctx, userID, spot)
+	return args.Get(0).(parkingspot.Entry), args.Get(1).([]models.TimeUnit), args.Error(2)
+}
+
+// GetByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetByUUID(ctx context.Context, spotID uuid.UUID) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// GetOwnerByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetOwnerByUUID(ctx context.Context, spotID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetAvailByUUID(ctx context.Context, spotID uuid.UUID, startDate, endDate time.Time) ([]models.TimeUnit, error) {
+	args := m.Called(ctx, spotID, startDate, endDate)
+	return args.Get(0).([]models.TimeUnit), args.Error(1)
+}
+
+// GetMany implements parkingspot.Repository.
+func (m *mockParkingspotRepo) GetMany(ctx context.Context, limit int, filter *parkingspot.Filter) ([]parkingspot.GetManyEntry, error) {
+	args := m.Called(ctx, limit, filter)
+	return args.Get(0).([]parkingspot.GetManyEntry), args.Error(1)
+}
+
+// UpdateSpotByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateSpotByUUID(ctx context.Context, spotID uuid.UUID, updateSpot *models.ParkingSpotUpdateInput) (parkingspot.Entry, error) {
+	args := m.Called(ctx, spotID, updateSpot)
+	return args.Get(0).(parkingspot.Entry), args.Error(1)
+}
+
+// UpdateAvailByUUID implements parkingspot.Repository.
+func (m *mockParkingspotRepo) UpdateAvailByUUID(ctx context.Context, spotID uuid.UUID, updateTimes *models.ParkingSpotAvailUpdateInput) error {
+	args := m.Called(ctx, spotID, updateTimes)
+	return args.Error(0)
+}
+
+const (
+	testOwnerID   = int64(1)
+	testUserID    = int64(2)
+	testWebhookID = int64(3)
+	testSecret    = "whsec_test"
+)
+
+var (
+	testWebhookUUID  = uuid.New()
+	testWebhookEntry = webhook.Entry{
+		Secret: testSecret,
+		Webhook: models.Webhook{
+			WebhookInput: models.WebhookInput{
+				URL:    "https://203.0.113.10/hook",
+				Events: []string{models.WebhookEventBookingCreated},
+			},
+			ID: testWebhookUUID,
+		},
+		InternalID: testWebhookID,
+		UserID:     testUserID,
+	}
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	input := models.WebhookInput{
+		URL:    "https://203.0.113.10/hook",
+		Events: []string{models.WebhookEventBookingCreated},
+	}
+
+	t.Run("generates a secret", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, safehttp.New(false))
+
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return([]webhook.Entry{}, nil).
+			Once()
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(create *webhook.CreateInput) bool {
+			return create.UserID == testUserID && create.WebhookInput.URL == input.URL &&
+				strings.HasPrefix(create.Secret, "whsec_") && len(create.Secret) > 32
+		})).
+			Return(testWebhookEntry, nil).
+			Once()
+
+		result, err := srv.Create(ctx, testUserID, &input)
+		require.NoError(t, err)
+		assert.Equal(t, models.WebhookWithSecret{
+			Secret:  testSecret,
+			Webhook: testWebhookEntry.Webhook,
+		}, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects non-HTTPS URLs", func(t *testing.T) {
+		t.Parallel()
+
+		srv := New(nil, nil, safehttp.New(false))
+
+		for _, url := range []string{"http://203.0.113.10/hook", "https:///hook", "203.0.113.10/hook"} {
+			_, err := srv.Create(ctx, testUserID, &models.WebhookInput{
+				URL:    url,
+				Events: input.Events,
+			})
+			require.ErrorIs(t, err, models.ErrInvalidWebhookURL, url)
+		}
+	})
+
+	t.Run("rejects internal addresses", func(t *testing.T) {
+		t.Parallel()
+
+		srv := New(nil, nil, safehttp.New(false))
+
+		for _, url := range []string{"https://127.0.0.1/hook", "https://[::1]/hook", "https://10.0.0.5/hook", "https://169.254.169.254/latest"} {
+			_, err := srv.Create(ctx, testUserID, &models.WebhookInput{
+				URL:    url,
+				Events: input.Events,
+			})
+			require.ErrorIs(t, err, models.ErrInvalidWebhookURL, url)
+		}
+	})
+
+	t.Run("too many webhooks", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, safehttp.New(false))
+
+		repo.On("GetMany", mock.Anything, testUserID).
+			Return(make([]webhook.Entry, models.MaximumWebhooksPerUser), nil).
+			Once()
+
+		_, err := srv.Create(ctx, testUserID, &input)
+		require.ErrorIs(t, err, models.ErrTooManyWebhooks)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("delete own webhook", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, safehttp.New(false))
+
+		repo.On("GetByUUID", mock.Anything, testWebhookUUID).
+			Return(testWebhookEntry, nil).
+			Once()
+		repo.On("DeleteByUUID", mock.Anything, testWebhookUUID).
+			Return(nil).
+			Once()
+
+		err := srv.DeleteByUUID(ctx, testUserID, testWebhookUUID)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("webhooks of others are hidden", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, safehttp.New(false))
+
+		repo.On("GetByUUID", mock.Anything, testWebhookUUID).
+			Return(testWebhookEntry, nil).
+			Once()
+
+		err := srv.DeleteByUUID(ctx, testOwnerID, testWebhookUUID)
+		require.ErrorIs(t, err, models.ErrWebhookNotFound)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestGetDeliveries(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := new(mockRepo)
+	srv := New(repo, nil, safehttp.New(false))
+
+	entries := []webhook.DeliveryEntry{
+		{WebhookDelivery: models.WebhookDelivery{ID: uuid.New()}, InternalID: 3},
+		{WebhookDelivery: models.WebhookDelivery{ID: uuid.New()}, InternalID: 2},
+		{WebhookDelivery: models.WebhookDelivery{ID: uuid.New()}, InternalID: 1},
+	}
+	repo.On("GetByUUID", mock.Anything, testWebhookUUID).
+		Return(testWebhookEntry, nil).
+		Twice()
+	repo.On("GetDeliveries", mock.Anything, testWebhookID, 3, omit.Val[webhook.DeliveryCursor]{}).
+		Return(entries, nil).
+		Once()
+	repo.On("GetDeliveries", mock.Anything, testWebhookID, 3, omit.From(webhook.DeliveryCursor{ID: 2})).
+		Return(entries[2:], nil).
+		Once()
+
+	result, next, err := srv.GetDeliveries(ctx, testUserID, testWebhookUUID, 2, "")
+	require.NoError(t, err)
+	assert.Equal(t, []models.WebhookDelivery{entries[0].WebhookDelivery, entries[1].WebhookDelivery}, result)
+	require.NotEmpty(t, next)
+
+	result, next, err = srv.GetDeliveries(ctx, testUserID, testWebhookUUID, 2, next)
+	require.NoError(t, err)
+	assert.Equal(t, []models.WebhookDelivery{entries[2].WebhookDelivery}, result)
+	assert.Empty(t, next)
+	repo.AssertExpectations(t)
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repo := new(mockRepo)
+	srv := New(repo, nil, safehttp.New(false))
+
+	eventID := uuid.New()
+	var queued *webhook.EventInput
+	repo.On("Enqueue", mock.Anything, testUserID, mock.Anything).
+		Run(func(args mock.Arguments) {
+			queued = args.Get(2).(*webhook.EventInput)
+		}).
+		Return(int64(1), nil).
+		Once()
+
+	err := srv.Publish(ctx, testUserID, eventID, models.WebhookEventBookingCreated, map[string]string{"hello": "world"})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+
+	require.NotNil(t, queued)
+	assert.Equal(t, eventID, queued.ID)
+	assert.Equal(t, models.WebhookEventBookingCreated, queued.Type)
+
+	var event models.WebhookEvent
+	err = json.Unmarshal([]byte(queued.Payload), &event)
+	require.NoError(t, err)
+	assert.Equal(t, eventID, event.ID)
+	assert.Equal(t, models.WebhookEventBookingCreated, event.Type)
+	assert.JSONEq(t, `{"hello":"world"}`, string(event.Data))
+}
+
+func TestDeliverDue(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	const payload = `{"id":"some event"}`
+
+	t.Run("signed delivery succeeds", func(t *testing.T) {
+		t.Parallel()
+
+		deliveryID := uuid.New()
+		received := make(chan *http.Request, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, payload, string(body))
+
+			// Verify the signature the way receivers would
+			var timestamp int64
+			var signature string
+			for _, part := range strings.Split(r.Header.Get(SignatureHeader), ",") {
+				key, value, _ := strings.Cut(part, "=")
+				switch key {
+				case "t":
+					timestamp, _ = strconv.ParseInt(value, 10, 64)
+				case "v1":
+					signature = value
+				}
+			}
+			expected := Signature(testSecret, time.Unix(timestamp, 0), body)
+			assert.Equal(t, expected, "t="+strconv.FormatInt(timestamp, 10)+",v1="+signature)
+			assert.WithinDuration(t, time.Now(), time.Unix(timestamp, 0), time.Minute)
+
+			received <- r
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		t.Cleanup(server.Close)
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, safehttp.New(true))
+
+		now := time.Now()
+		repo.On("ClaimDue", mock.Anything, now, now.Add(deliveryLease), deliveryBatch).
+			Return([]webhook.DeliveryEntry{{
+				Payload: payload,
+				URL:     server.URL,
+				Secret:  testSecret,
+				WebhookDelivery: models.WebhookDelivery{
+					EventType: models.WebhookEventBookingCreated,
+					Status:    models.WebhookDeliveryPending,
+					ID:        deliveryID,
+				},
+				InternalID: 5,
+			}}, nil).
+			Once()
+		repo.On("RecordAttempt", mock.Anything, int64(5), mock.MatchedBy(func(result *webhook.AttemptResult) bool {
+			return result.Status == models.WebhookDeliverySucceeded && result.StatusCode == http.StatusNoContent &&
+				result.Error == ""
+		})).
+			Return(nil).
+			Once()
+
+		err := srv.deliverDue(ctx, now)
+		require.NoError(t, err)
+
+		req := <-received
+		assert.Equal(t, models.WebhookEventBookingCreated, req.Header.Get(EventHeader))
+		assert.Equal(t, deliveryID.String(), req.Header.Get(DeliveryHeader))
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("failed delivery is retried", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		t.Cleanup(server.Close)
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, safehttp.New(true))
+
+		now := time.Now()
+		repo.On("ClaimDue", mock.Anything, now, mock.Anything, deliveryBatch).
+			Return([]webhook.DeliveryEntry{
+				{Payload: payload, URL: server.URL, InternalID: 5, WebhookDelivery: models.WebhookDelivery{Attempts: 2}},
+				{Payload: payload, URL: server.URL, InternalID: 6, WebhookDelivery: models.WebhookDelivery{Attempts: MaxAttempts - 1}},
+			}, nil).
+			Once()
+		repo.On("RecordAttempt", mock.Anything, int64(5), mock.MatchedBy(func(result *webhook.AttemptResult) bool {
+			return result.Status == models.WebhookDeliveryPending && result.StatusCode == http.StatusInternalServerError &&
+				result.Error != "" && result.NextAttemptAt.Sub(result.AttemptedAt) == 4*BaseRetryDelay
+		})).
+			Return(nil).
+			Once()
+		// Given up after the last attempt
+		repo.On("RecordAttempt", mock.Anything, int64(6), mock.MatchedBy(func(result *webhook.AttemptResult) bool {
+			return result.Status == models.WebhookDeliveryFailed
+		})).
+			Return(nil).
+			Once()
+
+		err := srv.deliverDue(ctx, now)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, BaseRetryDelay, retryBackoff(1))
+	assert.Equal(t, 2*BaseRetryDelay, retryBackoff(2))
+	assert.Equal(t, 8*BaseRetryDelay, retryBackoff(4))
+	assert.Equal(t, MaxRetryDelay, retryBackoff(100))
+}
+
+func TestHandleEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Run("booking created", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		srv := New(repo, nil, safehttp.New(false))
+
+		eventID := uuid.New()
+		booking := models.BookingWithTimes{
+			Booking: models.Booking{ID: uuid.New()},
+		}
+		data, err := json.Marshal(&models.BookingCreatedEvent{
+			Booking:  booking,
+			BookerID: testUserID,
+			OwnerID:  testOwnerID,
+		})
+		require.NoError(t, err)
+
+		repo.On("Enqueue", mock.Anything, testOwnerID, mock.MatchedBy(func(event *webhook.EventInput) bool {
+			// Relaying the event again queues it under the same ID
+			return event.ID == eventID && event.Type == models.WebhookEventBookingCreated
+		})).
+			Return(int64(1), nil).
+			Once()
+
+		err = srv.HandleEvent(ctx, &models.DomainEvent{
+			Type: models.EventBookingCreated,
+			Data: data,
+			ID:   eventID,
+		})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("availability changed", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mockRepo)
+		spotRepo := new(mockParkingspotRepo)
+		srv := New(repo, spotRepo, safehttp.New(false))
+
+		spotID := uuid.New()
+		eventID := uuid.New()
+		data, err := json.Marshal(&models.AvailabilityEvent{
+			Type:   models.AvailabilityEventBooked,
+			Times:  []models.TimeUnit{},
+			SpotID: spotID,
+		})
+		require.NoError(t, err)
+
+		spotRepo.On("GetByUUID", mock.Anything, spotID).
+			Return(parkingspot.Entry{OwnerID: testOwnerID}, nil).
+			Once()
+		var queued *webhook.EventInput
+		repo.On("Enqueue", mock.Anything, testOwnerID, mock.Anything).
+			Run(func(args mock.Arguments) {
+				queued = args.Get(2).(*webhook.EventInput)
+			}).
+			Return(int64(1), nil).
+			Once()
+
+		err = srv.HandleEvent(ctx, &models.DomainEvent{
+			Type: models.EventAvailabilityChanged,
+			Data: data,
+			ID:   eventID,
+		})
+		require.NoError(t, err)
+		spotRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+
+		require.NotNil(t, queued)
+		assert.Equal(t, eventID, queued.ID)
+		assert.Equal(t, models.WebhookEventAvailabilityChanged, queued.Type)
+
+		var event models.WebhookEvent
+		err = json.Unmarshal([]byte(queued.Payload), &event)
+		require.NoError(t, err)
+		var changed models.AvailabilityEvent
+		err = json.Unmarshal(event.Data, &changed)
+		require.NoError(t, err)
+		assert.Equal(t, eventID, changed.ID)
+		assert.Equal(t, spotID, changed.SpotID)
+	})
+}
@@ -0,0 +1,84 @@
+// Days of the week and times of day used by recurring time bands
+package timeofday
+
+import (
+	"fmt"
+	"time"
+)
+
+// Bitmask of all days in a week
+const AllWeekdays = 1<<7 - 1
+
+// Number of minutes in a day
+const MinutesPerDay = 24 * 60
+
+var weekdayNames = [...]string{
+	time.Sunday:    "sunday",
+	time.Monday:    "monday",
+	time.Tuesday:   "tuesday",
+	time.Wednesday: "wednesday",
+	time.Thursday:  "thursday",
+	time.Friday:    "friday",
+	time.Saturday:  "saturday",
+}
+
+// Parse lowercase weekday names into a bitmask indexed by time.Weekday.
+//
+// Returns false if any name is not a weekday.
+func ParseWeekdays(names []string) (int16, bool) {
+	var mask int16
+	for _, name := range names {
+		day := weekdayFromName(name)
+		if day < 0 {
+			return 0, false
+		}
+		mask |= 1 << day
+	}
+	return mask, true
+}
+
+// Format a bitmask indexed by time.Weekday into lowercase weekday names.
+//
+// Returns nil if all days are set.
+func FormatWeekdays(mask int16) []string {
+	if mask == AllWeekdays {
+		return nil
+	}
+	result := make([]string, 0, len(weekdayNames))
+	for day, name := range weekdayNames {
+		if mask&(1<<day) != 0 {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// Parse a 24-hour HH:MM time into minutes since midnight.
+//
+// 24:00 is accepted as the end of day.
+func ParseMinute(value string) (int32, bool) {
+	var hour, minute int32
+	_, err := fmt.Sscanf(value, "%02d:%02d", &hour, &minute)
+	if err != nil || len(value) != len("HH:MM") {
+		return 0, false
+	}
+	if minute < 0 || minute >= 60 || hour < 0 || hour > 24 || (hour == 24 && minute != 0) {
+		return 0, false
+	}
+	return hour*60 + minute, true
+}
+
+// Format minutes since midnight as a 24-hour HH:MM time
+func FormatMinute(minute int32) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// Returns the weekday with `name`, or -1 if there are none
+func weekdayFromName(name string) time.Weekday {
+	for day, dayName := range weekdayNames {
+		if dayName == name {
+			return time.Weekday(day)
+		}
+	}
+	return -1
+}
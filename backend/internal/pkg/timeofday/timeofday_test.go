@@ -0,0 +1,38 @@
+package timeofday
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeekdays(t *testing.T) {
+	t.Parallel()
+
+	mask, ok := ParseWeekdays([]string{"monday", "friday"})
+	assert.True(t, ok)
+	assert.Equal(t, int16(0b0100010), mask)
+	assert.Equal(t, []string{"monday", "friday"}, FormatWeekdays(mask))
+
+	_, ok = ParseWeekdays([]string{"monday", "Friday"})
+	assert.False(t, ok)
+
+	mask, ok = ParseWeekdays([]string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"})
+	assert.True(t, ok)
+	assert.Equal(t, int16(AllWeekdays), mask)
+	assert.Nil(t, FormatWeekdays(mask))
+}
+
+func TestMinute(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"00:00", "09:30", "23:59", "24:00"} {
+		minute, ok := ParseMinute(value)
+		assert.True(t, ok, value)
+		assert.Equal(t, value, FormatMinute(minute))
+	}
+	for _, value := range []string{"", "9:30", "09:60", "24:01", "25:00", "09:30:00", "-1:00"} {
+		_, ok := ParseMinute(value)
+		assert.False(t, ok, value)
+	}
+}